@@ -8,6 +8,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/ctreminiom/go-atlassian/v2/admin/internal"
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
@@ -114,6 +115,71 @@ func WithTokenCallback(callback oauth2.TokenCallback) ClientOption {
 	}
 }
 
+// WithUnknownFieldsReporter reports the response fields that the models don't capture yet, so schema drifts can be detected.
+// The responses are decoded as usual and Call doesn't fail on the unknown fields, report is called with the response
+// and the paths of the unknown fields instead, e.g. to log them.
+func WithUnknownFieldsReporter(report model.UnknownFieldsReporter) ClientOption {
+	return func(c *Client) error {
+		if report == nil {
+			return fmt.Errorf("unknown fields reporter cannot be nil")
+		}
+
+		c.unknownFieldsReporter = report
+		return nil
+	}
+}
+
+// WithDumpRedaction redacts the traces written by ResponseScheme.Dump for the responses of the client, e.g. to scrub
+// the custom authentication headers or the personal data, in addition to the authentication and cookie headers.
+func WithDumpRedaction(redaction *model.DumpRedactionScheme) ClientOption {
+	return func(c *Client) error {
+		if redaction == nil {
+			return fmt.Errorf("dump redaction cannot be nil")
+		}
+
+		c.dumpRedaction = redaction
+		return nil
+	}
+}
+
+// WithoutResponseBytes releases the response body once it's decoded into the structure, so ResponseScheme.Bytes
+// is empty after a successful decode and the large pages aren't held twice in memory.
+// The bodies that aren't decoded, e.g. the downloads, and the bodies of the unsuccessful responses are kept.
+func WithoutResponseBytes() ClientOption {
+	return func(c *Client) error {
+		c.withoutResponseBytes = true
+		return nil
+	}
+}
+
+// WithMaxConnsPerHost limits the connections opened to the site and keeps as many of them idle for reuse, see
+// oauth2.LimitConnsPerHost. The OAuth 2.0 transport and the token storage wrapper are preserved, so the option can be
+// combined with the OAuth options in any order.
+func WithMaxConnsPerHost(maxConns int) ClientOption {
+	return func(c *Client) error {
+		httpClient, err := oauth2.LimitConnsPerHost(c.HTTP, maxConns)
+		if err != nil {
+			return err
+		}
+
+		c.HTTP = httpClient
+		return nil
+	}
+}
+
+// WithIdleTimeout closes the idle connections of the pool after the timeout, see WithMaxConnsPerHost.
+func WithIdleTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) error {
+		httpClient, err := oauth2.SetIdleTimeout(c.HTTP, timeout)
+		if err != nil {
+			return err
+		}
+
+		c.HTTP = httpClient
+		return nil
+	}
+}
+
 // New creates a new instance of Client.
 // It takes a common.HTTPClient and optional configuration options as input and returns a pointer to Client and an error.
 func New(httpClient common.HTTPClient, options ...ClientOption) (*Client, error) {
@@ -180,6 +246,15 @@ type Client struct {
 	User *internal.UserService
 	// SCIM is the service for SCIM-related operations.
 	SCIM *internal.SCIMService
+
+	// unknownFieldsReporter is called with the response fields the models don't capture, see WithUnknownFieldsReporter.
+	unknownFieldsReporter model.UnknownFieldsReporter
+
+	// dumpRedaction is the redaction applied by ResponseScheme.Dump to the responses, see WithDumpRedaction.
+	dumpRedaction *model.DumpRedactionScheme
+
+	// withoutResponseBytes releases the decoded response bodies.
+	withoutResponseBytes bool
 }
 
 // NewRequest creates a new HTTP request with the given context, method, URL string, content type, and body.
@@ -229,6 +304,10 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr, contentType str
 		req.Header.Set("User-Agent", c.Auth.GetUserAgent())
 	}
 
+	for key, values := range model.HeadersFromContext(ctx) {
+		req.Header[key] = values
+	}
+
 	return req, nil
 }
 
@@ -253,48 +332,50 @@ func (c *Client) Do(request *http.Request) (*http.Response, error) {
 
 func (c *Client) processResponse(response *http.Response, structure interface{}) (*model.ResponseScheme, error) {
 
-	defer response.Body.Close()
-
 	res := &model.ResponseScheme{
-		Response: response,
-		Code:     response.StatusCode,
-		Endpoint: response.Request.URL.String(),
-		Method:   response.Request.Method,
+		Response:  response,
+		Code:      response.StatusCode,
+		Endpoint:  response.Request.URL.String(),
+		Method:    response.Request.Method,
+		Redaction: c.dumpRedaction,
+	}
+
+	wasSuccess := response.StatusCode >= 200 && response.StatusCode < 300
+
+	// The body of a streamed response is left open for the caller, see model.StreamedResponseScheme
+	if wasSuccess && model.IsStreamedResponse(structure) {
+		res.Stream = response.Body
+		return res, nil
 	}
 
+	defer response.Body.Close()
+
 	responseAsBytes, err := io.ReadAll(response.Body)
 	if err != nil {
 		return res, err
 	}
 
-	res.Bytes.Write(responseAsBytes)
-
-	wasSuccess := response.StatusCode >= 200 && response.StatusCode < 300
+	res.Bytes = *bytes.NewBuffer(responseAsBytes)
 
 	if !wasSuccess {
 
-		switch response.StatusCode {
-
-		case http.StatusNotFound:
-			return res, fmt.Errorf("client: %w", model.ErrNotFound)
-
-		case http.StatusUnauthorized:
-			return res, fmt.Errorf("client: %w", model.ErrUnauthorized)
-
-		case http.StatusInternalServerError:
-			return res, fmt.Errorf("client: %w", model.ErrInternal)
-
-		case http.StatusBadRequest:
-			return res, fmt.Errorf("client: %w", model.ErrBadRequest)
-
-		default:
-			return res, fmt.Errorf("client: %w", model.ErrInvalidStatusCode)
+		if model.IsExpectedStatus(response.Request.Context(), response.StatusCode) {
+			return res, nil
 		}
+
+		return res, model.NewError(model.ProductAdmin, res)
 	}
 
 	if structure != nil {
 		if err = json.Unmarshal(responseAsBytes, &structure); err != nil {
-			return res, err
+			return res, model.NewDecodeError(res, structure, err)
+		}
+
+		model.ReportUnknownFields(c.unknownFieldsReporter, res, responseAsBytes, structure)
+
+		// The decoded body is released, see WithoutResponseBytes
+		if c.withoutResponseBytes {
+			res.Bytes = bytes.Buffer{}
 		}
 	}
 
@@ -68,6 +68,62 @@ func (o *OrganizationPolicyService) Delete(ctx context.Context, organizationID,
 	return o.internalClient.Delete(ctx, organizationID, policyID)
 }
 
+// AddResource adds a resource to a policy for an org.
+//
+// POST /admin/v1/orgs/{orgId}/policies/{policyId}/resources
+//
+// https://docs.go-atlassian.io/atlassian-admin-cloud/organization/policy
+func (o *OrganizationPolicyService) AddResource(ctx context.Context, organizationID, policyID string, payload *model.OrganizationPolicyResource) (*model.OrganizationPolicyScheme, *model.ResponseScheme, error) {
+	return o.internalClient.AddResource(ctx, organizationID, policyID, payload)
+}
+
+// UpdateResource updates a resource of a policy for an org.
+//
+// PUT /admin/v1/orgs/{orgId}/policies/{policyId}/resources/{resourceId}
+//
+// https://docs.go-atlassian.io/atlassian-admin-cloud/organization/policy
+func (o *OrganizationPolicyService) UpdateResource(ctx context.Context, organizationID, policyID, resourceID string, payload *model.OrganizationPolicyResource) (*model.OrganizationPolicyScheme, *model.ResponseScheme, error) {
+	return o.internalClient.UpdateResource(ctx, organizationID, policyID, resourceID, payload)
+}
+
+// DeleteResource deletes a resource from a policy for an org.
+//
+// DELETE /admin/v1/orgs/{orgId}/policies/{policyId}/resources/{resourceId}
+//
+// https://docs.go-atlassian.io/atlassian-admin-cloud/organization/policy
+func (o *OrganizationPolicyService) DeleteResource(ctx context.Context, organizationID, policyID, resourceID string) (*model.ResponseScheme, error) {
+	return o.internalClient.DeleteResource(ctx, organizationID, policyID, resourceID)
+}
+
+// Validate validates a policy for an org.
+//
+// GET /admin/v1/orgs/{orgId}/policies/{policyId}/validate
+//
+// https://docs.go-atlassian.io/atlassian-admin-cloud/organization/policy
+func (o *OrganizationPolicyService) Validate(ctx context.Context, organizationID, policyID string) (*model.OrganizationPolicyValidationScheme, *model.ResponseScheme, error) {
+	return o.internalClient.Validate(ctx, organizationID, policyID)
+}
+
+// IPAllowlists returns the IP allowlist policies of an org, following the pages of the policies.
+//
+// GET /admin/v1/orgs/{orgId}/policies?type=ip-allowlist
+//
+// https://docs.go-atlassian.io/atlassian-admin-cloud/organization/policy#get-list-of-policies
+func (o *OrganizationPolicyService) IPAllowlists(ctx context.Context, organizationID string) ([]*model.OrganizationPolicyData, error) {
+	return o.internalClient.IPAllowlists(ctx, organizationID)
+}
+
+// DataResidency returns the data residency status of the resources of an org, following the pages of the data residency policies.
+//
+// The application status of each resource reports whether the resource is pinned to the realm of its policy or a move is in progress.
+//
+// GET /admin/v1/orgs/{orgId}/policies?type=data-residency
+//
+// https://docs.go-atlassian.io/atlassian-admin-cloud/organization/policy#get-list-of-policies
+func (o *OrganizationPolicyService) DataResidency(ctx context.Context, organizationID string) ([]*model.DataResidencyResourceScheme, error) {
+	return o.internalClient.DataResidency(ctx, organizationID)
+}
+
 type internalOrganizationPolicyImpl struct {
 	c service.Connector
 }
@@ -201,3 +257,164 @@ func (i *internalOrganizationPolicyImpl) Delete(ctx context.Context, organizatio
 
 	return i.c.Call(request, nil)
 }
+
+func (i *internalOrganizationPolicyImpl) AddResource(ctx context.Context, organizationID, policyID string, payload *model.OrganizationPolicyResource) (*model.OrganizationPolicyScheme, *model.ResponseScheme, error) {
+
+	if organizationID == "" {
+		return nil, nil, fmt.Errorf("admin: %w", model.ErrNoAdminOrganization)
+	}
+
+	if policyID == "" {
+		return nil, nil, fmt.Errorf("admin: %w", model.ErrNoAdminPolicy)
+	}
+
+	endpoint := fmt.Sprintf("admin/v1/orgs/%v/policies/%v/resources", organizationID, policyID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	policy := new(model.OrganizationPolicyScheme)
+	response, err := i.c.Call(request, policy)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return policy, response, nil
+}
+
+func (i *internalOrganizationPolicyImpl) UpdateResource(ctx context.Context, organizationID, policyID, resourceID string, payload *model.OrganizationPolicyResource) (*model.OrganizationPolicyScheme, *model.ResponseScheme, error) {
+
+	if organizationID == "" {
+		return nil, nil, fmt.Errorf("admin: %w", model.ErrNoAdminOrganization)
+	}
+
+	if policyID == "" {
+		return nil, nil, fmt.Errorf("admin: %w", model.ErrNoAdminPolicy)
+	}
+
+	if resourceID == "" {
+		return nil, nil, fmt.Errorf("admin: %w", model.ErrNoAdminResourceID)
+	}
+
+	endpoint := fmt.Sprintf("admin/v1/orgs/%v/policies/%v/resources/%v", organizationID, policyID, resourceID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	policy := new(model.OrganizationPolicyScheme)
+	response, err := i.c.Call(request, policy)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return policy, response, nil
+}
+
+func (i *internalOrganizationPolicyImpl) DeleteResource(ctx context.Context, organizationID, policyID, resourceID string) (*model.ResponseScheme, error) {
+
+	if organizationID == "" {
+		return nil, fmt.Errorf("admin: %w", model.ErrNoAdminOrganization)
+	}
+
+	if policyID == "" {
+		return nil, fmt.Errorf("admin: %w", model.ErrNoAdminPolicy)
+	}
+
+	if resourceID == "" {
+		return nil, fmt.Errorf("admin: %w", model.ErrNoAdminResourceID)
+	}
+
+	endpoint := fmt.Sprintf("admin/v1/orgs/%v/policies/%v/resources/%v", organizationID, policyID, resourceID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalOrganizationPolicyImpl) Validate(ctx context.Context, organizationID, policyID string) (*model.OrganizationPolicyValidationScheme, *model.ResponseScheme, error) {
+
+	if organizationID == "" {
+		return nil, nil, fmt.Errorf("admin: %w", model.ErrNoAdminOrganization)
+	}
+
+	if policyID == "" {
+		return nil, nil, fmt.Errorf("admin: %w", model.ErrNoAdminPolicy)
+	}
+
+	endpoint := fmt.Sprintf("admin/v1/orgs/%v/policies/%v/validate", organizationID, policyID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	validation := new(model.OrganizationPolicyValidationScheme)
+	response, err := i.c.Call(request, validation)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return validation, response, nil
+}
+
+func (i *internalOrganizationPolicyImpl) IPAllowlists(ctx context.Context, organizationID string) ([]*model.OrganizationPolicyData, error) {
+	return i.getsByType(ctx, organizationID, model.OrganizationPolicyTypeIPAllowlist)
+}
+
+func (i *internalOrganizationPolicyImpl) DataResidency(ctx context.Context, organizationID string) ([]*model.DataResidencyResourceScheme, error) {
+
+	policies, err := i.getsByType(ctx, organizationID, model.OrganizationPolicyTypeDataResidency)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []*model.DataResidencyResourceScheme
+	for _, policy := range policies {
+
+		if policy.Attributes == nil {
+			continue
+		}
+
+		for _, resource := range policy.Attributes.Resources {
+			resources = append(resources, &model.DataResidencyResourceScheme{
+				PolicyID:          policy.ID,
+				PolicyName:        policy.Attributes.Name,
+				ResourceID:        resource.ID,
+				ApplicationStatus: resource.ApplicationStatus,
+			})
+		}
+	}
+
+	return resources, nil
+}
+
+// getsByType returns the policies of the type provided, following the cursor of the pages.
+func (i *internalOrganizationPolicyImpl) getsByType(ctx context.Context, organizationID, policyType string) ([]*model.OrganizationPolicyData, error) {
+
+	var (
+		policies []*model.OrganizationPolicyData
+		cursor   string
+	)
+
+	for {
+		page, _, err := i.Gets(ctx, organizationID, policyType, cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		policies = append(policies, page.Data...)
+
+		if page.Meta.Next == "" || page.Meta.Next == cursor {
+			return policies, nil
+		}
+
+		cursor = page.Meta.Next
+	}
+}
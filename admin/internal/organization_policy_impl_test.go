@@ -8,6 +8,7 @@ import (
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"net/http"
 	"net/url"
 	"testing"
@@ -671,3 +672,773 @@ func Test_internalOrganizationPolicyImpl_Delete(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalOrganizationPolicyImpl_AddResource(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx                      context.Context
+		organizationID, policyID string
+		payload                  *model.OrganizationPolicyResource
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:            context.Background(),
+				organizationID: "organization-id-sample",
+				policyID:       "policy-id-sample",
+				payload:        &model.OrganizationPolicyResource{ID: "resource-id-sample", ApplicationStatus: "APPLIED"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"admin/v1/orgs/organization-id-sample/policies/policy-id-sample/resources",
+					"",
+					&model.OrganizationPolicyResource{ID: "resource-id-sample", ApplicationStatus: "APPLIED"}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.OrganizationPolicyScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name: "when the organization id is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoAdminOrganization,
+		},
+
+		{
+			name: "when the policy id is not provided",
+			args: args{
+				ctx:            context.Background(),
+				organizationID: "organization-id-sample",
+			},
+			wantErr: true,
+			Err:     model.ErrNoAdminPolicy,
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:            context.Background(),
+				organizationID: "organization-id-sample",
+				policyID:       "policy-id-sample",
+				payload:        &model.OrganizationPolicyResource{ID: "resource-id-sample", ApplicationStatus: "APPLIED"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"admin/v1/orgs/organization-id-sample/policies/policy-id-sample/resources",
+					"",
+					&model.OrganizationPolicyResource{ID: "resource-id-sample", ApplicationStatus: "APPLIED"}).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewOrganizationPolicyService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.AddResource(testCase.args.ctx, testCase.args.organizationID, testCase.args.policyID, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalOrganizationPolicyImpl_UpdateResource(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx                                  context.Context
+		organizationID, policyID, resourceID string
+		payload                              *model.OrganizationPolicyResource
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:            context.Background(),
+				organizationID: "organization-id-sample",
+				policyID:       "policy-id-sample",
+				resourceID:     "resource-id-sample",
+				payload:        &model.OrganizationPolicyResource{ID: "resource-id-sample", ApplicationStatus: "APPLIED"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"admin/v1/orgs/organization-id-sample/policies/policy-id-sample/resources/resource-id-sample",
+					"",
+					&model.OrganizationPolicyResource{ID: "resource-id-sample", ApplicationStatus: "APPLIED"}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.OrganizationPolicyScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name: "when the organization id is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoAdminOrganization,
+		},
+
+		{
+			name: "when the policy id is not provided",
+			args: args{
+				ctx:            context.Background(),
+				organizationID: "organization-id-sample",
+			},
+			wantErr: true,
+			Err:     model.ErrNoAdminPolicy,
+		},
+
+		{
+			name: "when the resource id is not provided",
+			args: args{
+				ctx:            context.Background(),
+				organizationID: "organization-id-sample",
+				policyID:       "policy-id-sample",
+			},
+			wantErr: true,
+			Err:     model.ErrNoAdminResourceID,
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:            context.Background(),
+				organizationID: "organization-id-sample",
+				policyID:       "policy-id-sample",
+				resourceID:     "resource-id-sample",
+				payload:        &model.OrganizationPolicyResource{ID: "resource-id-sample", ApplicationStatus: "APPLIED"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"admin/v1/orgs/organization-id-sample/policies/policy-id-sample/resources/resource-id-sample",
+					"",
+					&model.OrganizationPolicyResource{ID: "resource-id-sample", ApplicationStatus: "APPLIED"}).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewOrganizationPolicyService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.UpdateResource(testCase.args.ctx, testCase.args.organizationID, testCase.args.policyID, testCase.args.resourceID,
+				testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalOrganizationPolicyImpl_DeleteResource(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx                                  context.Context
+		organizationID, policyID, resourceID string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:            context.Background(),
+				organizationID: "organization-id-sample",
+				policyID:       "policy-id-sample",
+				resourceID:     "resource-id-sample",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"admin/v1/orgs/organization-id-sample/policies/policy-id-sample/resources/resource-id-sample",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name: "when the organization id is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoAdminOrganization,
+		},
+
+		{
+			name: "when the policy id is not provided",
+			args: args{
+				ctx:            context.Background(),
+				organizationID: "organization-id-sample",
+			},
+			wantErr: true,
+			Err:     model.ErrNoAdminPolicy,
+		},
+
+		{
+			name: "when the resource id is not provided",
+			args: args{
+				ctx:            context.Background(),
+				organizationID: "organization-id-sample",
+				policyID:       "policy-id-sample",
+			},
+			wantErr: true,
+			Err:     model.ErrNoAdminResourceID,
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:            context.Background(),
+				organizationID: "organization-id-sample",
+				policyID:       "policy-id-sample",
+				resourceID:     "resource-id-sample",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"admin/v1/orgs/organization-id-sample/policies/policy-id-sample/resources/resource-id-sample",
+					"",
+					nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewOrganizationPolicyService(testCase.fields.c)
+
+			gotResponse, err := newService.DeleteResource(testCase.args.ctx, testCase.args.organizationID, testCase.args.policyID, testCase.args.resourceID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalOrganizationPolicyImpl_Validate(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx                      context.Context
+		organizationID, policyID string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:            context.Background(),
+				organizationID: "organization-id-sample",
+				policyID:       "policy-id-sample",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"admin/v1/orgs/organization-id-sample/policies/policy-id-sample/validate",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.OrganizationPolicyValidationScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name: "when the organization id is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoAdminOrganization,
+		},
+
+		{
+			name: "when the policy id is not provided",
+			args: args{
+				ctx:            context.Background(),
+				organizationID: "organization-id-sample",
+			},
+			wantErr: true,
+			Err:     model.ErrNoAdminPolicy,
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:            context.Background(),
+				organizationID: "organization-id-sample",
+				policyID:       "policy-id-sample",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"admin/v1/orgs/organization-id-sample/policies/policy-id-sample/validate",
+					"",
+					nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewOrganizationPolicyService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Validate(testCase.args.ctx, testCase.args.organizationID, testCase.args.policyID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalOrganizationPolicyImpl_DataResidency(t *testing.T) {
+
+	mockPage := func(client *mocks.Connector, endpoint string, page *model.OrganizationPolicyPageScheme, err error) {
+
+		request := &http.Request{Host: endpoint}
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			endpoint,
+			"",
+			nil).
+			Return(request, nil)
+
+		client.On("Call",
+			request,
+			&model.OrganizationPolicyPageScheme{}).
+			Run(func(args mock.Arguments) {
+				if page != nil {
+					*args.Get(1).(*model.OrganizationPolicyPageScheme) = *page
+				}
+			}).
+			Return(&model.ResponseScheme{}, err)
+	}
+
+	firstPage := &model.OrganizationPolicyPageScheme{
+		Data: []*model.OrganizationPolicyData{
+			{
+				ID:   "policy-id-eu",
+				Type: "policy",
+				Attributes: &model.OrganizationPolicyAttributes{
+					Type:      model.OrganizationPolicyTypeDataResidency,
+					Name:      "EU",
+					Resources: []*model.OrganizationPolicyResource{{ID: "ari:cloud:jira::site/1", ApplicationStatus: "APPLIED"}},
+				},
+			},
+		},
+	}
+	firstPage.Meta.Next = "cursor-sample"
+
+	secondPage := &model.OrganizationPolicyPageScheme{
+		Data: []*model.OrganizationPolicyData{
+			{
+				ID:   "policy-id-us",
+				Type: "policy",
+				Attributes: &model.OrganizationPolicyAttributes{
+					Type:      model.OrganizationPolicyTypeDataResidency,
+					Name:      "US",
+					Resources: []*model.OrganizationPolicyResource{{ID: "ari:cloud:confluence::site/2", ApplicationStatus: "PENDING"}},
+				},
+			},
+		},
+	}
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx            context.Context
+		organizationID string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    []*model.DataResidencyResourceScheme
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the policies are paginated",
+			args: args{
+				ctx:            context.Background(),
+				organizationID: "organization-id-sample",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				mockPage(client, "admin/v1/orgs/organization-id-sample/policies?type=data-residency", firstPage, nil)
+				mockPage(client, "admin/v1/orgs/organization-id-sample/policies?cursor=cursor-sample&type=data-residency", secondPage, nil)
+
+				fields.c = client
+			},
+			want: []*model.DataResidencyResourceScheme{
+				{PolicyID: "policy-id-eu", PolicyName: "EU", ResourceID: "ari:cloud:jira::site/1", ApplicationStatus: "APPLIED"},
+				{PolicyID: "policy-id-us", PolicyName: "US", ResourceID: "ari:cloud:confluence::site/2", ApplicationStatus: "PENDING"},
+			},
+		},
+
+		{
+			name: "when the policies cannot be fetched",
+			args: args{
+				ctx:            context.Background(),
+				organizationID: "organization-id-sample",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				mockPage(client, "admin/v1/orgs/organization-id-sample/policies?type=data-residency", nil, model.ErrUnauthorized)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrUnauthorized,
+		},
+
+		{
+			name: "when the organization id is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoAdminOrganization,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewOrganizationPolicyService(testCase.fields.c)
+
+			gotResult, err := newService.DataResidency(testCase.args.ctx, testCase.args.organizationID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.Equal(t, testCase.want, gotResult)
+			}
+		})
+	}
+}
+
+func Test_internalOrganizationPolicyImpl_IPAllowlists(t *testing.T) {
+
+	policyMocked := &model.OrganizationPolicyData{
+		ID:   "policy-id-sample",
+		Type: "policy",
+		Attributes: &model.OrganizationPolicyAttributes{
+			Type: model.OrganizationPolicyTypeIPAllowlist,
+			Name: "Office",
+			Rule: &model.OrganizationPolicyRuleScheme{IPs: []string{"203.0.113.0/24"}},
+		},
+	}
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx            context.Context
+		organizationID string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    []*model.OrganizationPolicyData
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:            context.Background(),
+				organizationID: "organization-id-sample",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"admin/v1/orgs/organization-id-sample/policies?type=ip-allowlist",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.OrganizationPolicyPageScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.OrganizationPolicyPageScheme).Data = []*model.OrganizationPolicyData{policyMocked}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			want: []*model.OrganizationPolicyData{policyMocked},
+		},
+
+		{
+			name: "when the organization id is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoAdminOrganization,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewOrganizationPolicyService(testCase.fields.c)
+
+			gotResult, err := newService.IPAllowlists(testCase.args.ctx, testCase.args.organizationID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.Equal(t, testCase.want, gotResult)
+			}
+		})
+	}
+}
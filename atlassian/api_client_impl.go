@@ -0,0 +1,168 @@
+// Package atlassian builds the Jira, Agile, Jira Service Management and Confluence clients from one credential set.
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/ctreminiom/go-atlassian/v2/confluence"
+	confluenceV2 "github.com/ctreminiom/go-atlassian/v2/confluence/v2"
+	"github.com/ctreminiom/go-atlassian/v2/jira/agile"
+	"github.com/ctreminiom/go-atlassian/v2/jira/sm"
+	v3 "github.com/ctreminiom/go-atlassian/v2/jira/v3"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/oauth2"
+	"github.com/ctreminiom/go-atlassian/v2/service/common"
+)
+
+// GatewaySite is the site of the Atlassian API gateway, used to reach the products of a cloud ID.
+const GatewaySite = "https://api.atlassian.com/"
+
+// Credentials represents the credential set shared by the product clients.
+//
+// Only one authentication method is used: the OAuth 2.0 token when provided, then the bearer token, then the basic auth.
+type Credentials struct {
+	Email     string // The email of the account, used with the API token for the basic auth.
+	APIToken  string // The API token of the account, used with the email for the basic auth.
+	UserAgent string // The user agent sent by the product clients.
+
+	// BearerToken is sent as a bearer token, e.g. a JWT, a personal access token or an OAuth 2.0 access token without renewal.
+	BearerToken string
+
+	// OAuth configures the OAuth 2.0 service of the product clients.
+	OAuth *common.OAuth2Config
+
+	// Token is the OAuth 2.0 token renewed automatically with the OAuth configuration, the renewal is shared by the product clients.
+	Token *common.OAuth2Token
+
+	// CloudID routes the product clients through the API gateway, as required by the OAuth 2.0 and the scoped API tokens.
+	// The Jira clients use GatewaySite/ex/jira/{cloudID}/ and the Confluence clients use GatewaySite/ex/confluence/{cloudID}/.
+	CloudID string
+
+	// HTTPClient is the HTTP client shared by the product clients, http.DefaultClient when nil.
+	// Wrap it with oauth2.WrapHTTPClient to store or observe the renewed OAuth 2.0 tokens.
+	HTTPClient common.HTTPClient
+}
+
+// Client represents the product clients built from one credential set.
+type Client struct {
+	Jira              *v3.Client
+	Agile             *agile.Client
+	ServiceManagement *sm.Client
+	Confluence        *confluence.Client
+	ConfluenceV2      *confluenceV2.Client
+
+	// OAuth is the OAuth 2.0 service shared by the product clients, nil when the credentials have no OAuth configuration.
+	OAuth common.OAuth2Service
+}
+
+// New returns the Jira, Agile, Jira Service Management and Confluence clients of the site, sharing the authentication transport.
+//
+// The site is ignored when the credentials provide a cloud ID, the clients are routed through the API gateway instead.
+func New(creds *Credentials, site string) (*Client, error) {
+
+	if creds == nil {
+		return nil, models.ErrNoCredentials
+	}
+
+	jiraSite, confluenceSite := site, site
+	if creds.CloudID != "" {
+		jiraSite = fmt.Sprintf("%vex/jira/%v/", GatewaySite, creds.CloudID)
+		confluenceSite = fmt.Sprintf("%vex/confluence/%v/", GatewaySite, creds.CloudID)
+	}
+
+	if strings.TrimSpace(jiraSite) == "" {
+		return nil, models.ErrNoSite
+	}
+
+	httpClient := creds.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	client := new(Client)
+
+	if creds.OAuth != nil {
+
+		oauthService, err := oauth2.NewOAuth2Service(httpClient, creds.OAuth)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create OAuth service: %w", err)
+		}
+
+		client.OAuth = oauthService
+	}
+
+	if creds.Token != nil {
+
+		if client.OAuth == nil {
+			return nil, fmt.Errorf("OAuth must be configured to renew the token (set the OAuth configuration)")
+		}
+
+		_, reuseSource, err := oauth2.SetupTokenSourcesWithStorage(context.Background(), creds.Token, client.OAuth, httpClient)
+		if err != nil {
+			return nil, fmt.Errorf("failed to setup token sources: %w", err)
+		}
+
+		// The transport adds the renewed token to the requests of every product client
+		httpClient = oauth2.CreateOAuthTransport(reuseSource, oauth2.ExtractBaseTransport(httpClient), nil)
+	}
+
+	var err error
+
+	if client.Jira, err = v3.New(httpClient, jiraSite); err != nil {
+		return nil, err
+	}
+
+	if client.Agile, err = agile.New(httpClient, jiraSite); err != nil {
+		return nil, err
+	}
+
+	if client.ServiceManagement, err = sm.New(httpClient, jiraSite); err != nil {
+		return nil, err
+	}
+
+	if client.Confluence, err = confluence.New(httpClient, confluenceSite); err != nil {
+		return nil, err
+	}
+
+	if client.ConfluenceV2, err = confluenceV2.New(httpClient, confluenceSite); err != nil {
+		return nil, err
+	}
+
+	client.Jira.OAuth = client.OAuth
+	client.Agile.OAuth = client.OAuth
+	client.ServiceManagement.OAuth = client.OAuth
+	client.Confluence.OAuth = client.OAuth
+	client.ConfluenceV2.OAuth = client.OAuth
+
+	for _, auth := range client.authentications() {
+
+		if creds.UserAgent != "" {
+			auth.SetUserAgent(creds.UserAgent)
+		}
+
+		switch {
+		case creds.Token != nil:
+			// The OAuth 2.0 transport authenticates the requests
+		case creds.BearerToken != "":
+			auth.SetBearerToken(creds.BearerToken)
+		case creds.Email != "" || creds.APIToken != "":
+			auth.SetBasicAuth(creds.Email, creds.APIToken)
+		}
+	}
+
+	return client, nil
+}
+
+// authentications returns the authentication services of the product clients.
+func (c *Client) authentications() []common.Authentication {
+	return []common.Authentication{
+		c.Jira.Auth,
+		c.Agile.Auth,
+		c.ServiceManagement.Auth,
+		c.Confluence.Auth,
+		c.ConfluenceV2.Auth,
+	}
+}
@@ -0,0 +1,107 @@
+package atlassian
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/common"
+)
+
+func TestNew(t *testing.T) {
+
+	testCases := []struct {
+		name    string
+		creds   *Credentials
+		site    string
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the basic auth credentials are provided",
+			creds: &Credentials{
+				Email:     "example@example.com",
+				APIToken:  "token",
+				UserAgent: "go-atlassian",
+			},
+			site: "https://ctreminiom.atlassian.net",
+		},
+		{
+			name: "when the bearer token is provided",
+			creds: &Credentials{
+				BearerToken: "jwt",
+			},
+			site: "https://ctreminiom.atlassian.net",
+		},
+		{
+			name: "when the cloud id is provided",
+			creds: &Credentials{
+				BearerToken: "jwt",
+				CloudID:     "a436116f-02ce-4520-8fbb-7301462a1674",
+			},
+		},
+		{
+			name:    "when the credentials are not provided",
+			site:    "https://ctreminiom.atlassian.net",
+			wantErr: true,
+			Err:     model.ErrNoCredentials,
+		},
+		{
+			name:    "when the site is not provided",
+			creds:   &Credentials{BearerToken: "jwt"},
+			wantErr: true,
+			Err:     model.ErrNoSite,
+		},
+		{
+			name: "when the token is provided without the oauth configuration",
+			creds: &Credentials{
+				Token: &common.OAuth2Token{AccessToken: "access", RefreshToken: "refresh"},
+			},
+			site:    "https://ctreminiom.atlassian.net",
+			wantErr: true,
+			Err:     errors.New("OAuth must be configured to renew the token (set the OAuth configuration)"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			gotClient, err := New(testCase.creds, testCase.site)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+				assert.Nil(t, gotClient)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotNil(t, gotClient)
+
+			for _, auth := range gotClient.authentications() {
+
+				assert.Equal(t, testCase.creds.UserAgent, auth.GetUserAgent())
+
+				if testCase.creds.BearerToken != "" {
+					assert.Equal(t, testCase.creds.BearerToken, auth.GetBearerToken())
+					continue
+				}
+
+				email, token := auth.GetBasicAuth()
+				assert.Equal(t, testCase.creds.Email, email)
+				assert.Equal(t, testCase.creds.APIToken, token)
+			}
+
+			if testCase.creds.CloudID != "" {
+				assert.Equal(t, "https://api.atlassian.com/ex/jira/"+testCase.creds.CloudID+"/", gotClient.Jira.Site.String())
+				assert.Equal(t, "https://api.atlassian.com/ex/confluence/"+testCase.creds.CloudID+"/", gotClient.Confluence.Site.String())
+			}
+		})
+	}
+}
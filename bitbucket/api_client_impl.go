@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/ctreminiom/go-atlassian/v2/bitbucket/internal"
 	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
@@ -116,6 +117,71 @@ func WithTokenCallback(callback oauth2.TokenCallback) ClientOption {
 	}
 }
 
+// WithUnknownFieldsReporter reports the response fields that the models don't capture yet, so schema drifts can be detected.
+// The responses are decoded as usual and Call doesn't fail on the unknown fields, report is called with the response
+// and the paths of the unknown fields instead, e.g. to log them.
+func WithUnknownFieldsReporter(report models.UnknownFieldsReporter) ClientOption {
+	return func(c *Client) error {
+		if report == nil {
+			return fmt.Errorf("unknown fields reporter cannot be nil")
+		}
+
+		c.unknownFieldsReporter = report
+		return nil
+	}
+}
+
+// WithDumpRedaction redacts the traces written by ResponseScheme.Dump for the responses of the client, e.g. to scrub
+// the custom authentication headers or the personal data, in addition to the authentication and cookie headers.
+func WithDumpRedaction(redaction *models.DumpRedactionScheme) ClientOption {
+	return func(c *Client) error {
+		if redaction == nil {
+			return fmt.Errorf("dump redaction cannot be nil")
+		}
+
+		c.dumpRedaction = redaction
+		return nil
+	}
+}
+
+// WithoutResponseBytes releases the response body once it's decoded into the structure, so ResponseScheme.Bytes
+// is empty after a successful decode and the large pages aren't held twice in memory.
+// The bodies that aren't decoded, e.g. the downloads, and the bodies of the unsuccessful responses are kept.
+func WithoutResponseBytes() ClientOption {
+	return func(c *Client) error {
+		c.withoutResponseBytes = true
+		return nil
+	}
+}
+
+// WithMaxConnsPerHost limits the connections opened to the site and keeps as many of them idle for reuse, see
+// oauth2.LimitConnsPerHost. The OAuth 2.0 transport and the token storage wrapper are preserved, so the option can be
+// combined with the OAuth options in any order.
+func WithMaxConnsPerHost(maxConns int) ClientOption {
+	return func(c *Client) error {
+		httpClient, err := oauth2.LimitConnsPerHost(c.HTTP, maxConns)
+		if err != nil {
+			return err
+		}
+
+		c.HTTP = httpClient
+		return nil
+	}
+}
+
+// WithIdleTimeout closes the idle connections of the pool after the timeout, see WithMaxConnsPerHost.
+func WithIdleTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) error {
+		httpClient, err := oauth2.SetIdleTimeout(c.HTTP, timeout)
+		if err != nil {
+			return err
+		}
+
+		c.HTTP = httpClient
+		return nil
+	}
+}
+
 // New creates a new Bitbucket API client.
 func New(httpClient common.HTTPClient, site string, options ...ClientOption) (*Client, error) {
 
@@ -165,6 +231,15 @@ type Client struct {
 	Auth      common.Authentication
 	OAuth     common.OAuth2Service
 	Workspace *internal.WorkspaceService
+
+	// unknownFieldsReporter is called with the response fields the models don't capture, see WithUnknownFieldsReporter.
+	unknownFieldsReporter models.UnknownFieldsReporter
+
+	// dumpRedaction is the redaction applied by ResponseScheme.Dump to the responses, see WithDumpRedaction.
+	dumpRedaction *models.DumpRedactionScheme
+
+	// withoutResponseBytes releases the decoded response bodies.
+	withoutResponseBytes bool
 }
 
 // NewRequest creates an API request.
@@ -219,6 +294,10 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr, typ string, bod
 		req.Header.Add("Authorization", fmt.Sprintf("Bearer %v", c.Auth.GetBearerToken()))
 	}
 
+	for key, values := range models.HeadersFromContext(ctx) {
+		req.Header[key] = values
+	}
+
 	return req, nil
 }
 
@@ -239,48 +318,50 @@ func (c *Client) Do(request *http.Request) (*http.Response, error) {
 
 func (c *Client) processResponse(response *http.Response, structure interface{}) (*models.ResponseScheme, error) {
 
-	defer response.Body.Close()
-
 	res := &models.ResponseScheme{
-		Response: response,
-		Code:     response.StatusCode,
-		Endpoint: response.Request.URL.String(),
-		Method:   response.Request.Method,
+		Response:  response,
+		Code:      response.StatusCode,
+		Endpoint:  response.Request.URL.String(),
+		Method:    response.Request.Method,
+		Redaction: c.dumpRedaction,
+	}
+
+	wasSuccess := response.StatusCode >= 200 && response.StatusCode < 300
+
+	// The body of a streamed response is left open for the caller, see models.StreamedResponseScheme
+	if wasSuccess && models.IsStreamedResponse(structure) {
+		res.Stream = response.Body
+		return res, nil
 	}
 
+	defer response.Body.Close()
+
 	responseAsBytes, err := io.ReadAll(response.Body)
 	if err != nil {
 		return res, err
 	}
 
-	res.Bytes.Write(responseAsBytes)
-
-	wasSuccess := response.StatusCode >= 200 && response.StatusCode < 300
+	res.Bytes = *bytes.NewBuffer(responseAsBytes)
 
 	if !wasSuccess {
 
-		switch response.StatusCode {
-
-		case http.StatusNotFound:
-			return res, models.ErrNotFound
-
-		case http.StatusUnauthorized:
-			return res, models.ErrUnauthorized
-
-		case http.StatusInternalServerError:
-			return res, models.ErrInternal
-
-		case http.StatusBadRequest:
-			return res, models.ErrBadRequest
-
-		default:
-			return res, models.ErrInvalidStatusCode
+		if models.IsExpectedStatus(response.Request.Context(), response.StatusCode) {
+			return res, nil
 		}
+
+		return res, models.NewError(models.ProductBitbucket, res)
 	}
 
 	if structure != nil {
 		if err = json.Unmarshal(responseAsBytes, &structure); err != nil {
-			return res, err
+			return res, models.NewDecodeError(res, structure, err)
+		}
+
+		models.ReportUnknownFields(c.unknownFieldsReporter, res, responseAsBytes, structure)
+
+		// The decoded body is released, see WithoutResponseBytes
+		if c.withoutResponseBytes {
+			res.Bytes = bytes.Buffer{}
 		}
 	}
 
@@ -0,0 +1,144 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/confluence"
+)
+
+// NewAuditService creates a new instance of AuditService.
+// It takes a service.Connector as input and returns a pointer to AuditService.
+func NewAuditService(client service.Connector) *AuditService {
+	return &AuditService{
+		internalClient: &internalAuditImpl{c: client},
+	}
+}
+
+// AuditService provides methods to interact with the audit records in Confluence.
+type AuditService struct {
+	// internalClient is the connector interface for audit operations.
+	internalClient confluence.AuditConnector
+}
+
+// Get returns all records in the audit log, optionally filtered by date range and search string.
+//
+// GET /wiki/rest/api/audit
+//
+// https://docs.go-atlassian.io/confluence-cloud/audit#get-audit-records
+func (a *AuditService) Get(ctx context.Context, options *model.ConfluenceAuditRecordGetOptions, start, limit int) (*model.ConfluenceAuditRecordPageScheme, *model.ResponseScheme, error) {
+	return a.internalClient.Get(ctx, options, start, limit)
+}
+
+// Create creates a record in the audit log.
+//
+// POST /wiki/rest/api/audit
+//
+// https://docs.go-atlassian.io/confluence-cloud/audit#create-audit-record
+func (a *AuditService) Create(ctx context.Context, payload *model.ConfluenceAuditRecordPayloadScheme) (*model.ConfluenceAuditRecordScheme, *model.ResponseScheme, error) {
+	return a.internalClient.Create(ctx, payload)
+}
+
+// Export exports audit records as a CSV file or ZIP file, the file is available in the ResponseScheme bytes.
+//
+// GET /wiki/rest/api/audit/export
+//
+// https://docs.go-atlassian.io/confluence-cloud/audit#export-audit-records
+func (a *AuditService) Export(ctx context.Context, options *model.ConfluenceAuditRecordGetOptions, format string) (*model.ResponseScheme, error) {
+	return a.internalClient.Export(ctx, options, format)
+}
+
+type internalAuditImpl struct {
+	c service.Connector
+}
+
+func (i *internalAuditImpl) Get(ctx context.Context, options *model.ConfluenceAuditRecordGetOptions, start, limit int) (*model.ConfluenceAuditRecordPageScheme, *model.ResponseScheme, error) {
+
+	query := auditRecordQuery(options)
+	query.Add("start", strconv.Itoa(start))
+	query.Add("limit", strconv.Itoa(limit))
+
+	endpoint := fmt.Sprintf("wiki/rest/api/audit?%v", query.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.ConfluenceAuditRecordPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalAuditImpl) Create(ctx context.Context, payload *model.ConfluenceAuditRecordPayloadScheme) (*model.ConfluenceAuditRecordScheme, *model.ResponseScheme, error) {
+
+	if payload == nil {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoConfluenceAuditRecord)
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, "wiki/rest/api/audit", "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	record := new(model.ConfluenceAuditRecordScheme)
+	response, err := i.c.Call(request, record)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return record, response, nil
+}
+
+func (i *internalAuditImpl) Export(ctx context.Context, options *model.ConfluenceAuditRecordGetOptions, format string) (*model.ResponseScheme, error) {
+
+	query := auditRecordQuery(options)
+
+	if format != "" {
+		query.Add("format", format)
+	}
+
+	endpoint := "wiki/rest/api/audit/export"
+	if query.Encode() != "" {
+		endpoint = fmt.Sprintf("%v?%v", endpoint, query.Encode())
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+// auditRecordQuery builds the filters shared by the audit records listing and export, dates are sent as epoch milliseconds.
+func auditRecordQuery(options *model.ConfluenceAuditRecordGetOptions) url.Values {
+
+	query := url.Values{}
+	if options == nil {
+		return query
+	}
+
+	if options.SearchString != "" {
+		query.Add("searchString", options.SearchString)
+	}
+
+	if !options.StartDate.IsZero() {
+		query.Add("startDate", strconv.FormatInt(options.StartDate.UnixMilli(), 10))
+	}
+
+	if !options.EndDate.IsZero() {
+		query.Add("endDate", strconv.FormatInt(options.EndDate.UnixMilli(), 10))
+	}
+
+	return query
+}
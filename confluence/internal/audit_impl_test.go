@@ -0,0 +1,557 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func Test_internalAuditImpl_Get(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx          context.Context
+		options      *model.ConfluenceAuditRecordGetOptions
+		start, limit int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx: context.Background(),
+				options: &model.ConfluenceAuditRecordGetOptions{
+					SearchString: "space",
+					StartDate:    time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					EndDate:      time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+				},
+				start: 0,
+				limit: 50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/audit?endDate=1706745600000&limit=50&searchString=space&start=0&startDate=1704067200000",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ConfluenceAuditRecordPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name: "when the options are not provided",
+			args: args{
+				ctx:   context.Background(),
+				start: 0,
+				limit: 50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/audit?limit=50&start=0",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ConfluenceAuditRecordPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name: "when the http call cannot be executed",
+			args: args{
+				ctx: context.Background(),
+				options: &model.ConfluenceAuditRecordGetOptions{
+					SearchString: "space",
+					StartDate:    time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					EndDate:      time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+				},
+				start: 0,
+				limit: 50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/audit?endDate=1706745600000&limit=50&searchString=space&start=0&startDate=1704067200000",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ConfluenceAuditRecordPageScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrUnauthorized)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrUnauthorized,
+		},
+
+		{
+			name: "when the request cannot be created",
+			args: args{
+				ctx: context.Background(),
+				options: &model.ConfluenceAuditRecordGetOptions{
+					SearchString: "space",
+					StartDate:    time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					EndDate:      time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+				},
+				start: 0,
+				limit: 50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/audit?endDate=1706745600000&limit=50&searchString=space&start=0&startDate=1704067200000",
+					"",
+					nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewAuditService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Get(testCase.args.ctx, testCase.args.options, testCase.args.start, testCase.args.limit)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalAuditImpl_Create(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx     context.Context
+		payload *model.ConfluenceAuditRecordPayloadScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx: context.Background(),
+				payload: &model.ConfluenceAuditRecordPayloadScheme{
+					RemoteAddress: "127.0.0.1",
+					Summary:       "Space permissions reviewed",
+					Category:      "Permissions",
+					AffectedObject: &model.ConfluenceAuditAffectedObjectScheme{
+						Name:       "DUMMY",
+						ObjectType: "Space",
+					},
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"wiki/rest/api/audit",
+					"",
+					&model.ConfluenceAuditRecordPayloadScheme{
+						RemoteAddress: "127.0.0.1",
+						Summary:       "Space permissions reviewed",
+						Category:      "Permissions",
+						AffectedObject: &model.ConfluenceAuditAffectedObjectScheme{
+							Name:       "DUMMY",
+							ObjectType: "Space",
+						},
+					}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ConfluenceAuditRecordScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name: "when the http call cannot be executed",
+			args: args{
+				ctx: context.Background(),
+				payload: &model.ConfluenceAuditRecordPayloadScheme{
+					RemoteAddress: "127.0.0.1",
+					Summary:       "Space permissions reviewed",
+					Category:      "Permissions",
+					AffectedObject: &model.ConfluenceAuditAffectedObjectScheme{
+						Name:       "DUMMY",
+						ObjectType: "Space",
+					},
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"wiki/rest/api/audit",
+					"",
+					&model.ConfluenceAuditRecordPayloadScheme{
+						RemoteAddress: "127.0.0.1",
+						Summary:       "Space permissions reviewed",
+						Category:      "Permissions",
+						AffectedObject: &model.ConfluenceAuditAffectedObjectScheme{
+							Name:       "DUMMY",
+							ObjectType: "Space",
+						},
+					}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ConfluenceAuditRecordScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrBadRequest)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrBadRequest,
+		},
+
+		{
+			name: "when the request cannot be created",
+			args: args{
+				ctx: context.Background(),
+				payload: &model.ConfluenceAuditRecordPayloadScheme{
+					RemoteAddress: "127.0.0.1",
+					Summary:       "Space permissions reviewed",
+					Category:      "Permissions",
+					AffectedObject: &model.ConfluenceAuditAffectedObjectScheme{
+						Name:       "DUMMY",
+						ObjectType: "Space",
+					},
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"wiki/rest/api/audit",
+					"",
+					&model.ConfluenceAuditRecordPayloadScheme{
+						RemoteAddress: "127.0.0.1",
+						Summary:       "Space permissions reviewed",
+						Category:      "Permissions",
+						AffectedObject: &model.ConfluenceAuditAffectedObjectScheme{
+							Name:       "DUMMY",
+							ObjectType: "Space",
+						},
+					}).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoConfluenceAuditRecord,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewAuditService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Create(testCase.args.ctx, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalAuditImpl_Export(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx     context.Context
+		options *model.ConfluenceAuditRecordGetOptions
+		format  string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx: context.Background(),
+				options: &model.ConfluenceAuditRecordGetOptions{
+					SearchString: "space",
+					StartDate:    time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					EndDate:      time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+				},
+				format: model.ConfluenceAuditExportFormatZIP,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/audit/export?endDate=1706745600000&format=zip&searchString=space&startDate=1704067200000",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name: "when the options are not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/audit/export",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name: "when the http call cannot be executed",
+			args: args{
+				ctx: context.Background(),
+				options: &model.ConfluenceAuditRecordGetOptions{
+					SearchString: "space",
+					StartDate:    time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					EndDate:      time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+				},
+				format: model.ConfluenceAuditExportFormatZIP,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/audit/export?endDate=1706745600000&format=zip&searchString=space&startDate=1704067200000",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, model.ErrUnauthorized)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrUnauthorized,
+		},
+
+		{
+			name: "when the request cannot be created",
+			args: args{
+				ctx: context.Background(),
+				options: &model.ConfluenceAuditRecordGetOptions{
+					SearchString: "space",
+					StartDate:    time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					EndDate:      time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+				},
+				format: model.ConfluenceAuditExportFormatZIP,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/audit/export?endDate=1706745600000&format=zip&searchString=space&startDate=1704067200000",
+					"",
+					nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewAuditService(testCase.fields.c)
+
+			gotResponse, err := newService.Export(testCase.args.ctx, testCase.args.options, testCase.args.format)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+
+		})
+	}
+}
@@ -0,0 +1,285 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/confluence"
+)
+
+// NewBlogPostService creates a new instance of BlogPostService.
+// It takes a service.Connector as input and returns a pointer to BlogPostService.
+func NewBlogPostService(client service.Connector) *BlogPostService {
+	return &BlogPostService{internalClient: &internalBlogPostImpl{c: client}}
+}
+
+// BlogPostService provides methods to interact with blog post operations in Confluence V2.
+type BlogPostService struct {
+	// internalClient is the connector interface for blog post operations.
+	internalClient confluence.BlogPostConnector
+}
+
+// Gets returns all blog posts that fit the filtering criteria.
+//
+// The number of results is limited by the limit parameter and additional results
+// (if available) will be available through the next cursor.
+//
+// GET /wiki/api/v2/blogposts
+//
+// https://docs.go-atlassian.io/confluence-cloud/v2/blogpost#get-blog-posts
+func (b *BlogPostService) Gets(ctx context.Context, options *model.BlogPostOptionsScheme, cursor string, limit int) (*model.BlogPostChunkScheme, *model.ResponseScheme, error) {
+	return b.internalClient.Gets(ctx, options, cursor, limit)
+}
+
+// Get returns a specific blog post.
+//
+// GET /wiki/api/v2/blogposts/{id}
+//
+// https://docs.go-atlassian.io/confluence-cloud/v2/blogpost#get-blog-post-by-id
+func (b *BlogPostService) Get(ctx context.Context, blogPostID int, format string, draft bool, version int) (*model.BlogPostScheme, *model.ResponseScheme, error) {
+	return b.internalClient.Get(ctx, blogPostID, format, draft, version)
+}
+
+// GetsBySpace returns all blog posts in a space.
+//
+// The number of results is limited by the limit parameter and additional results (if available)
+// will be available through the next cursor.
+//
+// GET /wiki/api/v2/spaces/{id}/blogposts
+//
+// https://docs.go-atlassian.io/confluence-cloud/v2/blogpost#get-blog-posts-in-space
+func (b *BlogPostService) GetsBySpace(ctx context.Context, spaceID int, cursor string, limit int) (*model.BlogPostChunkScheme, *model.ResponseScheme, error) {
+	return b.internalClient.GetsBySpace(ctx, spaceID, cursor, limit)
+}
+
+// Create creates a blog post in the space.
+//
+// Blog posts are created as published by default unless specified as a draft in the status field.
+//
+// POST /wiki/api/v2/blogposts
+//
+// https://docs.go-atlassian.io/confluence-cloud/v2/blogpost#create-blog-post
+func (b *BlogPostService) Create(ctx context.Context, payload *model.BlogPostCreatePayloadScheme) (*model.BlogPostScheme, *model.ResponseScheme, error) {
+	return b.internalClient.Create(ctx, payload)
+}
+
+// Update updates a blog post by id.
+//
+// PUT /wiki/api/v2/blogposts/{id}
+//
+// https://docs.go-atlassian.io/confluence-cloud/v2/blogpost#update-blog-post
+func (b *BlogPostService) Update(ctx context.Context, blogPostID int, payload *model.BlogPostUpdatePayloadScheme) (*model.BlogPostScheme, *model.ResponseScheme, error) {
+	return b.internalClient.Update(ctx, blogPostID, payload)
+}
+
+// Delete deletes a blog post by id.
+//
+// DELETE /wiki/api/v2/blogposts/{id}
+//
+// https://docs.go-atlassian.io/confluence-cloud/v2/blogpost#delete-blog-post
+func (b *BlogPostService) Delete(ctx context.Context, blogPostID int) (*model.ResponseScheme, error) {
+	return b.internalClient.Delete(ctx, blogPostID)
+}
+
+type internalBlogPostImpl struct {
+	c service.Connector
+}
+
+func (i *internalBlogPostImpl) Gets(ctx context.Context, options *model.BlogPostOptionsScheme, cursor string, limit int) (*model.BlogPostChunkScheme, *model.ResponseScheme, error) {
+
+	query := url.Values{}
+	query.Add("limit", strconv.Itoa(limit))
+
+	if cursor != "" {
+		query.Add("cursor", cursor)
+	}
+
+	if options != nil {
+
+		if options.Title != "" {
+			query.Add("title", options.Title)
+		}
+
+		if options.Sort != "" {
+			query.Add("sort", options.Sort)
+		}
+
+		if options.BodyFormat != "" {
+			query.Add("body-format", options.BodyFormat)
+		}
+
+		if options.Status != nil {
+			query.Add("status", strings.Join(options.Status, ","))
+		}
+
+		if len(options.BlogPostIDs) > 0 {
+
+			var blogPostIDs = make([]string, 0, len(options.BlogPostIDs))
+			for _, blogPostIDAsInt := range options.BlogPostIDs {
+				blogPostIDs = append(blogPostIDs, strconv.Itoa(blogPostIDAsInt))
+			}
+
+			query.Add("id", strings.Join(blogPostIDs, ","))
+		}
+
+		if len(options.SpaceIDs) > 0 {
+
+			var spaceIDs = make([]string, 0, len(options.SpaceIDs))
+			for _, spaceIDAsInt := range options.SpaceIDs {
+				spaceIDs = append(spaceIDs, strconv.Itoa(spaceIDAsInt))
+			}
+
+			query.Add("space-id", strings.Join(spaceIDs, ","))
+		}
+	}
+
+	endpoint := fmt.Sprintf("wiki/api/v2/blogposts?%v", query.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	chunk := new(model.BlogPostChunkScheme)
+	response, err := i.c.Call(request, chunk)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return chunk, response, nil
+}
+
+func (i *internalBlogPostImpl) Get(ctx context.Context, blogPostID int, format string, draft bool, version int) (*model.BlogPostScheme, *model.ResponseScheme, error) {
+
+	if blogPostID == 0 {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoBlogPostID)
+	}
+
+	query := url.Values{}
+
+	if format != "" {
+		query.Add("body-format", format)
+	}
+
+	if draft {
+		query.Add("get-draft", "true")
+	}
+
+	if version != 0 {
+		query.Add("version", strconv.Itoa(version))
+	}
+
+	endpoint := fmt.Sprintf("wiki/api/v2/blogposts/%v?%v", blogPostID, query.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blogPost := new(model.BlogPostScheme)
+	response, err := i.c.Call(request, blogPost)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return blogPost, response, nil
+}
+
+func (i *internalBlogPostImpl) GetsBySpace(ctx context.Context, spaceID int, cursor string, limit int) (*model.BlogPostChunkScheme, *model.ResponseScheme, error) {
+
+	if spaceID == 0 {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoSpaceID)
+	}
+
+	query := url.Values{}
+	query.Add("limit", strconv.Itoa(limit))
+
+	if cursor != "" {
+		query.Add("cursor", cursor)
+	}
+
+	endpoint := fmt.Sprintf("wiki/api/v2/spaces/%v/blogposts?%v", spaceID, query.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	chunk := new(model.BlogPostChunkScheme)
+	response, err := i.c.Call(request, chunk)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return chunk, response, nil
+}
+
+func (i *internalBlogPostImpl) Create(ctx context.Context, payload *model.BlogPostCreatePayloadScheme) (*model.BlogPostScheme, *model.ResponseScheme, error) {
+
+	if payload == nil || payload.SpaceID == "" {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoSpaceID)
+	}
+
+	endpoint := "wiki/api/v2/blogposts"
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blogPost := new(model.BlogPostScheme)
+	response, err := i.c.Call(request, blogPost)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return blogPost, response, nil
+}
+
+func (i *internalBlogPostImpl) Update(ctx context.Context, blogPostID int, payload *model.BlogPostUpdatePayloadScheme) (*model.BlogPostScheme, *model.ResponseScheme, error) {
+
+	if blogPostID == 0 {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoBlogPostID)
+	}
+
+	if payload == nil || payload.Version == nil || payload.Version.Number == 0 {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoVersionNumber)
+	}
+
+	endpoint := fmt.Sprintf("wiki/api/v2/blogposts/%v", blogPostID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blogPost := new(model.BlogPostScheme)
+	response, err := i.c.Call(request, blogPost)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return blogPost, response, nil
+}
+
+func (i *internalBlogPostImpl) Delete(ctx context.Context, blogPostID int) (*model.ResponseScheme, error) {
+
+	if blogPostID == 0 {
+		return nil, fmt.Errorf("confluence: %w", model.ErrNoBlogPostID)
+	}
+
+	endpoint := fmt.Sprintf("wiki/api/v2/blogposts/%v", blogPostID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
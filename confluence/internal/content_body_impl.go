@@ -0,0 +1,179 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/confluence"
+)
+
+// NewContentBodyService creates a new instance of ContentBodyService.
+// It takes a service.Connector as input and returns a pointer to ContentBodyService.
+func NewContentBodyService(client service.Connector) *ContentBodyService {
+	return &ContentBodyService{
+		internalClient: &internalContentBodyImpl{c: client},
+	}
+}
+
+// ContentBodyService provides methods to convert content bodies between representations in Confluence.
+type ContentBodyService struct {
+	// internalClient is the connector interface for content body operations.
+	internalClient confluence.ContentBodyConnector
+}
+
+// Convert converts a content body from one representation to another, e.g. storage to atlas_doc_format.
+//
+// Supported target representations are storage, editor, view, export_view, styled_view and atlas_doc_format.
+//
+// POST /wiki/rest/api/contentbody/convert/{to}
+//
+// https://docs.go-atlassian.io/confluence-cloud/content/body#convert-content-body
+func (c *ContentBodyService) Convert(ctx context.Context, to string, payload *model.ContentBodyConvertPayloadScheme, options *model.ContentBodyConvertOptionsScheme) (*model.ContentBodyConvertedScheme, *model.ResponseScheme, error) {
+	return c.internalClient.Convert(ctx, to, payload, options)
+}
+
+// ConvertAsync starts the conversion of a content body in the background and returns the ID of the conversion task.
+//
+// Use AsyncResult to poll the task until it's completed.
+//
+// POST /wiki/rest/api/contentbody/convert/async/{to}
+//
+// https://docs.go-atlassian.io/confluence-cloud/content/body#asynchronously-convert-content-body
+func (c *ContentBodyService) ConvertAsync(ctx context.Context, to string, payload *model.ContentBodyConvertPayloadScheme, options *model.ContentBodyConvertOptionsScheme) (*model.ContentBodyAsyncIDScheme, *model.ResponseScheme, error) {
+	return c.internalClient.ConvertAsync(ctx, to, payload, options)
+}
+
+// AsyncResult returns the converted content body of an async conversion task, or its current status
+//
+// if the task is not completed yet.
+//
+// GET /wiki/rest/api/contentbody/convert/async/{id}
+//
+// https://docs.go-atlassian.io/confluence-cloud/content/body#get-asynchronously-converted-content-body
+func (c *ContentBodyService) AsyncResult(ctx context.Context, asyncID string) (*model.ContentBodyAsyncScheme, *model.ResponseScheme, error) {
+	return c.internalClient.AsyncResult(ctx, asyncID)
+}
+
+type internalContentBodyImpl struct {
+	c service.Connector
+}
+
+func (i *internalContentBodyImpl) Convert(ctx context.Context, to string, payload *model.ContentBodyConvertPayloadScheme, options *model.ContentBodyConvertOptionsScheme) (*model.ContentBodyConvertedScheme, *model.ResponseScheme, error) {
+
+	if to == "" {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoContentBodyRepresentation)
+	}
+
+	if payload == nil {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoContentBody)
+	}
+
+	var endpoint strings.Builder
+	endpoint.WriteString(fmt.Sprintf("wiki/rest/api/contentbody/convert/%v", to))
+
+	if query := contentBodyConvertQuery(options, false); query.Encode() != "" {
+		endpoint.WriteString(fmt.Sprintf("?%v", query.Encode()))
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint.String(), "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body := new(model.ContentBodyConvertedScheme)
+	response, err := i.c.Call(request, body)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return body, response, nil
+}
+
+func (i *internalContentBodyImpl) ConvertAsync(ctx context.Context, to string, payload *model.ContentBodyConvertPayloadScheme, options *model.ContentBodyConvertOptionsScheme) (*model.ContentBodyAsyncIDScheme, *model.ResponseScheme, error) {
+
+	if to == "" {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoContentBodyRepresentation)
+	}
+
+	if payload == nil {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoContentBody)
+	}
+
+	var endpoint strings.Builder
+	endpoint.WriteString(fmt.Sprintf("wiki/rest/api/contentbody/convert/async/%v", to))
+
+	if query := contentBodyConvertQuery(options, true); query.Encode() != "" {
+		endpoint.WriteString(fmt.Sprintf("?%v", query.Encode()))
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint.String(), "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	task := new(model.ContentBodyAsyncIDScheme)
+	response, err := i.c.Call(request, task)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return task, response, nil
+}
+
+func (i *internalContentBodyImpl) AsyncResult(ctx context.Context, asyncID string) (*model.ContentBodyAsyncScheme, *model.ResponseScheme, error) {
+
+	if asyncID == "" {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoContentBodyAsyncID)
+	}
+
+	endpoint := fmt.Sprintf("wiki/rest/api/contentbody/convert/async/%v", asyncID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(model.ContentBodyAsyncScheme)
+	response, err := i.c.Call(request, result)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return result, response, nil
+}
+
+// contentBodyConvertQuery builds the query parameters shared by the sync and async conversion endpoints.
+func contentBodyConvertQuery(options *model.ContentBodyConvertOptionsScheme, async bool) url.Values {
+
+	query := url.Values{}
+	if options == nil {
+		return query
+	}
+
+	if options.SpaceKeyContext != "" {
+		query.Add("spaceKeyContext", options.SpaceKeyContext)
+	}
+
+	if options.ContentIDContext != "" {
+		query.Add("contentIdContext", options.ContentIDContext)
+	}
+
+	if options.EmbeddedContentRender != "" {
+		query.Add("embeddedContentRender", options.EmbeddedContentRender)
+	}
+
+	if len(options.Expand) != 0 {
+		query.Add("expand", strings.Join(options.Expand, ","))
+	}
+
+	if async && options.AllowCache {
+		query.Add("allowCache", "true")
+	}
+
+	return query
+}
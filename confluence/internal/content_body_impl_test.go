@@ -0,0 +1,538 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func Test_internalContentBodyImpl_Convert(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx     context.Context
+		to      string
+		payload *model.ContentBodyConvertPayloadScheme
+		options *model.ContentBodyConvertOptionsScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx: context.Background(),
+				to:  model.ContentBodyRepresentationAtlasDocFormat,
+				payload: &model.ContentBodyConvertPayloadScheme{
+					Value:          "<p>Hello</p>",
+					Representation: model.ContentBodyRepresentationStorage,
+				},
+				options: &model.ContentBodyConvertOptionsScheme{
+					SpaceKeyContext: "DUMMY",
+					Expand:          []string{"webresource", "mediaToken"},
+					AllowCache:      true,
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"wiki/rest/api/contentbody/convert/atlas_doc_format?expand=webresource%2CmediaToken&spaceKeyContext=DUMMY",
+					"",
+					&model.ContentBodyConvertPayloadScheme{
+						Value:          "<p>Hello</p>",
+						Representation: model.ContentBodyRepresentationStorage,
+					}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentBodyConvertedScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name: "when the http call cannot be executed",
+			args: args{
+				ctx: context.Background(),
+				to:  model.ContentBodyRepresentationAtlasDocFormat,
+				payload: &model.ContentBodyConvertPayloadScheme{
+					Value:          "<p>Hello</p>",
+					Representation: model.ContentBodyRepresentationStorage,
+				},
+				options: &model.ContentBodyConvertOptionsScheme{
+					SpaceKeyContext: "DUMMY",
+					Expand:          []string{"webresource", "mediaToken"},
+					AllowCache:      true,
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"wiki/rest/api/contentbody/convert/atlas_doc_format?expand=webresource%2CmediaToken&spaceKeyContext=DUMMY",
+					"",
+					&model.ContentBodyConvertPayloadScheme{
+						Value:          "<p>Hello</p>",
+						Representation: model.ContentBodyRepresentationStorage,
+					}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentBodyConvertedScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrBadRequest)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrBadRequest,
+		},
+
+		{
+			name: "when the request cannot be created",
+			args: args{
+				ctx: context.Background(),
+				to:  model.ContentBodyRepresentationAtlasDocFormat,
+				payload: &model.ContentBodyConvertPayloadScheme{
+					Value:          "<p>Hello</p>",
+					Representation: model.ContentBodyRepresentationStorage,
+				},
+				options: &model.ContentBodyConvertOptionsScheme{
+					SpaceKeyContext: "DUMMY",
+					Expand:          []string{"webresource", "mediaToken"},
+					AllowCache:      true,
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"wiki/rest/api/contentbody/convert/atlas_doc_format?expand=webresource%2CmediaToken&spaceKeyContext=DUMMY",
+					"",
+					&model.ContentBodyConvertPayloadScheme{
+						Value:          "<p>Hello</p>",
+						Representation: model.ContentBodyRepresentationStorage,
+					}).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the target representation is not provided",
+			args: args{
+				ctx: context.Background(),
+				payload: &model.ContentBodyConvertPayloadScheme{
+					Value:          "<p>Hello</p>",
+					Representation: model.ContentBodyRepresentationStorage,
+				},
+			},
+			wantErr: true,
+			Err:     model.ErrNoContentBodyRepresentation,
+		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx: context.Background(),
+				to:  model.ContentBodyRepresentationView,
+			},
+			wantErr: true,
+			Err:     model.ErrNoContentBody,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewContentBodyService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Convert(testCase.args.ctx, testCase.args.to, testCase.args.payload, testCase.args.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalContentBodyImpl_ConvertAsync(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx     context.Context
+		to      string
+		payload *model.ContentBodyConvertPayloadScheme
+		options *model.ContentBodyConvertOptionsScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx: context.Background(),
+				to:  model.ContentBodyRepresentationAtlasDocFormat,
+				payload: &model.ContentBodyConvertPayloadScheme{
+					Value:          "<p>Hello</p>",
+					Representation: model.ContentBodyRepresentationStorage,
+				},
+				options: &model.ContentBodyConvertOptionsScheme{
+					SpaceKeyContext: "DUMMY",
+					Expand:          []string{"webresource", "mediaToken"},
+					AllowCache:      true,
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"wiki/rest/api/contentbody/convert/async/atlas_doc_format?allowCache=true&expand=webresource%2CmediaToken&spaceKeyContext=DUMMY",
+					"",
+					&model.ContentBodyConvertPayloadScheme{
+						Value:          "<p>Hello</p>",
+						Representation: model.ContentBodyRepresentationStorage,
+					}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentBodyAsyncIDScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name: "when the http call cannot be executed",
+			args: args{
+				ctx: context.Background(),
+				to:  model.ContentBodyRepresentationAtlasDocFormat,
+				payload: &model.ContentBodyConvertPayloadScheme{
+					Value:          "<p>Hello</p>",
+					Representation: model.ContentBodyRepresentationStorage,
+				},
+				options: &model.ContentBodyConvertOptionsScheme{
+					SpaceKeyContext: "DUMMY",
+					Expand:          []string{"webresource", "mediaToken"},
+					AllowCache:      true,
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"wiki/rest/api/contentbody/convert/async/atlas_doc_format?allowCache=true&expand=webresource%2CmediaToken&spaceKeyContext=DUMMY",
+					"",
+					&model.ContentBodyConvertPayloadScheme{
+						Value:          "<p>Hello</p>",
+						Representation: model.ContentBodyRepresentationStorage,
+					}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentBodyAsyncIDScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrBadRequest)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrBadRequest,
+		},
+
+		{
+			name: "when the request cannot be created",
+			args: args{
+				ctx: context.Background(),
+				to:  model.ContentBodyRepresentationAtlasDocFormat,
+				payload: &model.ContentBodyConvertPayloadScheme{
+					Value:          "<p>Hello</p>",
+					Representation: model.ContentBodyRepresentationStorage,
+				},
+				options: &model.ContentBodyConvertOptionsScheme{
+					SpaceKeyContext: "DUMMY",
+					Expand:          []string{"webresource", "mediaToken"},
+					AllowCache:      true,
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"wiki/rest/api/contentbody/convert/async/atlas_doc_format?allowCache=true&expand=webresource%2CmediaToken&spaceKeyContext=DUMMY",
+					"",
+					&model.ContentBodyConvertPayloadScheme{
+						Value:          "<p>Hello</p>",
+						Representation: model.ContentBodyRepresentationStorage,
+					}).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the target representation is not provided",
+			args: args{
+				ctx: context.Background(),
+				payload: &model.ContentBodyConvertPayloadScheme{
+					Value:          "<p>Hello</p>",
+					Representation: model.ContentBodyRepresentationStorage,
+				},
+			},
+			wantErr: true,
+			Err:     model.ErrNoContentBodyRepresentation,
+		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx: context.Background(),
+				to:  model.ContentBodyRepresentationView,
+			},
+			wantErr: true,
+			Err:     model.ErrNoContentBody,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewContentBodyService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.ConvertAsync(testCase.args.ctx, testCase.args.to, testCase.args.payload, testCase.args.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalContentBodyImpl_AsyncResult(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx     context.Context
+		asyncID string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:     context.Background(),
+				asyncID: "8a4a2e1c",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/contentbody/convert/async/8a4a2e1c",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentBodyAsyncScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name: "when the http call cannot be executed",
+			args: args{
+				ctx:     context.Background(),
+				asyncID: "8a4a2e1c",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/contentbody/convert/async/8a4a2e1c",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentBodyAsyncScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrNotFound)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrNotFound,
+		},
+
+		{
+			name: "when the request cannot be created",
+			args: args{
+				ctx:     context.Background(),
+				asyncID: "8a4a2e1c",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/contentbody/convert/async/8a4a2e1c",
+					"",
+					nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the async id is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoContentBodyAsyncID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewContentBodyService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.AsyncResult(testCase.args.ctx, testCase.args.asyncID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
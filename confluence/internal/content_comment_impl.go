@@ -0,0 +1,280 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/confluence"
+)
+
+// NewFooterCommentService creates a new instance of ContentCommentService for the footer comments.
+// It takes a service.Connector as input and returns a pointer to ContentCommentService.
+func NewFooterCommentService(client service.Connector) *ContentCommentService {
+	return &ContentCommentService{internalClient: &internalContentCommentImpl{c: client, kind: "footer-comments"}}
+}
+
+// NewInlineCommentService creates a new instance of ContentCommentService for the inline comments.
+// It takes a service.Connector as input and returns a pointer to ContentCommentService.
+func NewInlineCommentService(client service.Connector) *ContentCommentService {
+	return &ContentCommentService{internalClient: &internalContentCommentImpl{c: client, kind: "inline-comments"}}
+}
+
+// ContentCommentService provides methods to interact with the footer or inline comments in Confluence V2.
+//
+// The footer comments are displayed at the bottom of the pages and blog posts, the inline comments are attached to a highlighted text of their body.
+type ContentCommentService struct {
+	// internalClient is the connector interface for comment operations.
+	internalClient confluence.ContentCommentConnector
+}
+
+// Gets returns all the comments, footer or inline depending on the service.
+//
+// GET /wiki/api/v2/{footer-comments,inline-comments}
+//
+// https://docs.go-atlassian.io/confluence-cloud/v2/comment
+func (c *ContentCommentService) Gets(ctx context.Context, options *model.ContentCommentOptionsScheme, cursor string, limit int) (*model.ContentCommentChunkScheme, *model.ResponseScheme, error) {
+	return c.internalClient.Gets(ctx, options, cursor, limit)
+}
+
+// GetsByPage returns the root comments of a page.
+//
+// GET /wiki/api/v2/pages/{id}/{footer-comments,inline-comments}
+//
+// https://docs.go-atlassian.io/confluence-cloud/v2/comment
+func (c *ContentCommentService) GetsByPage(ctx context.Context, pageID int, options *model.ContentCommentOptionsScheme, cursor string, limit int) (*model.ContentCommentChunkScheme, *model.ResponseScheme, error) {
+	return c.internalClient.GetsByPage(ctx, pageID, options, cursor, limit)
+}
+
+// GetsByBlogPost returns the root comments of a blog post.
+//
+// GET /wiki/api/v2/blogposts/{id}/{footer-comments,inline-comments}
+//
+// https://docs.go-atlassian.io/confluence-cloud/v2/comment
+func (c *ContentCommentService) GetsByBlogPost(ctx context.Context, blogPostID int, options *model.ContentCommentOptionsScheme, cursor string, limit int) (*model.ContentCommentChunkScheme, *model.ResponseScheme, error) {
+	return c.internalClient.GetsByBlogPost(ctx, blogPostID, options, cursor, limit)
+}
+
+// Children returns the replies of a comment.
+//
+// GET /wiki/api/v2/{footer-comments,inline-comments}/{id}/children
+//
+// https://docs.go-atlassian.io/confluence-cloud/v2/comment
+func (c *ContentCommentService) Children(ctx context.Context, commentID int, options *model.ContentCommentOptionsScheme, cursor string, limit int) (*model.ContentCommentChunkScheme, *model.ResponseScheme, error) {
+	return c.internalClient.Children(ctx, commentID, options, cursor, limit)
+}
+
+// Get returns a specific comment.
+//
+// GET /wiki/api/v2/{footer-comments,inline-comments}/{id}
+//
+// https://docs.go-atlassian.io/confluence-cloud/v2/comment
+func (c *ContentCommentService) Get(ctx context.Context, commentID int, format string, version int) (*model.ContentCommentScheme, *model.ResponseScheme, error) {
+	return c.internalClient.Get(ctx, commentID, format, version)
+}
+
+// Create creates a comment on a page or a blog post, or a reply to a comment.
+//
+// POST /wiki/api/v2/{footer-comments,inline-comments}
+//
+// https://docs.go-atlassian.io/confluence-cloud/v2/comment
+func (c *ContentCommentService) Create(ctx context.Context, payload *model.ContentCommentCreatePayloadScheme) (*model.ContentCommentScheme, *model.ResponseScheme, error) {
+	return c.internalClient.Create(ctx, payload)
+}
+
+// Update updates a comment, the inline comments can be resolved or reopened too.
+//
+// PUT /wiki/api/v2/{footer-comments,inline-comments}/{id}
+//
+// https://docs.go-atlassian.io/confluence-cloud/v2/comment
+func (c *ContentCommentService) Update(ctx context.Context, commentID int, payload *model.ContentCommentUpdatePayloadScheme) (*model.ContentCommentScheme, *model.ResponseScheme, error) {
+	return c.internalClient.Update(ctx, commentID, payload)
+}
+
+// Delete deletes a comment.
+//
+// DELETE /wiki/api/v2/{footer-comments,inline-comments}/{id}
+//
+// https://docs.go-atlassian.io/confluence-cloud/v2/comment
+func (c *ContentCommentService) Delete(ctx context.Context, commentID int) (*model.ResponseScheme, error) {
+	return c.internalClient.Delete(ctx, commentID)
+}
+
+type internalContentCommentImpl struct {
+	c service.Connector
+
+	// kind is the path segment of the comments, footer-comments or inline-comments.
+	kind string
+}
+
+func (i *internalContentCommentImpl) Gets(ctx context.Context, options *model.ContentCommentOptionsScheme, cursor string, limit int) (*model.ContentCommentChunkScheme, *model.ResponseScheme, error) {
+	return i.chunk(ctx, fmt.Sprintf("wiki/api/v2/%v", i.kind), options, cursor, limit)
+}
+
+func (i *internalContentCommentImpl) GetsByPage(ctx context.Context, pageID int, options *model.ContentCommentOptionsScheme, cursor string, limit int) (*model.ContentCommentChunkScheme, *model.ResponseScheme, error) {
+
+	if pageID == 0 {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoPageID)
+	}
+
+	return i.chunk(ctx, fmt.Sprintf("wiki/api/v2/pages/%v/%v", pageID, i.kind), options, cursor, limit)
+}
+
+func (i *internalContentCommentImpl) GetsByBlogPost(ctx context.Context, blogPostID int, options *model.ContentCommentOptionsScheme, cursor string, limit int) (*model.ContentCommentChunkScheme, *model.ResponseScheme, error) {
+
+	if blogPostID == 0 {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoBlogPostID)
+	}
+
+	return i.chunk(ctx, fmt.Sprintf("wiki/api/v2/blogposts/%v/%v", blogPostID, i.kind), options, cursor, limit)
+}
+
+func (i *internalContentCommentImpl) Children(ctx context.Context, commentID int, options *model.ContentCommentOptionsScheme, cursor string, limit int) (*model.ContentCommentChunkScheme, *model.ResponseScheme, error) {
+
+	if commentID == 0 {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoCommentID)
+	}
+
+	return i.chunk(ctx, fmt.Sprintf("wiki/api/v2/%v/%v/children", i.kind, commentID), options, cursor, limit)
+}
+
+// chunk returns a chunk of the comments listed by the endpoint.
+func (i *internalContentCommentImpl) chunk(ctx context.Context, path string, options *model.ContentCommentOptionsScheme, cursor string, limit int) (*model.ContentCommentChunkScheme, *model.ResponseScheme, error) {
+
+	query := url.Values{}
+	query.Add("limit", strconv.Itoa(limit))
+
+	if cursor != "" {
+		query.Add("cursor", cursor)
+	}
+
+	if options != nil {
+
+		if options.BodyFormat != "" {
+			query.Add("body-format", options.BodyFormat)
+		}
+
+		if options.Sort != "" {
+			query.Add("sort", options.Sort)
+		}
+	}
+
+	endpoint := fmt.Sprintf("%v?%v", path, query.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	chunk := new(model.ContentCommentChunkScheme)
+	response, err := i.c.Call(request, chunk)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return chunk, response, nil
+}
+
+func (i *internalContentCommentImpl) Get(ctx context.Context, commentID int, format string, version int) (*model.ContentCommentScheme, *model.ResponseScheme, error) {
+
+	if commentID == 0 {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoCommentID)
+	}
+
+	query := url.Values{}
+
+	if format != "" {
+		query.Add("body-format", format)
+	}
+
+	if version != 0 {
+		query.Add("version", strconv.Itoa(version))
+	}
+
+	endpoint := fmt.Sprintf("wiki/api/v2/%v/%v?%v", i.kind, commentID, query.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comment := new(model.ContentCommentScheme)
+	response, err := i.c.Call(request, comment)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return comment, response, nil
+}
+
+func (i *internalContentCommentImpl) Create(ctx context.Context, payload *model.ContentCommentCreatePayloadScheme) (*model.ContentCommentScheme, *model.ResponseScheme, error) {
+
+	if payload == nil || (payload.PageID == "" && payload.BlogPostID == "" && payload.ParentCommentID == "") {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoCommentContainer)
+	}
+
+	if payload.Body == nil || payload.Body.Value == "" {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoCommentBody)
+	}
+
+	endpoint := fmt.Sprintf("wiki/api/v2/%v", i.kind)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comment := new(model.ContentCommentScheme)
+	response, err := i.c.Call(request, comment)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return comment, response, nil
+}
+
+func (i *internalContentCommentImpl) Update(ctx context.Context, commentID int, payload *model.ContentCommentUpdatePayloadScheme) (*model.ContentCommentScheme, *model.ResponseScheme, error) {
+
+	if commentID == 0 {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoCommentID)
+	}
+
+	if payload == nil || payload.Version == nil || payload.Version.Number == 0 {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoVersionNumber)
+	}
+
+	endpoint := fmt.Sprintf("wiki/api/v2/%v/%v", i.kind, commentID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comment := new(model.ContentCommentScheme)
+	response, err := i.c.Call(request, comment)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return comment, response, nil
+}
+
+func (i *internalContentCommentImpl) Delete(ctx context.Context, commentID int) (*model.ResponseScheme, error) {
+
+	if commentID == 0 {
+		return nil, fmt.Errorf("confluence: %w", model.ErrNoCommentID)
+	}
+
+	endpoint := fmt.Sprintf("wiki/api/v2/%v/%v", i.kind, commentID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
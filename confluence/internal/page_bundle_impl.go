@@ -0,0 +1,384 @@
+package internal
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+const (
+	// pageBundleManifest is the path of the manifest in a page bundle.
+	pageBundleManifest = "manifest.json"
+
+	// pageBundlePageSize is the page size used to fetch the children, labels and attachments of the exported pages.
+	pageBundlePageSize = 250
+)
+
+// pageBundleLinkIDs matches the page IDs referenced by the links of a storage format body.
+var pageBundleLinkIDs = regexp.MustCompile(`(/pages/|pageId=|ri:content-id=")(\d+)`)
+
+// pageBundleFileName replaces the characters not allowed in the bundle file names.
+var pageBundleFileName = strings.NewReplacer("/", "_", `\`, "_", ":", "_")
+
+func (i *internalPageImpl) Export(ctx context.Context, pageID int, w io.Writer) (*model.PageBundleScheme, error) {
+
+	if pageID == 0 {
+		return nil, fmt.Errorf("confluence: %w", model.ErrNoPageID)
+	}
+
+	root, _, err := i.Get(ctx, pageID, "storage", false, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := &model.PageBundleScheme{Version: model.PageBundleVersion, SpaceID: root.SpaceID}
+
+	if spaceID, err := strconv.Atoi(root.SpaceID); err == nil {
+
+		space, _, err := (&internalSpaceV2Impl{c: i.c}).Get(ctx, spaceID, "")
+		if err != nil {
+			return nil, err
+		}
+
+		bundle.SpaceKey = space.Key
+	}
+
+	writer := zip.NewWriter(w)
+
+	if err := i.exportPage(ctx, writer, bundle, root, "", 0); err != nil {
+		return bundle, err
+	}
+
+	manifest, err := writer.Create(pageBundleManifest)
+	if err != nil {
+		return bundle, err
+	}
+
+	encoder := json.NewEncoder(manifest)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(bundle); err != nil {
+		return bundle, err
+	}
+
+	return bundle, writer.Close()
+}
+
+// exportPage writes the page, its labels and attachments to the bundle, then its children ordered by position.
+func (i *internalPageImpl) exportPage(ctx context.Context, writer *zip.Writer, bundle *model.PageBundleScheme, page *model.PageScheme, parentID string, position int) error {
+
+	pageID, err := strconv.Atoi(page.ID)
+	if err != nil {
+		return fmt.Errorf("confluence: %w", err)
+	}
+
+	entry := &model.PageBundlePageScheme{
+		ID:       page.ID,
+		ParentID: parentID,
+		Title:    page.Title,
+		Position: position,
+		Body:     path.Join("pages", page.ID+".xml"),
+	}
+
+	var body string
+	if page.Body != nil && page.Body.Storage != nil {
+		body = page.Body.Storage.Value
+	}
+
+	if err := writePageBundleFile(writer, entry.Body, strings.NewReader(body)); err != nil {
+		return err
+	}
+
+	if entry.Labels, err = i.exportLabels(ctx, page.ID); err != nil {
+		return err
+	}
+
+	if entry.Attachments, err = i.exportAttachments(ctx, writer, pageID); err != nil {
+		return err
+	}
+
+	bundle.Pages = append(bundle.Pages, entry)
+
+	var (
+		children []*model.ChildPageScheme
+		cursor   string
+	)
+
+	for {
+		chunk, _, err := i.GetsByParent(ctx, pageID, cursor, pageBundlePageSize)
+		if err != nil {
+			return err
+		}
+
+		children = append(children, chunk.Results...)
+
+		if chunk.Links == nil || chunk.Links.Next == "" {
+			break
+		}
+
+		if cursor = pageBundleCursor(chunk.Links.Next); cursor == "" {
+			break
+		}
+	}
+
+	slices.SortStableFunc(children, func(a, b *model.ChildPageScheme) int { return a.ChildPosition - b.ChildPosition })
+
+	for _, child := range children {
+
+		childID, err := strconv.Atoi(child.ID)
+		if err != nil {
+			return fmt.Errorf("confluence: %w", err)
+		}
+
+		childPage, _, err := i.Get(ctx, childID, "storage", false, 0)
+		if err != nil {
+			return err
+		}
+
+		if err := i.exportPage(ctx, writer, bundle, childPage, page.ID, child.ChildPosition); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// exportLabels returns the labels of the page.
+func (i *internalPageImpl) exportLabels(ctx context.Context, pageID string) ([]*model.ContentLabelPayloadScheme, error) {
+
+	var labels []*model.ContentLabelPayloadScheme
+	labelService := &internalContentLabelImpl{c: i.c}
+
+	for start := 0; ; {
+
+		page, _, err := labelService.Gets(ctx, pageID, "", start, pageBundlePageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, label := range page.Results {
+			labels = append(labels, &model.ContentLabelPayloadScheme{Prefix: label.Prefix, Name: label.Name})
+		}
+
+		if len(page.Results) < pageBundlePageSize {
+			return labels, nil
+		}
+
+		start += len(page.Results)
+	}
+}
+
+// exportAttachments writes the attachments of the page to the bundle.
+func (i *internalPageImpl) exportAttachments(ctx context.Context, writer *zip.Writer, pageID int) ([]*model.PageBundleAttachmentScheme, error) {
+
+	var (
+		attachments       []*model.PageBundleAttachmentScheme
+		cursor            string
+		attachmentService = &internalAttachmentImpl{c: i.c}
+	)
+
+	for {
+		page, _, err := attachmentService.Gets(ctx, pageID, "pages", nil, cursor, pageBundlePageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, attachment := range page.Results {
+
+			entry := &model.PageBundleAttachmentScheme{
+				ID:        attachment.ID,
+				Title:     attachment.Title,
+				MediaType: attachment.MediaType,
+				File:      path.Join("attachments", strconv.Itoa(pageID), attachment.ID+"-"+pageBundleFileName.Replace(attachment.Title)),
+			}
+
+			content, err := attachmentService.Download(ctx, attachment.ID)
+			if err != nil {
+				return nil, fmt.Errorf("confluence: attachment %v: %w", attachment.ID, err)
+			}
+
+			err = writePageBundleFile(writer, entry.File, content)
+			content.Close()
+
+			if err != nil {
+				return nil, err
+			}
+
+			attachments = append(attachments, entry)
+		}
+
+		if page.Links == nil || page.Links.Next == "" {
+			return attachments, nil
+		}
+
+		if cursor = pageBundleCursor(page.Links.Next); cursor == "" {
+			return attachments, nil
+		}
+	}
+}
+
+func (i *internalPageImpl) Import(ctx context.Context, bundle fs.FS, options *model.PageBundleImportOptionsScheme) (*model.PageBundleImportScheme, error) {
+
+	if options == nil || options.SpaceID == 0 {
+		return nil, fmt.Errorf("confluence: %w", model.ErrNoSpaceID)
+	}
+
+	data, err := fs.ReadFile(bundle, pageBundleManifest)
+	if err != nil {
+		return nil, fmt.Errorf("confluence: %w", err)
+	}
+
+	manifest := new(model.PageBundleScheme)
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf("confluence: %w", err)
+	}
+
+	var replacements []string
+	if manifest.SpaceKey != "" && options.SpaceKey != "" && manifest.SpaceKey != options.SpaceKey {
+		replacements = append(replacements,
+			fmt.Sprintf(`ri:space-key="%v"`, manifest.SpaceKey), fmt.Sprintf(`ri:space-key="%v"`, options.SpaceKey),
+			fmt.Sprintf("/spaces/%v/", manifest.SpaceKey), fmt.Sprintf("/spaces/%v/", options.SpaceKey))
+	}
+
+	for source, target := range options.Replacements {
+		replacements = append(replacements, source, target)
+	}
+
+	replacer := strings.NewReplacer(replacements...)
+
+	result := &model.PageBundleImportScheme{Pages: make(map[string]string, len(manifest.Pages))}
+	created := make(map[string]*model.PageScheme, len(manifest.Pages))
+	bodies := make(map[string]string, len(manifest.Pages))
+
+	labelService := &internalContentLabelImpl{c: i.c}
+	attachmentService := &internalContentAttachmentImpl{c: i.c}
+
+	for _, entry := range manifest.Pages {
+
+		body, err := fs.ReadFile(bundle, entry.Body)
+		if err != nil {
+			return result, fmt.Errorf("confluence: page %v: %w", entry.Title, err)
+		}
+
+		parentID := options.ParentID
+		if entry.ParentID != "" {
+			parentID = result.Pages[entry.ParentID]
+		}
+
+		bodies[entry.ID] = replacer.Replace(string(body))
+
+		page, _, err := i.Create(ctx, &model.PageCreatePayloadScheme{
+			SpaceID:  strconv.Itoa(options.SpaceID),
+			Status:   "current",
+			Title:    entry.Title,
+			ParentID: parentID,
+			Body:     &model.PageBodyRepresentationScheme{Representation: "storage", Value: bodies[entry.ID]},
+		})
+		if err != nil {
+			return result, fmt.Errorf("confluence: page %v: %w", entry.Title, err)
+		}
+
+		result.Pages[entry.ID] = page.ID
+		created[entry.ID] = page
+
+		if len(entry.Labels) != 0 {
+			if _, _, err := labelService.Add(ctx, page.ID, entry.Labels, false); err != nil {
+				return result, fmt.Errorf("confluence: page %v: %w", entry.Title, err)
+			}
+		}
+
+		for _, attachment := range entry.Attachments {
+
+			file, err := bundle.Open(attachment.File)
+			if err != nil {
+				return result, fmt.Errorf("confluence: page %v: %w", entry.Title, err)
+			}
+
+			_, _, err = attachmentService.Create(ctx, page.ID, "current", attachment.Title, file)
+			file.Close()
+
+			if err != nil {
+				return result, fmt.Errorf("confluence: page %v: attachment %v: %w", entry.Title, attachment.Title, err)
+			}
+		}
+	}
+
+	// The links between the pages of the tree are rewritten once all the pages are created
+	for _, entry := range manifest.Pages {
+
+		page, body := created[entry.ID], bodies[entry.ID]
+
+		rewritten := pageBundleLinkIDs.ReplaceAllStringFunc(body, func(link string) string {
+
+			match := pageBundleLinkIDs.FindStringSubmatch(link)
+			if pageID, ok := result.Pages[match[2]]; ok {
+				return match[1] + pageID
+			}
+
+			return link
+		})
+
+		if rewritten == body {
+			continue
+		}
+
+		pageID, err := strconv.Atoi(page.ID)
+		if err != nil {
+			return result, fmt.Errorf("confluence: %w", err)
+		}
+
+		version := 2
+		if page.Version != nil {
+			version = page.Version.Number + 1
+		}
+
+		_, _, err = i.Update(ctx, pageID, &model.PageUpdatePayloadScheme{
+			ID:       page.ID,
+			Status:   "current",
+			Title:    entry.Title,
+			SpaceID:  strconv.Itoa(options.SpaceID),
+			ParentID: page.ParentID,
+			Body:     &model.PageBodyRepresentationScheme{Representation: "storage", Value: rewritten},
+			Version:  &model.PageUpdatePayloadVersionScheme{Number: version, Message: "Rewrite the links of the imported pages"},
+		})
+		if err != nil {
+			return result, fmt.Errorf("confluence: page %v: %w", entry.Title, err)
+		}
+	}
+
+	return result, nil
+}
+
+// writePageBundleFile writes the content to a new file of the bundle.
+func writePageBundleFile(writer *zip.Writer, name string, content io.Reader) error {
+
+	file, err := writer.Create(name)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(file, content)
+	return err
+}
+
+// pageBundleCursor returns the cursor of the next link returned by the paginated endpoints.
+func pageBundleCursor(next string) string {
+
+	link, err := url.Parse(next)
+	if err != nil {
+		return ""
+	}
+
+	return link.Query().Get("cursor")
+}
@@ -0,0 +1,472 @@
+package internal
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"net/http"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func Test_internalPageImpl_Export(t *testing.T) {
+
+	mockGet := func(client *mocks.Connector, endpoint string, structure, result interface{}) {
+
+		request := &http.Request{Host: endpoint}
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			endpoint,
+			"",
+			nil).
+			Return(request, nil)
+
+		client.On("Call",
+			request,
+			structure).
+			Run(func(args mock.Arguments) {
+				switch structure.(type) {
+				case *model.PageScheme:
+					*args.Get(1).(*model.PageScheme) = *result.(*model.PageScheme)
+				case *model.SpaceSchemeV2:
+					*args.Get(1).(*model.SpaceSchemeV2) = *result.(*model.SpaceSchemeV2)
+				case *model.ContentLabelPageScheme:
+					*args.Get(1).(*model.ContentLabelPageScheme) = *result.(*model.ContentLabelPageScheme)
+				case *model.AttachmentPageScheme:
+					*args.Get(1).(*model.AttachmentPageScheme) = *result.(*model.AttachmentPageScheme)
+				case *model.ChildPageChunkScheme:
+					*args.Get(1).(*model.ChildPageChunkScheme) = *result.(*model.ChildPageChunkScheme)
+				}
+			}).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	mockTree := func(client *mocks.Connector) {
+
+		mockGet(client, "wiki/api/v2/pages/100?body-format=storage", &model.PageScheme{}, &model.PageScheme{
+			ID:      "100",
+			Title:   "Handbook",
+			SpaceID: "10001",
+			Body:    &model.PageBodyScheme{Storage: &model.PageBodyRepresentationScheme{Value: `<a href="/wiki/spaces/DOC/pages/101">Onboarding</a>`}},
+		})
+
+		mockGet(client, "wiki/api/v2/spaces/10001", &model.SpaceSchemeV2{}, &model.SpaceSchemeV2{ID: "10001", Key: "DOC"})
+
+		mockGet(client, "wiki/rest/api/content/100/label?limit=250&start=0", &model.ContentLabelPageScheme{},
+			&model.ContentLabelPageScheme{Results: []*model.ContentLabelScheme{{Prefix: "global", Name: "handbook"}}})
+
+		mockGet(client, "wiki/api/v2/pages/100/attachments?limit=250", &model.AttachmentPageScheme{},
+			&model.AttachmentPageScheme{Results: []*model.AttachmentScheme{{ID: "att300", Title: "logo.png", MediaType: "image/png"}}})
+
+		request := &http.Request{Host: "download"}
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"wiki/api/v2/attachments/att300/download",
+			"",
+			nil).
+			Return(request, nil)
+
+		client.On("Do",
+			request).
+			Return(&http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("PNG"))}, nil)
+
+		mockGet(client, "wiki/api/v2/pages/100/children?limit=250", &model.ChildPageChunkScheme{},
+			&model.ChildPageChunkScheme{Results: []*model.ChildPageScheme{{ID: "102", ChildPosition: 2}, {ID: "101", ChildPosition: 1}}})
+
+		for _, pageID := range []string{"101", "102"} {
+
+			mockGet(client, "wiki/api/v2/pages/"+pageID+"?body-format=storage", &model.PageScheme{}, &model.PageScheme{
+				ID:      pageID,
+				Title:   "Page " + pageID,
+				SpaceID: "10001",
+				Body:    &model.PageBodyScheme{Storage: &model.PageBodyRepresentationScheme{Value: "<p>" + pageID + "</p>"}},
+			})
+
+			mockGet(client, "wiki/rest/api/content/"+pageID+"/label?limit=250&start=0", &model.ContentLabelPageScheme{}, &model.ContentLabelPageScheme{})
+			mockGet(client, "wiki/api/v2/pages/"+pageID+"/attachments?limit=250", &model.AttachmentPageScheme{}, &model.AttachmentPageScheme{})
+			mockGet(client, "wiki/api/v2/pages/"+pageID+"/children?limit=250", &model.ChildPageChunkScheme{}, &model.ChildPageChunkScheme{})
+		}
+	}
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx    context.Context
+		pageID int
+	}
+
+	testCases := []struct {
+		name      string
+		fields    fields
+		args      args
+		on        func(*fields)
+		want      *model.PageBundleScheme
+		wantFiles map[string]string
+		wantErr   bool
+		Err       error
+	}{
+		{
+			name: "when the page tree is exported",
+			args: args{
+				ctx:    context.Background(),
+				pageID: 100,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				mockTree(client)
+
+				fields.c = client
+			},
+			want: &model.PageBundleScheme{
+				Version:  model.PageBundleVersion,
+				SpaceID:  "10001",
+				SpaceKey: "DOC",
+				Pages: []*model.PageBundlePageScheme{
+					{
+						ID:          "100",
+						Title:       "Handbook",
+						Body:        "pages/100.xml",
+						Labels:      []*model.ContentLabelPayloadScheme{{Prefix: "global", Name: "handbook"}},
+						Attachments: []*model.PageBundleAttachmentScheme{{ID: "att300", Title: "logo.png", MediaType: "image/png", File: "attachments/100/att300-logo.png"}},
+					},
+					{ID: "101", ParentID: "100", Title: "Page 101", Position: 1, Body: "pages/101.xml"},
+					{ID: "102", ParentID: "100", Title: "Page 102", Position: 2, Body: "pages/102.xml"},
+				},
+			},
+			wantFiles: map[string]string{
+				"pages/100.xml":                   `<a href="/wiki/spaces/DOC/pages/101">Onboarding</a>`,
+				"pages/101.xml":                   "<p>101</p>",
+				"pages/102.xml":                   "<p>102</p>",
+				"attachments/100/att300-logo.png": "PNG",
+			},
+		},
+
+		{
+			name: "when the page cannot be fetched",
+			args: args{
+				ctx:    context.Background(),
+				pageID: 100,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/api/v2/pages/100?body-format=storage",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.PageScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrNotFound)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrNotFound,
+		},
+
+		{
+			name: "when the page id is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoPageID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewPageService(testCase.fields.c)
+
+			buffer := &bytes.Buffer{}
+			gotResult, err := newService.Export(testCase.args.ctx, testCase.args.pageID, buffer)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.Equal(t, testCase.want, gotResult)
+
+				bundle, err := zip.NewReader(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+				assert.NoError(t, err)
+
+				for name, content := range testCase.wantFiles {
+					data, err := fs.ReadFile(bundle, name)
+					assert.NoError(t, err)
+					assert.Equal(t, content, string(data))
+				}
+
+				_, err = fs.Stat(bundle, "manifest.json")
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_internalPageImpl_Import(t *testing.T) {
+
+	bundleMocked := fstest.MapFS{
+		"manifest.json": {Data: []byte(`{
+			"version": 1,
+			"spaceId": "10001",
+			"spaceKey": "DOC",
+			"pages": [
+				{
+					"id": "100",
+					"title": "Handbook",
+					"body": "pages/100.xml",
+					"labels": [{"prefix": "global", "name": "handbook"}],
+					"attachments": [{"id": "att300", "title": "logo.png", "file": "attachments/100/att300-logo.png"}]
+				},
+				{"id": "101", "parentId": "100", "title": "Onboarding", "position": 1, "body": "pages/101.xml"}
+			]
+		}`)},
+		"pages/100.xml":                   {Data: []byte(`<a href="/wiki/spaces/DOC/pages/101">Onboarding</a>`)},
+		"pages/101.xml":                   {Data: []byte("<p>Welcome</p>")},
+		"attachments/100/att300-logo.png": {Data: []byte("PNG")},
+	}
+
+	mockCreate := func(client *mocks.Connector, payload *model.PageCreatePayloadScheme, page *model.PageScheme, err error) {
+
+		request := &http.Request{Host: "create-" + payload.Title}
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPost,
+			"wiki/api/v2/pages",
+			"",
+			payload).
+			Return(request, nil)
+
+		client.On("Call",
+			request,
+			&model.PageScheme{}).
+			Run(func(args mock.Arguments) {
+				if page != nil {
+					*args.Get(1).(*model.PageScheme) = *page
+				}
+			}).
+			Return(&model.ResponseScheme{}, err)
+	}
+
+	mockRoot := func(client *mocks.Connector) {
+
+		mockCreate(client, &model.PageCreatePayloadScheme{
+			SpaceID:  "20002",
+			Status:   "current",
+			Title:    "Handbook",
+			ParentID: "900",
+			Body:     &model.PageBodyRepresentationScheme{Representation: "storage", Value: `<a href="/wiki/spaces/OPS/pages/101">Onboarding</a>`},
+		}, &model.PageScheme{ID: "500", ParentID: "900", Version: &model.PageVersionScheme{Number: 1}}, nil)
+
+		labels := &http.Request{Host: "labels"}
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPost,
+			"wiki/rest/api/content/500/label",
+			"",
+			[]*model.ContentLabelPayloadScheme{{Prefix: "global", Name: "handbook"}}).
+			Return(labels, nil)
+
+		client.On("Call",
+			labels,
+			&model.ContentLabelPageScheme{}).
+			Return(&model.ResponseScheme{}, nil)
+
+		attachment := &http.Request{Host: "attachment"}
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPost,
+			"wiki/rest/api/content/500/child/attachment?status=current",
+			mock.Anything,
+			mock.Anything).
+			Return(attachment, nil)
+
+		client.On("Call",
+			attachment,
+			&model.ContentPageScheme{}).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx     context.Context
+		bundle  fs.FS
+		options *model.PageBundleImportOptionsScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    *model.PageBundleImportScheme
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the page tree is imported",
+			args: args{
+				ctx:     context.Background(),
+				bundle:  bundleMocked,
+				options: &model.PageBundleImportOptionsScheme{SpaceID: 20002, SpaceKey: "OPS", ParentID: "900"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				mockRoot(client)
+
+				mockCreate(client, &model.PageCreatePayloadScheme{
+					SpaceID:  "20002",
+					Status:   "current",
+					Title:    "Onboarding",
+					ParentID: "500",
+					Body:     &model.PageBodyRepresentationScheme{Representation: "storage", Value: "<p>Welcome</p>"},
+				}, &model.PageScheme{ID: "501", ParentID: "500"}, nil)
+
+				request := &http.Request{Host: "update"}
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"wiki/api/v2/pages/500",
+					"",
+					&model.PageUpdatePayloadScheme{
+						ID:       "500",
+						Status:   "current",
+						Title:    "Handbook",
+						SpaceID:  "20002",
+						ParentID: "900",
+						Body:     &model.PageBodyRepresentationScheme{Representation: "storage", Value: `<a href="/wiki/spaces/OPS/pages/501">Onboarding</a>`},
+						Version:  &model.PageUpdatePayloadVersionScheme{Number: 2, Message: "Rewrite the links of the imported pages"},
+					}).
+					Return(request, nil)
+
+				client.On("Call",
+					request,
+					&model.PageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			want: &model.PageBundleImportScheme{Pages: map[string]string{"100": "500", "101": "501"}},
+		},
+
+		{
+			name: "when a page cannot be created",
+			args: args{
+				ctx:     context.Background(),
+				bundle:  bundleMocked,
+				options: &model.PageBundleImportOptionsScheme{SpaceID: 20002, SpaceKey: "OPS", ParentID: "900"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				mockRoot(client)
+
+				mockCreate(client, &model.PageCreatePayloadScheme{
+					SpaceID:  "20002",
+					Status:   "current",
+					Title:    "Onboarding",
+					ParentID: "500",
+					Body:     &model.PageBodyRepresentationScheme{Representation: "storage", Value: "<p>Welcome</p>"},
+				}, nil, model.ErrConflict)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrConflict,
+		},
+
+		{
+			name: "when the manifest is not found",
+			args: args{
+				ctx:     context.Background(),
+				bundle:  fstest.MapFS{},
+				options: &model.PageBundleImportOptionsScheme{SpaceID: 20002},
+			},
+			wantErr: true,
+			Err:     fs.ErrNotExist,
+		},
+
+		{
+			name: "when the space id is not provided",
+			args: args{
+				ctx:     context.Background(),
+				bundle:  bundleMocked,
+				options: &model.PageBundleImportOptionsScheme{},
+			},
+			wantErr: true,
+			Err:     model.ErrNoSpaceID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewPageService(testCase.fields.c)
+
+			gotResult, err := newService.Import(testCase.args.ctx, testCase.args.bundle, testCase.args.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.Equal(t, testCase.want, gotResult)
+			}
+		})
+	}
+}
@@ -2,12 +2,18 @@ package internal
 
 import (
 	"context"
+	"encoding/xml"
+	"errors"
 	"fmt"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/adf"
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/confluence"
+	"io"
+	"io/fs"
 	"net/http"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
 )
@@ -119,6 +125,87 @@ func (p *PageService) Delete(ctx context.Context, pageID int) (*model.ResponseSc
 	return p.internalClient.Delete(ctx, pageID)
 }
 
+// Versions returns the versions of a page.
+//
+// GET /wiki/api/v2/pages/{id}/versions
+//
+// https://docs.go-atlassian.io/confluence-cloud/v2/page#get-page-versions
+func (p *PageService) Versions(ctx context.Context, pageID int, options *model.PageVersionOptionsScheme, cursor string, limit int) (*model.PageVersionChunkScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Versions(ctx, pageID, options, cursor, limit)
+}
+
+// Version returns the details of a specific version of a page.
+//
+// GET /wiki/api/v2/pages/{page-id}/versions/{version-number}
+//
+// https://docs.go-atlassian.io/confluence-cloud/v2/page#get-version-details-for-page-version
+func (p *PageService) Version(ctx context.Context, pageID, versionNumber int) (*model.PageVersionDetailScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Version(ctx, pageID, versionNumber)
+}
+
+// DeleteVersion deletes a historical version of a page.
+//
+// The changes of the deleted version are rolled up into the next version, the current version cannot be deleted.
+//
+// DELETE /wiki/rest/api/content/{id}/version/{versionNumber}
+//
+// https://docs.go-atlassian.io/confluence-cloud/content/versions#delete-content-version
+func (p *PageService) DeleteVersion(ctx context.Context, pageID, versionNumber int) (*model.ResponseScheme, error) {
+	return p.internalClient.DeleteVersion(ctx, pageID, versionNumber)
+}
+
+// Restore rolls back a page to a historical version.
+//
+// A new version is created with the content and the title of the historical version.
+//
+// POST /wiki/rest/api/content/{id}/version
+//
+// https://docs.go-atlassian.io/confluence-cloud/content/versions#restore-content-version
+func (p *PageService) Restore(ctx context.Context, pageID, versionNumber int, message string) (*model.ContentVersionScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Restore(ctx, pageID, versionNumber, message)
+}
+
+// Publish converts the Markdown to the storage format and publishes it as a page, identified by its title within the space.
+//
+// The page is created when missing, otherwise a new version is published when the body changed.
+//
+// A version conflict caused by a concurrent update is retried once with the latest version number.
+//
+// GET /wiki/api/v2/pages
+//
+// POST /wiki/api/v2/pages
+//
+// PUT /wiki/api/v2/pages/{id}
+//
+// https://docs.go-atlassian.io/confluence-cloud/v2/page#update-page
+func (p *PageService) Publish(ctx context.Context, payload *model.PagePublishPayloadScheme) (*model.PagePublishScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Publish(ctx, payload)
+}
+
+// Export writes the page tree rooted at the page to a zip bundle, with the storage format bodies, labels, attachments and the ordering of the pages.
+//
+// The bundle can be recreated in another space or site with the Import method.
+//
+// GET /wiki/api/v2/pages/{id}
+//
+// GET /wiki/api/v2/pages/{id}/children
+//
+// https://docs.go-atlassian.io/confluence-cloud/v2/page
+func (p *PageService) Export(ctx context.Context, pageID int, w io.Writer) (*model.PageBundleScheme, error) {
+	return p.internalClient.Export(ctx, pageID, w)
+}
+
+// Import recreates the page tree of a bundle written by Export, the bundle can be a zip reader or an extracted directory.
+//
+// The links to the source space and between the pages of the tree are rewritten to the target space and the created pages.
+//
+// POST /wiki/api/v2/pages
+//
+// https://docs.go-atlassian.io/confluence-cloud/v2/page
+func (p *PageService) Import(ctx context.Context, bundle fs.FS, options *model.PageBundleImportOptionsScheme) (*model.PageBundleImportScheme, error) {
+	return p.internalClient.Import(ctx, bundle, options)
+}
+
 type internalPageImpl struct {
 	c service.Connector
 }
@@ -373,3 +460,261 @@ func (i *internalPageImpl) Delete(ctx context.Context, pageID int) (*model.Respo
 
 	return i.c.Call(request, nil)
 }
+
+func (i *internalPageImpl) Versions(ctx context.Context, pageID int, options *model.PageVersionOptionsScheme, cursor string, limit int) (*model.PageVersionChunkScheme, *model.ResponseScheme, error) {
+
+	if pageID == 0 {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoPageID)
+	}
+
+	query := url.Values{}
+	query.Add("limit", strconv.Itoa(limit))
+
+	if cursor != "" {
+		query.Add("cursor", cursor)
+	}
+
+	if options != nil {
+
+		if options.BodyFormat != "" {
+			query.Add("body-format", options.BodyFormat)
+		}
+
+		if options.Sort != "" {
+			query.Add("sort", options.Sort)
+		}
+	}
+
+	endpoint := fmt.Sprintf("wiki/api/v2/pages/%v/versions?%v", pageID, query.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	chunk := new(model.PageVersionChunkScheme)
+	response, err := i.c.Call(request, chunk)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return chunk, response, nil
+}
+
+func (i *internalPageImpl) Version(ctx context.Context, pageID, versionNumber int) (*model.PageVersionDetailScheme, *model.ResponseScheme, error) {
+
+	if pageID == 0 {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoPageID)
+	}
+
+	if versionNumber == 0 {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoVersionNumber)
+	}
+
+	endpoint := fmt.Sprintf("wiki/api/v2/pages/%v/versions/%v", pageID, versionNumber)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	version := new(model.PageVersionDetailScheme)
+	response, err := i.c.Call(request, version)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return version, response, nil
+}
+
+func (i *internalPageImpl) DeleteVersion(ctx context.Context, pageID, versionNumber int) (*model.ResponseScheme, error) {
+
+	if pageID == 0 {
+		return nil, fmt.Errorf("confluence: %w", model.ErrNoPageID)
+	}
+
+	if versionNumber == 0 {
+		return nil, fmt.Errorf("confluence: %w", model.ErrNoVersionNumber)
+	}
+
+	version := &internalVersionImpl{c: i.c}
+	return version.Delete(ctx, strconv.Itoa(pageID), versionNumber)
+}
+
+func (i *internalPageImpl) Restore(ctx context.Context, pageID, versionNumber int, message string) (*model.ContentVersionScheme, *model.ResponseScheme, error) {
+
+	if pageID == 0 {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoPageID)
+	}
+
+	if versionNumber == 0 {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoVersionNumber)
+	}
+
+	payload := &model.ContentRestorePayloadScheme{
+		OperationKey: "restore",
+		Params: &model.ContentRestoreParamsPayloadScheme{
+			VersionNumber: versionNumber,
+			Message:       message,
+			RestoreTitle:  true,
+		},
+	}
+
+	version := &internalVersionImpl{c: i.c}
+	return version.Restore(ctx, strconv.Itoa(pageID), payload, nil)
+}
+
+func (i *internalPageImpl) Publish(ctx context.Context, payload *model.PagePublishPayloadScheme) (*model.PagePublishScheme, *model.ResponseScheme, error) {
+
+	if payload == nil || payload.SpaceID == 0 {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoSpaceID)
+	}
+
+	if payload.Title == "" {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoPageTitle)
+	}
+
+	body := &model.PageBodyRepresentationScheme{Representation: "storage", Value: adf.MarkdownToStorage(payload.Markdown)}
+
+	options := &model.PageOptionsScheme{
+		SpaceIDs:   []int{payload.SpaceID},
+		Title:      payload.Title,
+		Status:     []string{"current"},
+		BodyFormat: "storage",
+	}
+
+	chunk, response, err := i.Gets(ctx, options, "", 1)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if len(chunk.Results) == 0 {
+
+		page, response, err := i.Create(ctx, &model.PageCreatePayloadScheme{
+			SpaceID:  strconv.Itoa(payload.SpaceID),
+			Status:   "current",
+			Title:    payload.Title,
+			ParentID: payload.ParentID,
+			Body:     body,
+		})
+		if err != nil {
+			return nil, response, err
+		}
+
+		return &model.PagePublishScheme{Page: page, Created: true, Updated: true}, response, nil
+	}
+
+	page := chunk.Results[0]
+
+	// A version conflict means the page was updated since it was fetched, the latest version is fetched and the update retried once
+	for attempt := 0; ; attempt++ {
+
+		// Confluence reformats the stored body, e.g. the entities and the self-closing tags, the canonical forms are compared
+		if page.Body != nil && page.Body.Storage != nil && storageCanonical(page.Body.Storage.Value) == storageCanonical(body.Value) {
+			return &model.PagePublishScheme{Page: page}, response, nil
+		}
+
+		pageID, err := strconv.Atoi(page.ID)
+		if err != nil {
+			return nil, response, fmt.Errorf("confluence: %w", err)
+		}
+
+		version := 1
+		if page.Version != nil {
+			version = page.Version.Number + 1
+		}
+
+		updated, updateResponse, err := i.Update(ctx, pageID, &model.PageUpdatePayloadScheme{
+			ID:      page.ID,
+			Status:  "current",
+			Title:   payload.Title,
+			SpaceID: strconv.Itoa(payload.SpaceID),
+			Body:    body,
+			Version: &model.PageUpdatePayloadVersionScheme{Number: version, Message: payload.Message},
+		})
+
+		if err == nil {
+			return &model.PagePublishScheme{Page: updated, Updated: true}, updateResponse, nil
+		}
+
+		if attempt != 0 || !errors.Is(err, model.ErrConflict) {
+			return nil, updateResponse, err
+		}
+
+		page, response, err = i.Get(ctx, pageID, "storage", false, 0)
+		if err != nil {
+			return nil, response, err
+		}
+	}
+}
+
+// storageCanonical returns the canonical form of a storage format body, the bodies differing only by their markup
+// comparing equal: the entities are decoded, the attributes sorted, the whitespace between the tags dropped and the
+// whitespace of the text collapsed, except in the code macros. The body is returned as is when it can't be parsed.
+func storageCanonical(storage string) string {
+
+	decoder := xml.NewDecoder(strings.NewReader("<body>" + storage + "</body>"))
+	decoder.Strict = false
+	decoder.AutoClose = xml.HTMLAutoClose
+	decoder.Entity = xml.HTMLEntity
+
+	name := func(name xml.Name) string {
+		if name.Space == "" {
+			return name.Local
+		}
+
+		return name.Space + ":" + name.Local
+	}
+
+	var (
+		canonical strings.Builder
+		verbatim  int
+	)
+
+	for {
+
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return canonical.String()
+		}
+
+		if err != nil {
+			return storage
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+
+			attrs := make([]string, 0, len(token.Attr))
+			for _, attr := range token.Attr {
+				attrs = append(attrs, fmt.Sprintf("%v=%q", name(attr.Name), attr.Value))
+			}
+			slices.Sort(attrs)
+
+			fmt.Fprintf(&canonical, "<%v %v>", name(token.Name), strings.Join(attrs, " "))
+
+			if token.Name.Local == "plain-text-body" {
+				verbatim++
+			}
+
+		case xml.EndElement:
+
+			fmt.Fprintf(&canonical, "</%v>", name(token.Name))
+
+			if token.Name.Local == "plain-text-body" {
+				verbatim--
+			}
+
+		case xml.CharData:
+
+			if verbatim > 0 {
+				canonical.Write(token)
+				continue
+			}
+
+			if text := strings.Join(strings.Fields(string(token)), " "); text != "" {
+				canonical.WriteString(text)
+			}
+		}
+	}
+}
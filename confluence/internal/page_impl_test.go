@@ -4,12 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"log"
 	"net/http"
 	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
@@ -1186,3 +1188,909 @@ func Test_internalPageImpl_Update(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalPageImpl_Versions(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx     context.Context
+		pageID  int
+		options *model.PageVersionOptionsScheme
+		cursor  string
+		limit   int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:     context.Background(),
+				pageID:  200001,
+				options: &model.PageVersionOptionsScheme{BodyFormat: "storage", Sort: "-modified-date"},
+				cursor:  "cursor-sample",
+				limit:   25,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/api/v2/pages/200001/versions?body-format=storage&cursor=cursor-sample&limit=25&sort=-modified-date",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.PageVersionChunkScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name: "when the http call cannot be executed",
+			args: args{
+				ctx:     context.Background(),
+				pageID:  200001,
+				options: &model.PageVersionOptionsScheme{BodyFormat: "storage", Sort: "-modified-date"},
+				cursor:  "cursor-sample",
+				limit:   25,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/api/v2/pages/200001/versions?body-format=storage&cursor=cursor-sample&limit=25&sort=-modified-date",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.PageVersionChunkScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrNoHttpResponse)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrNoHttpResponse,
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:     context.Background(),
+				pageID:  200001,
+				options: &model.PageVersionOptionsScheme{BodyFormat: "storage", Sort: "-modified-date"},
+				cursor:  "cursor-sample",
+				limit:   25,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/api/v2/pages/200001/versions?body-format=storage&cursor=cursor-sample&limit=25&sort=-modified-date",
+					"",
+					nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the page id is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoPageID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewPageService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Versions(testCase.args.ctx, testCase.args.pageID, testCase.args.options, testCase.args.cursor, testCase.args.limit)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalPageImpl_Version(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx           context.Context
+		pageID        int
+		versionNumber int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:           context.Background(),
+				pageID:        200001,
+				versionNumber: 3,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/api/v2/pages/200001/versions/3",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.PageVersionDetailScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name: "when the http call cannot be executed",
+			args: args{
+				ctx:           context.Background(),
+				pageID:        200001,
+				versionNumber: 3,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/api/v2/pages/200001/versions/3",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.PageVersionDetailScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrNoHttpResponse)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrNoHttpResponse,
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:           context.Background(),
+				pageID:        200001,
+				versionNumber: 3,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/api/v2/pages/200001/versions/3",
+					"",
+					nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the version number is not provided",
+			args: args{
+				ctx:    context.Background(),
+				pageID: 200001,
+			},
+			wantErr: true,
+			Err:     model.ErrNoVersionNumber,
+		},
+
+		{
+			name: "when the page id is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoPageID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewPageService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Version(testCase.args.ctx, testCase.args.pageID, testCase.args.versionNumber)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalPageImpl_DeleteVersion(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx           context.Context
+		pageID        int
+		versionNumber int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:           context.Background(),
+				pageID:        200001,
+				versionNumber: 3,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"wiki/rest/api/content/200001/version/3",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:           context.Background(),
+				pageID:        200001,
+				versionNumber: 3,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"wiki/rest/api/content/200001/version/3",
+					"",
+					nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the version number is not provided",
+			args: args{
+				ctx:    context.Background(),
+				pageID: 200001,
+			},
+			wantErr: true,
+			Err:     model.ErrNoVersionNumber,
+		},
+
+		{
+			name: "when the page id is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoPageID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewPageService(testCase.fields.c)
+
+			gotResponse, err := newService.DeleteVersion(testCase.args.ctx, testCase.args.pageID, testCase.args.versionNumber)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalPageImpl_Restore(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx           context.Context
+		pageID        int
+		versionNumber int
+		message       string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:           context.Background(),
+				pageID:        200001,
+				versionNumber: 3,
+				message:       "Rollback the bad publish",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"wiki/rest/api/content/200001/version",
+					"",
+					&model.ContentRestorePayloadScheme{
+						OperationKey: "restore",
+						Params: &model.ContentRestoreParamsPayloadScheme{
+							VersionNumber: 3,
+							Message:       "Rollback the bad publish",
+							RestoreTitle:  true,
+						},
+					}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentVersionScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name: "when the http call cannot be executed",
+			args: args{
+				ctx:           context.Background(),
+				pageID:        200001,
+				versionNumber: 3,
+				message:       "Rollback the bad publish",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"wiki/rest/api/content/200001/version",
+					"",
+					&model.ContentRestorePayloadScheme{
+						OperationKey: "restore",
+						Params: &model.ContentRestoreParamsPayloadScheme{
+							VersionNumber: 3,
+							Message:       "Rollback the bad publish",
+							RestoreTitle:  true,
+						},
+					}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentVersionScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrNoHttpResponse)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrNoHttpResponse,
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:           context.Background(),
+				pageID:        200001,
+				versionNumber: 3,
+				message:       "Rollback the bad publish",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"wiki/rest/api/content/200001/version",
+					"",
+					&model.ContentRestorePayloadScheme{
+						OperationKey: "restore",
+						Params: &model.ContentRestoreParamsPayloadScheme{
+							VersionNumber: 3,
+							Message:       "Rollback the bad publish",
+							RestoreTitle:  true,
+						},
+					}).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the version number is not provided",
+			args: args{
+				ctx:    context.Background(),
+				pageID: 200001,
+			},
+			wantErr: true,
+			Err:     model.ErrNoVersionNumber,
+		},
+
+		{
+			name: "when the page id is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoPageID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewPageService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Restore(testCase.args.ctx, testCase.args.pageID, testCase.args.versionNumber, testCase.args.message)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalPageImpl_Publish(t *testing.T) {
+
+	payloadMocked := &model.PagePublishPayloadScheme{
+		SpaceID:  10001,
+		Title:    "Release notes",
+		ParentID: "200000",
+		Markdown: "# Release notes",
+		Message:  "Published from the pipeline",
+	}
+
+	bodyMocked := &model.PageBodyRepresentationScheme{Representation: "storage", Value: "<h1>Release notes</h1>"}
+
+	mockSearch := func(client *mocks.Connector, pages ...*model.PageScheme) {
+
+		request := &http.Request{Host: "search"}
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"wiki/api/v2/pages?body-format=storage&limit=1&space-id=10001&status=current&title=Release+notes",
+			"",
+			nil).
+			Return(request, nil)
+
+		client.On("Call",
+			request,
+			&model.PageChunkScheme{}).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*model.PageChunkScheme).Results = pages
+			}).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	mockUpdate := func(client *mocks.Connector, version int, err error) {
+
+		request := &http.Request{Host: fmt.Sprintf("update-%v", version)}
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPut,
+			"wiki/api/v2/pages/200001",
+			"",
+			&model.PageUpdatePayloadScheme{
+				ID:      "200001",
+				Status:  "current",
+				Title:   "Release notes",
+				SpaceID: "10001",
+				Body:    bodyMocked,
+				Version: &model.PageUpdatePayloadVersionScheme{Number: version, Message: "Published from the pipeline"},
+			}).
+			Return(request, nil)
+
+		client.On("Call",
+			request,
+			&model.PageScheme{}).
+			Return(&model.ResponseScheme{}, err)
+	}
+
+	outdated := &model.PageScheme{
+		ID:      "200001",
+		Version: &model.PageVersionScheme{Number: 4},
+		Body:    &model.PageBodyScheme{Storage: &model.PageBodyRepresentationScheme{Value: "<h1>Draft</h1>"}},
+	}
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx     context.Context
+		payload *model.PagePublishPayloadScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    *model.PagePublishScheme
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the page is created",
+			args: args{
+				ctx:     context.Background(),
+				payload: payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				mockSearch(client)
+
+				request := &http.Request{Host: "create"}
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"wiki/api/v2/pages",
+					"",
+					&model.PageCreatePayloadScheme{
+						SpaceID:  "10001",
+						Status:   "current",
+						Title:    "Release notes",
+						ParentID: "200000",
+						Body:     bodyMocked,
+					}).
+					Return(request, nil)
+
+				client.On("Call",
+					request,
+					&model.PageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			want: &model.PagePublishScheme{Page: &model.PageScheme{}, Created: true, Updated: true},
+		},
+
+		{
+			name: "when the page is updated",
+			args: args{
+				ctx:     context.Background(),
+				payload: payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				mockSearch(client, outdated)
+				mockUpdate(client, 5, nil)
+
+				fields.c = client
+			},
+			want: &model.PagePublishScheme{Page: &model.PageScheme{}, Updated: true},
+		},
+
+		{
+			name: "when the page is unchanged",
+			args: args{
+				ctx:     context.Background(),
+				payload: payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				mockSearch(client, &model.PageScheme{ID: "200001", Body: &model.PageBodyScheme{Storage: bodyMocked}})
+
+				fields.c = client
+			},
+			want: &model.PagePublishScheme{Page: &model.PageScheme{ID: "200001", Body: &model.PageBodyScheme{Storage: bodyMocked}}},
+		},
+
+		{
+			name: "when the page is unchanged but reformatted by confluence",
+			args: args{
+				ctx:     context.Background(),
+				payload: payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				mockSearch(client, &model.PageScheme{ID: "200001", Body: &model.PageBodyScheme{Storage: &model.PageBodyRepresentationScheme{Value: "<h1>Release  notes</h1>\n"}}})
+
+				fields.c = client
+			},
+			want: &model.PagePublishScheme{Page: &model.PageScheme{ID: "200001", Body: &model.PageBodyScheme{Storage: &model.PageBodyRepresentationScheme{Value: "<h1>Release  notes</h1>\n"}}}},
+		},
+
+		{
+			name: "when the version conflicts with a concurrent update",
+			args: args{
+				ctx:     context.Background(),
+				payload: payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				mockSearch(client, outdated)
+				mockUpdate(client, 5, model.ErrConflict)
+
+				request := &http.Request{Host: "get"}
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/api/v2/pages/200001?body-format=storage",
+					"",
+					nil).
+					Return(request, nil)
+
+				client.On("Call",
+					request,
+					&model.PageScheme{}).
+					Run(func(args mock.Arguments) {
+						*args.Get(1).(*model.PageScheme) = model.PageScheme{ID: "200001", Version: &model.PageVersionScheme{Number: 5}}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				mockUpdate(client, 6, nil)
+
+				fields.c = client
+			},
+			want: &model.PagePublishScheme{Page: &model.PageScheme{}, Updated: true},
+		},
+
+		{
+			name: "when the version conflicts twice",
+			args: args{
+				ctx:     context.Background(),
+				payload: payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				mockSearch(client, outdated)
+				mockUpdate(client, 5, model.ErrConflict)
+
+				request := &http.Request{Host: "get"}
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/api/v2/pages/200001?body-format=storage",
+					"",
+					nil).
+					Return(request, nil)
+
+				client.On("Call",
+					request,
+					&model.PageScheme{}).
+					Run(func(args mock.Arguments) {
+						*args.Get(1).(*model.PageScheme) = model.PageScheme{ID: "200001", Version: &model.PageVersionScheme{Number: 5}}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				mockUpdate(client, 6, model.ErrConflict)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrConflict,
+		},
+
+		{
+			name: "when the title is not provided",
+			args: args{
+				ctx:     context.Background(),
+				payload: &model.PagePublishPayloadScheme{SpaceID: 10001},
+			},
+			wantErr: true,
+			Err:     model.ErrNoPageTitle,
+		},
+
+		{
+			name: "when the space id is not provided",
+			args: args{
+				ctx:     context.Background(),
+				payload: &model.PagePublishPayloadScheme{Title: "Release notes"},
+			},
+			wantErr: true,
+			Err:     model.ErrNoSpaceID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewPageService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Publish(testCase.args.ctx, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.Equal(t, testCase.want, gotResult)
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/confluence"
+)
+
+// NewSettingsService creates a new instance of SettingsService.
+// It takes a service.Connector as input and returns a pointer to SettingsService.
+func NewSettingsService(client service.Connector) *SettingsService {
+	return &SettingsService{
+		internalClient: &internalSettingsImpl{c: client},
+	}
+}
+
+// SettingsService provides methods to manage the look and feel and read the system settings of Confluence.
+type SettingsService struct {
+	// internalClient is the connector interface for settings operations.
+	internalClient confluence.SettingsConnector
+}
+
+// LookAndFeel returns the look and feel settings for the site or a single space.
+//
+// GET /wiki/rest/api/settings/lookandfeel
+//
+// https://docs.go-atlassian.io/confluence-cloud/settings#get-look-and-feel-settings
+func (s *SettingsService) LookAndFeel(ctx context.Context, spaceKey string) (*model.LookAndFeelSettingsScheme, *model.ResponseScheme, error) {
+	return s.internalClient.LookAndFeel(ctx, spaceKey)
+}
+
+// SelectLookAndFeel sets the look and feel type used by a space: global, custom or theme.
+//
+// PUT /wiki/rest/api/settings/lookandfeel
+//
+// https://docs.go-atlassian.io/confluence-cloud/settings#select-look-and-feel-settings
+func (s *SettingsService) SelectLookAndFeel(ctx context.Context, payload *model.LookAndFeelSelectionScheme) (*model.LookAndFeelSelectionScheme, *model.ResponseScheme, error) {
+	return s.internalClient.SelectLookAndFeel(ctx, payload)
+}
+
+// UpdateLookAndFeel updates the custom look and feel of the site or a single space.
+//
+// POST /wiki/rest/api/settings/lookandfeel/custom
+//
+// https://docs.go-atlassian.io/confluence-cloud/settings#update-look-and-feel-settings
+func (s *SettingsService) UpdateLookAndFeel(ctx context.Context, spaceKey string, payload *model.LookAndFeelScheme) (*model.LookAndFeelScheme, *model.ResponseScheme, error) {
+	return s.internalClient.UpdateLookAndFeel(ctx, spaceKey, payload)
+}
+
+// ResetLookAndFeel resets the custom look and feel of the site or a single space to the default values.
+//
+// DELETE /wiki/rest/api/settings/lookandfeel/custom
+//
+// https://docs.go-atlassian.io/confluence-cloud/settings#reset-look-and-feel-settings
+func (s *SettingsService) ResetLookAndFeel(ctx context.Context, spaceKey string) (*model.ResponseScheme, error) {
+	return s.internalClient.ResetLookAndFeel(ctx, spaceKey)
+}
+
+// Theme returns the theme selected for the site.
+//
+// GET /wiki/rest/api/settings/theme/selected
+//
+// https://docs.go-atlassian.io/confluence-cloud/settings#get-global-theme
+func (s *SettingsService) Theme(ctx context.Context) (*model.ThemeScheme, *model.ResponseScheme, error) {
+	return s.internalClient.Theme(ctx)
+}
+
+// SystemInfo returns the system information of the Confluence site, e.g. the cloud ID, edition and default locale.
+//
+// GET /wiki/rest/api/settings/systemInfo
+//
+// https://docs.go-atlassian.io/confluence-cloud/settings#get-system-info
+func (s *SettingsService) SystemInfo(ctx context.Context) (*model.SystemInfoScheme, *model.ResponseScheme, error) {
+	return s.internalClient.SystemInfo(ctx)
+}
+
+type internalSettingsImpl struct {
+	c service.Connector
+}
+
+func (i *internalSettingsImpl) LookAndFeel(ctx context.Context, spaceKey string) (*model.LookAndFeelSettingsScheme, *model.ResponseScheme, error) {
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, lookAndFeelEndpoint("wiki/rest/api/settings/lookandfeel", spaceKey), "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settings := new(model.LookAndFeelSettingsScheme)
+	response, err := i.c.Call(request, settings)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return settings, response, nil
+}
+
+func (i *internalSettingsImpl) SelectLookAndFeel(ctx context.Context, payload *model.LookAndFeelSelectionScheme) (*model.LookAndFeelSelectionScheme, *model.ResponseScheme, error) {
+
+	if payload == nil {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoLookAndFeel)
+	}
+
+	if payload.SpaceKey == "" {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoSpaceKey)
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, "wiki/rest/api/settings/lookandfeel", "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	selection := new(model.LookAndFeelSelectionScheme)
+	response, err := i.c.Call(request, selection)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return selection, response, nil
+}
+
+func (i *internalSettingsImpl) UpdateLookAndFeel(ctx context.Context, spaceKey string, payload *model.LookAndFeelScheme) (*model.LookAndFeelScheme, *model.ResponseScheme, error) {
+
+	if payload == nil {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoLookAndFeel)
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, lookAndFeelEndpoint("wiki/rest/api/settings/lookandfeel/custom", spaceKey), "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	lookAndFeel := new(model.LookAndFeelScheme)
+	response, err := i.c.Call(request, lookAndFeel)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return lookAndFeel, response, nil
+}
+
+func (i *internalSettingsImpl) ResetLookAndFeel(ctx context.Context, spaceKey string) (*model.ResponseScheme, error) {
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, lookAndFeelEndpoint("wiki/rest/api/settings/lookandfeel/custom", spaceKey), "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalSettingsImpl) Theme(ctx context.Context) (*model.ThemeScheme, *model.ResponseScheme, error) {
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, "wiki/rest/api/settings/theme/selected", "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	theme := new(model.ThemeScheme)
+	response, err := i.c.Call(request, theme)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return theme, response, nil
+}
+
+func (i *internalSettingsImpl) SystemInfo(ctx context.Context) (*model.SystemInfoScheme, *model.ResponseScheme, error) {
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, "wiki/rest/api/settings/systemInfo", "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	info := new(model.SystemInfoScheme)
+	response, err := i.c.Call(request, info)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return info, response, nil
+}
+
+// lookAndFeelEndpoint scopes the look and feel endpoint to the space, when the space key is provided.
+func lookAndFeelEndpoint(endpoint, spaceKey string) string {
+
+	if spaceKey == "" {
+		return endpoint
+	}
+
+	query := url.Values{}
+	query.Add("spaceKey", spaceKey)
+
+	return fmt.Sprintf("%v?%v", endpoint, query.Encode())
+}
@@ -0,0 +1,888 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func Test_internalSettingsImpl_LookAndFeel(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx      context.Context
+		spaceKey string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:      context.Background(),
+				spaceKey: "DUMMY",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/settings/lookandfeel?spaceKey=DUMMY",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.LookAndFeelSettingsScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name: "when the space key is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/settings/lookandfeel",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.LookAndFeelSettingsScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name: "when the http call cannot be executed",
+			args: args{
+				ctx:      context.Background(),
+				spaceKey: "DUMMY",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/settings/lookandfeel?spaceKey=DUMMY",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.LookAndFeelSettingsScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrUnauthorized)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrUnauthorized,
+		},
+
+		{
+			name: "when the request cannot be created",
+			args: args{
+				ctx:      context.Background(),
+				spaceKey: "DUMMY",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/settings/lookandfeel?spaceKey=DUMMY",
+					"",
+					nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewSettingsService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.LookAndFeel(testCase.args.ctx, testCase.args.spaceKey)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalSettingsImpl_SelectLookAndFeel(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx     context.Context
+		payload *model.LookAndFeelSelectionScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx: context.Background(),
+				payload: &model.LookAndFeelSelectionScheme{
+					SpaceKey:        "DUMMY",
+					LookAndFeelType: model.LookAndFeelTypeGlobal,
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"wiki/rest/api/settings/lookandfeel",
+					"",
+					&model.LookAndFeelSelectionScheme{
+						SpaceKey:        "DUMMY",
+						LookAndFeelType: model.LookAndFeelTypeGlobal,
+					}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.LookAndFeelSelectionScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name: "when the http call cannot be executed",
+			args: args{
+				ctx: context.Background(),
+				payload: &model.LookAndFeelSelectionScheme{
+					SpaceKey:        "DUMMY",
+					LookAndFeelType: model.LookAndFeelTypeGlobal,
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"wiki/rest/api/settings/lookandfeel",
+					"",
+					&model.LookAndFeelSelectionScheme{
+						SpaceKey:        "DUMMY",
+						LookAndFeelType: model.LookAndFeelTypeGlobal,
+					}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.LookAndFeelSelectionScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrUnauthorized)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrUnauthorized,
+		},
+
+		{
+			name: "when the request cannot be created",
+			args: args{
+				ctx: context.Background(),
+				payload: &model.LookAndFeelSelectionScheme{
+					SpaceKey:        "DUMMY",
+					LookAndFeelType: model.LookAndFeelTypeGlobal,
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"wiki/rest/api/settings/lookandfeel",
+					"",
+					&model.LookAndFeelSelectionScheme{
+						SpaceKey:        "DUMMY",
+						LookAndFeelType: model.LookAndFeelTypeGlobal,
+					}).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoLookAndFeel,
+		},
+
+		{
+			name: "when the space key is not provided",
+			args: args{
+				ctx:     context.Background(),
+				payload: &model.LookAndFeelSelectionScheme{LookAndFeelType: model.LookAndFeelTypeCustom},
+			},
+			wantErr: true,
+			Err:     model.ErrNoSpaceKey,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewSettingsService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.SelectLookAndFeel(testCase.args.ctx, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalSettingsImpl_UpdateLookAndFeel(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx      context.Context
+		spaceKey string
+		payload  *model.LookAndFeelScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:      context.Background(),
+				spaceKey: "DUMMY",
+				payload: &model.LookAndFeelScheme{
+					Headings: &model.LookAndFeelColorScheme{Color: "#172B4D"},
+					Links:    &model.LookAndFeelColorScheme{Color: "#0052CC"},
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"wiki/rest/api/settings/lookandfeel/custom?spaceKey=DUMMY",
+					"",
+					&model.LookAndFeelScheme{
+						Headings: &model.LookAndFeelColorScheme{Color: "#172B4D"},
+						Links:    &model.LookAndFeelColorScheme{Color: "#0052CC"},
+					}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.LookAndFeelScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name: "when the http call cannot be executed",
+			args: args{
+				ctx:      context.Background(),
+				spaceKey: "DUMMY",
+				payload: &model.LookAndFeelScheme{
+					Headings: &model.LookAndFeelColorScheme{Color: "#172B4D"},
+					Links:    &model.LookAndFeelColorScheme{Color: "#0052CC"},
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"wiki/rest/api/settings/lookandfeel/custom?spaceKey=DUMMY",
+					"",
+					&model.LookAndFeelScheme{
+						Headings: &model.LookAndFeelColorScheme{Color: "#172B4D"},
+						Links:    &model.LookAndFeelColorScheme{Color: "#0052CC"},
+					}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.LookAndFeelScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrUnauthorized)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrUnauthorized,
+		},
+
+		{
+			name: "when the request cannot be created",
+			args: args{
+				ctx:      context.Background(),
+				spaceKey: "DUMMY",
+				payload: &model.LookAndFeelScheme{
+					Headings: &model.LookAndFeelColorScheme{Color: "#172B4D"},
+					Links:    &model.LookAndFeelColorScheme{Color: "#0052CC"},
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"wiki/rest/api/settings/lookandfeel/custom?spaceKey=DUMMY",
+					"",
+					&model.LookAndFeelScheme{
+						Headings: &model.LookAndFeelColorScheme{Color: "#172B4D"},
+						Links:    &model.LookAndFeelColorScheme{Color: "#0052CC"},
+					}).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx:      context.Background(),
+				spaceKey: "DUMMY",
+			},
+			wantErr: true,
+			Err:     model.ErrNoLookAndFeel,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewSettingsService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.UpdateLookAndFeel(testCase.args.ctx, testCase.args.spaceKey, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalSettingsImpl_ResetLookAndFeel(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx      context.Context
+		spaceKey string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:      context.Background(),
+				spaceKey: "DUMMY",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"wiki/rest/api/settings/lookandfeel/custom?spaceKey=DUMMY",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name: "when the http call cannot be executed",
+			args: args{
+				ctx:      context.Background(),
+				spaceKey: "DUMMY",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"wiki/rest/api/settings/lookandfeel/custom?spaceKey=DUMMY",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, model.ErrUnauthorized)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrUnauthorized,
+		},
+
+		{
+			name: "when the request cannot be created",
+			args: args{
+				ctx:      context.Background(),
+				spaceKey: "DUMMY",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"wiki/rest/api/settings/lookandfeel/custom?spaceKey=DUMMY",
+					"",
+					nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewSettingsService(testCase.fields.c)
+
+			gotResponse, err := newService.ResetLookAndFeel(testCase.args.ctx, testCase.args.spaceKey)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalSettingsImpl_Theme(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx context.Context
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx: context.Background(),
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/settings/theme/selected",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ThemeScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name: "when the http call cannot be executed",
+			args: args{
+				ctx: context.Background(),
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/settings/theme/selected",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ThemeScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrUnauthorized)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrUnauthorized,
+		},
+
+		{
+			name: "when the request cannot be created",
+			args: args{
+				ctx: context.Background(),
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/settings/theme/selected",
+					"",
+					nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewSettingsService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Theme(testCase.args.ctx)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalSettingsImpl_SystemInfo(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx context.Context
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx: context.Background(),
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/settings/systemInfo",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.SystemInfoScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name: "when the http call cannot be executed",
+			args: args{
+				ctx: context.Background(),
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/settings/systemInfo",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.SystemInfoScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrUnauthorized)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrUnauthorized,
+		},
+
+		{
+			name: "when the request cannot be created",
+			args: args{
+				ctx: context.Background(),
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/settings/systemInfo",
+					"",
+					nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewSettingsService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.SystemInfo(testCase.args.ctx)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
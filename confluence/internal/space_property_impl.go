@@ -0,0 +1,203 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/confluence"
+)
+
+// NewSpacePropertyService creates a new instance of SpacePropertyService.
+// It takes a service.Connector as input and returns a pointer to SpacePropertyService.
+func NewSpacePropertyService(client service.Connector) *SpacePropertyService {
+	return &SpacePropertyService{
+		internalClient: &internalSpacePropertyImpl{c: client},
+	}
+}
+
+// SpacePropertyService provides methods to interact with space property operations in Confluence V2.
+//
+// The space properties store the per-space configuration of the apps, as JSON values identified by a key.
+type SpacePropertyService struct {
+	// internalClient is the connector interface for space property operations.
+	internalClient confluence.SpacePropertyConnector
+}
+
+// Gets returns the properties of a space, filtered by key when the key is provided.
+//
+// GET /wiki/api/v2/spaces/{space-id}/properties
+func (s *SpacePropertyService) Gets(ctx context.Context, spaceID int, key, cursor string, limit int) (*model.SpacePropertyPageScheme, *model.ResponseScheme, error) {
+	return s.internalClient.Gets(ctx, spaceID, key, cursor, limit)
+}
+
+// Create creates a property for a space.
+//
+// POST /wiki/api/v2/spaces/{space-id}/properties
+func (s *SpacePropertyService) Create(ctx context.Context, spaceID int, payload *model.SpacePropertyPayloadScheme) (*model.SpacePropertyScheme, *model.ResponseScheme, error) {
+	return s.internalClient.Create(ctx, spaceID, payload)
+}
+
+// Get returns a space property by its ID.
+//
+// GET /wiki/api/v2/spaces/{space-id}/properties/{property-id}
+func (s *SpacePropertyService) Get(ctx context.Context, spaceID int, propertyID string) (*model.SpacePropertyScheme, *model.ResponseScheme, error) {
+	return s.internalClient.Get(ctx, spaceID, propertyID)
+}
+
+// Update updates a space property, the payload version number being the current version number plus one.
+//
+// PUT /wiki/api/v2/spaces/{space-id}/properties/{property-id}
+func (s *SpacePropertyService) Update(ctx context.Context, spaceID int, propertyID string, payload *model.SpacePropertyPayloadScheme) (*model.SpacePropertyScheme, *model.ResponseScheme, error) {
+	return s.internalClient.Update(ctx, spaceID, propertyID, payload)
+}
+
+// Delete deletes a space property.
+//
+// DELETE /wiki/api/v2/spaces/{space-id}/properties/{property-id}
+func (s *SpacePropertyService) Delete(ctx context.Context, spaceID int, propertyID string) (*model.ResponseScheme, error) {
+	return s.internalClient.Delete(ctx, spaceID, propertyID)
+}
+
+type internalSpacePropertyImpl struct {
+	c service.Connector
+}
+
+func (i *internalSpacePropertyImpl) Gets(ctx context.Context, spaceID int, key, cursor string, limit int) (*model.SpacePropertyPageScheme, *model.ResponseScheme, error) {
+
+	if spaceID == 0 {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoSpaceID)
+	}
+
+	query := url.Values{}
+	query.Add("limit", strconv.Itoa(limit))
+
+	if key != "" {
+		query.Add("key", key)
+	}
+
+	if cursor != "" {
+		query.Add("cursor", cursor)
+	}
+
+	endpoint := fmt.Sprintf("wiki/api/v2/spaces/%v/properties?%v", spaceID, query.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.SpacePropertyPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalSpacePropertyImpl) Create(ctx context.Context, spaceID int, payload *model.SpacePropertyPayloadScheme) (*model.SpacePropertyScheme, *model.ResponseScheme, error) {
+
+	if spaceID == 0 {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoSpaceID)
+	}
+
+	if payload == nil || payload.Key == "" {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoPropertyKey)
+	}
+
+	endpoint := fmt.Sprintf("wiki/api/v2/spaces/%v/properties", spaceID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	property := new(model.SpacePropertyScheme)
+	response, err := i.c.Call(request, property)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return property, response, nil
+}
+
+func (i *internalSpacePropertyImpl) Get(ctx context.Context, spaceID int, propertyID string) (*model.SpacePropertyScheme, *model.ResponseScheme, error) {
+
+	if spaceID == 0 {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoSpaceID)
+	}
+
+	if propertyID == "" {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoSpacePropertyID)
+	}
+
+	endpoint := fmt.Sprintf("wiki/api/v2/spaces/%v/properties/%v", spaceID, propertyID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	property := new(model.SpacePropertyScheme)
+	response, err := i.c.Call(request, property)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return property, response, nil
+}
+
+func (i *internalSpacePropertyImpl) Update(ctx context.Context, spaceID int, propertyID string, payload *model.SpacePropertyPayloadScheme) (*model.SpacePropertyScheme, *model.ResponseScheme, error) {
+
+	if spaceID == 0 {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoSpaceID)
+	}
+
+	if propertyID == "" {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoSpacePropertyID)
+	}
+
+	if payload == nil || payload.Version == nil || payload.Version.Number == 0 {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoVersionNumber)
+	}
+
+	endpoint := fmt.Sprintf("wiki/api/v2/spaces/%v/properties/%v", spaceID, propertyID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	property := new(model.SpacePropertyScheme)
+	response, err := i.c.Call(request, property)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return property, response, nil
+}
+
+func (i *internalSpacePropertyImpl) Delete(ctx context.Context, spaceID int, propertyID string) (*model.ResponseScheme, error) {
+
+	if spaceID == 0 {
+		return nil, fmt.Errorf("confluence: %w", model.ErrNoSpaceID)
+	}
+
+	if propertyID == "" {
+		return nil, fmt.Errorf("confluence: %w", model.ErrNoSpacePropertyID)
+	}
+
+	endpoint := fmt.Sprintf("wiki/api/v2/spaces/%v/properties/%v", spaceID, propertyID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
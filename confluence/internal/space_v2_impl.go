@@ -16,6 +16,8 @@ import (
 type SpaceV2Service struct {
 	// internalClient is the connector interface for space operations.
 	internalClient confluence.SpaceV2Connector
+	// Property is a pointer to SpacePropertyService for the space property operations.
+	Property *SpacePropertyService
 }
 
 // Bulk returns all spaces.
@@ -53,6 +55,7 @@ func NewSpaceV2Service(client service.Connector) *SpaceV2Service {
 
 	return &SpaceV2Service{
 		internalClient: &internalSpaceV2Impl{c: client},
+		Property:       NewSpacePropertyService(client),
 	}
 }
 
@@ -9,8 +9,12 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"time"
 )
 
+// defaultLongTaskPollInterval is the interval used by WaitFor when no poll interval is provided.
+const defaultLongTaskPollInterval = time.Second
+
 // NewTaskService creates a new instance of TaskService.
 // It takes a service.Connector as input and returns a pointer to TaskService.
 func NewTaskService(client service.Connector) *TaskService {
@@ -47,6 +51,15 @@ func (t *TaskService) Get(ctx context.Context, taskID string) (*model.LongTaskSc
 	return t.internalClient.Get(ctx, taskID)
 }
 
+// WaitFor polls a long-running task (e.g. space delete, copy page hierarchy or PDF export) until it's finished.
+//
+// The task is fetched every pollInterval, a zero interval falls back to one second.
+//
+// It returns model.ErrLongTaskFailed when the task finishes without success, and the context error when ctx is done first.
+func (t *TaskService) WaitFor(ctx context.Context, taskID string, pollInterval time.Duration) (*model.LongTaskScheme, *model.ResponseScheme, error) {
+	return t.internalClient.WaitFor(ctx, taskID, pollInterval)
+}
+
 type internalTaskImpl struct {
 	c service.Connector
 }
@@ -90,3 +103,39 @@ func (i *internalTaskImpl) Get(ctx context.Context, taskID string) (*model.LongT
 
 	return task, response, nil
 }
+
+func (i *internalTaskImpl) WaitFor(ctx context.Context, taskID string, pollInterval time.Duration) (*model.LongTaskScheme, *model.ResponseScheme, error) {
+
+	if taskID == "" {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoTaskID)
+	}
+
+	if pollInterval <= 0 {
+		pollInterval = defaultLongTaskPollInterval
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		task, response, err := i.Get(ctx, taskID)
+		if err != nil {
+			return nil, response, err
+		}
+
+		if task.Finished {
+
+			if !task.Successful {
+				return task, response, fmt.Errorf("confluence: %w", model.ErrLongTaskFailed)
+			}
+
+			return task, response, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return task, response, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -8,9 +8,11 @@ import (
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"net/http"
 	"net/url"
 	"testing"
+	"time"
 )
 
 func Test_internalTaskImpl_Gets(t *testing.T) {
@@ -229,3 +231,196 @@ func Test_internalTaskImpl_Get(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalTaskImpl_WaitFor(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx          context.Context
+		taskID       string
+		pollInterval time.Duration
+	}
+
+	canceledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the task finishes successfully after polling",
+			args: args{
+				ctx:          context.Background(),
+				taskID:       "239287",
+				pollInterval: time.Millisecond,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/longtask/239287",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.LongTaskScheme{}).
+					Return(&model.ResponseScheme{}, nil).
+					Once()
+
+				client.On("Call",
+					&http.Request{},
+					&model.LongTaskScheme{}).
+					Run(func(args mock.Arguments) {
+						task := args.Get(1).(*model.LongTaskScheme)
+						task.Finished = true
+						task.Successful = true
+					}).
+					Return(&model.ResponseScheme{}, nil).
+					Once()
+
+				fields.c = client
+
+			},
+		},
+
+		{
+			name: "when the task finishes without success",
+			args: args{
+				ctx:    context.Background(),
+				taskID: "239287",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/longtask/239287",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.LongTaskScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.LongTaskScheme).Finished = true
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+
+			},
+			wantErr: true,
+			Err:     model.ErrLongTaskFailed,
+		},
+
+		{
+			name: "when the context is canceled before the task finishes",
+			args: args{
+				ctx:          canceledCtx,
+				taskID:       "239287",
+				pollInterval: time.Hour,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					canceledCtx,
+					http.MethodGet,
+					"wiki/rest/api/longtask/239287",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.LongTaskScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+
+			},
+			wantErr: true,
+			Err:     context.Canceled,
+		},
+
+		{
+			name: "when the http call cannot be executed",
+			args: args{
+				ctx:    context.Background(),
+				taskID: "239287",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/longtask/239287",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.LongTaskScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrNotFound)
+
+				fields.c = client
+
+			},
+			wantErr: true,
+			Err:     model.ErrNotFound,
+		},
+
+		{
+			name: "when the task id is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoTaskID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewTaskService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.WaitFor(testCase.args.ctx, testCase.args.taskID, testCase.args.pollInterval)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.True(t, gotResult.Finished)
+			}
+
+		})
+	}
+}
@@ -0,0 +1,250 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/confluence"
+)
+
+// NewWatcherService creates a new instance of WatcherService.
+// It takes a service.Connector as input and returns a pointer to WatcherService.
+func NewWatcherService(client service.Connector) *WatcherService {
+	return &WatcherService{
+		internalClient: &internalWatcherImpl{c: client},
+	}
+}
+
+// WatcherService provides methods to manage the content and space watchers in Confluence.
+type WatcherService struct {
+	// internalClient is the connector interface for watcher operations.
+	internalClient confluence.WatcherConnector
+}
+
+// Gets returns the watchers of a piece of content.
+//
+// GET /wiki/rest/api/content/{id}/notification/created
+//
+// https://docs.go-atlassian.io/confluence-cloud/content/watchers#get-watches-for-page
+func (w *WatcherService) Gets(ctx context.Context, contentID string, start, limit int) (*model.ContentWatcherPageScheme, *model.ResponseScheme, error) {
+	return w.internalClient.Gets(ctx, contentID, start, limit)
+}
+
+// Status returns whether a user is watching a piece of content.
+//
+// GET /wiki/rest/api/user/watch/content/{contentId}
+//
+// https://docs.go-atlassian.io/confluence-cloud/content/watchers#get-content-watch-status
+func (w *WatcherService) Status(ctx context.Context, contentID, accountID string) (*model.WatchStatusScheme, *model.ResponseScheme, error) {
+	return w.internalClient.Status(ctx, contentID, accountID)
+}
+
+// Add adds a user as a watcher to a piece of content.
+//
+// POST /wiki/rest/api/user/watch/content/{contentId}
+//
+// https://docs.go-atlassian.io/confluence-cloud/content/watchers#add-content-watcher
+func (w *WatcherService) Add(ctx context.Context, contentID, accountID string) (*model.ResponseScheme, error) {
+	return w.internalClient.Add(ctx, contentID, accountID)
+}
+
+// Remove removes a user as a watcher from a piece of content.
+//
+// DELETE /wiki/rest/api/user/watch/content/{contentId}
+//
+// https://docs.go-atlassian.io/confluence-cloud/content/watchers#remove-content-watcher
+func (w *WatcherService) Remove(ctx context.Context, contentID, accountID string) (*model.ResponseScheme, error) {
+	return w.internalClient.Remove(ctx, contentID, accountID)
+}
+
+// SpaceWatchers returns the watchers of a space.
+//
+// GET /wiki/rest/api/space/{spaceKey}/watch
+//
+// https://docs.go-atlassian.io/confluence-cloud/content/watchers#get-space-watchers
+func (w *WatcherService) SpaceWatchers(ctx context.Context, spaceKey string, start, limit int) (*model.SpaceWatcherPageScheme, *model.ResponseScheme, error) {
+	return w.internalClient.SpaceWatchers(ctx, spaceKey, start, limit)
+}
+
+// SpaceStatus returns whether a user is watching a space.
+//
+// GET /wiki/rest/api/user/watch/space/{spaceKey}
+//
+// https://docs.go-atlassian.io/confluence-cloud/content/watchers#get-space-watch-status
+func (w *WatcherService) SpaceStatus(ctx context.Context, spaceKey, accountID string) (*model.WatchStatusScheme, *model.ResponseScheme, error) {
+	return w.internalClient.SpaceStatus(ctx, spaceKey, accountID)
+}
+
+// AddSpace adds a user as a watcher to a space.
+//
+// POST /wiki/rest/api/user/watch/space/{spaceKey}
+//
+// https://docs.go-atlassian.io/confluence-cloud/content/watchers#add-space-watcher
+func (w *WatcherService) AddSpace(ctx context.Context, spaceKey, accountID string) (*model.ResponseScheme, error) {
+	return w.internalClient.AddSpace(ctx, spaceKey, accountID)
+}
+
+// RemoveSpace removes a user as a watcher from a space.
+//
+// DELETE /wiki/rest/api/user/watch/space/{spaceKey}
+//
+// https://docs.go-atlassian.io/confluence-cloud/content/watchers#remove-space-watcher
+func (w *WatcherService) RemoveSpace(ctx context.Context, spaceKey, accountID string) (*model.ResponseScheme, error) {
+	return w.internalClient.RemoveSpace(ctx, spaceKey, accountID)
+}
+
+type internalWatcherImpl struct {
+	c service.Connector
+}
+
+func (i *internalWatcherImpl) Gets(ctx context.Context, contentID string, start, limit int) (*model.ContentWatcherPageScheme, *model.ResponseScheme, error) {
+
+	if contentID == "" {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoContentID)
+	}
+
+	query := url.Values{}
+	query.Add("start", strconv.Itoa(start))
+	query.Add("limit", strconv.Itoa(limit))
+
+	endpoint := fmt.Sprintf("wiki/rest/api/content/%v/notification/created?%v", contentID, query.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.ContentWatcherPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalWatcherImpl) Status(ctx context.Context, contentID, accountID string) (*model.WatchStatusScheme, *model.ResponseScheme, error) {
+
+	if contentID == "" {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoContentID)
+	}
+
+	return i.status(ctx, watcherEndpoint("content", contentID, accountID))
+}
+
+func (i *internalWatcherImpl) Add(ctx context.Context, contentID, accountID string) (*model.ResponseScheme, error) {
+
+	if contentID == "" {
+		return nil, fmt.Errorf("confluence: %w", model.ErrNoContentID)
+	}
+
+	return i.watch(ctx, http.MethodPost, watcherEndpoint("content", contentID, accountID))
+}
+
+func (i *internalWatcherImpl) Remove(ctx context.Context, contentID, accountID string) (*model.ResponseScheme, error) {
+
+	if contentID == "" {
+		return nil, fmt.Errorf("confluence: %w", model.ErrNoContentID)
+	}
+
+	return i.watch(ctx, http.MethodDelete, watcherEndpoint("content", contentID, accountID))
+}
+
+func (i *internalWatcherImpl) SpaceWatchers(ctx context.Context, spaceKey string, start, limit int) (*model.SpaceWatcherPageScheme, *model.ResponseScheme, error) {
+
+	if spaceKey == "" {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoSpaceKey)
+	}
+
+	query := url.Values{}
+	query.Add("start", strconv.Itoa(start))
+	query.Add("limit", strconv.Itoa(limit))
+
+	endpoint := fmt.Sprintf("wiki/rest/api/space/%v/watch?%v", spaceKey, query.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.SpaceWatcherPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalWatcherImpl) SpaceStatus(ctx context.Context, spaceKey, accountID string) (*model.WatchStatusScheme, *model.ResponseScheme, error) {
+
+	if spaceKey == "" {
+		return nil, nil, fmt.Errorf("confluence: %w", model.ErrNoSpaceKey)
+	}
+
+	return i.status(ctx, watcherEndpoint("space", spaceKey, accountID))
+}
+
+func (i *internalWatcherImpl) AddSpace(ctx context.Context, spaceKey, accountID string) (*model.ResponseScheme, error) {
+
+	if spaceKey == "" {
+		return nil, fmt.Errorf("confluence: %w", model.ErrNoSpaceKey)
+	}
+
+	return i.watch(ctx, http.MethodPost, watcherEndpoint("space", spaceKey, accountID))
+}
+
+func (i *internalWatcherImpl) RemoveSpace(ctx context.Context, spaceKey, accountID string) (*model.ResponseScheme, error) {
+
+	if spaceKey == "" {
+		return nil, fmt.Errorf("confluence: %w", model.ErrNoSpaceKey)
+	}
+
+	return i.watch(ctx, http.MethodDelete, watcherEndpoint("space", spaceKey, accountID))
+}
+
+func (i *internalWatcherImpl) status(ctx context.Context, endpoint string) (*model.WatchStatusScheme, *model.ResponseScheme, error) {
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	status := new(model.WatchStatusScheme)
+	response, err := i.c.Call(request, status)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return status, response, nil
+}
+
+// watch adds or removes a watcher, the content type is set to send the X-Atlassian-Token header required by these endpoints.
+func (i *internalWatcherImpl) watch(ctx context.Context, method, endpoint string) (*model.ResponseScheme, error) {
+
+	request, err := i.c.NewRequest(ctx, method, endpoint, "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+// watcherEndpoint builds the user watch endpoint for the entity, scoped to the account ID when provided.
+func watcherEndpoint(entity, entityID, accountID string) string {
+
+	endpoint := fmt.Sprintf("wiki/rest/api/user/watch/%v/%v", entity, entityID)
+
+	if accountID != "" {
+		query := url.Values{}
+		query.Add("accountId", accountID)
+		endpoint = fmt.Sprintf("%v?%v", endpoint, query.Encode())
+	}
+
+	return endpoint
+}
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/ctreminiom/go-atlassian/v2/jira/agile/internal"
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
@@ -113,6 +114,105 @@ func WithTokenCallback(callback oauth2.TokenCallback) ClientOption {
 	}
 }
 
+// WithUnknownFieldsReporter reports the response fields that the models don't capture yet, so schema drifts can be detected.
+// The responses are decoded as usual and Call doesn't fail on the unknown fields, report is called with the response
+// and the paths of the unknown fields instead, e.g. to log them.
+func WithUnknownFieldsReporter(report model.UnknownFieldsReporter) ClientOption {
+	return func(c *Client) error {
+		if report == nil {
+			return fmt.Errorf("unknown fields reporter cannot be nil")
+		}
+
+		c.unknownFieldsReporter = report
+		return nil
+	}
+}
+
+// WithDumpRedaction redacts the traces written by ResponseScheme.Dump for the responses of the client, e.g. to scrub
+// the custom authentication headers or the personal data, in addition to the authentication and cookie headers.
+func WithDumpRedaction(redaction *model.DumpRedactionScheme) ClientOption {
+	return func(c *Client) error {
+		if redaction == nil {
+			return fmt.Errorf("dump redaction cannot be nil")
+		}
+
+		c.dumpRedaction = redaction
+		return nil
+	}
+}
+
+// WithoutResponseBytes releases the response body once it's decoded into the structure, so ResponseScheme.Bytes
+// is empty after a successful decode and the large pages aren't held twice in memory.
+// The bodies that aren't decoded, e.g. the downloads, and the bodies of the unsuccessful responses are kept.
+func WithoutResponseBytes() ClientOption {
+	return func(c *Client) error {
+		c.withoutResponseBytes = true
+		return nil
+	}
+}
+
+// WithRetryPolicy retries the requests failing with a rate limit, a maintenance or a server error, making at most
+// maxAttempts attempts and waiting between them a delay growing exponentially from baseDelay up to maxDelay.
+// The Retry-After header of the 429 Too Many Requests and 503 Service Unavailable responses is honored,
+// even when it's longer than maxDelay. See models.RetryPolicyScheme.
+func WithRetryPolicy(maxAttempts int, baseDelay, maxDelay time.Duration) ClientOption {
+	return func(c *Client) error {
+
+		policy, err := model.NewRetryPolicy(maxAttempts, baseDelay, maxDelay)
+		if err != nil {
+			return err
+		}
+
+		c.retryPolicy = policy
+		return nil
+	}
+}
+
+// WithRateLimiter throttles the requests sent by the client, shared by all its services, to rps requests per second
+// on average with bursts of up to burst requests, so the bulk scripts aren't rate limited by the site.
+// A 429 Too Many Requests with a Retry-After pauses every request of the client for the announced delay.
+// Combined with WithRetryPolicy, every attempt waits for the rate limiter. See Client.RateLimiter for the metrics.
+func WithRateLimiter(rps float64, burst int) ClientOption {
+	return func(c *Client) error {
+
+		limiter, err := model.NewRateLimiter(rps, burst)
+		if err != nil {
+			return err
+		}
+
+		c.rateLimiter = limiter
+		return nil
+	}
+}
+
+// WithMaxConnsPerHost limits the connections opened to the site and keeps as many of them idle for reuse, see
+// oauth2.LimitConnsPerHost. The OAuth 2.0 transport and the token storage wrapper are preserved, so the option can be
+// combined with the OAuth options in any order.
+func WithMaxConnsPerHost(maxConns int) ClientOption {
+	return func(c *Client) error {
+		httpClient, err := oauth2.LimitConnsPerHost(c.HTTP, maxConns)
+		if err != nil {
+			return err
+		}
+
+		c.HTTP = httpClient
+		return nil
+	}
+}
+
+// WithIdleTimeout closes the idle connections of the pool after the timeout, see WithMaxConnsPerHost.
+func WithIdleTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) error {
+		httpClient, err := oauth2.SetIdleTimeout(c.HTTP, timeout)
+		if err != nil {
+			return err
+		}
+
+		c.HTTP = httpClient
+		return nil
+	}
+}
+
 func New(httpClient common.HTTPClient, site string, options ...ClientOption) (*Client, error) {
 
 	if httpClient == nil {
@@ -141,6 +241,7 @@ func New(httpClient common.HTTPClient, site string, options ...ClientOption) (*C
 	client.Epic = internal.NewEpicService(client, "1.0")
 	client.Sprint = internal.NewSprintService(client, "1.0")
 	client.Backlog = internal.NewBoardBacklogService(client, "1.0")
+	client.Issue = internal.NewIssueService(client, "1.0")
 	client.Auth = internal.NewAuthenticationService(client)
 
 	// Apply client options
@@ -162,6 +263,22 @@ type Client struct {
 	Backlog *internal.BoardBacklogService
 	Epic    *internal.EpicService
 	Sprint  *internal.SprintService
+	Issue   *internal.IssueService
+
+	// unknownFieldsReporter is called with the response fields the models don't capture, see WithUnknownFieldsReporter.
+	unknownFieldsReporter model.UnknownFieldsReporter
+
+	// dumpRedaction is the redaction applied by ResponseScheme.Dump to the responses, see WithDumpRedaction.
+	dumpRedaction *model.DumpRedactionScheme
+
+	// withoutResponseBytes releases the decoded response bodies.
+	withoutResponseBytes bool
+
+	// retryPolicy retries the requests failing with a rate limit, a maintenance or a server error, see WithRetryPolicy.
+	retryPolicy *model.RetryPolicyScheme
+
+	// rateLimiter throttles the requests sent by the client, see WithRateLimiter.
+	rateLimiter *model.RateLimiterScheme
 }
 
 func (c *Client) NewRequest(ctx context.Context, method, urlStr, contentType string, body interface{}) (*http.Request, error) {
@@ -206,17 +323,40 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr, contentType str
 		req.Header.Add("Authorization", fmt.Sprintf("Bearer %v", c.Auth.GetBearerToken()))
 	}
 
+	for key, values := range model.HeadersFromContext(ctx) {
+		req.Header[key] = values
+	}
+
 	return req, nil
 }
 
 func (c *Client) Call(request *http.Request, structure interface{}) (*model.ResponseScheme, error) {
+	return c.retryPolicy.Do(request, func(request *http.Request) (*model.ResponseScheme, error) {
 
-	response, err := c.HTTP.Do(request)
-	if err != nil {
-		return nil, err
-	}
+		if c.rateLimiter != nil {
+			if err := c.rateLimiter.Wait(request.Context()); err != nil {
+				return nil, err
+			}
+		}
+
+		response, err := c.HTTP.Do(request)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := c.processResponse(response, structure)
 
-	return c.processResponse(response, structure)
+		if c.rateLimiter != nil {
+			c.rateLimiter.Observe(err)
+		}
+
+		return res, err
+	})
+}
+
+// RateLimiter returns the rate limiter of the client, to read its metrics, or nil when WithRateLimiter isn't used.
+func (c *Client) RateLimiter() *model.RateLimiterScheme {
+	return c.rateLimiter
 }
 
 func (c *Client) Do(request *http.Request) (*http.Response, error) {
@@ -225,48 +365,50 @@ func (c *Client) Do(request *http.Request) (*http.Response, error) {
 
 func (c *Client) processResponse(response *http.Response, structure interface{}) (*model.ResponseScheme, error) {
 
-	defer response.Body.Close()
-
 	res := &model.ResponseScheme{
-		Response: response,
-		Code:     response.StatusCode,
-		Endpoint: response.Request.URL.String(),
-		Method:   response.Request.Method,
+		Response:  response,
+		Code:      response.StatusCode,
+		Endpoint:  response.Request.URL.String(),
+		Method:    response.Request.Method,
+		Redaction: c.dumpRedaction,
+	}
+
+	wasSuccess := response.StatusCode >= 200 && response.StatusCode < 300
+
+	// The body of a streamed response is left open for the caller, see model.StreamedResponseScheme
+	if wasSuccess && model.IsStreamedResponse(structure) {
+		res.Stream = response.Body
+		return res, nil
 	}
 
+	defer response.Body.Close()
+
 	responseAsBytes, err := io.ReadAll(response.Body)
 	if err != nil {
 		return res, err
 	}
 
-	res.Bytes.Write(responseAsBytes)
-
-	wasSuccess := response.StatusCode >= 200 && response.StatusCode < 300
+	res.Bytes = *bytes.NewBuffer(responseAsBytes)
 
 	if !wasSuccess {
 
-		switch response.StatusCode {
-
-		case http.StatusNotFound:
-			return res, fmt.Errorf("client: %w", model.ErrNotFound)
-
-		case http.StatusUnauthorized:
-			return res, fmt.Errorf("client: %w", model.ErrUnauthorized)
-
-		case http.StatusInternalServerError:
-			return res, fmt.Errorf("client: %w", model.ErrInternal)
-
-		case http.StatusBadRequest:
-			return res, fmt.Errorf("client: %w", model.ErrBadRequest)
-
-		default:
-			return res, fmt.Errorf("client: %w", model.ErrInvalidStatusCode)
+		if model.IsExpectedStatus(response.Request.Context(), response.StatusCode) {
+			return res, nil
 		}
+
+		return res, model.NewError(model.ProductAgile, res)
 	}
 
 	if structure != nil {
 		if err = json.Unmarshal(responseAsBytes, &structure); err != nil {
-			return res, err
+			return res, model.NewDecodeError(res, structure, err)
+		}
+
+		model.ReportUnknownFields(c.unknownFieldsReporter, res, responseAsBytes, structure)
+
+		// The decoded body is released, see WithoutResponseBytes
+		if c.withoutResponseBytes {
+			res.Bytes = bytes.Buffer{}
 		}
 	}
 
@@ -43,6 +43,9 @@ func (b *BoardService) Get(ctx context.Context, boardID int) (*model.BoardScheme
 
 // Create creates a new board. Board name, type and filter ID is required.
 //
+// The board can be located in a project or in the user's profile, use the model.NewProjectBoardLocation and
+// model.NewUserBoardLocation helpers to build the location.
+//
 // POST /rest/agile/1.0/board
 //
 // Docs: https://docs.go-atlassian.io/jira-agile/boards#create-board
@@ -50,6 +53,28 @@ func (b *BoardService) Create(ctx context.Context, payload *model.BoardPayloadSc
 	return b.internalClient.Create(ctx, payload)
 }
 
+// UpdateFilter changes the saved filter used by the board.
+//
+// This method uses the private Jira Software API, the endpoint may change without notice.
+//
+// PUT /rest/greenhopper/1.0/rapidviewconfig/filter
+//
+// https://docs.go-atlassian.io/jira-agile/boards#update-board-filter
+func (b *BoardService) UpdateFilter(ctx context.Context, boardID, filterID int) (*model.ResponseScheme, error) {
+	return b.internalClient.UpdateFilter(ctx, boardID, filterID)
+}
+
+// Admins returns the users and groups who can administer the board.
+//
+// This method uses the private Jira Software API, the endpoint may change without notice.
+//
+// GET /rest/greenhopper/1.0/rapidviewconfig/editmodel.json?rapidViewId={boardID}
+//
+// https://docs.go-atlassian.io/jira-agile/boards#get-board-admins
+func (b *BoardService) Admins(ctx context.Context, boardID int) (*model.BoardAdminsScheme, *model.ResponseScheme, error) {
+	return b.internalClient.Admins(ctx, boardID)
+}
+
 // Filter returns any boards which use the provided filter id.
 //
 // # This method can be executed by users without a valid software license in order
@@ -183,6 +208,17 @@ func (b *BoardService) Projects(ctx context.Context, boardID, startAt, maxResult
 	return b.internalClient.Projects(ctx, boardID, startAt, maxResults)
 }
 
+// ProjectsFull returns all projects that are statically associated with the board, based on its filter.
+//
+// The results are not paginated.
+//
+// GET /rest/agile/1.0/board/{boardID}/project/full
+//
+// https://docs.go-atlassian.io/jira-agile/boards#get-projects-full
+func (b *BoardService) ProjectsFull(ctx context.Context, boardID int) ([]*model.BoardProjectScheme, *model.ResponseScheme, error) {
+	return b.internalClient.ProjectsFull(ctx, boardID)
+}
+
 // Sprints returns all sprints from a board, for a given board ID.
 //
 // This only includes sprints that the user has permission to view.
@@ -215,13 +251,24 @@ func (b *BoardService) IssuesBySprint(ctx context.Context, boardID, sprintID int
 //
 // Returned versions are ordered by the name of the project from which they belong and then by sequence defined by user.
 //
-// GET /rest/agile/1.0/board/{boardID}/sprint/{sprintId}/issue
+// GET /rest/agile/1.0/board/{boardID}/version
 //
 // https://docs.go-atlassian.io/jira-agile/boards#get-all-versions
 func (b *BoardService) Versions(ctx context.Context, boardID, startAt, maxResults int, released bool) (*model.BoardVersionPageScheme, *model.ResponseScheme, error) {
 	return b.internalClient.Versions(ctx, boardID, startAt, maxResults, released)
 }
 
+// AllVersions returns the released and unreleased versions from a board, for a given board ID.
+//
+// Unlike Versions, the released filter is not sent, so both kinds of versions are returned in the same page.
+//
+// GET /rest/agile/1.0/board/{boardID}/version
+//
+// https://docs.go-atlassian.io/jira-agile/boards#get-all-versions
+func (b *BoardService) AllVersions(ctx context.Context, boardID, startAt, maxResults int) (*model.BoardVersionPageScheme, *model.ResponseScheme, error) {
+	return b.internalClient.AllVersions(ctx, boardID, startAt, maxResults)
+}
+
 // Delete deletes the board. Admin without the view permission can still remove the board.
 //
 // DELETE /rest/agile/1.0/board/{boardID}
@@ -240,6 +287,44 @@ func (b *BoardService) Gets(ctx context.Context, opts *model.GetBoardsOptions, s
 	return b.internalClient.Gets(ctx, opts, startAt, maxResults)
 }
 
+// QuickFilters returns all quick filters from a board, for a given board ID.
+//
+// The quick filters are ordered by their position on the board.
+//
+// GET /rest/agile/1.0/board/{boardID}/quickfilter
+//
+// https://docs.go-atlassian.io/jira-agile/boards#get-all-quick-filters
+func (b *BoardService) QuickFilters(ctx context.Context, boardID, startAt, maxResults int) (*model.BoardQuickFilterPageScheme, *model.ResponseScheme, error) {
+	return b.internalClient.QuickFilters(ctx, boardID, startAt, maxResults)
+}
+
+// QuickFilter returns the quick filter for a given quick filter ID.
+//
+// GET /rest/agile/1.0/board/{boardID}/quickfilter/{quickFilterID}
+//
+// https://docs.go-atlassian.io/jira-agile/boards#get-quick-filter
+func (b *BoardService) QuickFilter(ctx context.Context, boardID, quickFilterID int) (*model.BoardQuickFilterScheme, *model.ResponseScheme, error) {
+	return b.internalClient.QuickFilter(ctx, boardID, quickFilterID)
+}
+
+// Features returns the features of a board, e.g. the estimation, the sprints or the backlog, and their state.
+//
+// GET /rest/agile/1.0/board/{boardID}/features
+//
+// https://docs.go-atlassian.io/jira-agile/boards#get-features-for-board
+func (b *BoardService) Features(ctx context.Context, boardID int) (*model.BoardFeaturesScheme, *model.ResponseScheme, error) {
+	return b.internalClient.Features(ctx, boardID)
+}
+
+// ToggleFeature enables or disables a feature of a board, and returns the features of the board.
+//
+// PUT /rest/agile/1.0/board/{boardID}/features
+//
+// https://docs.go-atlassian.io/jira-agile/boards#toggle-features
+func (b *BoardService) ToggleFeature(ctx context.Context, boardID int, payload *model.BoardFeatureTogglePayloadScheme) (*model.BoardFeaturesScheme, *model.ResponseScheme, error) {
+	return b.internalClient.ToggleFeature(ctx, boardID, payload)
+}
+
 type internalBoardImpl struct {
 	c       service.Connector
 	version string
@@ -269,6 +354,22 @@ func (i *internalBoardImpl) Get(ctx context.Context, boardID int) (*model.BoardS
 
 func (i *internalBoardImpl) Create(ctx context.Context, payload *model.BoardPayloadScheme) (*model.BoardScheme, *model.ResponseScheme, error) {
 
+	if payload == nil || payload.Name == "" {
+		return nil, nil, fmt.Errorf("agile: %w", model.ErrNoBoardName)
+	}
+
+	if payload.Type == "" {
+		return nil, nil, fmt.Errorf("agile: %w", model.ErrNoBoardType)
+	}
+
+	if payload.FilterID == 0 {
+		return nil, nil, fmt.Errorf("agile: %w", model.ErrNoFilterID)
+	}
+
+	if payload.Location != nil && payload.Location.Type == model.BoardLocationTypeProject && payload.Location.ProjectKeyOrID == "" {
+		return nil, nil, fmt.Errorf("agile: %w", model.ErrNoProjectIDOrKey)
+	}
+
 	url := fmt.Sprintf("rest/agile/%v/board", i.version)
 
 	req, err := i.c.NewRequest(ctx, http.MethodPost, url, "", payload)
@@ -285,6 +386,58 @@ func (i *internalBoardImpl) Create(ctx context.Context, payload *model.BoardPayl
 	return board, res, nil
 }
 
+func (i *internalBoardImpl) UpdateFilter(ctx context.Context, boardID, filterID int) (*model.ResponseScheme, error) {
+
+	if boardID == 0 {
+		return nil, fmt.Errorf("agile: %w", model.ErrNoBoardID)
+	}
+
+	if filterID == 0 {
+		return nil, fmt.Errorf("agile: %w", model.ErrNoFilterID)
+	}
+
+	payload := &model.BoardFilterPayloadScheme{ID: boardID, SavedFilterID: filterID}
+
+	req, err := i.c.NewRequest(ctx, http.MethodPut, "rest/greenhopper/1.0/rapidviewconfig/filter", "", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(req, nil)
+}
+
+func (i *internalBoardImpl) Admins(ctx context.Context, boardID int) (*model.BoardAdminsScheme, *model.ResponseScheme, error) {
+
+	if boardID == 0 {
+		return nil, nil, fmt.Errorf("agile: %w", model.ErrNoBoardID)
+	}
+
+	params := url.Values{}
+	params.Add("rapidViewId", strconv.Itoa(boardID))
+
+	url := fmt.Sprintf("rest/greenhopper/1.0/rapidviewconfig/editmodel.json?%v", params.Encode())
+
+	req, err := i.c.NewRequest(ctx, http.MethodGet, url, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	editModel := new(struct {
+		BoardAdmins *model.BoardAdminsScheme `json:"boardAdmins,omitempty"`
+	})
+
+	res, err := i.c.Call(req, editModel)
+	if err != nil {
+		return nil, res, err
+	}
+
+	if editModel.BoardAdmins == nil {
+		return new(model.BoardAdminsScheme), res, nil
+	}
+
+	return editModel.BoardAdmins, res, nil
+}
+
 func (i *internalBoardImpl) Filter(ctx context.Context, filterID, startAt, maxResults int) (*model.BoardPageScheme, *model.ResponseScheme, error) {
 
 	if filterID == 0 {
@@ -585,6 +738,28 @@ func (i *internalBoardImpl) Projects(ctx context.Context, boardID, startAt, maxR
 	return page, res, nil
 }
 
+func (i *internalBoardImpl) ProjectsFull(ctx context.Context, boardID int) ([]*model.BoardProjectScheme, *model.ResponseScheme, error) {
+
+	if boardID == 0 {
+		return nil, nil, fmt.Errorf("agile: %w", model.ErrNoBoardID)
+	}
+
+	url := fmt.Sprintf("rest/agile/%v/board/%v/project/full", i.version, boardID)
+
+	req, err := i.c.NewRequest(ctx, http.MethodGet, url, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var projects []*model.BoardProjectScheme
+	res, err := i.c.Call(req, &projects)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return projects, res, nil
+}
+
 func (i *internalBoardImpl) Sprints(ctx context.Context, boardID, startAt, maxResults int, states []string) (*model.BoardSprintPageScheme, *model.ResponseScheme, error) {
 
 	if boardID == 0 {
@@ -688,6 +863,32 @@ func (i *internalBoardImpl) Versions(ctx context.Context, boardID, startAt, maxR
 	return page, res, nil
 }
 
+func (i *internalBoardImpl) AllVersions(ctx context.Context, boardID, startAt, maxResults int) (*model.BoardVersionPageScheme, *model.ResponseScheme, error) {
+
+	if boardID == 0 {
+		return nil, nil, fmt.Errorf("agile: %w", model.ErrNoBoardID)
+	}
+
+	params := url.Values{}
+	params.Add("startAt", strconv.Itoa(startAt))
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	url := fmt.Sprintf("rest/agile/%v/board/%v/version?%v", i.version, boardID, params.Encode())
+
+	req, err := i.c.NewRequest(ctx, http.MethodGet, url, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.BoardVersionPageScheme)
+	res, err := i.c.Call(req, page)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return page, res, nil
+}
+
 func (i *internalBoardImpl) Delete(ctx context.Context, boardID int) (*model.ResponseScheme, error) {
 
 	if boardID == 0 {
@@ -768,3 +969,106 @@ func (i *internalBoardImpl) Gets(ctx context.Context, opts *model.GetBoardsOptio
 
 	return page, res, nil
 }
+
+func (i *internalBoardImpl) QuickFilters(ctx context.Context, boardID, startAt, maxResults int) (*model.BoardQuickFilterPageScheme, *model.ResponseScheme, error) {
+
+	if boardID == 0 {
+		return nil, nil, fmt.Errorf("agile: %w", model.ErrNoBoardID)
+	}
+
+	params := url.Values{}
+	params.Add("startAt", strconv.Itoa(startAt))
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	url := fmt.Sprintf("rest/agile/%v/board/%v/quickfilter?%v", i.version, boardID, params.Encode())
+
+	req, err := i.c.NewRequest(ctx, http.MethodGet, url, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.BoardQuickFilterPageScheme)
+	res, err := i.c.Call(req, page)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return page, res, nil
+}
+
+func (i *internalBoardImpl) QuickFilter(ctx context.Context, boardID, quickFilterID int) (*model.BoardQuickFilterScheme, *model.ResponseScheme, error) {
+
+	if boardID == 0 {
+		return nil, nil, fmt.Errorf("agile: %w", model.ErrNoBoardID)
+	}
+
+	if quickFilterID == 0 {
+		return nil, nil, fmt.Errorf("agile: %w", model.ErrNoQuickFilterID)
+	}
+
+	url := fmt.Sprintf("rest/agile/%v/board/%v/quickfilter/%v", i.version, boardID, quickFilterID)
+
+	req, err := i.c.NewRequest(ctx, http.MethodGet, url, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	filter := new(model.BoardQuickFilterScheme)
+	res, err := i.c.Call(req, filter)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return filter, res, nil
+}
+
+func (i *internalBoardImpl) Features(ctx context.Context, boardID int) (*model.BoardFeaturesScheme, *model.ResponseScheme, error) {
+
+	if boardID == 0 {
+		return nil, nil, fmt.Errorf("agile: %w", model.ErrNoBoardID)
+	}
+
+	url := fmt.Sprintf("rest/agile/%v/board/%v/features", i.version, boardID)
+
+	req, err := i.c.NewRequest(ctx, http.MethodGet, url, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	features := new(model.BoardFeaturesScheme)
+	res, err := i.c.Call(req, features)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return features, res, nil
+}
+
+func (i *internalBoardImpl) ToggleFeature(ctx context.Context, boardID int, payload *model.BoardFeatureTogglePayloadScheme) (*model.BoardFeaturesScheme, *model.ResponseScheme, error) {
+
+	if boardID == 0 {
+		return nil, nil, fmt.Errorf("agile: %w", model.ErrNoBoardID)
+	}
+
+	if payload == nil || payload.Feature == "" {
+		return nil, nil, fmt.Errorf("agile: %w", model.ErrNoBoardFeature)
+	}
+
+	toggle := *payload
+	toggle.BoardID = boardID
+
+	url := fmt.Sprintf("rest/agile/%v/board/%v/features", i.version, boardID)
+
+	req, err := i.c.NewRequest(ctx, http.MethodPut, url, "", &toggle)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	features := new(model.BoardFeaturesScheme)
+	res, err := i.c.Call(req, features)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return features, res, nil
+}
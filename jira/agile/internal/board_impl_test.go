@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
@@ -169,8 +170,9 @@ func Test_BoardService_Create(t *testing.T) {
 
 	payloadMocked := &model.BoardPayloadScheme{
 		Name:     "BoardConnector Name Sample",
-		Type:     "scrum",
+		Type:     model.BoardTypeScrum,
 		FilterID: 1002,
+		Location: model.NewProjectBoardLocation("KP"),
 	}
 
 	type fields struct {
@@ -269,6 +271,51 @@ func Test_BoardService_Create(t *testing.T) {
 			Err:     model.ErrNotFound,
 			wantErr: true,
 		},
+
+		{
+			name: "when the board name is not provided",
+			args: args{
+				ctx:     context.Background(),
+				payload: &model.BoardPayloadScheme{Type: model.BoardTypeScrum, FilterID: 1002},
+			},
+			Err:     model.ErrNoBoardName,
+			wantErr: true,
+		},
+
+		{
+			name: "when the board type is not provided",
+			args: args{
+				ctx:     context.Background(),
+				payload: &model.BoardPayloadScheme{Name: "Board Name Sample", FilterID: 1002},
+			},
+			Err:     model.ErrNoBoardType,
+			wantErr: true,
+		},
+
+		{
+			name: "when the filter id is not provided",
+			args: args{
+				ctx:     context.Background(),
+				payload: &model.BoardPayloadScheme{Name: "Board Name Sample", Type: model.BoardTypeKanban},
+			},
+			Err:     model.ErrNoFilterID,
+			wantErr: true,
+		},
+
+		{
+			name: "when the project location does not contain the project key",
+			args: args{
+				ctx: context.Background(),
+				payload: &model.BoardPayloadScheme{
+					Name:     "Board Name Sample",
+					Type:     model.BoardTypeKanban,
+					FilterID: 1002,
+					Location: model.NewProjectBoardLocation(""),
+				},
+			},
+			Err:     model.ErrNoProjectIDOrKey,
+			wantErr: true,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -2760,3 +2807,934 @@ func Test_BoardService_Versions(t *testing.T) {
 		})
 	}
 }
+
+func Test_BoardService_UpdateFilter(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx      context.Context
+		boardID  int
+		filterID int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:      context.Background(),
+				boardID:  101,
+				filterID: 10002,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/greenhopper/1.0/rapidviewconfig/filter",
+					"",
+					&model.BoardFilterPayloadScheme{ID: 101, SavedFilterID: 10002}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name: "when the board id is not provided",
+			args: args{
+				ctx:      context.Background(),
+				filterID: 10002,
+			},
+			wantErr: true,
+			Err:     model.ErrNoBoardID,
+		},
+
+		{
+			name: "when the filter id is not provided",
+			args: args{
+				ctx:     context.Background(),
+				boardID: 101,
+			},
+			wantErr: true,
+			Err:     model.ErrNoFilterID,
+		},
+
+		{
+			name: "when the api cannot be executed",
+			args: args{
+				ctx:      context.Background(),
+				boardID:  101,
+				filterID: 10002,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/greenhopper/1.0/rapidviewconfig/filter",
+					"",
+					&model.BoardFilterPayloadScheme{ID: 101, SavedFilterID: 10002}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, model.ErrNoExecHttpCall)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrNoExecHttpCall,
+		},
+
+		{
+			name: "when the request cannot be created",
+			args: args{
+				ctx:      context.Background(),
+				boardID:  101,
+				filterID: 10002,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/greenhopper/1.0/rapidviewconfig/filter",
+					"",
+					&model.BoardFilterPayloadScheme{ID: 101, SavedFilterID: 10002}).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewBoardService(testCase.fields.c, "1.0")
+
+			gotResponse, err := newService.UpdateFilter(testCase.args.ctx, testCase.args.boardID, testCase.args.filterID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+
+		})
+	}
+}
+
+func Test_BoardService_Admins(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx     context.Context
+		boardID int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:     context.Background(),
+				boardID: 101,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/greenhopper/1.0/rapidviewconfig/editmodel.json?rapidViewId=101",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					mock.Anything).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name: "when the board id is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoBoardID,
+		},
+
+		{
+			name: "when the api cannot be executed",
+			args: args{
+				ctx:     context.Background(),
+				boardID: 101,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/greenhopper/1.0/rapidviewconfig/editmodel.json?rapidViewId=101",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					mock.Anything).
+					Return(&model.ResponseScheme{}, model.ErrNoExecHttpCall)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrNoExecHttpCall,
+		},
+
+		{
+			name: "when the request cannot be created",
+			args: args{
+				ctx:     context.Background(),
+				boardID: 101,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/greenhopper/1.0/rapidviewconfig/editmodel.json?rapidViewId=101",
+					"",
+					nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewBoardService(testCase.fields.c, "1.0")
+
+			gotResult, gotResponse, err := newService.Admins(testCase.args.ctx, testCase.args.boardID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_BoardService_ProjectsFull(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx     context.Context
+		boardID int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:     context.Background(),
+				boardID: 1000,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/agile/1.0/board/1000/project/full",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					mock.Anything).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name: "when the http call cannot be executed",
+			args: args{
+				ctx:     context.Background(),
+				boardID: 1000,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/agile/1.0/board/1000/project/full",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					mock.Anything).
+					Return(&model.ResponseScheme{}, fmt.Errorf("agile: %w", model.ErrNotFound))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrNotFound,
+		},
+
+		{
+			name: "when the request cannot be created",
+			args: args{
+				ctx:     context.Background(),
+				boardID: 1000,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/agile/1.0/board/1000/project/full",
+					"",
+					nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the board id is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoBoardID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewBoardService(testCase.fields.c, "1.0")
+
+			gotResult, gotResponse, err := newService.ProjectsFull(testCase.args.ctx, testCase.args.boardID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_BoardService_AllVersions(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx                          context.Context
+		boardID, startAt, maxResults int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:        context.Background(),
+				boardID:    1000,
+				startAt:    0,
+				maxResults: 50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/agile/1.0/board/1000/version?maxResults=50&startAt=0",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.BoardVersionPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name: "when the http call cannot be executed",
+			args: args{
+				ctx:        context.Background(),
+				boardID:    1000,
+				startAt:    0,
+				maxResults: 50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/agile/1.0/board/1000/version?maxResults=50&startAt=0",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.BoardVersionPageScheme{}).
+					Return(&model.ResponseScheme{}, fmt.Errorf("agile: %w", model.ErrNotFound))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrNotFound,
+		},
+
+		{
+			name: "when the request cannot be created",
+			args: args{
+				ctx:        context.Background(),
+				boardID:    1000,
+				startAt:    0,
+				maxResults: 50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/agile/1.0/board/1000/version?maxResults=50&startAt=0",
+					"",
+					nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name: "when the board id is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoBoardID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewBoardService(testCase.fields.c, "1.0")
+
+			gotResult, gotResponse, err := newService.AllVersions(testCase.args.ctx, testCase.args.boardID, testCase.args.startAt,
+				testCase.args.maxResults)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_BoardService_QuickFilters(t *testing.T) {
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx                 context.Context
+		boardID             int
+		startAt, maxResults int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:        context.Background(),
+				boardID:    1001,
+				startAt:    0,
+				maxResults: 50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/agile/1.0/board/1001/quickfilter?maxResults=50&startAt=0",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.BoardQuickFilterPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the request cannot be created",
+			args: args{
+				ctx:        context.Background(),
+				boardID:    1001,
+				startAt:    0,
+				maxResults: 50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/agile/1.0/board/1001/quickfilter?maxResults=50&startAt=0",
+					"",
+					nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			Err:     model.ErrCreateHttpReq,
+			wantErr: true,
+		},
+
+		{
+			name: "when the board id is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			Err:     model.ErrNoBoardID,
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			boardService := NewBoardService(testCase.fields.c, "1.0")
+
+			gotResult, gotResponse, err := boardService.QuickFilters(testCase.args.ctx, testCase.args.boardID, testCase.args.startAt,
+				testCase.args.maxResults)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+		})
+	}
+}
+
+func Test_BoardService_QuickFilter(t *testing.T) {
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx                    context.Context
+		boardID, quickFilterID int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:           context.Background(),
+				boardID:       1001,
+				quickFilterID: 10,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/agile/1.0/board/1001/quickfilter/10",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.BoardQuickFilterScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the board id is not provided",
+			args: args{
+				ctx:           context.Background(),
+				quickFilterID: 10,
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			Err:     model.ErrNoBoardID,
+			wantErr: true,
+		},
+
+		{
+			name: "when the quick filter id is not provided",
+			args: args{
+				ctx:     context.Background(),
+				boardID: 1001,
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			Err:     model.ErrNoQuickFilterID,
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			boardService := NewBoardService(testCase.fields.c, "1.0")
+
+			gotResult, gotResponse, err := boardService.QuickFilter(testCase.args.ctx, testCase.args.boardID, testCase.args.quickFilterID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+		})
+	}
+}
+
+func Test_BoardService_ToggleFeature(t *testing.T) {
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx     context.Context
+		boardID int
+		payload *model.BoardFeatureTogglePayloadScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:     context.Background(),
+				boardID: 1001,
+				payload: &model.BoardFeatureTogglePayloadScheme{Feature: model.BoardFeatureEstimation},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/agile/1.0/board/1001/features",
+					"",
+					&model.BoardFeatureTogglePayloadScheme{BoardID: 1001, Feature: model.BoardFeatureEstimation}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.BoardFeaturesScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http call cannot be executed",
+			args: args{
+				ctx:     context.Background(),
+				boardID: 1001,
+				payload: &model.BoardFeatureTogglePayloadScheme{Feature: model.BoardFeatureSprints, Enabling: true},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/agile/1.0/board/1001/features",
+					"",
+					&model.BoardFeatureTogglePayloadScheme{BoardID: 1001, Feature: model.BoardFeatureSprints, Enabling: true}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.BoardFeaturesScheme{}).
+					Return(&model.ResponseScheme{}, fmt.Errorf("agile: %w", model.ErrNotFound))
+
+				fields.c = client
+			},
+			Err:     model.ErrNotFound,
+			wantErr: true,
+		},
+
+		{
+			name: "when the board id is not provided",
+			args: args{
+				ctx:     context.Background(),
+				payload: &model.BoardFeatureTogglePayloadScheme{Feature: model.BoardFeatureEstimation},
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			Err:     model.ErrNoBoardID,
+			wantErr: true,
+		},
+
+		{
+			name: "when the feature is not provided",
+			args: args{
+				ctx:     context.Background(),
+				boardID: 1001,
+				payload: &model.BoardFeatureTogglePayloadScheme{},
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			Err:     model.ErrNoBoardFeature,
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			boardService := NewBoardService(testCase.fields.c, "1.0")
+
+			gotResult, gotResponse, err := boardService.ToggleFeature(testCase.args.ctx, testCase.args.boardID, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/agile"
+)
+
+// NewIssueService creates a new instance of IssueService.
+// It takes a service.Connector and a version string as input and returns a pointer to IssueService.
+func NewIssueService(client service.Connector, version string) *IssueService {
+	return &IssueService{
+		internalClient: &internalIssueImpl{c: client, version: version},
+	}
+}
+
+// IssueService provides methods to interact with issue operations in Jira Agile.
+type IssueService struct {
+	// internalClient is the connector interface for issue operations.
+	internalClient agile.IssueConnector
+}
+
+// Estimation returns the estimation of the issue and a fieldId of the field that is used for it.
+//
+// The boardID is required to determine which field is used for estimation.
+//
+// GET /rest/agile/1.0/issue/{issueIDOrKey}/estimation
+//
+// https://docs.go-atlassian.io/jira-agile/issues#get-issue-estimation-for-board
+func (i *IssueService) Estimation(ctx context.Context, issueIDOrKey string, boardID int) (*model.IssueEstimationScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Estimation(ctx, issueIDOrKey, boardID)
+}
+
+// Estimate updates the estimation of the issue.
+//
+// The boardID is required to determine which field is used for estimation.
+//
+// PUT /rest/agile/1.0/issue/{issueIDOrKey}/estimation
+//
+// https://docs.go-atlassian.io/jira-agile/issues#estimate-issue-for-board
+func (i *IssueService) Estimate(ctx context.Context, issueIDOrKey string, boardID int, value string) (*model.IssueEstimationScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Estimate(ctx, issueIDOrKey, boardID, value)
+}
+
+// Rank moves (ranks) issues before or after a given issue.
+//
+// At most 50 issues may be ranked at once, if the ranking partially fails the result contains the status of each issue.
+//
+// PUT /rest/agile/1.0/issue/rank
+//
+// https://docs.go-atlassian.io/jira-agile/issues#rank-issues
+func (i *IssueService) Rank(ctx context.Context, payload *model.IssueRankPayloadScheme) (*model.IssueRankResultScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Rank(ctx, payload)
+}
+
+type internalIssueImpl struct {
+	c       service.Connector
+	version string
+}
+
+func (i *internalIssueImpl) Estimation(ctx context.Context, issueIDOrKey string, boardID int) (*model.IssueEstimationScheme, *model.ResponseScheme, error) {
+
+	if issueIDOrKey == "" {
+		return nil, nil, fmt.Errorf("agile: %w", model.ErrNoIssueKeyOrID)
+	}
+
+	if boardID == 0 {
+		return nil, nil, fmt.Errorf("agile: %w", model.ErrNoBoardID)
+	}
+
+	params := url.Values{}
+	params.Add("boardId", strconv.Itoa(boardID))
+
+	url := fmt.Sprintf("rest/agile/%v/issue/%v/estimation?%v", i.version, issueIDOrKey, params.Encode())
+
+	req, err := i.c.NewRequest(ctx, http.MethodGet, url, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	estimation := new(model.IssueEstimationScheme)
+	res, err := i.c.Call(req, estimation)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return estimation, res, nil
+}
+
+func (i *internalIssueImpl) Estimate(ctx context.Context, issueIDOrKey string, boardID int, value string) (*model.IssueEstimationScheme, *model.ResponseScheme, error) {
+
+	if issueIDOrKey == "" {
+		return nil, nil, fmt.Errorf("agile: %w", model.ErrNoIssueKeyOrID)
+	}
+
+	if boardID == 0 {
+		return nil, nil, fmt.Errorf("agile: %w", model.ErrNoBoardID)
+	}
+
+	params := url.Values{}
+	params.Add("boardId", strconv.Itoa(boardID))
+
+	url := fmt.Sprintf("rest/agile/%v/issue/%v/estimation?%v", i.version, issueIDOrKey, params.Encode())
+
+	req, err := i.c.NewRequest(ctx, http.MethodPut, url, "", &model.IssueEstimationPayloadScheme{Value: value})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	estimation := new(model.IssueEstimationScheme)
+	res, err := i.c.Call(req, estimation)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return estimation, res, nil
+}
+
+func (i *internalIssueImpl) Rank(ctx context.Context, payload *model.IssueRankPayloadScheme) (*model.IssueRankResultScheme, *model.ResponseScheme, error) {
+
+	if payload == nil || len(payload.Issues) == 0 {
+		return nil, nil, fmt.Errorf("agile: %w", model.ErrNoIssuesSlice)
+	}
+
+	if payload.RankBeforeIssue == "" && payload.RankAfterIssue == "" {
+		return nil, nil, fmt.Errorf("agile: %w", model.ErrNoIssueRankTarget)
+	}
+
+	url := fmt.Sprintf("rest/agile/%v/issue/rank", i.version)
+
+	req, err := i.c.NewRequest(ctx, http.MethodPut, url, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The endpoint returns 204 No Content when all the issues are ranked, and 207 Multi-Status with the entries otherwise.
+	res, err := i.c.Call(req, nil)
+	if err != nil {
+		return nil, res, err
+	}
+
+	result := new(model.IssueRankResultScheme)
+	if res != nil && res.Bytes.Len() != 0 {
+		if err = json.Unmarshal(res.Bytes.Bytes(), result); err != nil {
+			return nil, res, err
+		}
+	}
+
+	return result, res, nil
+}
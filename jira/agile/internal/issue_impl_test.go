@@ -0,0 +1,486 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func Test_IssueService_Estimation(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx          context.Context
+		issueIDOrKey string
+		boardID      int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:          context.Background(),
+				issueIDOrKey: "KP-1",
+				boardID:      1001,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/agile/1.0/issue/KP-1/estimation?boardId=1001",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueEstimationScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name: "when the issue key or id is not provided",
+			args: args{
+				ctx:     context.Background(),
+				boardID: 1001,
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueKeyOrID,
+		},
+
+		{
+			name: "when the board id is not provided",
+			args: args{
+				ctx:          context.Background(),
+				issueIDOrKey: "KP-1",
+			},
+			wantErr: true,
+			Err:     model.ErrNoBoardID,
+		},
+
+		{
+			name: "when the api cannot be executed",
+			args: args{
+				ctx:          context.Background(),
+				issueIDOrKey: "KP-1",
+				boardID:      1001,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/agile/1.0/issue/KP-1/estimation?boardId=1001",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueEstimationScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrNoExecHttpCall)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrNoExecHttpCall,
+		},
+
+		{
+			name: "when the request cannot be created",
+			args: args{
+				ctx:          context.Background(),
+				issueIDOrKey: "KP-1",
+				boardID:      1001,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/agile/1.0/issue/KP-1/estimation?boardId=1001",
+					"",
+					nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewIssueService(testCase.fields.c, "1.0")
+
+			gotResult, gotResponse, err := newService.Estimation(testCase.args.ctx, testCase.args.issueIDOrKey, testCase.args.boardID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_IssueService_Estimate(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx          context.Context
+		issueIDOrKey string
+		boardID      int
+		value        string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:          context.Background(),
+				issueIDOrKey: "KP-1",
+				boardID:      1001,
+				value:        "5",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/agile/1.0/issue/KP-1/estimation?boardId=1001",
+					"",
+					&model.IssueEstimationPayloadScheme{Value: "5"}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueEstimationScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name: "when the issue key or id is not provided",
+			args: args{
+				ctx:     context.Background(),
+				boardID: 1001,
+				value:   "5",
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueKeyOrID,
+		},
+
+		{
+			name: "when the board id is not provided",
+			args: args{
+				ctx:          context.Background(),
+				issueIDOrKey: "KP-1",
+				value:        "5",
+			},
+			wantErr: true,
+			Err:     model.ErrNoBoardID,
+		},
+
+		{
+			name: "when the api cannot be executed",
+			args: args{
+				ctx:          context.Background(),
+				issueIDOrKey: "KP-1",
+				boardID:      1001,
+				value:        "5",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/agile/1.0/issue/KP-1/estimation?boardId=1001",
+					"",
+					&model.IssueEstimationPayloadScheme{Value: "5"}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueEstimationScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrNoExecHttpCall)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrNoExecHttpCall,
+		},
+
+		{
+			name: "when the request cannot be created",
+			args: args{
+				ctx:          context.Background(),
+				issueIDOrKey: "KP-1",
+				boardID:      1001,
+				value:        "5",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/agile/1.0/issue/KP-1/estimation?boardId=1001",
+					"",
+					&model.IssueEstimationPayloadScheme{Value: "5"}).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewIssueService(testCase.fields.c, "1.0")
+
+			gotResult, gotResponse, err := newService.Estimate(testCase.args.ctx, testCase.args.issueIDOrKey, testCase.args.boardID, testCase.args.value)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_IssueService_Rank(t *testing.T) {
+
+	payloadMocked := &model.IssueRankPayloadScheme{
+		Issues:          []string{"KP-1", "KP-2"},
+		RankBeforeIssue: "KP-10",
+	}
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx     context.Context
+		payload *model.IssueRankPayloadScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:     context.Background(),
+				payload: payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/agile/1.0/issue/rank",
+					"",
+					payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name: "when the issues are not provided",
+			args: args{
+				ctx:     context.Background(),
+				payload: &model.IssueRankPayloadScheme{RankAfterIssue: "KP-10"},
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssuesSlice,
+		},
+
+		{
+			name: "when the rank target is not provided",
+			args: args{
+				ctx:     context.Background(),
+				payload: &model.IssueRankPayloadScheme{Issues: []string{"KP-1"}},
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueRankTarget,
+		},
+
+		{
+			name: "when the api cannot be executed",
+			args: args{
+				ctx:     context.Background(),
+				payload: payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/agile/1.0/issue/rank",
+					"",
+					payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, model.ErrNoExecHttpCall)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrNoExecHttpCall,
+		},
+
+		{
+			name: "when the request cannot be created",
+			args: args{
+				ctx:     context.Background(),
+				payload: payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/agile/1.0/issue/rank",
+					"",
+					payloadMocked).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewIssueService(testCase.fields.c, "1.0")
+
+			gotResult, gotResponse, err := newService.Rank(testCase.args.ctx, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
@@ -8,8 +8,10 @@ import (
 	"github.com/ctreminiom/go-atlassian/v2/service/agile"
 	"net/http"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // NewSprintService creates a new instance of SprintService.
@@ -131,6 +133,25 @@ func (s *SprintService) Move(ctx context.Context, sprintID int, payload *model.S
 	return s.internalClient.Move(ctx, sprintID, payload)
 }
 
+// Burndown returns the raw data of the burndown chart of a started sprint, derived from the changelogs of its issues.
+//
+// The scope at the start of the sprint, and every issue added, removed, completed, reopened or re-estimated
+// during the sprint are returned, so the chart can be drawn or exported with WriteCSV.
+//
+// The issues removed from the sprint and the issues carried over to another sprint are read from the sprint report
+// of the board of the sprint, and the truncated changelogs are paged.
+//
+// GET /rest/agile/1.0/sprint/{sprintId}/issue
+//
+// GET /rest/greenhopper/1.0/rapid/charts/sprintreport
+//
+// GET /rest/agile/1.0/issue/{issueIdOrKey}
+//
+// GET /rest/api/3/issue/{issueIdOrKey}/changelog
+func (s *SprintService) Burndown(ctx context.Context, sprintID int, options *model.SprintBurndownOptionsScheme) (*model.SprintBurndownScheme, error) {
+	return s.internalClient.Burndown(ctx, sprintID, options)
+}
+
 type internalSprintImpl struct {
 	c       service.Connector
 	version string
@@ -328,3 +349,260 @@ func (i *internalSprintImpl) Close(ctx context.Context, sprintID int) (*model.Re
 
 	return i.c.Call(req, nil)
 }
+
+// sprintBurndownPageSize is the number of issues fetched per page by Burndown.
+const sprintBurndownPageSize = 50
+
+// sprintBurndownIssueScheme represents an issue of a sprint with the fields and the changelog used by Burndown.
+type sprintBurndownIssueScheme struct {
+	Key       string                      `json:"key"`
+	Fields    map[string]interface{}      `json:"fields"`
+	Changelog *model.IssueChangelogScheme `json:"changelog"`
+}
+
+func (i *internalSprintImpl) Burndown(ctx context.Context, sprintID int, options *model.SprintBurndownOptionsScheme) (*model.SprintBurndownScheme, error) {
+
+	if sprintID == 0 {
+		return nil, fmt.Errorf("agile: %w", model.ErrNoSprintID)
+	}
+
+	if options == nil {
+		options = new(model.SprintBurndownOptionsScheme)
+	}
+
+	sprint, _, err := i.Get(ctx, sprintID)
+	if err != nil {
+		return nil, err
+	}
+
+	if sprint.StartDate.IsZero() {
+		return nil, fmt.Errorf("agile: %w", model.ErrSprintNotStarted)
+	}
+
+	fields := []string{model.IssueFieldCreated, model.IssueFieldStatus, model.IssueFieldResolution}
+	if options.EstimationField != "" {
+		fields = append(fields, options.EstimationField)
+	}
+
+	var issues []*model.SprintBurndownIssueScheme
+	fetched := make(map[string]bool)
+
+	for startAt := 0; ; startAt += sprintBurndownPageSize {
+
+		params := url.Values{}
+		params.Add("startAt", strconv.Itoa(startAt))
+		params.Add("maxResults", strconv.Itoa(sprintBurndownPageSize))
+		params.Add("expand", "changelog")
+		params.Add("fields", strings.Join(fields, ","))
+
+		endpoint := fmt.Sprintf("rest/agile/%v/sprint/%v/issue?%v", i.version, sprintID, params.Encode())
+
+		req, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+		if err != nil {
+			return nil, err
+		}
+
+		page := struct {
+			Total  int                          `json:"total"`
+			Issues []*sprintBurndownIssueScheme `json:"issues"`
+		}{}
+
+		if _, err = i.c.Call(req, &page); err != nil {
+			return nil, err
+		}
+
+		for _, issue := range page.Issues {
+
+			burndownIssue, err := i.burndownIssue(ctx, issue, options)
+			if err != nil {
+				return nil, err
+			}
+
+			fetched[issue.Key] = true
+			issues = append(issues, burndownIssue)
+		}
+
+		if len(page.Issues) == 0 || startAt+len(page.Issues) >= page.Total {
+			break
+		}
+	}
+
+	// The issues removed from the sprint aren't returned with the issues of the sprint, nor sometimes the issues of
+	// a closed sprint carried over to the next one: they're listed by the sprint report of the board of the sprint
+	if sprint.OriginBoardID != 0 {
+
+		removed, carriedOver, err := i.sprintReport(ctx, sprint.OriginBoardID, sprintID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, key := range append(removed, carriedOver...) {
+
+			if fetched[key] {
+				continue
+			}
+
+			issue, err := i.sprintIssue(ctx, key, fields)
+			if err != nil {
+				return nil, err
+			}
+
+			burndownIssue, err := i.burndownIssue(ctx, issue, options)
+			if err != nil {
+				return nil, err
+			}
+
+			burndownIssue.Removed = slices.Contains(removed, key)
+
+			fetched[key] = true
+			issues = append(issues, burndownIssue)
+		}
+	}
+
+	burndown, err := model.NewSprintBurndown(sprint, issues, options)
+	if err != nil {
+		return nil, fmt.Errorf("agile: %w", err)
+	}
+
+	return burndown, nil
+}
+
+// sprintChangelogPageSize is the number of changelog histories fetched per page when the changelog of an issue
+// returned by Burndown is truncated.
+const sprintChangelogPageSize = 100
+
+// burndownIssue converts the fetched issue into its burndown representation, fetching its whole changelog when the
+// changelog returned with the issue is truncated.
+func (i *internalSprintImpl) burndownIssue(ctx context.Context, issue *sprintBurndownIssueScheme, options *model.SprintBurndownOptionsScheme) (*model.SprintBurndownIssueScheme, error) {
+
+	if issue.Changelog != nil && issue.Changelog.Total > len(issue.Changelog.Histories) {
+
+		var histories []*model.IssueChangelogHistoryScheme
+		for startAt := 0; ; startAt += sprintChangelogPageSize {
+
+			params := url.Values{}
+			params.Add("startAt", strconv.Itoa(startAt))
+			params.Add("maxResults", strconv.Itoa(sprintChangelogPageSize))
+
+			endpoint := fmt.Sprintf("rest/api/3/issue/%v/changelog?%v", issue.Key, params.Encode())
+
+			req, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+			if err != nil {
+				return nil, err
+			}
+
+			page := new(model.IssueChangelogPageScheme)
+			if _, err = i.c.Call(req, page); err != nil {
+				return nil, err
+			}
+
+			histories = append(histories, page.Values...)
+
+			if page.IsLast || len(page.Values) == 0 || startAt+len(page.Values) >= page.Total {
+				break
+			}
+		}
+
+		issue.Changelog = &model.IssueChangelogScheme{Total: len(histories), MaxResults: len(histories), Histories: histories}
+	}
+
+	return sprintBurndownIssue(issue, options), nil
+}
+
+// sprintReport returns the keys of the issues removed from the sprint and of the issues not completed in it,
+// as listed by the sprint report of the board.
+func (i *internalSprintImpl) sprintReport(ctx context.Context, boardID, sprintID int) (removed, carriedOver []string, err error) {
+
+	params := url.Values{}
+	params.Add("rapidViewId", strconv.Itoa(boardID))
+	params.Add("sprintId", strconv.Itoa(sprintID))
+
+	endpoint := fmt.Sprintf("rest/greenhopper/1.0/rapid/charts/sprintreport?%v", params.Encode())
+
+	req, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	type reportIssue struct {
+		Key string `json:"key"`
+	}
+
+	report := struct {
+		Contents struct {
+			NotCompleted []*reportIssue `json:"issuesNotCompletedInCurrentSprint"`
+			Punted       []*reportIssue `json:"puntedIssues"`
+		} `json:"contents"`
+	}{}
+
+	if _, err = i.c.Call(req, &report); err != nil {
+		return nil, nil, err
+	}
+
+	for _, issue := range report.Contents.Punted {
+		removed = append(removed, issue.Key)
+	}
+
+	for _, issue := range report.Contents.NotCompleted {
+		carriedOver = append(carriedOver, issue.Key)
+	}
+
+	return removed, carriedOver, nil
+}
+
+// sprintIssue returns an issue with the fields and the changelog used by Burndown.
+func (i *internalSprintImpl) sprintIssue(ctx context.Context, issueKey string, fields []string) (*sprintBurndownIssueScheme, error) {
+
+	params := url.Values{}
+	params.Add("expand", "changelog")
+	params.Add("fields", strings.Join(fields, ","))
+
+	endpoint := fmt.Sprintf("rest/agile/%v/issue/%v?%v", i.version, issueKey, params.Encode())
+
+	req, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	issue := new(sprintBurndownIssueScheme)
+	if _, err = i.c.Call(req, issue); err != nil {
+		return nil, err
+	}
+
+	return issue, nil
+}
+
+// sprintBurndownIssue converts the fetched issue into its burndown representation.
+func sprintBurndownIssue(issue *sprintBurndownIssueScheme, options *model.SprintBurndownOptionsScheme) *model.SprintBurndownIssueScheme {
+
+	burndownIssue := &model.SprintBurndownIssueScheme{Key: issue.Key}
+
+	if created, ok := issue.Fields[model.IssueFieldCreated].(string); ok {
+		burndownIssue.Created, _ = time.Parse("2006-01-02T15:04:05.000-0700", created)
+	}
+
+	if options.EstimationField != "" {
+		burndownIssue.Estimate, _ = issue.Fields[options.EstimationField].(float64)
+	}
+
+	if len(options.DoneStatuses) != 0 {
+
+		status, _ := issue.Fields[model.IssueFieldStatus].(map[string]interface{})
+		name, _ := status["name"].(string)
+
+		for _, doneStatus := range options.DoneStatuses {
+			if strings.EqualFold(doneStatus, name) {
+				burndownIssue.Done = true
+			}
+		}
+
+	} else {
+		burndownIssue.Done = issue.Fields[model.IssueFieldResolution] != nil
+	}
+
+	if issue.Changelog != nil {
+		burndownIssue.Histories = issue.Changelog.Histories
+	}
+
+	return burndownIssue
+}
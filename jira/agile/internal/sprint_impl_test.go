@@ -7,8 +7,10 @@ import (
 	"net/http"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
@@ -1374,3 +1376,267 @@ func Test_SprintService_Move(t *testing.T) {
 		})
 	}
 }
+
+func Test_SprintService_Burndown(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx      context.Context
+		sprintID int
+		options  *model.SprintBurndownOptionsScheme
+	}
+
+	issuesEndpoint := "rest/agile/1.0/sprint/1001/issue?expand=changelog&fields=created%2Cstatus%2Cresolution%2Ccustomfield_10016&maxResults=50&startAt=0"
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    *model.SprintBurndownScheme
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:      context.Background(),
+				sprintID: 1001,
+				options:  &model.SprintBurndownOptionsScheme{EstimationField: "customfield_10016"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/agile/1.0/sprint/1001",
+					"",
+					nil).
+					Return(&http.Request{Method: http.MethodGet}, nil)
+
+				client.On("Call",
+					&http.Request{Method: http.MethodGet},
+					&model.SprintScheme{}).
+					Run(func(args mock.Arguments) {
+						sprint := args.Get(1).(*model.SprintScheme)
+						sprint.ID = 1001
+						sprint.OriginBoardID = 5
+						sprint.StartDate = time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+						sprint.EndDate = time.Date(2024, 3, 15, 9, 0, 0, 0, time.UTC)
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					issuesEndpoint,
+					"",
+					nil).
+					Return(&http.Request{RequestURI: "issues"}, nil)
+
+				client.On("Call",
+					&http.Request{RequestURI: "issues"},
+					mock.Anything).
+					Run(func(args mock.Arguments) {
+						_ = json.Unmarshal([]byte(`{"total":1,"issues":[{"key":"KP-1","fields":{
+							"created":"2024-02-28T09:00:00.000+0000","resolution":{"name":"Done"},"customfield_10016":5},
+							"changelog":{"total":2,"histories":[{"created":"2024-03-04T10:00:00.000+0000",
+							"items":[{"field":"resolution","fieldId":"resolution","toString":"Done"}]}]}}]}`), args.Get(1))
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/issue/KP-1/changelog?maxResults=100&startAt=0",
+					"",
+					nil).
+					Return(&http.Request{RequestURI: "changelog"}, nil)
+
+				client.On("Call",
+					&http.Request{RequestURI: "changelog"},
+					&model.IssueChangelogPageScheme{}).
+					Run(func(args mock.Arguments) {
+						_ = json.Unmarshal([]byte(`{"total":2,"isLast":true,"values":[{"created":"2024-03-02T10:00:00.000+0000",
+							"items":[{"field":"Sprint","from":"","to":"1001"}]},{"created":"2024-03-04T10:00:00.000+0000",
+							"items":[{"field":"resolution","fieldId":"resolution","toString":"Done"}]}]}`), args.Get(1))
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/greenhopper/1.0/rapid/charts/sprintreport?rapidViewId=5&sprintId=1001",
+					"",
+					nil).
+					Return(&http.Request{RequestURI: "report"}, nil)
+
+				client.On("Call",
+					&http.Request{RequestURI: "report"},
+					mock.Anything).
+					Run(func(args mock.Arguments) {
+						_ = json.Unmarshal([]byte(`{"contents":{"puntedIssues":[{"key":"KP-2"}],
+							"issuesNotCompletedInCurrentSprint":[{"key":"KP-1"}]}}`), args.Get(1))
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/agile/1.0/issue/KP-2?expand=changelog&fields=created%2Cstatus%2Cresolution%2Ccustomfield_10016",
+					"",
+					nil).
+					Return(&http.Request{RequestURI: "removed"}, nil)
+
+				client.On("Call",
+					&http.Request{RequestURI: "removed"},
+					mock.Anything).
+					Run(func(args mock.Arguments) {
+						_ = json.Unmarshal([]byte(`{"key":"KP-2","fields":{"created":"2024-02-28T09:00:00.000+0000",
+							"customfield_10016":3},"changelog":{"total":1,"histories":[{"created":"2024-03-05T10:00:00.000+0000",
+							"items":[{"field":"Sprint","from":"1001","to":""}]}]}}`), args.Get(1))
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			want: &model.SprintBurndownScheme{
+				SprintID:        1001,
+				Start:           time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC),
+				End:             time.Date(2024, 3, 15, 9, 0, 0, 0, time.UTC),
+				EstimationField: "customfield_10016",
+				InitialIssues:   []string{"KP-2"},
+				InitialScope:    3,
+				Events: []*model.SprintBurndownEventScheme{
+					{
+						Time:      time.Date(2024, 3, 2, 10, 0, 0, 0, time.UTC),
+						IssueKey:  "KP-1",
+						Type:      model.SprintBurndownIssueAdded,
+						Change:    5,
+						Remaining: 8,
+					},
+					{
+						Time:      time.Date(2024, 3, 4, 10, 0, 0, 0, time.UTC),
+						IssueKey:  "KP-1",
+						Type:      model.SprintBurndownIssueCompleted,
+						Change:    -5,
+						Remaining: 3,
+					},
+					{
+						Time:      time.Date(2024, 3, 5, 10, 0, 0, 0, time.UTC),
+						IssueKey:  "KP-2",
+						Type:      model.SprintBurndownIssueRemoved,
+						Change:    -3,
+						Remaining: 0,
+					},
+				},
+			},
+		},
+
+		{
+			name: "when the sprint is not started",
+			args: args{
+				ctx:      context.Background(),
+				sprintID: 1001,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/agile/1.0/sprint/1001",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.SprintScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			Err:     model.ErrSprintNotStarted,
+			wantErr: true,
+		},
+
+		{
+			name: "when the sprintId is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			Err:     model.ErrNoSprintID,
+			wantErr: true,
+		},
+
+		{
+			name: "when the sprint cannot be fetched",
+			args: args{
+				ctx:      context.Background(),
+				sprintID: 1001,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/agile/1.0/sprint/1001",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.SprintScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrNoExecHttpCall)
+
+				fields.c = client
+			},
+			Err:     model.ErrNoExecHttpCall,
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			sprintService := NewSprintService(testCase.fields.c, "1.0")
+
+			got, err := sprintService.Burndown(testCase.args.ctx, testCase.args.sprintID, testCase.args.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				for _, event := range got.Events {
+					event.Time = event.Time.UTC()
+				}
+
+				assert.Equal(t, testCase.want, got)
+			}
+		})
+	}
+}
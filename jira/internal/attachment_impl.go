@@ -1,20 +1,30 @@
 package internal
 
 import (
+	"archive/zip"
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
 	"mime/multipart"
 	"net/http"
 	"net/url"
+	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/jira"
 )
 
+// attachmentArchiveConcurrency is the number of attachments downloaded at the same time by Archive.
+const attachmentArchiveConcurrency = 5
+
 // NewIssueAttachmentService creates a new instance of IssueAttachmentService.
 // It takes a service.Connector and a version string as input.
 // Returns a pointer to IssueAttachmentService and an error if the version is not provided.
@@ -75,6 +85,23 @@ func (i *IssueAttachmentService) Human(ctx context.Context, attachmentID string)
 	return i.internalClient.Human(ctx, attachmentID)
 }
 
+// Raw returns the metadata for the contents of an attachment, if it is an archive, e.g. the entries of a ZIP file,
+// with their sizes in bytes. The attachment itself is not downloaded.
+//
+// GET /rest/api/{2-3}/attachment/{attachmentID}/expand/raw
+//
+// # Experimental Endpoint
+func (i *IssueAttachmentService) Raw(ctx context.Context, attachmentID string) (*model.IssueAttachmentRawMetadataScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Raw(ctx, attachmentID)
+}
+
+// Thumbnail returns the thumbnail of an attachment, read as Download.
+//
+// GET /rest/api/{2-3}/attachment/thumbnail/{id}
+func (i *IssueAttachmentService) Thumbnail(ctx context.Context, attachmentID string, options *model.IssueAttachmentThumbnailOptionsScheme) (*model.ResponseScheme, error) {
+	return i.internalClient.Thumbnail(ctx, attachmentID, options)
+}
+
 // Add adds one attachment to an issue. Attachments are posted as multipart/form-data (RFC 1867).
 //
 // POST /rest/api/{2-3}/issue/{issueKeyOrID}/attachments
@@ -84,10 +111,36 @@ func (i *IssueAttachmentService) Add(ctx context.Context, issueKeyOrID, fileName
 	return i.internalClient.Add(ctx, issueKeyOrID, fileName, file)
 }
 
+// AddFromReader adds one attachment to an issue, streaming the file into the multipart/form-data body instead of
+// buffering it in memory, so the large files can be uploaded with a constant memory usage.
+//
+// The size of the file sets the Content-Length of the request, a negative size sends it chunked. The file being
+// read once, the request isn't retried.
+//
+// POST /rest/api/{2-3}/issue/{issueKeyOrID}/attachments
+func (i *IssueAttachmentService) AddFromReader(ctx context.Context, issueKeyOrID, fileName string, size int64, file io.Reader) ([]*model.IssueAttachmentScheme, *model.ResponseScheme, error) {
+	return i.internalClient.AddFromReader(ctx, issueKeyOrID, fileName, size, file)
+}
+
+// Upload adds one attachment to an issue and checks that the attachment matches the file.
+//
+// Jira doesn't support chunked uploads, the rate-limited and mismatching uploads are retried from the beginning of the file.
+//
+// POST /rest/api/{2-3}/issue/{issueKeyOrID}/attachments
+//
+// GET /rest/api/{2-3}/attachment/content/{id}
+//
+// DELETE /rest/api/{2-3}/attachment/{id}
+func (i *IssueAttachmentService) Upload(ctx context.Context, issueKeyOrID, fileName string, file io.ReadSeeker, options *model.IssueAttachmentUploadOptionsScheme) (*model.IssueAttachmentUploadScheme, error) {
+	return i.internalClient.Upload(ctx, issueKeyOrID, fileName, file, options)
+}
+
 // Download returns the contents of an attachment. A Range header can be set to define a range of bytes within the attachment to download.
 //
 // See the HTTP Range header standard for details.
 //
+// The content is read into ResponseScheme.Bytes, see DownloadStream to stream it instead.
+//
 // GET /rest/api/{2-3}/attachment/content/{id}
 //
 // https://docs.go-atlassian.io/jira-software-cloud/issues/attachments#download-attachment
@@ -95,12 +148,52 @@ func (i *IssueAttachmentService) Download(ctx context.Context, attachmentID stri
 	return i.internalClient.Download(ctx, attachmentID, redirect)
 }
 
+// DownloadStream returns the contents of an attachment like Download, streamed instead of read into ResponseScheme.Bytes.
+//
+// The contents are read from ResponseScheme.Reader, and the response must be closed with ResponseScheme.Close.
+//
+// GET /rest/api/{2-3}/attachment/content/{id}
+func (i *IssueAttachmentService) DownloadStream(ctx context.Context, attachmentID string, redirect bool) (*model.ResponseScheme, error) {
+	return i.internalClient.DownloadStream(ctx, attachmentID, redirect)
+}
+
+// DownloadTo streams the contents of an attachment to the writer, without reading them in memory,
+// and returns the number of bytes written.
+//
+// GET /rest/api/{2-3}/attachment/content/{id}
+func (i *IssueAttachmentService) DownloadTo(ctx context.Context, attachmentID string, w io.Writer) (int64, *model.ResponseScheme, error) {
+	return i.internalClient.DownloadTo(ctx, attachmentID, w)
+}
+
+// Archive downloads all the attachments of an issue concurrently into a ZIP file without reading them in memory.
+//
+// The attachments are downloaded to temporary files, a few at a time, then written to the ZIP file in the order
+// returned by Jira. The attachments that can't be downloaded are reported on the returned archive and left out of
+// the ZIP file; an error while writing an attachment stops the archive, as the ZIP file can't be completed.
+//
+// GET /rest/api/{2-3}/issue/{issueKeyOrID}?fields=attachment
+//
+// GET /rest/api/{2-3}/attachment/content/{id}
+func (i *IssueAttachmentService) Archive(ctx context.Context, issueKeyOrID string, w io.Writer) (*model.IssueAttachmentArchiveScheme, error) {
+	return i.internalClient.Archive(ctx, issueKeyOrID, w)
+}
+
 type internalIssueAttachmentServiceImpl struct {
 	c       service.Connector
 	version string
 }
 
 func (i *internalIssueAttachmentServiceImpl) Download(ctx context.Context, attachmentID string, redirect bool) (*model.ResponseScheme, error) {
+	return i.download(ctx, attachmentID, redirect, nil)
+}
+
+func (i *internalIssueAttachmentServiceImpl) DownloadStream(ctx context.Context, attachmentID string, redirect bool) (*model.ResponseScheme, error) {
+	return i.download(ctx, attachmentID, redirect, new(model.StreamedResponseScheme))
+}
+
+// download returns the contents of an attachment, read into ResponseScheme.Bytes when structure is nil,
+// or streamed when it's a StreamedResponseScheme.
+func (i *internalIssueAttachmentServiceImpl) download(ctx context.Context, attachmentID string, redirect bool, structure interface{}) (*model.ResponseScheme, error) {
 
 	if attachmentID == "" {
 		return nil, fmt.Errorf("jira: %w", model.ErrNoAttachmentID)
@@ -122,7 +215,28 @@ func (i *internalIssueAttachmentServiceImpl) Download(ctx context.Context, attac
 		return nil, err
 	}
 
-	return i.c.Call(request, nil)
+	return i.c.Call(request, structure)
+}
+
+func (i *internalIssueAttachmentServiceImpl) DownloadTo(ctx context.Context, attachmentID string, w io.Writer) (int64, *model.ResponseScheme, error) {
+
+	if w == nil {
+		return 0, nil, fmt.Errorf("jira: %w", model.ErrNoWriter)
+	}
+
+	response, err := i.DownloadStream(ctx, attachmentID, true)
+	if err != nil {
+		return 0, response, err
+	}
+
+	defer response.Close()
+
+	written, err := io.Copy(w, response.Reader())
+	if err != nil {
+		return written, response, err
+	}
+
+	return written, response, nil
 }
 
 func (i *internalIssueAttachmentServiceImpl) Settings(ctx context.Context) (*model.AttachmentSettingScheme, *model.ResponseScheme, error) {
@@ -203,6 +317,69 @@ func (i *internalIssueAttachmentServiceImpl) Human(ctx context.Context, attachme
 	return metadata, response, nil
 }
 
+func (i *internalIssueAttachmentServiceImpl) Raw(ctx context.Context, attachmentID string) (*model.IssueAttachmentRawMetadataScheme, *model.ResponseScheme, error) {
+
+	if attachmentID == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoAttachmentID)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/attachment/%v/expand/raw", i.version, attachmentID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	metadata := new(model.IssueAttachmentRawMetadataScheme)
+	response, err := i.c.Call(request, metadata)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return metadata, response, nil
+}
+
+func (i *internalIssueAttachmentServiceImpl) Thumbnail(ctx context.Context, attachmentID string, options *model.IssueAttachmentThumbnailOptionsScheme) (*model.ResponseScheme, error) {
+
+	if attachmentID == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoAttachmentID)
+	}
+
+	if options == nil {
+		options = new(model.IssueAttachmentThumbnailOptionsScheme)
+	}
+
+	params := url.Values{}
+
+	if !options.Redirect {
+		params.Add("redirect", "false") //default: true
+	}
+
+	if options.FallbackToDefault {
+		params.Add("fallbackToDefault", "true")
+	}
+
+	if options.Width > 0 {
+		params.Add("width", strconv.Itoa(options.Width))
+	}
+
+	if options.Height > 0 {
+		params.Add("height", strconv.Itoa(options.Height))
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/attachment/thumbnail/%v", i.version, attachmentID)
+	if len(params) != 0 {
+		endpoint = fmt.Sprintf("%v?%v", endpoint, params.Encode())
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
 func (i *internalIssueAttachmentServiceImpl) Add(ctx context.Context, issueKeyOrID, fileName string, file io.Reader) ([]*model.IssueAttachmentScheme, *model.ResponseScheme, error) {
 
 	if issueKeyOrID == "" {
@@ -247,3 +424,298 @@ func (i *internalIssueAttachmentServiceImpl) Add(ctx context.Context, issueKeyOr
 
 	return attachments, response, nil
 }
+
+func (i *internalIssueAttachmentServiceImpl) AddFromReader(ctx context.Context, issueKeyOrID, fileName string, size int64, file io.Reader) ([]*model.IssueAttachmentScheme, *model.ResponseScheme, error) {
+
+	if issueKeyOrID == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoIssueKeyOrID)
+	}
+
+	if fileName == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoAttachmentName)
+	}
+
+	if file == nil {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoReader)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/attachments", i.version, issueKeyOrID)
+
+	// The multipart header and trailer are written upfront, the file is streamed between them
+	envelope := &bytes.Buffer{}
+	writer := multipart.NewWriter(envelope)
+
+	if _, err := writer.CreateFormFile("file", fileName); err != nil {
+		return nil, nil, err
+	}
+
+	headerLength := envelope.Len()
+
+	if err := writer.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	header, trailer := envelope.Bytes()[:headerLength], envelope.Bytes()[headerLength:]
+	body := io.MultiReader(bytes.NewReader(header), file, bytes.NewReader(trailer))
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, writer.FormDataContentType(), body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if size >= 0 {
+		request.ContentLength = int64(len(header)) + size + int64(len(trailer))
+	}
+
+	var attachments []*model.IssueAttachmentScheme
+	response, err := i.c.Call(request, &attachments)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return attachments, response, nil
+}
+
+func (i *internalIssueAttachmentServiceImpl) Upload(ctx context.Context, issueKeyOrID, fileName string, file io.ReadSeeker, options *model.IssueAttachmentUploadOptionsScheme) (*model.IssueAttachmentUploadScheme, error) {
+
+	if file == nil {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoReader)
+	}
+
+	if options == nil {
+		options = new(model.IssueAttachmentUploadOptionsScheme)
+	}
+
+	attempts := options.Attempts
+	if attempts <= 0 {
+		attempts = 1
+	}
+
+	upload := new(model.IssueAttachmentUploadScheme)
+
+	var err error
+	for upload.Attempts < attempts {
+
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return upload, ctxErr
+		}
+
+		upload.Attempts++
+
+		var retry bool
+		if retry, err = i.upload(ctx, issueKeyOrID, fileName, file, options, upload); err == nil || !retry {
+			return upload, err
+		}
+
+		// The rate limits and the maintenance windows are waited out before the next upload
+		if delay, ok := model.RetryDelay(err, upload.Attempts); ok && upload.Attempts < attempts {
+
+			select {
+			case <-ctx.Done():
+				return upload, ctx.Err()
+			case <-time.After(delay):
+			}
+		}
+	}
+
+	return upload, err
+}
+
+// upload uploads the file once and fills the upload result, and returns whether a failed upload can be retried.
+func (i *internalIssueAttachmentServiceImpl) upload(ctx context.Context, issueKeyOrID, fileName string, file io.ReadSeeker, options *model.IssueAttachmentUploadOptionsScheme,
+	upload *model.IssueAttachmentUploadScheme) (bool, error) {
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return false, err
+	}
+
+	// The checksum is computed while the file is read for the multipart body
+	hash := sha256.New()
+	counter := &attachmentCounter{}
+
+	attachments, _, err := i.Add(ctx, issueKeyOrID, fileName, io.TeeReader(io.TeeReader(file, hash), counter))
+	if err != nil {
+		// Only the rate-limited uploads are retried, the server may have attached the file before failing otherwise
+		var apiErr *model.Error
+		return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusTooManyRequests, err
+	}
+
+	upload.Checksum = hex.EncodeToString(hash.Sum(nil))
+	upload.Size = counter.size
+
+	if len(attachments) == 0 {
+		return false, fmt.Errorf("jira: %w", model.ErrNoAttachmentUploaded)
+	}
+
+	upload.Attachment = attachments[0]
+
+	mismatch := int64(upload.Attachment.Size) != upload.Size
+	if !mismatch && options.Verify {
+
+		content, err := i.DownloadStream(ctx, upload.Attachment.ID, true)
+		if err != nil {
+			return false, err
+		}
+
+		// The content is hashed while it's streamed
+		hash := sha256.New()
+		_, err = io.Copy(hash, content.Reader())
+		content.Close()
+		if err != nil {
+			return false, err
+		}
+
+		mismatch = hex.EncodeToString(hash.Sum(nil)) != upload.Checksum
+		upload.Verified = true
+	}
+
+	if !mismatch {
+		return false, nil
+	}
+
+	// The corrupted attachment is removed, so the retries don't leave duplicates on the issue
+	attachmentID := upload.Attachment.ID
+	if _, err = i.Delete(ctx, attachmentID); err != nil {
+		return false, err
+	}
+
+	upload.Attachment = nil
+
+	return true, fmt.Errorf("jira: attachment %v: %w", attachmentID, model.ErrAttachmentChecksumMismatch)
+}
+
+// attachmentCounter counts the bytes of the uploaded file.
+type attachmentCounter struct {
+	size int64
+}
+
+func (c *attachmentCounter) Write(p []byte) (int, error) {
+	c.size += int64(len(p))
+	return len(p), nil
+}
+
+func (i *internalIssueAttachmentServiceImpl) Archive(ctx context.Context, issueKeyOrID string, w io.Writer) (*model.IssueAttachmentArchiveScheme, error) {
+
+	if issueKeyOrID == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoIssueKeyOrID)
+	}
+
+	if w == nil {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoWriter)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/%v?fields=attachment", i.version, issueKeyOrID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	issue := new(model.IssueAttachmentsScheme)
+	if _, err = i.c.Call(request, issue); err != nil {
+		return nil, err
+	}
+
+	var attachments []*model.IssueAttachmentScheme
+	if issue.Fields != nil {
+		attachments = issue.Fields.Attachment
+	}
+
+	archive := &model.IssueAttachmentArchiveScheme{Files: make([]*model.IssueAttachmentArchiveFileScheme, 0, len(attachments))}
+	names := make(map[string]struct{}, len(attachments))
+
+	for _, attachment := range attachments {
+
+		if attachment == nil {
+			continue
+		}
+
+		name := attachment.Filename
+		if name == "" {
+			name = attachment.ID
+		}
+
+		// Jira allows several attachments with the same filename, the ID keeps the archive entries unique
+		if _, ok := names[name]; ok {
+			name = fmt.Sprintf("%v_%v", attachment.ID, name)
+		}
+		names[name] = struct{}{}
+
+		archive.Files = append(archive.Files, &model.IssueAttachmentArchiveFileScheme{Attachment: attachment, Name: name})
+	}
+
+	downloads := make([]*os.File, len(archive.Files))
+	defer func() {
+		for _, download := range downloads {
+			if download != nil {
+				download.Close()
+				os.Remove(download.Name())
+			}
+		}
+	}()
+
+	errs := model.RunWorkers(ctx, len(archive.Files), attachmentArchiveConcurrency, 0, func(index int) (err error) {
+		downloads[index], err = i.archiveDownload(ctx, archive.Files[index].Attachment.ID)
+		return err
+	})
+
+	writer := zip.NewWriter(w)
+	for index, file := range archive.Files {
+
+		if errs[index] != nil {
+			file.Err = fmt.Errorf("jira: attachment %v: %w", file.Attachment.ID, errs[index])
+			continue
+		}
+
+		if err = archiveAttachment(writer, file.Name, downloads[index]); err != nil {
+			return archive, fmt.Errorf("jira: attachment %v: %w", file.Attachment.ID, err)
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return archive, err
+	}
+
+	return archive, nil
+}
+
+// archiveDownload streams an attachment to a temporary file, and returns the file rewound for reading.
+// The caller closes and removes the file.
+func (i *internalIssueAttachmentServiceImpl) archiveDownload(ctx context.Context, attachmentID string) (*os.File, error) {
+
+	response, err := i.DownloadStream(ctx, attachmentID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	defer response.Close()
+
+	file, err := os.CreateTemp("", "go-atlassian-attachment-*")
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = io.Copy(file, response.Reader()); err == nil {
+		_, err = file.Seek(0, io.SeekStart)
+	}
+
+	if err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return nil, err
+	}
+
+	return file, nil
+}
+
+// archiveAttachment copies the downloaded attachment into a new entry of the ZIP file.
+func archiveAttachment(writer *zip.Writer, name string, content io.Reader) error {
+
+	entry, err := writer.Create(name)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(entry, content)
+	return err
+}
@@ -1,6 +1,8 @@
 package internal
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -9,7 +11,9 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"testing/iotest"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -467,7 +471,7 @@ func Test_internalIssueAttachmentServiceImpl_Human(t *testing.T) {
 	}
 }
 
-func Test_internalIssueAttachmentServiceImpl_Delete(t *testing.T) {
+func Test_internalIssueAttachmentServiceImpl_Raw(t *testing.T) {
 
 	type fields struct {
 		c       service.Connector
@@ -500,15 +504,15 @@ func Test_internalIssueAttachmentServiceImpl_Delete(t *testing.T) {
 
 				client.On("NewRequest",
 					context.Background(),
-					http.MethodDelete,
-					"rest/api/2/attachment/1110",
+					http.MethodGet,
+					"rest/api/2/attachment/1110/expand/raw",
 					"",
 					nil).
 					Return(&http.Request{}, nil)
 
 				client.On("Call",
 					&http.Request{},
-					nil).
+					&model.IssueAttachmentRawMetadataScheme{}).
 					Return(&model.ResponseScheme{}, nil)
 
 				fields.c = client
@@ -528,15 +532,15 @@ func Test_internalIssueAttachmentServiceImpl_Delete(t *testing.T) {
 
 				client.On("NewRequest",
 					context.Background(),
-					http.MethodDelete,
-					"rest/api/3/attachment/1110",
+					http.MethodGet,
+					"rest/api/3/attachment/1110/expand/raw",
 					"",
 					nil).
 					Return(&http.Request{}, nil)
 
 				client.On("Call",
 					&http.Request{},
-					nil).
+					&model.IssueAttachmentRawMetadataScheme{}).
 					Return(&model.ResponseScheme{}, nil)
 
 				fields.c = client
@@ -571,8 +575,8 @@ func Test_internalIssueAttachmentServiceImpl_Delete(t *testing.T) {
 
 				client.On("NewRequest",
 					context.Background(),
-					http.MethodDelete,
-					"rest/api/2/attachment/1110",
+					http.MethodGet,
+					"rest/api/2/attachment/1110/expand/raw",
 					"",
 					nil).
 					Return(&http.Request{}, model.ErrCreateHttpReq)
@@ -595,7 +599,7 @@ func Test_internalIssueAttachmentServiceImpl_Delete(t *testing.T) {
 			attachmentService, err := NewIssueAttachmentService(testCase.fields.c, testCase.fields.version)
 			assert.NoError(t, err)
 
-			gotResponse, err := attachmentService.Delete(testCase.args.ctx, testCase.args.attachmentID)
+			gotResult, gotResponse, err := attachmentService.Raw(testCase.args.ctx, testCase.args.attachmentID)
 
 			if testCase.wantErr {
 
@@ -615,23 +619,14 @@ func Test_internalIssueAttachmentServiceImpl_Delete(t *testing.T) {
 
 				assert.NoError(t, err)
 				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
 			}
 
 		})
 	}
 }
 
-func Test_internalIssueAttachmentServiceImpl_Add(t *testing.T) {
-
-	absolutePathMocked, err := filepath.Abs("../../LICENSE")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	fileMocked, err := os.Open(absolutePathMocked)
-	if err != nil {
-		t.Fatal(err)
-	}
+func Test_internalIssueAttachmentServiceImpl_Thumbnail(t *testing.T) {
 
 	type fields struct {
 		c       service.Connector
@@ -639,9 +634,9 @@ func Test_internalIssueAttachmentServiceImpl_Add(t *testing.T) {
 	}
 
 	type args struct {
-		ctx                    context.Context
-		issueKeyOrID, fileName string
-		file                   io.Reader
+		ctx          context.Context
+		attachmentID string
+		options      *model.IssueAttachmentThumbnailOptionsScheme
 	}
 
 	testCases := []struct {
@@ -653,13 +648,16 @@ func Test_internalIssueAttachmentServiceImpl_Add(t *testing.T) {
 		Err     error
 	}{
 		{
-			name:   "when the api version is v2",
-			fields: fields{version: "2"},
+			name:   "when the options are provided",
+			fields: fields{version: "3"},
 			args: args{
 				ctx:          context.Background(),
-				issueKeyOrID: "DUMMY-1",
-				fileName:     "LICENSE",
-				file:         fileMocked,
+				attachmentID: "1110",
+				options: &model.IssueAttachmentThumbnailOptionsScheme{
+					FallbackToDefault: true,
+					Width:             200,
+					Height:            100,
+				},
 			},
 			on: func(fields *fields) {
 
@@ -667,15 +665,15 @@ func Test_internalIssueAttachmentServiceImpl_Add(t *testing.T) {
 
 				client.On("NewRequest",
 					context.Background(),
-					http.MethodPost,
-					"rest/api/2/issue/DUMMY-1/attachments",
-					mock.Anything,
-					mock.Anything).
+					http.MethodGet,
+					"rest/api/3/attachment/thumbnail/1110?fallbackToDefault=true&height=100&redirect=false&width=200",
+					"",
+					nil).
 					Return(&http.Request{}, nil)
 
 				client.On("Call",
 					&http.Request{},
-					mock.Anything).
+					nil).
 					Return(&model.ResponseScheme{}, nil)
 
 				fields.c = client
@@ -683,13 +681,12 @@ func Test_internalIssueAttachmentServiceImpl_Add(t *testing.T) {
 		},
 
 		{
-			name:   "when the api version is v3",
-			fields: fields{version: "3"},
+			name:   "when the thumbnail is redirected",
+			fields: fields{version: "2"},
 			args: args{
 				ctx:          context.Background(),
-				issueKeyOrID: "DUMMY-1",
-				fileName:     "LICENSE",
-				file:         fileMocked,
+				attachmentID: "1110",
+				options:      &model.IssueAttachmentThumbnailOptionsScheme{Redirect: true},
 			},
 			on: func(fields *fields) {
 
@@ -697,15 +694,15 @@ func Test_internalIssueAttachmentServiceImpl_Add(t *testing.T) {
 
 				client.On("NewRequest",
 					context.Background(),
-					http.MethodPost,
-					"rest/api/3/issue/DUMMY-1/attachments",
-					mock.Anything,
-					mock.Anything).
+					http.MethodGet,
+					"rest/api/2/attachment/thumbnail/1110",
+					"",
+					nil).
 					Return(&http.Request{}, nil)
 
 				client.On("Call",
 					&http.Request{},
-					mock.Anything).
+					nil).
 					Return(&model.ResponseScheme{}, nil)
 
 				fields.c = client
@@ -713,52 +710,21 @@ func Test_internalIssueAttachmentServiceImpl_Add(t *testing.T) {
 		},
 
 		{
-			name:   "when the issue key or id is not provided",
-			fields: fields{version: "2"},
-			args: args{
-				ctx:          context.Background(),
-				issueKeyOrID: "",
-				fileName:     "LICENSE",
-				file:         fileMocked,
-			},
-			wantErr: true,
-			Err:     model.ErrNoIssueKeyOrID,
-		},
-
-		{
-			name:   "when the file name is not provided",
-			fields: fields{version: "2"},
-			args: args{
-				ctx:          context.Background(),
-				issueKeyOrID: "DUMMY-1",
-				fileName:     "",
-				file:         fileMocked,
-			},
-			wantErr: true,
-			Err:     model.ErrNoAttachmentName,
-		},
-
-		{
-			name:   "when the field reader is not provided",
-			fields: fields{version: "2"},
+			name:   "when the attachment id is not provided",
+			fields: fields{version: "3"},
 			args: args{
-				ctx:          context.Background(),
-				issueKeyOrID: "DUMMY-1",
-				fileName:     "LICENSE",
-				file:         nil,
+				ctx: context.Background(),
 			},
 			wantErr: true,
-			Err:     model.ErrNoReader,
+			Err:     model.ErrNoAttachmentID,
 		},
 
 		{
 			name:   "when the http request cannot be created",
-			fields: fields{version: "2"},
+			fields: fields{version: "3"},
 			args: args{
 				ctx:          context.Background(),
-				issueKeyOrID: "DUMMY-1",
-				fileName:     "LICENSE",
-				file:         fileMocked,
+				attachmentID: "1110",
 			},
 			on: func(fields *fields) {
 
@@ -766,10 +732,10 @@ func Test_internalIssueAttachmentServiceImpl_Add(t *testing.T) {
 
 				client.On("NewRequest",
 					context.Background(),
-					http.MethodPost,
-					"rest/api/2/issue/DUMMY-1/attachments",
-					mock.Anything,
-					mock.Anything).
+					http.MethodGet,
+					"rest/api/3/attachment/thumbnail/1110?redirect=false",
+					"",
+					nil).
 					Return(&http.Request{}, model.ErrCreateHttpReq)
 
 				fields.c = client
@@ -789,35 +755,20 @@ func Test_internalIssueAttachmentServiceImpl_Add(t *testing.T) {
 			attachmentService, err := NewIssueAttachmentService(testCase.fields.c, testCase.fields.version)
 			assert.NoError(t, err)
 
-			gotResult, gotResponse, err := attachmentService.Add(testCase.args.ctx, testCase.args.issueKeyOrID,
-				testCase.args.fileName, testCase.args.file)
+			gotResponse, err := attachmentService.Thumbnail(testCase.args.ctx, testCase.args.attachmentID, testCase.args.options)
 
 			if testCase.wantErr {
-
-				if err != nil {
-					t.Logf("error returned: %v", err.Error())
-				}
-
-				// the first if statement is to handle wrapped errors from url and json packages for more accurate comparison
-				var urlErr *url.Error
-				var jsonErr *json.SyntaxError
-				if errors.As(err, &urlErr) || errors.As(err, &jsonErr) {
-					assert.Contains(t, err.Error(), testCase.Err.Error())
-				} else {
-					assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
-				}
-			} else {
-
-				assert.NoError(t, err)
-				assert.NotEqual(t, gotResponse, nil)
-				assert.NotEqual(t, gotResult, nil)
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+				return
 			}
 
+			assert.NoError(t, err)
+			assert.NotNil(t, gotResponse)
 		})
 	}
 }
 
-func Test_internalIssueAttachmentServiceImpl_Download(t *testing.T) {
+func Test_internalIssueAttachmentServiceImpl_Delete(t *testing.T) {
 
 	type fields struct {
 		c       service.Connector
@@ -827,7 +778,6 @@ func Test_internalIssueAttachmentServiceImpl_Download(t *testing.T) {
 	type args struct {
 		ctx          context.Context
 		attachmentID string
-		redirect     bool
 	}
 
 	testCases := []struct {
@@ -844,7 +794,6 @@ func Test_internalIssueAttachmentServiceImpl_Download(t *testing.T) {
 			args: args{
 				ctx:          context.Background(),
 				attachmentID: "1110",
-				redirect:     false,
 			},
 			on: func(fields *fields) {
 
@@ -852,8 +801,8 @@ func Test_internalIssueAttachmentServiceImpl_Download(t *testing.T) {
 
 				client.On("NewRequest",
 					context.Background(),
-					http.MethodGet,
-					"rest/api/2/attachment/content/1110?redirect=false",
+					http.MethodDelete,
+					"rest/api/2/attachment/1110",
 					"",
 					nil).
 					Return(&http.Request{}, nil)
@@ -873,7 +822,6 @@ func Test_internalIssueAttachmentServiceImpl_Download(t *testing.T) {
 			args: args{
 				ctx:          context.Background(),
 				attachmentID: "1110",
-				redirect:     true,
 			},
 			on: func(fields *fields) {
 
@@ -881,8 +829,8 @@ func Test_internalIssueAttachmentServiceImpl_Download(t *testing.T) {
 
 				client.On("NewRequest",
 					context.Background(),
-					http.MethodGet,
-					"rest/api/3/attachment/content/1110",
+					http.MethodDelete,
+					"rest/api/3/attachment/1110",
 					"",
 					nil).
 					Return(&http.Request{}, nil)
@@ -917,7 +865,6 @@ func Test_internalIssueAttachmentServiceImpl_Download(t *testing.T) {
 			args: args{
 				ctx:          context.Background(),
 				attachmentID: "1110",
-				redirect:     true,
 			},
 			on: func(fields *fields) {
 
@@ -925,8 +872,8 @@ func Test_internalIssueAttachmentServiceImpl_Download(t *testing.T) {
 
 				client.On("NewRequest",
 					context.Background(),
-					http.MethodGet,
-					"rest/api/2/attachment/content/1110",
+					http.MethodDelete,
+					"rest/api/2/attachment/1110",
 					"",
 					nil).
 					Return(&http.Request{}, model.ErrCreateHttpReq)
@@ -949,7 +896,7 @@ func Test_internalIssueAttachmentServiceImpl_Download(t *testing.T) {
 			attachmentService, err := NewIssueAttachmentService(testCase.fields.c, testCase.fields.version)
 			assert.NoError(t, err)
 
-			gotResponse, err := attachmentService.Download(testCase.args.ctx, testCase.args.attachmentID, testCase.args.redirect)
+			gotResponse, err := attachmentService.Delete(testCase.args.ctx, testCase.args.attachmentID)
 
 			if testCase.wantErr {
 
@@ -975,61 +922,1217 @@ func Test_internalIssueAttachmentServiceImpl_Download(t *testing.T) {
 	}
 }
 
-func TestNewIssueAttachmentService(t *testing.T) {
+func Test_internalIssueAttachmentServiceImpl_Add(t *testing.T) {
 
-	type args struct {
-		client  service.Connector
+	absolutePathMocked, err := filepath.Abs("../../LICENSE")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fileMocked, err := os.Open(absolutePathMocked)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type fields struct {
+		c       service.Connector
 		version string
 	}
 
+	type args struct {
+		ctx                    context.Context
+		issueKeyOrID, fileName string
+		file                   io.Reader
+	}
+
 	testCases := []struct {
 		name    string
+		fields  fields
 		args    args
+		on      func(*fields)
 		wantErr bool
 		Err     error
 	}{
 		{
-			name: "when the parameters are correct",
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
 			args: args{
-				client:  nil,
-				version: "3",
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				fileName:     "LICENSE",
+				file:         fileMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/issue/DUMMY-1/attachments",
+					mock.Anything,
+					mock.Anything).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					mock.Anything).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
 			},
-			wantErr: false,
 		},
 
 		{
-			name: "when the version is not provided",
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
 			args: args{
-				client:  nil,
-				version: "",
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				fileName:     "LICENSE",
+				file:         fileMocked,
 			},
-			wantErr: true,
-			Err:     model.ErrNoVersionProvided,
-		},
-	}
-	for _, testCase := range testCases {
-		t.Run(testCase.name, func(t *testing.T) {
-			got, err := NewIssueAttachmentService(testCase.args.client, testCase.args.version)
+			on: func(fields *fields) {
 
-			if testCase.wantErr {
+				client := mocks.NewConnector(t)
 
-				if err != nil {
-					t.Logf("error returned: %v", err.Error())
-				}
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/issue/DUMMY-1/attachments",
+					mock.Anything,
+					mock.Anything).
+					Return(&http.Request{}, nil)
 
-				// the first if statement is to handle wrapped errors from url and json packages for more accurate comparison
-				var urlErr *url.Error
-				var jsonErr *json.SyntaxError
-				if errors.As(err, &urlErr) || errors.As(err, &jsonErr) {
-					assert.Contains(t, err.Error(), testCase.Err.Error())
-				} else {
-					assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
-				}
-			} else {
+				client.On("Call",
+					&http.Request{},
+					mock.Anything).
+					Return(&model.ResponseScheme{}, nil)
 
-				assert.NoError(t, err)
-				assert.NotEqual(t, got, nil)
-			}
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the issue key or id is not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "",
+				fileName:     "LICENSE",
+				file:         fileMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueKeyOrID,
+		},
+
+		{
+			name:   "when the file name is not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				fileName:     "",
+				file:         fileMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoAttachmentName,
+		},
+
+		{
+			name:   "when the field reader is not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				fileName:     "LICENSE",
+				file:         nil,
+			},
+			wantErr: true,
+			Err:     model.ErrNoReader,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				fileName:     "LICENSE",
+				file:         fileMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/issue/DUMMY-1/attachments",
+					mock.Anything,
+					mock.Anything).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			attachmentService, err := NewIssueAttachmentService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := attachmentService.Add(testCase.args.ctx, testCase.args.issueKeyOrID,
+				testCase.args.fileName, testCase.args.file)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				// the first if statement is to handle wrapped errors from url and json packages for more accurate comparison
+				var urlErr *url.Error
+				var jsonErr *json.SyntaxError
+				if errors.As(err, &urlErr) || errors.As(err, &jsonErr) {
+					assert.Contains(t, err.Error(), testCase.Err.Error())
+				} else {
+					assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+				}
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalIssueAttachmentServiceImpl_Download(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx          context.Context
+		attachmentID string
+		redirect     bool
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				attachmentID: "1110",
+				redirect:     false,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/attachment/content/1110?redirect=false",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				attachmentID: "1110",
+				redirect:     true,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/attachment/content/1110",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+
+			},
+		},
+
+		{
+			name:   "when the attachment id is not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				attachmentID: "",
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNoAttachmentID,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				attachmentID: "1110",
+				redirect:     true,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/attachment/content/1110",
+					"",
+					nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			attachmentService, err := NewIssueAttachmentService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResponse, err := attachmentService.Download(testCase.args.ctx, testCase.args.attachmentID, testCase.args.redirect)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				// the first if statement is to handle wrapped errors from url and json packages for more accurate comparison
+				var urlErr *url.Error
+				var jsonErr *json.SyntaxError
+				if errors.As(err, &urlErr) || errors.As(err, &jsonErr) {
+					assert.Contains(t, err.Error(), testCase.Err.Error())
+				} else {
+					assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+				}
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+
+		})
+	}
+}
+
+func TestNewIssueAttachmentService(t *testing.T) {
+
+	type args struct {
+		client  service.Connector
+		version string
+	}
+
+	testCases := []struct {
+		name    string
+		args    args
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				client:  nil,
+				version: "3",
+			},
+			wantErr: false,
+		},
+
+		{
+			name: "when the version is not provided",
+			args: args{
+				client:  nil,
+				version: "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoVersionProvided,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := NewIssueAttachmentService(testCase.args.client, testCase.args.version)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				// the first if statement is to handle wrapped errors from url and json packages for more accurate comparison
+				var urlErr *url.Error
+				var jsonErr *json.SyntaxError
+				if errors.As(err, &urlErr) || errors.As(err, &jsonErr) {
+					assert.Contains(t, err.Error(), testCase.Err.Error())
+				} else {
+					assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+				}
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, got, nil)
+			}
+		})
+	}
+}
+
+func Test_internalIssueAttachmentServiceImpl_Archive(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx          context.Context
+		issueKeyOrID string
+		w            io.Writer
+	}
+
+	testCases := []struct {
+		name      string
+		fields    fields
+		args      args
+		on        func(*fields)
+		wantFiles []string
+		wantErr   bool
+		Err       error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				w:            &bytes.Buffer{},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/issue/KP-1?fields=attachment",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueAttachmentsScheme{}).
+					Run(func(args mock.Arguments) {
+						issue := args.Get(1).(*model.IssueAttachmentsScheme)
+						issue.Fields = &model.IssueAttachmentsFieldsScheme{
+							Attachment: []*model.IssueAttachmentScheme{
+								{ID: "10001", Filename: "report.txt"},
+								{ID: "10002", Filename: "report.txt"},
+								{ID: "10003", Filename: "logs.txt"},
+								{ID: "10004", Filename: "trace.txt"},
+							},
+						}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				for _, attachmentID := range []string{"10001", "10002", "10003", "10004"} {
+
+					request := &http.Request{Host: attachmentID}
+
+					client.On("NewRequest",
+						context.Background(),
+						http.MethodGet,
+						"rest/api/3/attachment/content/"+attachmentID,
+						"",
+						nil).
+						Return(request, nil)
+
+					if attachmentID == "10003" {
+						client.On("Call", request, &model.StreamedResponseScheme{}).
+							Return(&model.ResponseScheme{}, model.ErrNotFound)
+						continue
+					}
+
+					response := &model.ResponseScheme{Stream: io.NopCloser(strings.NewReader("content of " + attachmentID))}
+
+					// The download is interrupted after the response is returned
+					if attachmentID == "10004" {
+						response = &model.ResponseScheme{Stream: io.NopCloser(iotest.ErrReader(errors.New("connection reset")))}
+					}
+
+					client.On("Call", request, &model.StreamedResponseScheme{}).
+						Return(response, nil)
+				}
+
+				fields.c = client
+			},
+			wantFiles: []string{"report.txt", "10002_report.txt"},
+		},
+
+		{
+			name:   "when the issue key or id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+				w:   &bytes.Buffer{},
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueKeyOrID,
+		},
+
+		{
+			name:   "when the writer is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+			},
+			wantErr: true,
+			Err:     model.ErrNoWriter,
+		},
+
+		{
+			name:   "when the attachments cannot be listed",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				w:            &bytes.Buffer{},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/issue/KP-1?fields=attachment",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueAttachmentsScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrNotFound)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrNotFound,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				w:            &bytes.Buffer{},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/issue/KP-1?fields=attachment",
+					"",
+					nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			attachmentService, err := NewIssueAttachmentService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotArchive, err := attachmentService.Archive(testCase.args.ctx, testCase.args.issueKeyOrID, testCase.args.w)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, gotArchive.Files, 4)
+			assert.True(t, errors.Is(gotArchive.Err(), model.ErrNotFound))
+			assert.EqualError(t, gotArchive.Files[3].Err, "jira: attachment 10004: connection reset")
+
+			buffer := testCase.args.w.(*bytes.Buffer)
+			reader, err := zip.NewReader(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+			assert.NoError(t, err)
+
+			var gotFiles []string
+			for index, file := range reader.File {
+				gotFiles = append(gotFiles, file.Name)
+
+				entry, err := file.Open()
+				assert.NoError(t, err)
+
+				content, err := io.ReadAll(entry)
+				assert.NoError(t, err)
+				assert.Equal(t, "content of "+gotArchive.Files[index].Attachment.ID, string(content))
+			}
+
+			assert.Equal(t, testCase.wantFiles, gotFiles)
+		})
+	}
+}
+
+func Test_internalIssueAttachmentServiceImpl_Upload(t *testing.T) {
+
+	content := "attachment content"
+
+	addMocked := func(client *mocks.Connector, attachment *model.IssueAttachmentScheme, response *model.ResponseScheme, err error) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPost,
+			"rest/api/3/issue/KP-1/attachments",
+			mock.Anything,
+			mock.Anything).
+			Return(&http.Request{Host: "add"}, nil).
+			Once()
+
+		client.On("Call",
+			&http.Request{Host: "add"},
+			mock.Anything).
+			Run(func(args mock.Arguments) {
+				if attachment != nil {
+					*args.Get(1).(*[]*model.IssueAttachmentScheme) = []*model.IssueAttachmentScheme{attachment}
+				}
+			}).
+			Return(response, err).
+			Once()
+	}
+
+	downloadMocked := func(client *mocks.Connector, attachmentID, body string) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/attachment/content/"+attachmentID,
+			"",
+			nil).
+			Return(&http.Request{Host: "download-" + attachmentID}, nil).
+			Once()
+
+		response := &model.ResponseScheme{Stream: io.NopCloser(strings.NewReader(body))}
+
+		client.On("Call",
+			&http.Request{Host: "download-" + attachmentID},
+			&model.StreamedResponseScheme{}).
+			Return(response, nil).
+			Once()
+	}
+
+	deleteMocked := func(client *mocks.Connector, attachmentID string) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodDelete,
+			"rest/api/3/attachment/"+attachmentID,
+			"",
+			nil).
+			Return(&http.Request{Host: "delete-" + attachmentID}, nil).
+			Once()
+
+		client.On("Call",
+			&http.Request{Host: "delete-" + attachmentID},
+			nil).
+			Return(&model.ResponseScheme{}, nil).
+			Once()
+	}
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx          context.Context
+		issueKeyOrID string
+		fileName     string
+		file         io.ReadSeeker
+		options      *model.IssueAttachmentUploadOptionsScheme
+	}
+
+	testCases := []struct {
+		name         string
+		fields       fields
+		args         args
+		on           func(*fields)
+		wantAttempts int
+		wantVerified bool
+		wantErr      bool
+		Err          error
+	}{
+		{
+			name:   "when the attachment is verified",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				fileName:     "notes.txt",
+				file:         strings.NewReader(content),
+				options:      &model.IssueAttachmentUploadOptionsScheme{Verify: true},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				addMocked(client, &model.IssueAttachmentScheme{ID: "10000", Size: len(content)}, &model.ResponseScheme{}, nil)
+				downloadMocked(client, "10000", content)
+
+				fields.c = client
+			},
+			wantAttempts: 1,
+			wantVerified: true,
+		},
+
+		{
+			name:   "when the mismatching attachment is uploaded again",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				fileName:     "notes.txt",
+				file:         strings.NewReader(content),
+				options:      &model.IssueAttachmentUploadOptionsScheme{Verify: true, Attempts: 2},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				addMocked(client, &model.IssueAttachmentScheme{ID: "10000", Size: len(content)}, &model.ResponseScheme{}, nil)
+				downloadMocked(client, "10000", "attachment c0ntent")
+				deleteMocked(client, "10000")
+				addMocked(client, &model.IssueAttachmentScheme{ID: "10001", Size: len(content)}, &model.ResponseScheme{}, nil)
+				downloadMocked(client, "10001", content)
+
+				fields.c = client
+			},
+			wantAttempts: 2,
+			wantVerified: true,
+		},
+
+		{
+			name:   "when the upload is rate limited",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				fileName:     "notes.txt",
+				file:         strings.NewReader(content),
+				options:      &model.IssueAttachmentUploadOptionsScheme{Attempts: 2},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				addMocked(client, nil, &model.ResponseScheme{Code: http.StatusTooManyRequests},
+					&model.Error{StatusCode: http.StatusTooManyRequests, Retryable: true, Err: model.ErrInvalidStatusCode})
+				addMocked(client, &model.IssueAttachmentScheme{ID: "10000", Size: len(content)}, &model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantAttempts: 2,
+		},
+
+		{
+			name:   "when the upload fails on the server side",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				fileName:     "notes.txt",
+				file:         strings.NewReader(content),
+				options:      &model.IssueAttachmentUploadOptionsScheme{Attempts: 2},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				addMocked(client, nil, &model.ResponseScheme{Code: http.StatusBadGateway},
+					&model.Error{StatusCode: http.StatusBadGateway, Retryable: true, Err: model.ErrInvalidStatusCode})
+
+				fields.c = client
+			},
+			wantAttempts: 1,
+			wantErr:      true,
+			Err:          model.ErrInvalidStatusCode,
+		},
+
+		{
+			name:   "when the attachment size doesn't match the file",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				fileName:     "notes.txt",
+				file:         strings.NewReader(content),
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				addMocked(client, &model.IssueAttachmentScheme{ID: "10000", Size: 3}, &model.ResponseScheme{}, nil)
+				deleteMocked(client, "10000")
+
+				fields.c = client
+			},
+			wantAttempts: 1,
+			wantErr:      true,
+			Err:          model.ErrAttachmentChecksumMismatch,
+		},
+
+		{
+			name:   "when the upload is rejected",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				fileName:     "notes.txt",
+				file:         strings.NewReader(content),
+				options:      &model.IssueAttachmentUploadOptionsScheme{Attempts: 3},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				addMocked(client, nil, &model.ResponseScheme{Code: http.StatusForbidden}, model.ErrUnauthorized)
+
+				fields.c = client
+			},
+			wantAttempts: 1,
+			wantErr:      true,
+			Err:          model.ErrUnauthorized,
+		},
+
+		{
+			name:   "when the file is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				fileName:     "notes.txt",
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNoReader,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			attachmentService, err := NewIssueAttachmentService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotUpload, err := attachmentService.Upload(testCase.args.ctx, testCase.args.issueKeyOrID, testCase.args.fileName, testCase.args.file,
+				testCase.args.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+
+				if gotUpload != nil {
+					assert.Equal(t, testCase.wantAttempts, gotUpload.Attempts)
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.wantAttempts, gotUpload.Attempts)
+			assert.Equal(t, testCase.wantVerified, gotUpload.Verified)
+			assert.Equal(t, int64(len(content)), gotUpload.Size)
+			assert.Equal(t, "275448a1a959fc53524b38f1366f57a3ed7afaa59c9e099c72454e5fd7f8a6fa", gotUpload.Checksum)
+			assert.NotNil(t, gotUpload.Attachment)
+		})
+	}
+}
+
+func Test_internalIssueAttachmentServiceImpl_AddFromReader(t *testing.T) {
+
+	content := "Hello World"
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx                    context.Context
+		issueKeyOrID, fileName string
+		size                   int64
+		file                   io.Reader
+	}
+
+	testCases := []struct {
+		name              string
+		fields            fields
+		args              args
+		on                func(*fields)
+		wantContentLength bool
+		wantErr           bool
+		Err               error
+	}{
+		{
+			name:   "when the size is provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				fileName:     "hello.txt",
+				size:         int64(len(content)),
+				file:         strings.NewReader(content),
+			},
+			wantContentLength: true,
+		},
+
+		{
+			name:   "when the size is unknown",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				fileName:     "hello.txt",
+				size:         -1,
+				file:         strings.NewReader(content),
+			},
+		},
+
+		{
+			name:   "when the issue key is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:      context.Background(),
+				fileName: "hello.txt",
+				file:     strings.NewReader(content),
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueKeyOrID,
+		},
+
+		{
+			name:   "when the file name is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				file:         strings.NewReader(content),
+			},
+			wantErr: true,
+			Err:     model.ErrNoAttachmentName,
+		},
+
+		{
+			name:   "when the file is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				fileName:     "hello.txt",
+			},
+			wantErr: true,
+			Err:     model.ErrNoReader,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				fileName:     "hello.txt",
+				size:         int64(len(content)),
+				file:         strings.NewReader(content),
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/issue/DUMMY-1/attachments",
+					mock.Anything,
+					mock.Anything).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			request, body := &http.Request{}, new(bytes.Buffer)
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			} else if !testCase.wantErr {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/"+testCase.fields.version+"/issue/DUMMY-1/attachments",
+					mock.MatchedBy(func(contentType string) bool { return strings.HasPrefix(contentType, "multipart/form-data") }),
+					mock.Anything).
+					Run(func(args mock.Arguments) {
+						_, err := io.Copy(body, args.Get(4).(io.Reader))
+						assert.NoError(t, err)
+					}).
+					Return(request, nil)
+
+				client.On("Call",
+					request,
+					mock.Anything).
+					Return(&model.ResponseScheme{}, nil)
+
+				testCase.fields.c = client
+			}
+
+			attachmentService, err := NewIssueAttachmentService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			_, gotResponse, err := attachmentService.AddFromReader(testCase.args.ctx, testCase.args.issueKeyOrID, testCase.args.fileName,
+				testCase.args.size, testCase.args.file)
+
+			if testCase.wantErr {
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotNil(t, gotResponse)
+
+			assert.Contains(t, body.String(), `filename="hello.txt"`)
+			assert.Contains(t, body.String(), content)
+
+			if testCase.wantContentLength {
+				assert.Equal(t, int64(body.Len()), request.ContentLength)
+			} else {
+				assert.Equal(t, int64(0), request.ContentLength)
+			}
+		})
+	}
+}
+
+func Test_internalIssueAttachmentServiceImpl_DownloadTo(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx          context.Context
+		attachmentID string
+		w            io.Writer
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    string
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the attachment is streamed to the writer",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				attachmentID: "10001",
+				w:            new(bytes.Buffer),
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/attachment/content/10001",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				response := &model.ResponseScheme{Stream: io.NopCloser(strings.NewReader("Hello World"))}
+
+				client.On("Call",
+					&http.Request{},
+					&model.StreamedResponseScheme{}).
+					Return(response, nil)
+
+				fields.c = client
+			},
+			want: "Hello World",
+		},
+
+		{
+			name:   "when the writer is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				attachmentID: "10001",
+			},
+			wantErr: true,
+			Err:     model.ErrNoWriter,
+		},
+
+		{
+			name:   "when the attachment id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+				w:   new(bytes.Buffer),
+			},
+			wantErr: true,
+			Err:     model.ErrNoAttachmentID,
+		},
+
+		{
+			name:   "when the http call cannot be executed",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				attachmentID: "10001",
+				w:            new(bytes.Buffer),
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/attachment/content/10001",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.StreamedResponseScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrBadRequest)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrBadRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			attachmentService, err := NewIssueAttachmentService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			written, gotResponse, err := attachmentService.DownloadTo(testCase.args.ctx, testCase.args.attachmentID, testCase.args.w)
+
+			if testCase.wantErr {
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotNil(t, gotResponse)
+			assert.Equal(t, int64(len(testCase.want)), written)
+			assert.Equal(t, testCase.want, testCase.args.w.(*bytes.Buffer).String())
 		})
 	}
 }
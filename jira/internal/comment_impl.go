@@ -2,23 +2,33 @@ package internal
 
 import (
 	"fmt"
+	"time"
+
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
 )
 
 // NewCommentService creates a new instance of CommentADFService and CommentRichTextService.
-// It takes a service.Connector and a version string as input.
+// It takes a service.Connector, a version string and the user service resolving the mentioned emails as input,
+// the mentions sharing the email cache of the user service. A nil user service gives the comments a cache of their own.
 // Returns pointers to CommentADFService and CommentRichTextService, and an error if the version is not provided.
-func NewCommentService(client service.Connector, version string) (*CommentADFService, *CommentRichTextService, error) {
+func NewCommentService(client service.Connector, version string, users *UserService) (*CommentADFService, *CommentRichTextService, error) {
 
 	if version == "" {
 		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoVersionProvided)
 	}
 
+	var resolver jira.UserConnector = &internalUserImpl{c: client, version: version}
+	if users != nil {
+		resolver = users.internalClient
+	}
+
 	adfService := &CommentADFService{
 		internalClient: &internalAdfCommentImpl{
 			c:       client,
 			version: version,
+			users:   resolver,
 		},
 	}
 
@@ -31,3 +41,62 @@ func NewCommentService(client service.Connector, version string) (*CommentADFSer
 
 	return adfService, richTextService, nil
 }
+
+// commentSyncDateLayout is the layout of the created and updated dates of the comments.
+const commentSyncDateLayout = "2006-01-02T15:04:05.000-0700"
+
+// syncCheckpointOverlap is subtracted from the server date of the first page to take the checkpoint of an issue or
+// comment sync, so the items changed while the sync runs are returned again by the next sync.
+const syncCheckpointOverlap = 5 * time.Second
+
+// commentSyncPlan returns the options and the page size of a comment sync.
+func commentSyncPlan(options *model.IssueCommentSyncOptionsScheme) (*model.IssueCommentSyncOptionsScheme, int) {
+
+	if options == nil {
+		options = new(model.IssueCommentSyncOptionsScheme)
+	}
+
+	pageSize := options.MaxResults
+	if pageSize <= 0 {
+		pageSize = model.IssueCommentSyncPageSize
+	}
+
+	return options, pageSize
+}
+
+// syncCheckpoint returns the checkpoint of an issue or comment sync, taken on the clock of the server with the Date
+// header of the first page, or the local clock when the header is missing.
+func syncCheckpoint(response *model.ResponseScheme) time.Time {
+
+	date, ok := response.Date()
+	if !ok {
+		date = time.Now()
+	}
+
+	return date.Add(-syncCheckpointOverlap)
+}
+
+// commentChangedSince reports whether the comment was changed at or after the checkpoint, and whether it was created
+// at or after it. The comments whose dates can't be parsed are reported as updated, so they're synced again.
+func commentChangedSince(created, updated string, since time.Time) (changed, isNew bool) {
+
+	if since.IsZero() {
+		return true, true
+	}
+
+	createdAt, err := time.Parse(commentSyncDateLayout, created)
+	if err != nil {
+		return true, false
+	}
+
+	if !createdAt.Before(since) {
+		return true, true
+	}
+
+	updatedAt, err := time.Parse(commentSyncDateLayout, updated)
+	if err != nil {
+		return true, false
+	}
+
+	return !updatedAt.Before(since), false
+}
@@ -64,9 +64,36 @@ func (c *CommentADFService) Update(ctx context.Context, issueKeyOrID, commentID
 	return c.internalClient.Update(ctx, issueKeyOrID, commentID, payload, expand)
 }
 
+// Mention adds a comment to an issue, mentioning the users after the text.
+//
+// The users are identified by account ID or by email address, the email addresses are resolved with the user search
+// endpoint by User.ResolveEmails, sharing its cache.
+//
+// POST /rest/api/{2-3}/issue/{issueKeyOrID}/comment
+func (c *CommentADFService) Mention(ctx context.Context, issueKeyOrID, text string, users, expand []string) (*model.IssueCommentScheme, *model.ResponseScheme, error) {
+	return c.internalClient.Mention(ctx, issueKeyOrID, text, users, expand)
+}
+
+// Sync returns the comments of an issue created or updated since the checkpoint of the previous sync,
+// e.g. to mirror the comments to an external system.
+//
+// The comments can't be filtered by update date, so every page of the comments is fetched in the order of creation,
+// and the comments are filtered by their updated date. The deleted comments aren't reported.
+//
+// The returned checkpoint is taken on the clock of the server, with the Date header of the first page, minus a few
+// seconds of overlap, so the comments changed while the sync runs are returned again by the next sync.
+//
+// GET /rest/api/{2-3}/issue/{issueKeyOrID}/comment
+func (c *CommentADFService) Sync(ctx context.Context, issueKeyOrID string, options *model.IssueCommentSyncOptionsScheme) (*model.IssueCommentSyncScheme, error) {
+	return c.internalClient.Sync(ctx, issueKeyOrID, options)
+}
+
 type internalAdfCommentImpl struct {
 	c       service.Connector
 	version string
+
+	// users resolves the email addresses of the mentioned users, the user service of the client caching the account IDs.
+	users jira.UserConnector
 }
 
 func (i *internalAdfCommentImpl) Delete(ctx context.Context, issueKeyOrID, commentID string) (*model.ResponseScheme, error) {
@@ -182,6 +209,11 @@ func (i *internalAdfCommentImpl) Add(ctx context.Context, issueKeyOrID string, p
 }
 
 func (i *internalAdfCommentImpl) Update(ctx context.Context, issueKeyOrID, commentID string, payload *model.CommentPayloadScheme, expand []string) (*model.IssueCommentScheme, *model.ResponseScheme, error) {
+	return i.update(ctx, issueKeyOrID, commentID, payload, expand, true)
+}
+
+// update updates the comment, notifying the watchers of the issue only when notifyUsers is set.
+func (i *internalAdfCommentImpl) update(ctx context.Context, issueKeyOrID, commentID string, payload *model.CommentPayloadScheme, expand []string, notifyUsers bool) (*model.IssueCommentScheme, *model.ResponseScheme, error) {
 
 	if issueKeyOrID == "" {
 		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoIssueKeyOrID)
@@ -196,6 +228,10 @@ func (i *internalAdfCommentImpl) Update(ctx context.Context, issueKeyOrID, comme
 		params.Add("expand", strings.Join(expand, ","))
 	}
 
+	if !notifyUsers {
+		params.Add("notifyUsers", "false")
+	}
+
 	var endpoint strings.Builder
 	fmt.Fprintf(&endpoint, "rest/api/%v/issue/%v/comment/%v", i.version, issueKeyOrID, commentID)
 
@@ -216,3 +252,102 @@ func (i *internalAdfCommentImpl) Update(ctx context.Context, issueKeyOrID, comme
 
 	return comment, response, nil
 }
+
+func (i *internalAdfCommentImpl) Mention(ctx context.Context, issueKeyOrID, text string, users, expand []string) (*model.IssueCommentScheme, *model.ResponseScheme, error) {
+
+	if issueKeyOrID == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoIssueKeyOrID)
+	}
+
+	var emails []string
+	for _, user := range users {
+		if strings.Contains(user, "@") {
+			emails = append(emails, user)
+		}
+	}
+
+	var resolution *model.UserEmailResolutionScheme
+	if len(emails) != 0 {
+
+		var resolver jira.UserConnector = &internalUserImpl{c: i.c, version: i.version}
+		if i.users != nil {
+			resolver = i.users
+		}
+
+		var err error
+		resolution, err = resolver.ResolveEmails(ctx, emails)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if len(resolution.Unresolved) != 0 {
+			return nil, nil, fmt.Errorf("jira: %w: %v", model.ErrUnresolvedEmails, strings.Join(resolution.Unresolved, ", "))
+		}
+	}
+
+	paragraph := model.NewCommentParagraph()
+	if text != "" {
+		paragraph.AppendNode(model.NewCommentText(text + " "))
+	}
+
+	for index, user := range users {
+
+		accountID := user
+		if resolution != nil {
+			if resolved, ok := resolution.AccountIDs[user]; ok {
+				accountID = resolved
+			}
+		}
+
+		if index != 0 {
+			paragraph.AppendNode(model.NewCommentText(" "))
+		}
+
+		paragraph.AppendNode(model.NewCommentMention(accountID, ""))
+	}
+
+	return i.Add(ctx, issueKeyOrID, &model.CommentPayloadScheme{Body: model.NewCommentDocument(paragraph)}, expand)
+}
+
+func (i *internalAdfCommentImpl) Sync(ctx context.Context, issueKeyOrID string, options *model.IssueCommentSyncOptionsScheme) (*model.IssueCommentSyncScheme, error) {
+
+	if issueKeyOrID == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoIssueKeyOrID)
+	}
+
+	options, pageSize := commentSyncPlan(options)
+	result := new(model.IssueCommentSyncScheme)
+
+	for startAt := 0; ; {
+
+		page, response, err := i.Gets(ctx, issueKeyOrID, "created", options.Expand, startAt, pageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		if startAt == 0 {
+			result.Checkpoint = syncCheckpoint(response)
+		}
+
+		for _, comment := range page.Comments {
+
+			if comment == nil {
+				continue
+			}
+
+			switch changed, isNew := commentChangedSince(comment.Created, comment.Updated, options.Since); {
+			case isNew:
+				result.Created = append(result.Created, comment)
+			case changed:
+				result.Updated = append(result.Updated, comment)
+			}
+		}
+
+		startAt += len(page.Comments)
+		if len(page.Comments) == 0 || startAt >= page.Total {
+			break
+		}
+	}
+
+	return result, nil
+}
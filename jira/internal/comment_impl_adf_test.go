@@ -7,8 +7,10 @@ import (
 	"net/http"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
@@ -127,7 +129,7 @@ func Test_internalAdfCommentImpl_Gets(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			commentService, _, err := NewCommentService(testCase.fields.c, testCase.fields.version)
+			commentService, _, err := NewCommentService(testCase.fields.c, testCase.fields.version, nil)
 			assert.NoError(t, err)
 
 			gotResult, gotResponse, err := commentService.Gets(testCase.args.ctx, testCase.args.issueKeyOrID,
@@ -267,7 +269,7 @@ func Test_internalAdfCommentImpl_Get(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			commentService, _, err := NewCommentService(testCase.fields.c, testCase.fields.version)
+			commentService, _, err := NewCommentService(testCase.fields.c, testCase.fields.version, nil)
 			assert.NoError(t, err)
 
 			gotResult, gotResponse, err := commentService.Get(testCase.args.ctx, testCase.args.issueKeyOrID, testCase.args.commentID)
@@ -406,7 +408,7 @@ func Test_internalAdfCommentImpl_Delete(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			commentService, _, err := NewCommentService(testCase.fields.c, testCase.fields.version)
+			commentService, _, err := NewCommentService(testCase.fields.c, testCase.fields.version, nil)
 			assert.NoError(t, err)
 
 			gotResponse, err := commentService.Delete(testCase.args.ctx, testCase.args.issueKeyOrID, testCase.args.commentID)
@@ -578,7 +580,7 @@ func Test_internalAdfCommentImpl_Add(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			commentService, _, err := NewCommentService(testCase.fields.c, testCase.fields.version)
+			commentService, _, err := NewCommentService(testCase.fields.c, testCase.fields.version, nil)
 			assert.NoError(t, err)
 
 			gotResult, gotResponse, err := commentService.Add(testCase.args.ctx, testCase.args.issueKeyOrID, testCase.args.payload,
@@ -753,7 +755,7 @@ func Test_internalAdfCommentImpl_Update(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			commentService, _, err := NewCommentService(testCase.fields.c, testCase.fields.version)
+			commentService, _, err := NewCommentService(testCase.fields.c, testCase.fields.version, nil)
 			assert.NoError(t, err)
 
 			gotResult, gotResponse, err := commentService.Update(testCase.args.ctx, testCase.args.issueKeyOrID,
@@ -783,3 +785,334 @@ func Test_internalAdfCommentImpl_Update(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalAdfCommentImpl_Mention(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx          context.Context
+		issueKeyOrID string
+		text         string
+		users        []string
+		expand       []string
+	}
+
+	onSearch := func(client *mocks.Connector, users []*model.UserScheme) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/user/search?maxResults=2&query=jane%40example.com&startAt=0",
+			"",
+			nil).
+			Return(&http.Request{Method: http.MethodGet}, nil)
+
+		client.On("Call",
+			&http.Request{Method: http.MethodGet},
+			mock.AnythingOfType("*[]*models.UserScheme")).
+			Run(func(args mock.Arguments) {
+				*args.Get(1).(*[]*model.UserScheme) = users
+			}).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	payloadMocked := &model.CommentPayloadScheme{
+		Body: model.NewCommentDocument(
+			model.NewCommentParagraph(
+				model.NewCommentText("Please review "),
+				model.NewCommentMention("account-id-a", ""),
+				model.NewCommentText(" "),
+				model.NewCommentMention("account-id-jane", ""),
+			),
+		),
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the users are mentioned by account id and email",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-5",
+				text:         "Please review",
+				users:        []string{"account-id-a", "jane@example.com"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				onSearch(client, []*model.UserScheme{{AccountID: "account-id-jane", EmailAddress: "jane@example.com"}})
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/issue/DUMMY-5/comment",
+					"",
+					payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueCommentScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the email doesn't match a user",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-5",
+				text:         "Please review",
+				users:        []string{"jane@example.com"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				onSearch(client, nil)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrUnresolvedEmails,
+		},
+
+		{
+			name:   "when the issue key or id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueKeyOrID,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-5",
+				users:        []string{"account-id-a"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/issue/DUMMY-5/comment",
+					"",
+					&model.CommentPayloadScheme{
+						Body: model.NewCommentDocument(model.NewCommentParagraph(model.NewCommentMention("account-id-a", ""))),
+					}).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			commentService, _, err := NewCommentService(testCase.fields.c, testCase.fields.version, nil)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := commentService.Mention(testCase.args.ctx, testCase.args.issueKeyOrID, testCase.args.text,
+				testCase.args.users, testCase.args.expand)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+		})
+	}
+
+	t.Run("when the emails are cached by the user service", func(t *testing.T) {
+
+		client := mocks.NewConnector(t)
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/user/search?maxResults=2&query=jane%40example.com&startAt=0",
+			"",
+			nil).
+			Return(&http.Request{Method: http.MethodGet}, nil).
+			Once()
+
+		client.On("Call",
+			&http.Request{Method: http.MethodGet},
+			mock.AnythingOfType("*[]*models.UserScheme")).
+			Run(func(args mock.Arguments) {
+				*args.Get(1).(*[]*model.UserScheme) = []*model.UserScheme{{AccountID: "account-id-jane", EmailAddress: "jane@example.com"}}
+			}).
+			Return(&model.ResponseScheme{}, nil).
+			Once()
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPost,
+			"rest/api/3/issue/DUMMY-5/comment",
+			"",
+			payloadMocked).
+			Return(&http.Request{}, nil)
+
+		client.On("Call",
+			&http.Request{},
+			&model.IssueCommentScheme{}).
+			Return(&model.ResponseScheme{}, nil)
+
+		userService, err := NewUserService(client, "3", nil)
+		assert.NoError(t, err)
+
+		_, err = userService.ResolveEmails(context.Background(), []string{"jane@example.com"})
+		assert.NoError(t, err)
+
+		commentService, _, err := NewCommentService(client, "3", userService)
+		assert.NoError(t, err)
+
+		_, _, err = commentService.Mention(context.Background(), "DUMMY-5", "Please review", []string{"account-id-a", "jane@example.com"}, nil)
+		assert.NoError(t, err)
+	})
+}
+
+func Test_internalAdfCommentImpl_Sync(t *testing.T) {
+
+	pageMocked := func(client *mocks.Connector, startAt string, total int, comments ...*model.IssueCommentScheme) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/issue/DUMMY-5/comment?expand=renderedBody&maxResults=2&orderBy=created&startAt="+startAt,
+			"",
+			nil).
+			Return(&http.Request{Host: startAt}, nil)
+
+		client.On("Call",
+			&http.Request{Host: startAt},
+			&model.IssueCommentPageScheme{}).
+			Run(func(args mock.Arguments) {
+				page := args.Get(1).(*model.IssueCommentPageScheme)
+				page.Total = total
+				page.Comments = comments
+			}).
+			Return(&model.ResponseScheme{Response: &http.Response{Header: http.Header{"Date": {"Fri, 01 Mar 2024 14:00:00 GMT"}}}}, nil)
+	}
+
+	since := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name        string
+		options     *model.IssueCommentSyncOptionsScheme
+		on          func() service.Connector
+		wantCreated []string
+		wantUpdated []string
+		wantErr     bool
+		Err         error
+	}{
+		{
+			name:    "when the comments changed since the checkpoint are returned",
+			options: &model.IssueCommentSyncOptionsScheme{Since: since, Expand: []string{"renderedBody"}, MaxResults: 2},
+			on: func() service.Connector {
+
+				client := mocks.NewConnector(t)
+
+				pageMocked(client, "0", 4,
+					&model.IssueCommentScheme{ID: "10000", Created: "2024-02-01T10:00:00.000+0000", Updated: "2024-02-01T10:00:00.000+0000"},
+					&model.IssueCommentScheme{ID: "10001", Created: "2024-02-02T10:00:00.000+0000", Updated: "2024-03-01T13:00:00.000+0100"},
+				)
+
+				pageMocked(client, "2", 4,
+					&model.IssueCommentScheme{ID: "10002", Created: "2024-02-03T10:00:00.000+0000", Updated: "invalid"},
+					&model.IssueCommentScheme{ID: "10003", Created: "2024-03-02T08:30:00.000-0500", Updated: "2024-03-02T08:30:00.000-0500"},
+				)
+
+				return client
+			},
+			wantCreated: []string{"10003"},
+			wantUpdated: []string{"10001", "10002"},
+		},
+
+		{
+			name:    "when the comments cannot be fetched",
+			options: &model.IssueCommentSyncOptionsScheme{Since: since, Expand: []string{"renderedBody"}, MaxResults: 2},
+			on: func() service.Connector {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/issue/DUMMY-5/comment?expand=renderedBody&maxResults=2&orderBy=created&startAt=0",
+					"",
+					nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				return client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			commentService, _, err := NewCommentService(testCase.on(), "3", nil)
+			assert.NoError(t, err)
+
+			gotResult, err := commentService.Sync(context.Background(), "DUMMY-5", testCase.options)
+
+			if testCase.wantErr {
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, time.Date(2024, time.March, 1, 13, 59, 55, 0, time.UTC), gotResult.Checkpoint)
+
+			var gotCreated, gotUpdated []string
+			for _, comment := range gotResult.Created {
+				gotCreated = append(gotCreated, comment.ID)
+			}
+
+			for _, comment := range gotResult.Updated {
+				gotUpdated = append(gotUpdated, comment.ID)
+			}
+
+			assert.Equal(t, testCase.wantCreated, gotCreated)
+			assert.Equal(t, testCase.wantUpdated, gotUpdated)
+		})
+	}
+}
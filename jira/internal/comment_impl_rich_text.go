@@ -64,6 +64,20 @@ func (c *CommentRichTextService) Update(ctx context.Context, issueKeyOrID, comme
 	return c.internalClient.Update(ctx, issueKeyOrID, commentID, payload, expand)
 }
 
+// Sync returns the comments of an issue created or updated since the checkpoint of the previous sync,
+// e.g. to mirror the comments to an external system.
+//
+// The comments can't be filtered by update date, so every page of the comments is fetched in the order of creation,
+// and the comments are filtered by their updated date. The deleted comments aren't reported.
+//
+// The returned checkpoint is taken on the clock of the server, with the Date header of the first page, minus a few
+// seconds of overlap, so the comments changed while the sync runs are returned again by the next sync.
+//
+// GET /rest/api/{2-3}/issue/{issueKeyOrID}/comment
+func (c *CommentRichTextService) Sync(ctx context.Context, issueKeyOrID string, options *model.IssueCommentSyncOptionsScheme) (*model.IssueCommentSyncSchemeV2, error) {
+	return c.internalClient.Sync(ctx, issueKeyOrID, options)
+}
+
 type internalRichTextCommentImpl struct {
 	c       service.Connector
 	version string
@@ -182,6 +196,11 @@ func (i *internalRichTextCommentImpl) Add(ctx context.Context, issueKeyOrID stri
 }
 
 func (i *internalRichTextCommentImpl) Update(ctx context.Context, issueKeyOrID, commentID string, payload *model.CommentPayloadSchemeV2, expand []string) (*model.IssueCommentSchemeV2, *model.ResponseScheme, error) {
+	return i.update(ctx, issueKeyOrID, commentID, payload, expand, true)
+}
+
+// update updates the comment, notifying the watchers of the issue only when notifyUsers is set.
+func (i *internalRichTextCommentImpl) update(ctx context.Context, issueKeyOrID, commentID string, payload *model.CommentPayloadSchemeV2, expand []string, notifyUsers bool) (*model.IssueCommentSchemeV2, *model.ResponseScheme, error) {
 
 	if issueKeyOrID == "" {
 		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoIssueKeyOrID)
@@ -196,6 +215,10 @@ func (i *internalRichTextCommentImpl) Update(ctx context.Context, issueKeyOrID,
 		params.Add("expand", strings.Join(expand, ","))
 	}
 
+	if !notifyUsers {
+		params.Add("notifyUsers", "false")
+	}
+
 	var endpoint strings.Builder
 	fmt.Fprintf(&endpoint, "rest/api/%v/issue/%v/comment/%v", i.version, issueKeyOrID, commentID)
 
@@ -216,3 +239,46 @@ func (i *internalRichTextCommentImpl) Update(ctx context.Context, issueKeyOrID,
 
 	return comment, response, nil
 }
+
+func (i *internalRichTextCommentImpl) Sync(ctx context.Context, issueKeyOrID string, options *model.IssueCommentSyncOptionsScheme) (*model.IssueCommentSyncSchemeV2, error) {
+
+	if issueKeyOrID == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoIssueKeyOrID)
+	}
+
+	options, pageSize := commentSyncPlan(options)
+	result := new(model.IssueCommentSyncSchemeV2)
+
+	for startAt := 0; ; {
+
+		page, response, err := i.Gets(ctx, issueKeyOrID, "created", options.Expand, startAt, pageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		if startAt == 0 {
+			result.Checkpoint = syncCheckpoint(response)
+		}
+
+		for _, comment := range page.Comments {
+
+			if comment == nil {
+				continue
+			}
+
+			switch changed, isNew := commentChangedSince(comment.Created, comment.Updated, options.Since); {
+			case isNew:
+				result.Created = append(result.Created, comment)
+			case changed:
+				result.Updated = append(result.Updated, comment)
+			}
+		}
+
+		startAt += len(page.Comments)
+		if len(page.Comments) == 0 || startAt >= page.Total {
+			break
+		}
+	}
+
+	return result, nil
+}
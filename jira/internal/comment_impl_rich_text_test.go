@@ -7,8 +7,10 @@ import (
 	"net/http"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
@@ -127,7 +129,7 @@ func Test_internalRichTextCommentImpl_Gets(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			_, commentService, err := NewCommentService(testCase.fields.c, testCase.fields.version)
+			_, commentService, err := NewCommentService(testCase.fields.c, testCase.fields.version, nil)
 			assert.NoError(t, err)
 
 			gotResult, gotResponse, err := commentService.Gets(testCase.args.ctx, testCase.args.issueKeyOrID,
@@ -267,7 +269,7 @@ func Test_internalRichTextCommentImpl_Get(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			_, commentService, err := NewCommentService(testCase.fields.c, testCase.fields.version)
+			_, commentService, err := NewCommentService(testCase.fields.c, testCase.fields.version, nil)
 			assert.NoError(t, err)
 
 			gotResult, gotResponse, err := commentService.Get(testCase.args.ctx, testCase.args.issueKeyOrID, testCase.args.commentID)
@@ -406,7 +408,7 @@ func Test_internalRichTextCommentImpl_Delete(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			_, commentService, err := NewCommentService(testCase.fields.c, testCase.fields.version)
+			_, commentService, err := NewCommentService(testCase.fields.c, testCase.fields.version, nil)
 			assert.NoError(t, err)
 
 			gotResponse, err := commentService.Delete(testCase.args.ctx, testCase.args.issueKeyOrID, testCase.args.commentID)
@@ -547,7 +549,7 @@ func Test_internalRichTextCommentImpl_Add(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			_, commentService, err := NewCommentService(testCase.fields.c, testCase.fields.version)
+			_, commentService, err := NewCommentService(testCase.fields.c, testCase.fields.version, nil)
 			assert.NoError(t, err)
 
 			gotResult, gotResponse, err := commentService.Add(testCase.args.ctx, testCase.args.issueKeyOrID, testCase.args.payload,
@@ -708,7 +710,7 @@ func Test_internalRichTextCommentImpl_Update(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			_, commentService, err := NewCommentService(testCase.fields.c, testCase.fields.version)
+			_, commentService, err := NewCommentService(testCase.fields.c, testCase.fields.version, nil)
 			assert.NoError(t, err)
 
 			gotResult, gotResponse, err := commentService.Update(testCase.args.ctx, testCase.args.issueKeyOrID,
@@ -738,3 +740,79 @@ func Test_internalRichTextCommentImpl_Update(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalRichTextCommentImpl_Sync(t *testing.T) {
+
+	testCases := []struct {
+		name         string
+		issueKeyOrID string
+		on           func() service.Connector
+		wantCreated  int
+		wantErr      bool
+		Err          error
+	}{
+		{
+			name:         "when every comment is returned without a checkpoint",
+			issueKeyOrID: "DUMMY-5",
+			on: func() service.Connector {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/issue/DUMMY-5/comment?maxResults=100&orderBy=created&startAt=0",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueCommentPageSchemeV2{}).
+					Run(func(args mock.Arguments) {
+						page := args.Get(1).(*model.IssueCommentPageSchemeV2)
+						page.Total = 2
+						page.Comments = []*model.IssueCommentSchemeV2{{ID: "10000"}, {ID: "10001"}}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				return client
+			},
+			wantCreated: 2,
+		},
+
+		{
+			name:    "when the issue key or id is not provided",
+			wantErr: true,
+			Err:     model.ErrNoIssueKeyOrID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			var client service.Connector
+			if testCase.on != nil {
+				client = testCase.on()
+			}
+
+			_, commentService, err := NewCommentService(client, "2", nil)
+			assert.NoError(t, err)
+
+			before := time.Now()
+			gotResult, err := commentService.Sync(context.Background(), testCase.issueKeyOrID, nil)
+
+			if testCase.wantErr {
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, gotResult.Created, testCase.wantCreated)
+			assert.Empty(t, gotResult.Updated)
+
+			// Without a Date header, the checkpoint is taken on the local clock
+			assert.False(t, gotResult.Checkpoint.Before(before.Add(-syncCheckpointOverlap)))
+		})
+	}
+}
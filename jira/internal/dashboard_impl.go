@@ -64,6 +64,15 @@ func (d *DashboardService) Search(ctx context.Context, options *model.DashboardS
 	return d.internalClient.Search(ctx, options, startAt, maxResults)
 }
 
+// SearchAll returns the dashboards matching the options, following the pages of the search.
+//
+// GET /rest/api/{2-3}/dashboard/search
+//
+// https://docs.go-atlassian.io/jira-software-cloud/dashboards#search-for-dashboards
+func (d *DashboardService) SearchAll(ctx context.Context, options *model.DashboardSearchOptionsScheme) ([]*model.DashboardScheme, error) {
+	return d.internalClient.SearchAll(ctx, options)
+}
+
 // Get returns a dashboard.
 //
 // GET /rest/api/{2-3}/dashboard/{id}
@@ -102,6 +111,16 @@ func (d *DashboardService) Update(ctx context.Context, dashboardID string, paylo
 	return d.internalClient.Update(ctx, dashboardID, payload)
 }
 
+// ChangeOwner changes the owner of the dashboards, up to 100 at a time.
+//
+// The dashboards whose name is already used by the new owner are renamed, the errors of the dashboards
+// that couldn't be changed are returned in the entity errors of the result.
+//
+// PUT /rest/api/{2-3}/dashboard/bulk/edit
+func (d *DashboardService) ChangeOwner(ctx context.Context, dashboardIDs []string, accountID string) (*model.DashboardBulkEditScheme, *model.ResponseScheme, error) {
+	return d.internalClient.ChangeOwner(ctx, dashboardIDs, accountID)
+}
+
 type internalDashboardImpl struct {
 	c       service.Connector
 	version string
@@ -164,15 +183,27 @@ func (i *internalDashboardImpl) Search(ctx context.Context, options *model.Dashb
 		}
 
 		if len(options.DashboardName) != 0 {
-			params.Add("dashboardName", options.OwnerAccountID)
+			params.Add("dashboardName", options.DashboardName)
 		}
 
 		if len(options.GroupPermissionName) != 0 {
-			params.Add("groupname", options.OwnerAccountID)
+			params.Add("groupname", options.GroupPermissionName)
+		}
+
+		if len(options.GroupID) != 0 {
+			params.Add("groupId", options.GroupID)
+		}
+
+		if options.ProjectID != 0 {
+			params.Add("projectId", strconv.Itoa(options.ProjectID))
+		}
+
+		if len(options.Status) != 0 {
+			params.Add("status", options.Status)
 		}
 
 		if len(options.OrderBy) != 0 {
-			params.Add("orderBy", options.OwnerAccountID)
+			params.Add("orderBy", options.OrderBy)
 		}
 
 		if len(options.Expand) != 0 {
@@ -196,6 +227,29 @@ func (i *internalDashboardImpl) Search(ctx context.Context, options *model.Dashb
 	return page, response, nil
 }
 
+// dashboardSearchPageSize is the number of dashboards requested per page by SearchAll.
+const dashboardSearchPageSize = 50
+
+func (i *internalDashboardImpl) SearchAll(ctx context.Context, options *model.DashboardSearchOptionsScheme) ([]*model.DashboardScheme, error) {
+
+	var dashboards []*model.DashboardScheme
+	for startAt := 0; ; {
+
+		page, _, err := i.Search(ctx, options, startAt, dashboardSearchPageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		dashboards = append(dashboards, page.Values...)
+
+		if page.IsLast || len(page.Values) == 0 {
+			return dashboards, nil
+		}
+
+		startAt += len(page.Values)
+	}
+}
+
 func (i *internalDashboardImpl) Get(ctx context.Context, dashboardID string) (*model.DashboardScheme, *model.ResponseScheme, error) {
 
 	if dashboardID == "" {
@@ -277,3 +331,50 @@ func (i *internalDashboardImpl) Update(ctx context.Context, dashboardID string,
 
 	return dashboard, response, nil
 }
+
+func (i *internalDashboardImpl) ChangeOwner(ctx context.Context, dashboardIDs []string, accountID string) (*model.DashboardBulkEditScheme, *model.ResponseScheme, error) {
+
+	if len(dashboardIDs) == 0 {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoDashboardID)
+	}
+
+	if accountID == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoAccountID)
+	}
+
+	entityIDs := make([]int, 0, len(dashboardIDs))
+	for _, dashboardID := range dashboardIDs {
+
+		entityID, err := strconv.Atoi(dashboardID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("jira: invalid dashboard id %q: %w", dashboardID, err)
+		}
+
+		entityIDs = append(entityIDs, entityID)
+	}
+
+	payload := map[string]interface{}{
+		"action":    "changeOwner",
+		"entityIds": entityIDs,
+		"changeOwnerDetails": map[string]interface{}{
+			"newOwner":    accountID,
+			"autofixName": true,
+		},
+		"extendAdminPermissions": true,
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/dashboard/bulk/edit", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(model.DashboardBulkEditScheme)
+	response, err := i.c.Call(request, result)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return result, response, nil
+}
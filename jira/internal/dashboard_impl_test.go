@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
@@ -710,7 +711,7 @@ func TestDashboardService_Search(t *testing.T) {
 				client.On("NewRequest",
 					context.Background(),
 					http.MethodGet,
-					"rest/api/2/dashboard/search?accountId=owner-id&dashboardName=owner-id&expand=isWritable&groupname=owner-id&maxResults=0&orderBy=owner-id&startAt=0",
+					"rest/api/2/dashboard/search?accountId=owner-id&dashboardName=dashboard-name-sample&expand=isWritable&groupname=jira-users&maxResults=0&orderBy=favourite_count&startAt=0",
 					"",
 					nil).
 					Return(&http.Request{}, nil)
@@ -747,7 +748,7 @@ func TestDashboardService_Search(t *testing.T) {
 				client.On("NewRequest",
 					context.Background(),
 					http.MethodGet,
-					"rest/api/3/dashboard/search?accountId=owner-id&dashboardName=owner-id&expand=isWritable&groupname=owner-id&maxResults=0&orderBy=owner-id&startAt=0",
+					"rest/api/3/dashboard/search?accountId=owner-id&dashboardName=dashboard-name-sample&expand=isWritable&groupname=jira-users&maxResults=0&orderBy=favourite_count&startAt=0",
 					"",
 					nil).
 					Return(&http.Request{}, nil)
@@ -784,7 +785,7 @@ func TestDashboardService_Search(t *testing.T) {
 				client.On("NewRequest",
 					context.Background(),
 					http.MethodGet,
-					"rest/api/2/dashboard/search?accountId=owner-id&dashboardName=owner-id&expand=isWritable&groupname=owner-id&maxResults=0&orderBy=owner-id&startAt=0",
+					"rest/api/2/dashboard/search?accountId=owner-id&dashboardName=dashboard-name-sample&expand=isWritable&groupname=jira-users&maxResults=0&orderBy=favourite_count&startAt=0",
 					"",
 					nil).
 					Return(&http.Request{}, model.ErrCreateHttpReq)
@@ -817,7 +818,7 @@ func TestDashboardService_Search(t *testing.T) {
 				client.On("NewRequest",
 					context.Background(),
 					http.MethodGet,
-					"rest/api/2/dashboard/search?accountId=owner-id&dashboardName=owner-id&expand=isWritable&groupname=owner-id&maxResults=0&orderBy=owner-id&startAt=0",
+					"rest/api/2/dashboard/search?accountId=owner-id&dashboardName=dashboard-name-sample&expand=isWritable&groupname=jira-users&maxResults=0&orderBy=favourite_count&startAt=0",
 					"",
 					nil).
 					Return(&http.Request{}, nil)
@@ -872,6 +873,125 @@ func TestDashboardService_Search(t *testing.T) {
 	}
 }
 
+func TestDashboardService_SearchAll(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx     context.Context
+		options *model.DashboardSearchOptionsScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    int
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the dashboards fit in a page",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+				options: &model.DashboardSearchOptionsScheme{
+					OwnerAccountID: "owner-id",
+					GroupID:        "group-id",
+					ProjectID:      10000,
+					Status:         "archived",
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/dashboard/search?accountId=owner-id&groupId=group-id&maxResults=50&projectId=10000&startAt=0&status=archived",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.DashboardSearchPageScheme{}).
+					Run(func(args mock.Arguments) {
+						page := args.Get(1).(*model.DashboardSearchPageScheme)
+						page.Values = []*model.DashboardScheme{{ID: "10000"}, {ID: "10001"}}
+						page.IsLast = true
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			want: 2,
+		},
+
+		{
+			name:   "when the http call cannot be executed",
+			fields: fields{version: "2"},
+			args: args{
+				ctx: context.Background(),
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/dashboard/search?maxResults=50&startAt=0",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.DashboardSearchPageScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrUnauthorized)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrUnauthorized,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			dashboardService, err := NewDashboardService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, err := dashboardService.SearchAll(testCase.args.ctx, testCase.args.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err))
+				assert.Nil(t, gotResult)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, gotResult, testCase.want)
+		})
+	}
+}
+
 func TestDashboardService_Get(t *testing.T) {
 
 	type fields struct {
@@ -1239,3 +1359,146 @@ func TestNewDashboardService(t *testing.T) {
 		})
 	}
 }
+
+func TestDashboardService_ChangeOwner(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx          context.Context
+		dashboardIDs []string
+		accountID    string
+	}
+
+	payload := map[string]interface{}{
+		"action":    "changeOwner",
+		"entityIds": []int{10001, 10002},
+		"changeOwnerDetails": map[string]interface{}{
+			"newOwner":    "uuid-sample",
+			"autofixName": true,
+		},
+		"extendAdminPermissions": true,
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				dashboardIDs: []string{"10001", "10002"},
+				accountID:    "uuid-sample",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/3/dashboard/bulk/edit",
+					"",
+					payload).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.DashboardBulkEditScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the dashboard ids are not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				accountID: "uuid-sample",
+			},
+			wantErr: true,
+			Err:     model.ErrNoDashboardID,
+		},
+
+		{
+			name:   "when the account id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				dashboardIDs: []string{"10001", "10002"},
+			},
+			wantErr: true,
+			Err:     model.ErrNoAccountID,
+		},
+
+		{
+			name:   "when the http call cannot be executed",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				dashboardIDs: []string{"10001", "10002"},
+				accountID:    "uuid-sample",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/2/dashboard/bulk/edit",
+					"",
+					payload).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.DashboardBulkEditScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrUnauthorized)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrUnauthorized,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewDashboardService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := newService.ChangeOwner(testCase.args.ctx, testCase.args.dashboardIDs, testCase.args.accountID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+)
+
+// NewExpressionService creates a new instance of ExpressionService.
+func NewExpressionService(client service.Connector, version string) (*ExpressionService, error) {
+
+	if version == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoVersionProvided)
+	}
+
+	return &ExpressionService{
+		internalClient: &internalExpressionImpl{c: client, version: version},
+	}, nil
+}
+
+// ExpressionService provides methods to evaluate and analyse Jira expressions.
+//
+// The Jira expressions are evaluated on the server with the permissions of the caller, e.g. to check the
+// permissions of a user on an issue without fetching it.
+type ExpressionService struct {
+	// internalClient is the connector interface for Jira expression operations.
+	internalClient jira.ExpressionConnector
+}
+
+// Evaluate evaluates a Jira expression in the context, and returns its value with the complexity of the evaluation.
+//
+// Example usage:
+//
+//	result, _, err := client.Expression.Evaluate(ctx, "issue.status.name == 'Done'",
+//		&models.ExpressionContextScheme{Issue: &models.ExpressionEntityContextScheme{Key: "KP-1"}})
+//
+//	var done bool
+//	err = result.Decode(&done)
+//
+// POST /rest/api/{2-3}/expression/eval
+func (e *ExpressionService) Evaluate(ctx context.Context, expression string, expressionContext *model.ExpressionContextScheme) (*model.ExpressionEvaluationScheme, *model.ResponseScheme, error) {
+	return e.internalClient.Evaluate(ctx, expression, expressionContext)
+}
+
+// Analyse checks the Jira expressions for syntax, type or complexity errors, without evaluating them.
+//
+// The check is one of the ExpressionCheck constants, ExpressionCheckSyntax when empty. The context variables map
+// the names of the custom context variables to their types.
+//
+// POST /rest/api/{2-3}/expression/analyse
+func (e *ExpressionService) Analyse(ctx context.Context, expressions []string, check string, contextVariables map[string]string) (*model.ExpressionAnalysisScheme, *model.ResponseScheme, error) {
+	return e.internalClient.Analyse(ctx, expressions, check, contextVariables)
+}
+
+type internalExpressionImpl struct {
+	c       service.Connector
+	version string
+}
+
+func (i *internalExpressionImpl) Evaluate(ctx context.Context, expression string, expressionContext *model.ExpressionContextScheme) (*model.ExpressionEvaluationScheme, *model.ResponseScheme, error) {
+
+	if expression == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoExpression)
+	}
+
+	payload := map[string]interface{}{"expression": expression}
+	if expressionContext != nil {
+		payload["context"] = expressionContext
+	}
+
+	params := url.Values{}
+	params.Add("expand", "meta.complexity")
+
+	endpoint := fmt.Sprintf("rest/api/%v/expression/eval?%v", i.version, params.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(model.ExpressionEvaluationScheme)
+	response, err := i.c.Call(request, result)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return result, response, nil
+}
+
+func (i *internalExpressionImpl) Analyse(ctx context.Context, expressions []string, check string, contextVariables map[string]string) (*model.ExpressionAnalysisScheme, *model.ResponseScheme, error) {
+
+	if len(expressions) == 0 {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoExpression)
+	}
+
+	payload := map[string]interface{}{"expressions": expressions}
+	if len(contextVariables) != 0 {
+		payload["contextVariables"] = contextVariables
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/expression/analyse", i.version)
+
+	if check != "" {
+		params := url.Values{}
+		params.Add("check", check)
+
+		endpoint += "?" + params.Encode()
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	analysis := new(model.ExpressionAnalysisScheme)
+	response, err := i.c.Call(request, analysis)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return analysis, response, nil
+}
@@ -0,0 +1,358 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func Test_internalExpressionImpl_Evaluate(t *testing.T) {
+
+	expressionContext := &model.ExpressionContextScheme{
+		Issue: &model.ExpressionEntityContextScheme{Key: "KP-1"},
+	}
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx               context.Context
+		expression        string
+		expressionContext *model.ExpressionContextScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:               context.Background(),
+				expression:        "issue.status.name == 'Done'",
+				expressionContext: expressionContext,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/expression/eval?expand=meta.complexity",
+					"",
+					map[string]interface{}{"expression": "issue.status.name == 'Done'", "context": expressionContext}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					mock.Anything).
+					Run(func(args mock.Arguments) {
+						result := args.Get(1).(*model.ExpressionEvaluationScheme)
+						result.Value = []byte("true")
+						result.Meta = &model.ExpressionEvaluationMetaScheme{
+							Complexity: &model.ExpressionComplexityScheme{
+								Steps: &model.ExpressionComplexityValueScheme{Value: 3, Limit: 10000},
+							},
+						}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the api version is v2 and the context is not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:        context.Background(),
+				expression: "user.accountId",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/expression/eval?expand=meta.complexity",
+					"",
+					map[string]interface{}{"expression": "user.accountId"}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ExpressionEvaluationScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the expression is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoExpression,
+		},
+
+		{
+			name:   "when the http call cannot be executed",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				expression: "user.accountId",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/expression/eval?expand=meta.complexity",
+					"",
+					map[string]interface{}{"expression": "user.accountId"}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ExpressionEvaluationScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrBadRequest)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrBadRequest,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				expression: "user.accountId",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/expression/eval?expand=meta.complexity",
+					"",
+					map[string]interface{}{"expression": "user.accountId"}).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewExpressionService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := newService.Evaluate(testCase.args.ctx, testCase.args.expression, testCase.args.expressionContext)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				if gotResult.Value != nil {
+
+					var done bool
+					assert.NoError(t, gotResult.Decode(&done))
+					assert.True(t, done)
+					assert.Equal(t, 3, gotResult.Meta.Complexity.Steps.Value)
+				}
+			}
+		})
+	}
+}
+
+func Test_internalExpressionImpl_Analyse(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx              context.Context
+		expressions      []string
+		check            string
+		contextVariables map[string]string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:              context.Background(),
+				expressions:      []string{"issues.map(issue => issue.key)", "listOfStrings.length"},
+				check:            model.ExpressionCheckComplexity,
+				contextVariables: map[string]string{"listOfStrings": "List<String>"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/expression/analyse?check=complexity",
+					"",
+					map[string]interface{}{
+						"expressions":      []string{"issues.map(issue => issue.key)", "listOfStrings.length"},
+						"contextVariables": map[string]string{"listOfStrings": "List<String>"},
+					}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ExpressionAnalysisScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the api version is v2 and the check is not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:         context.Background(),
+				expressions: []string{"issue.key"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/expression/analyse",
+					"",
+					map[string]interface{}{"expressions": []string{"issue.key"}}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ExpressionAnalysisScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the expressions are not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoExpression,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:         context.Background(),
+				expressions: []string{"issue.key"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/expression/analyse",
+					"",
+					map[string]interface{}{"expressions": []string{"issue.key"}}).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewExpressionService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := newService.Analyse(testCase.args.ctx, testCase.args.expressions,
+				testCase.args.check, testCase.args.contextVariables)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+		})
+	}
+}
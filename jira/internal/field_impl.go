@@ -63,6 +63,8 @@ func (i *IssueFieldService) Gets(ctx context.Context) ([]*model.IssueFieldScheme
 
 // Create creates a custom field.
 //
+// The searcher of the types provided by Jira is checked before sending the request, see models.CustomFieldSearcherKeys.
+//
 // POST /rest/api/{2-3}/field
 //
 // https://docs.go-atlassian.io/jira-software-cloud/issues/fields#create-custom-field
@@ -101,6 +103,18 @@ func (i *IssueFieldService) Delete(ctx context.Context, fieldID string) (*model.
 	return i.internalClient.Delete(ctx, fieldID)
 }
 
+// Usage returns the usage report of the custom fields, walking their contexts, screens and issues.
+//
+// The issue counts are approximate, and the errors of a custom field are reported on its usage.
+//
+// It helps to find the custom fields that are candidates for deletion, see CustomFieldUsageScheme.Unused.
+func (i *IssueFieldService) Usage(ctx context.Context, options *model.FieldSearchOptionsScheme) ([]*model.CustomFieldUsageScheme, error) {
+	return i.internalClient.Usage(ctx, options)
+}
+
+// customFieldUsagePageSize is the page size used by Usage to walk the fields, contexts and screens.
+const customFieldUsagePageSize = 50
+
 type internalIssueFieldServiceImpl struct {
 	c       service.Connector
 	version string
@@ -126,6 +140,10 @@ func (i *internalIssueFieldServiceImpl) Gets(ctx context.Context) ([]*model.Issu
 
 func (i *internalIssueFieldServiceImpl) Create(ctx context.Context, payload *model.CustomFieldScheme) (*model.IssueFieldScheme, *model.ResponseScheme, error) {
 
+	if err := payload.Validate(); err != nil {
+		return nil, nil, fmt.Errorf("jira: %w", err)
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/field", i.version)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
@@ -236,3 +254,108 @@ func (i *internalIssueFieldServiceImpl) Delete(ctx context.Context, fieldID stri
 
 	return task, response, nil
 }
+
+func (i *internalIssueFieldServiceImpl) Usage(ctx context.Context, options *model.FieldSearchOptionsScheme) ([]*model.CustomFieldUsageScheme, error) {
+
+	search := &model.FieldSearchOptionsScheme{Types: []string{"custom"}}
+	if options != nil {
+		search.IDs, search.Query, search.OrderBy, search.Expand = options.IDs, options.Query, options.OrderBy, options.Expand
+	}
+
+	var usages []*model.CustomFieldUsageScheme
+	for startAt := 0; ; startAt += customFieldUsagePageSize {
+
+		page, _, err := i.Search(ctx, search, startAt, customFieldUsagePageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, field := range page.Values {
+			usages = append(usages, i.usage(ctx, field))
+		}
+
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+	}
+
+	return usages, nil
+}
+
+// usage walks the contexts, project mappings and screens of the custom field, and counts the issues with a value on it.
+func (i *internalIssueFieldServiceImpl) usage(ctx context.Context, field *model.IssueFieldScheme) *model.CustomFieldUsageScheme {
+
+	usage := &model.CustomFieldUsageScheme{Field: field}
+	contexts := &internalIssueFieldContextServiceImpl{c: i.c, version: i.version}
+
+	for startAt := 0; ; startAt += customFieldUsagePageSize {
+
+		page, _, err := contexts.Gets(ctx, field.ID, nil, startAt, customFieldUsagePageSize)
+		if err != nil {
+			usage.Err = err
+			return usage
+		}
+
+		usage.Contexts += len(page.Values)
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+	}
+
+	projects := make(map[string]struct{})
+	for startAt := 0; ; startAt += customFieldUsagePageSize {
+
+		page, _, err := contexts.ProjectsContext(ctx, field.ID, nil, startAt, customFieldUsagePageSize)
+		if err != nil {
+			usage.Err = err
+			return usage
+		}
+
+		for _, mapping := range page.Values {
+
+			if mapping.IsGlobalContext {
+				usage.Global = true
+				continue
+			}
+
+			if _, ok := projects[mapping.ProjectID]; !ok {
+				projects[mapping.ProjectID] = struct{}{}
+				usage.ProjectIDs = append(usage.ProjectIDs, mapping.ProjectID)
+			}
+		}
+
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+	}
+
+	screens := &internalScreenImpl{c: i.c, version: i.version}
+	for startAt := 0; ; startAt += customFieldUsagePageSize {
+
+		page, _, err := screens.Fields(ctx, field.ID, startAt, customFieldUsagePageSize)
+		if err != nil {
+			usage.Err = err
+			return usage
+		}
+
+		usage.Screens = append(usage.Screens, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+	}
+
+	customID := strings.TrimPrefix(field.ID, "customfield_")
+	if field.Schema != nil && field.Schema.CustomID != 0 {
+		customID = strconv.Itoa(field.Schema.CustomID)
+	}
+
+	search := &internalSearchADFImpl{c: i.c, version: i.version}
+	count, _, err := search.ApproximateCount(ctx, fmt.Sprintf("cf[%v] is not EMPTY", customID))
+	if err != nil {
+		usage.Err = err
+		return usage
+	}
+
+	usage.IssueCount = count.Count
+	return usage
+}
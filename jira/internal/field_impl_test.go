@@ -271,6 +271,31 @@ func Test_internalIssueFieldServiceImpl_Create(t *testing.T) {
 			wantErr: true,
 			Err:     model.ErrCreateHttpReq,
 		},
+
+		{
+			name:   "when the searcher is not accepted by the field type",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+				payload: &model.CustomFieldScheme{
+					Name:        "Due",
+					FieldType:   model.CustomFieldTypeDatePicker,
+					SearcherKey: model.CustomFieldSearcherText,
+				},
+			},
+			wantErr: true,
+			Err:     model.ErrInvalidCustomFieldSearcher,
+		},
+
+		{
+			name:   "when the payload is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoCustomFieldName,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -664,6 +689,232 @@ func Test_internalIssueFieldServiceImpl_Delete(t *testing.T) {
 	}
 }
 
+func Test_internalIssueFieldServiceImpl_Usage(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx     context.Context
+		options *model.FieldSearchOptionsScheme
+	}
+
+	approximateCount := struct {
+		Jql string `json:"jql,omitempty"`
+	}{
+		Jql: "cf[10001] is not EMPTY",
+	}
+
+	onField := func(client *mocks.Connector, screensErr error) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/field/search?maxResults=50&query=Team&startAt=0&type=custom",
+			"",
+			nil).
+			Return(&http.Request{}, nil)
+
+		client.On("Call",
+			&http.Request{},
+			&model.FieldSearchPageScheme{}).
+			Run(func(args mock.Arguments) {
+				page := args.Get(1).(*model.FieldSearchPageScheme)
+				page.IsLast = true
+				page.Values = []*model.IssueFieldScheme{
+					{ID: "customfield_10001", Name: "Team", Schema: &model.IssueFieldSchemaScheme{CustomID: 10001}},
+				}
+			}).
+			Return(&model.ResponseScheme{}, nil)
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/field/customfield_10001/context?maxResults=50&startAt=0",
+			"",
+			nil).
+			Return(&http.Request{}, nil)
+
+		client.On("Call",
+			&http.Request{},
+			&model.CustomFieldContextPageScheme{}).
+			Run(func(args mock.Arguments) {
+				page := args.Get(1).(*model.CustomFieldContextPageScheme)
+				page.IsLast = true
+				page.Values = []*model.FieldContextScheme{{ID: "10100"}, {ID: "10101"}}
+			}).
+			Return(&model.ResponseScheme{}, nil)
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/field/customfield_10001/context/projectmapping?maxResults=50&startAt=0",
+			"",
+			nil).
+			Return(&http.Request{}, nil)
+
+		client.On("Call",
+			&http.Request{},
+			&model.CustomFieldContextProjectMappingPageScheme{}).
+			Run(func(args mock.Arguments) {
+				page := args.Get(1).(*model.CustomFieldContextProjectMappingPageScheme)
+				page.IsLast = true
+				page.Values = []*model.CustomFieldContextProjectMappingValueScheme{
+					{ContextID: "10100", IsGlobalContext: true},
+					{ContextID: "10101", ProjectID: "10000"},
+					{ContextID: "10101", ProjectID: "10000"},
+				}
+			}).
+			Return(&model.ResponseScheme{}, nil)
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/field/customfield_10001/screens?maxResults=50&startAt=0",
+			"",
+			nil).
+			Return(&http.Request{}, nil)
+
+		client.On("Call",
+			&http.Request{},
+			&model.ScreenFieldPageScheme{}).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*model.ScreenFieldPageScheme).IsLast = true
+			}).
+			Return(&model.ResponseScheme{}, screensErr)
+
+		if screensErr != nil {
+			return
+		}
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPost,
+			"rest/api/3/search/approximate-count",
+			"",
+			approximateCount).
+			Return(&http.Request{}, nil)
+
+		client.On("Call",
+			&http.Request{},
+			&model.IssueSearchApproximateCountScheme{}).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    []*model.CustomFieldUsageScheme
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the custom field is unused",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				options: &model.FieldSearchOptionsScheme{Types: []string{"system"}, Query: "Team"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				onField(client, nil)
+
+				fields.c = client
+			},
+			want: []*model.CustomFieldUsageScheme{
+				{
+					Field:      &model.IssueFieldScheme{ID: "customfield_10001", Name: "Team", Schema: &model.IssueFieldSchemaScheme{CustomID: 10001}},
+					Contexts:   2,
+					Global:     true,
+					ProjectIDs: []string{"10000"},
+				},
+			},
+		},
+
+		{
+			name:   "when the screens of the custom field cannot be fetched",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				options: &model.FieldSearchOptionsScheme{Query: "Team"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				onField(client, model.ErrUnauthorized)
+
+				fields.c = client
+			},
+			want: []*model.CustomFieldUsageScheme{
+				{
+					Field:      &model.IssueFieldScheme{ID: "customfield_10001", Name: "Team", Schema: &model.IssueFieldSchemaScheme{CustomID: 10001}},
+					Contexts:   2,
+					Global:     true,
+					ProjectIDs: []string{"10000"},
+					Err:        model.ErrUnauthorized,
+				},
+			},
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/field/search?maxResults=50&startAt=0&type=custom",
+					"",
+					nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			fieldService, err := NewIssueFieldService(testCase.fields.c, testCase.fields.version, nil, nil, nil)
+			assert.NoError(t, err)
+
+			gotResult, err := fieldService.Usage(testCase.args.ctx, testCase.args.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.want, gotResult)
+			assert.Equal(t, testCase.want[0].Err == nil, gotResult[0].Unused())
+		})
+	}
+}
+
 func Test_NewIssueFieldService(t *testing.T) {
 
 	type args struct {
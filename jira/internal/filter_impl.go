@@ -76,6 +76,15 @@ func (f *FilterService) Search(ctx context.Context, options *model.FilterSearchO
 	return f.internalClient.Search(ctx, options, startAt, maxResults)
 }
 
+// SearchAll returns the filters matching the options, following the pages of the search.
+//
+// GET /rest/api/{2-3}/filter/search
+//
+// https://docs.go-atlassian.io/jira-software-cloud/filters#search-filters
+func (f *FilterService) SearchAll(ctx context.Context, options *model.FilterSearchOptionScheme) ([]*model.FilterDetailScheme, error) {
+	return f.internalClient.SearchAll(ctx, options)
+}
+
 // Get returns a filter.
 //
 // GET /rest/api/{2-3}/filter/{id}
@@ -112,6 +121,17 @@ func (f *FilterService) Change(ctx context.Context, filterID int, accountID stri
 	return f.internalClient.Change(ctx, filterID, accountID)
 }
 
+// Subscriptions returns a page of the subscriptions of a filter.
+//
+// The subscriptions are returned by the get filter endpoint with the subscriptions expansion, whose end index is inclusive.
+//
+// GET /rest/api/{2-3}/filter/{id}?expand=subscriptions[startAt:endAt]
+//
+// https://docs.go-atlassian.io/jira-software-cloud/filters#get-filter-subscriptions
+func (f *FilterService) Subscriptions(ctx context.Context, filterID, startAt, maxResults int) (*model.FilterSubscriptionPageScheme, *model.ResponseScheme, error) {
+	return f.internalClient.Subscriptions(ctx, filterID, startAt, maxResults)
+}
+
 type internalFilterServiceImpl struct {
 	c       service.Connector
 	version string
@@ -202,6 +222,10 @@ func (i *internalFilterServiceImpl) Search(ctx context.Context, options *model.F
 			params.Add("groupname", options.Group)
 		}
 
+		if options.GroupID != "" {
+			params.Add("groupId", options.GroupID)
+		}
+
 		if options.ProjectID != 0 {
 			params.Add("projectId", strconv.Itoa(options.ProjectID))
 		}
@@ -213,6 +237,14 @@ func (i *internalFilterServiceImpl) Search(ctx context.Context, options *model.F
 		if options.OrderBy != "" {
 			params.Add("orderBy", options.OrderBy)
 		}
+
+		if options.OverrideSharePermissions {
+			params.Add("overrideSharePermissions", "true")
+		}
+
+		if options.IsSubstringMatch {
+			params.Add("isSubstringMatch", "true")
+		}
 	}
 
 	endpoint := fmt.Sprintf("rest/api/%v/filter/search?%v", i.version, params.Encode())
@@ -231,6 +263,29 @@ func (i *internalFilterServiceImpl) Search(ctx context.Context, options *model.F
 	return page, response, nil
 }
 
+// filterSearchPageSize is the number of filters requested per page by SearchAll.
+const filterSearchPageSize = 50
+
+func (i *internalFilterServiceImpl) SearchAll(ctx context.Context, options *model.FilterSearchOptionScheme) ([]*model.FilterDetailScheme, error) {
+
+	var filters []*model.FilterDetailScheme
+	for startAt := 0; ; {
+
+		page, _, err := i.Search(ctx, options, startAt, filterSearchPageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		filters = append(filters, page.Values...)
+
+		if page.IsLast || len(page.Values) == 0 {
+			return filters, nil
+		}
+
+		startAt += len(page.Values)
+	}
+}
+
 func (i *internalFilterServiceImpl) Get(ctx context.Context, filterID int, expand []string) (*model.FilterScheme, *model.ResponseScheme, error) {
 
 	if filterID == 0 {
@@ -325,3 +380,33 @@ func (i *internalFilterServiceImpl) Change(ctx context.Context, filterID int, ac
 
 	return i.c.Call(request, nil)
 }
+
+func (i *internalFilterServiceImpl) Subscriptions(ctx context.Context, filterID, startAt, maxResults int) (*model.FilterSubscriptionPageScheme, *model.ResponseScheme, error) {
+
+	if filterID == 0 {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoFilterID)
+	}
+
+	params := url.Values{}
+	// The end index of the range is inclusive
+	params.Add("expand", fmt.Sprintf("subscriptions[%v:%v]", startAt, startAt+maxResults-1))
+
+	endpoint := fmt.Sprintf("rest/api/%v/filter/%v?%v", i.version, filterID, params.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	filter := new(model.FilterScheme)
+	response, err := i.c.Call(request, filter)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if filter.Subscriptions == nil {
+		return &model.FilterSubscriptionPageScheme{}, response, nil
+	}
+
+	return filter.Subscriptions, response, nil
+}
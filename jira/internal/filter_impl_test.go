@@ -629,6 +629,147 @@ func TestFilterService_Search(t *testing.T) {
 	}
 }
 
+func TestFilterService_SearchAll(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+		share   jira.FilterSharingConnector
+	}
+
+	type args struct {
+		ctx     context.Context
+		options *model.FilterSearchOptionScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    int
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the filters are split across pages",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+				options: &model.FilterSearchOptionScheme{
+					AccountID:                "account-id",
+					GroupID:                  "group-id",
+					OverrideSharePermissions: true,
+					IsSubstringMatch:         true,
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/filter/search?accountId=account-id&groupId=group-id&isSubstringMatch=true&maxResults=50&overrideSharePermissions=true&startAt=0",
+					"",
+					nil).
+					Return(&http.Request{Host: "first"}, nil).
+					Once()
+
+				client.On("Call",
+					&http.Request{Host: "first"},
+					&model.FilterSearchPageScheme{}).
+					Run(func(args mock.Arguments) {
+						page := args.Get(1).(*model.FilterSearchPageScheme)
+						page.Values = []*model.FilterDetailScheme{{ID: "10000"}, {ID: "10001"}}
+					}).
+					Return(&model.ResponseScheme{}, nil).
+					Once()
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/filter/search?accountId=account-id&groupId=group-id&isSubstringMatch=true&maxResults=50&overrideSharePermissions=true&startAt=2",
+					"",
+					nil).
+					Return(&http.Request{Host: "second"}, nil).
+					Once()
+
+				client.On("Call",
+					&http.Request{Host: "second"},
+					&model.FilterSearchPageScheme{}).
+					Run(func(args mock.Arguments) {
+						page := args.Get(1).(*model.FilterSearchPageScheme)
+						page.Values = []*model.FilterDetailScheme{{ID: "10002"}}
+						page.IsLast = true
+					}).
+					Return(&model.ResponseScheme{}, nil).
+					Once()
+
+				fields.c = client
+			},
+			want: 3,
+		},
+
+		{
+			name:   "when the http call cannot be executed",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/filter/search?maxResults=50&startAt=0",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.FilterSearchPageScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrUnauthorized)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrUnauthorized,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			filterService, err := NewFilterService(testCase.fields.c, testCase.fields.version, testCase.fields.share)
+			assert.NoError(t, err)
+
+			gotResult, err := filterService.SearchAll(testCase.args.ctx, testCase.args.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err))
+				assert.Nil(t, gotResult)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, gotResult, testCase.want)
+		})
+	}
+}
+
 func TestFilterService_Get(t *testing.T) {
 
 	type fields struct {
@@ -1321,3 +1462,222 @@ func Test_NewFilterService(t *testing.T) {
 		})
 	}
 }
+
+func TestFilterService_Subscriptions(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+		share   jira.FilterSharingConnector
+	}
+
+	type args struct {
+		ctx        context.Context
+		filterID   int
+		startAt    int
+		maxResults int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:        context.Background(),
+				filterID:   10001,
+				startAt:    0,
+				maxResults: 50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/filter/10001?expand=subscriptions%5B0%3A49%5D",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.FilterScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				filterID:   10001,
+				startAt:    0,
+				maxResults: 50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/filter/10001?expand=subscriptions%5B0%3A49%5D",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.FilterScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the second page is requested",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				filterID:   10001,
+				startAt:    50,
+				maxResults: 50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/filter/10001?expand="+url.QueryEscape("subscriptions[50:99]"),
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.FilterScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the filter id is not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoFilterID,
+		},
+
+		{
+			name:   "when the http call cannot be executed",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:        context.Background(),
+				filterID:   10001,
+				startAt:    0,
+				maxResults: 50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/filter/10001?expand=subscriptions%5B0%3A49%5D",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.FilterScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrBadRequest)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrBadRequest,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:        context.Background(),
+				filterID:   10001,
+				startAt:    0,
+				maxResults: 50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/filter/10001?expand=subscriptions%5B0%3A49%5D",
+					"",
+					nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewFilterService(testCase.fields.c, testCase.fields.version, testCase.fields.share)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := newService.Subscriptions(testCase.args.ctx, testCase.args.filterID, testCase.args.startAt, testCase.args.maxResults)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
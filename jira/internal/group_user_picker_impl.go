@@ -70,5 +70,9 @@ func (i internalGroupUserPickerServiceImpl) Find(ctx context.Context, options *m
 		return nil, response, err
 	}
 
+	if options.ShowAvatar {
+		find.SetAvatarSize(options.AvatarSize)
+	}
+
 	return find, response, nil
 }
@@ -91,6 +91,34 @@ func Test_internalGroupUserPickerServiceImpl_Find(t *testing.T) {
 			},
 		},
 
+		{
+			name:   "when the avatar is requested",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				options: &model.GroupUserPickerFindOptionScheme{Query: "jira", ShowAvatar: true, AvatarSize: "small@2x"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/groupuserpicker?avatarSize=small%402x&query=jira&showAvatar=true",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.GroupUserPickerFindScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
 		{
 			name:   "when the query is not provided",
 			fields: fields{version: "2"},
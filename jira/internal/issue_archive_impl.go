@@ -9,6 +9,9 @@ import (
 	"github.com/ctreminiom/go-atlassian/v2/service/jira"
 	"net/http"
 	"path"
+	"slices"
+	"strings"
+	"time"
 )
 
 // NewIssueArchivalService creates a new instance of IssueArchivalService.
@@ -70,6 +73,24 @@ func (i *IssueArchivalService) PreserveByJQL(ctx context.Context, jql string) (s
 	return i.internalClient.PreserveByJQL(ctx, jql)
 }
 
+// PreserveByJQLAndWait archives the issues that match the provided JQL query and waits for the archival task.
+//
+// The task is polled until it's done, and onProgress, when set, is called with each state of the task.
+//
+// Parameters:
+//   - ctx: The context for request lifecycle management, the wait stops when it's done.
+//   - jql: The JQL query to select issues for archival.
+//   - onProgress: The callback receiving the progress of the archival task.
+//
+// Returns:
+//   - task: The archival task once it's done.
+//   - err: An error if the operation fails, models.ErrTaskFailed when the task doesn't complete.
+//
+// https://docs.go-atlassian.io/jira-software-cloud/issues/archiving#archive-issues-by-jql
+func (i *IssueArchivalService) PreserveByJQLAndWait(ctx context.Context, jql string, onProgress func(*model.TaskProgressScheme)) (*model.TaskScheme, error) {
+	return i.internalClient.PreserveByJQLAndWait(ctx, jql, onProgress)
+}
+
 // Restore brings back the given archived issues using their issue IDs or keys.
 //
 // Parameters:
@@ -102,9 +123,50 @@ func (i *IssueArchivalService) Export(ctx context.Context, payload *model.IssueA
 	return i.internalClient.Export(ctx, payload)
 }
 
+// Settings returns the license of the Jira instance, deciding whether the issues can be archived.
+//
+// Parameters:
+//   - ctx: The context for controlling request execution.
+//
+// Returns:
+//   - settings: The applications of the instance and their plans.
+//   - response: The HTTP response scheme for the request.
+//   - err: An error if the operation fails.
+//
+// GET /rest/api/{2-3}/instance/license
+func (i *IssueArchivalService) Settings(ctx context.Context) (*model.IssueArchivalSettingsScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Settings(ctx)
+}
+
+// CheckEligibility classifies the issues before archiving them with Preserve, reducing its partial failures.
+//
+// The license of the instance, the Administer Jira permission of the calling user, the existence of the issues,
+// the license of their projects and their issue types are checked, the ineligible issues being classified as
+// the errors returned by Preserve.
+//
+// Parameters:
+//   - ctx: The context for controlling request execution.
+//   - issueIdsOrKeys: A list of issue IDs or keys to be archived.
+//
+// Returns:
+//   - eligibility: The eligible issues and the ineligible issues by reason.
+//   - err: An error if the checks can't be made.
+//
+// GET /rest/api/{2-3}/instance/license
+//
+// GET /rest/api/{2-3}/mypermissions
+//
+// POST /rest/api/{2-3}/issue/bulkfetch
+func (i *IssueArchivalService) CheckEligibility(ctx context.Context, issueIDsOrKeys []string) (*model.IssueArchivalEligibilityScheme, error) {
+	return i.internalClient.CheckEligibility(ctx, issueIDsOrKeys)
+}
+
 type internalIssueArchivalImpl struct {
 	c       service.Connector
 	version string
+
+	// pollInterval is the interval used by PreserveByJQLAndWait to poll the archival task.
+	pollInterval time.Duration
 }
 
 func (i *internalIssueArchivalImpl) Preserve(ctx context.Context, issueIDsOrKeys []string) (result *model.IssueArchivalSyncResponseScheme, response *model.ResponseScheme, err error) {
@@ -153,7 +215,19 @@ func (i *internalIssueArchivalImpl) PreserveByJQL(ctx context.Context, jql strin
 		return "", response, err
 	}
 
-	return path.Base(response.Bytes.String()), response, nil
+	// The task URL is returned as a JSON string.
+	return path.Base(strings.Trim(response.Bytes.String(), "\" \n")), response, nil
+}
+
+func (i *internalIssueArchivalImpl) PreserveByJQLAndWait(ctx context.Context, jql string, onProgress func(*model.TaskProgressScheme)) (*model.TaskScheme, error) {
+
+	taskID, _, err := i.PreserveByJQL(ctx, jql)
+	if err != nil {
+		return nil, err
+	}
+
+	task, _, err := (&internalTaskServiceImpl{c: i.c, version: i.version}).WaitFor(ctx, taskID, i.pollInterval, onProgress)
+	return task, err
 }
 
 func (i *internalIssueArchivalImpl) Restore(ctx context.Context, issueIDsOrKeys []string) (result *model.IssueArchivalSyncResponseScheme, response *model.ResponseScheme, err error) {
@@ -198,3 +272,100 @@ func (i *internalIssueArchivalImpl) Export(ctx context.Context, payload *model.I
 
 	return result, response, nil
 }
+
+func (i *internalIssueArchivalImpl) Settings(ctx context.Context) (*model.IssueArchivalSettingsScheme, *model.ResponseScheme, error) {
+
+	endpoint := fmt.Sprintf("rest/api/%s/instance/license", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settings := new(model.IssueArchivalSettingsScheme)
+	response, err := i.c.Call(request, settings)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return settings, response, nil
+}
+
+// issueArchivalBulkFetchSize is the number of issues read per request by CheckEligibility, the limit of the bulk fetch.
+const issueArchivalBulkFetchSize = 100
+
+func (i *internalIssueArchivalImpl) CheckEligibility(ctx context.Context, issueIDsOrKeys []string) (*model.IssueArchivalEligibilityScheme, error) {
+
+	if len(issueIDsOrKeys) == 0 {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoIssuesSlice)
+	}
+
+	settings, _, err := i.Settings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions, _, err := (&internalPermissionImpl{c: i.c, version: i.version}).Mine(ctx, &model.MyPermissionsOptionsScheme{Permissions: []string{"ADMINISTER"}})
+	if err != nil {
+		return nil, err
+	}
+
+	// The issues are indexed by ID and key, the checked issues being identified by either
+	issues := make(map[string]*model.IssueScheme)
+	search := &internalSearchADFImpl{c: i.c, version: i.version}
+	for chunk := range slices.Chunk(issueIDsOrKeys, issueArchivalBulkFetchSize) {
+
+		page, _, err := search.BulkFetch(ctx, chunk, []string{"issuetype", "project"})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, issue := range page.Issues {
+			if issue != nil {
+				issues[issue.ID] = issue
+				issues[strings.ToUpper(issue.Key)] = issue
+			}
+		}
+	}
+
+	eligibility := &model.IssueArchivalEligibilityScheme{Settings: settings, Ineligible: new(model.IssueArchivalSyncErrorScheme)}
+	for _, issueIDOrKey := range issueIDsOrKeys {
+
+		issue, found := issues[strings.ToUpper(issueIDOrKey)]
+
+		var category **model.IssueArchivalErrorScheme
+		var message string
+		switch {
+		case !found:
+			category, message = &eligibility.Ineligible.IssuesNotFound, "The issues don't exist, are in archived projects or can't be browsed."
+		case !permissions.Has("ADMINISTER"):
+			category, message = &eligibility.Ineligible.UserDoesNotHavePermission, "The user doesn't have the Administer Jira permission."
+		case !settings.SupportsProjectType(issueArchivalProjectType(issue)):
+			category, message = &eligibility.Ineligible.IssuesInUnlicensedProjects, "The issues are in projects not licensed for archiving."
+		case issue.Fields != nil && issue.Fields.IssueType != nil && issue.Fields.IssueType.Subtask:
+			category, message = &eligibility.Ineligible.IssueIsSubtask, "The issues are subtasks, archived with their parents."
+		default:
+			eligibility.Eligible = append(eligibility.Eligible, issueIDOrKey)
+			continue
+		}
+
+		if *category == nil {
+			*category = &model.IssueArchivalErrorScheme{Message: message}
+		}
+
+		(*category).Count++
+		(*category).IssueIDsOrKeys = append((*category).IssueIDsOrKeys, issueIDOrKey)
+	}
+
+	return eligibility, nil
+}
+
+// issueArchivalProjectType returns the type of the project of the issue, empty when it's not returned.
+func issueArchivalProjectType(issue *model.IssueScheme) string {
+
+	if issue.Fields == nil || issue.Fields.Project == nil {
+		return ""
+	}
+
+	return issue.Fields.Project.ProjectTypeKey
+}
@@ -1,14 +1,17 @@
 package internal
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"net/http"
 	"testing"
+	"time"
 )
 
 func Test_internalIssueArchivalImpl_Preserve(t *testing.T) {
@@ -445,3 +448,316 @@ func Test_internalIssueArchivalImpl_Export(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalIssueArchivalImpl_PreserveByJQLAndWait(t *testing.T) {
+
+	archiveMocked := func(client *mocks.Connector) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPost,
+			"rest/api/3/issue/archive", "", map[string]interface{}{"jql": "project = KP"}).
+			Return(&http.Request{Method: http.MethodPost}, nil)
+
+		client.On("Call",
+			&http.Request{Method: http.MethodPost},
+			nil).
+			Return(&model.ResponseScheme{Bytes: *bytes.NewBufferString(`"https://ctreminiom.atlassian.net/rest/api/3/task/10641"`)}, nil)
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/task/10641", "", nil).
+			Return(&http.Request{Method: http.MethodGet}, nil)
+	}
+
+	taskMocked := func(client *mocks.Connector, status string, progress int) {
+
+		client.On("Call",
+			&http.Request{Method: http.MethodGet},
+			&model.TaskScheme{}).
+			Run(func(args mock.Arguments) {
+				task := args.Get(1).(*model.TaskScheme)
+				task.ID = "10641"
+				task.Status = status
+				task.Progress = progress
+			}).
+			Return(&model.ResponseScheme{}, nil).
+			Once()
+	}
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+	type args struct {
+		ctx context.Context
+		jql string
+	}
+
+	tests := []struct {
+		name         string
+		fields       fields
+		args         args
+		on           func(*fields)
+		wantProgress []int
+		wantErr      bool
+		Err          error
+	}{
+		{
+			name:   "happy path - when the archival task completes",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+				jql: "project = KP",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				archiveMocked(client)
+				taskMocked(client, model.TaskStatusEnqueued, 0)
+				taskMocked(client, model.TaskStatusRunning, 40)
+				taskMocked(client, model.TaskStatusComplete, 100)
+
+				fields.c = client
+			},
+			wantProgress: []int{0, 40, 100},
+		},
+		{
+			name:   "fail path - when the archival task fails",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+				jql: "project = KP",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				archiveMocked(client)
+				taskMocked(client, model.TaskStatusRunning, 10)
+				taskMocked(client, model.TaskStatusFailed, 10)
+
+				fields.c = client
+			},
+			wantProgress: []int{10, 10},
+			wantErr:      true,
+			Err:          model.ErrTaskFailed,
+		},
+		{
+			name:   "fail path - when the jql is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoJQL,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			if tt.on != nil {
+				tt.on(&tt.fields)
+			}
+
+			archiveService := &IssueArchivalService{
+				internalClient: &internalIssueArchivalImpl{c: tt.fields.c, version: tt.fields.version, pollInterval: time.Millisecond},
+			}
+
+			var progress []int
+			gotTask, err := archiveService.PreserveByJQLAndWait(tt.args.ctx, tt.args.jql, func(update *model.TaskProgressScheme) {
+				assert.Equal(t, "10641", update.TaskID)
+				progress = append(progress, update.Progress)
+			})
+
+			assert.Equal(t, tt.wantProgress, progress)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.Err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, model.TaskStatusComplete, gotTask.Status)
+		})
+	}
+}
+
+func Test_internalIssueArchivalImpl_CheckEligibility(t *testing.T) {
+
+	licenseMocked := func(client *mocks.Connector, applications ...*model.LicensedApplicationScheme) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/instance/license", "", nil).
+			Return(&http.Request{Host: "license"}, nil)
+
+		client.On("Call",
+			&http.Request{Host: "license"},
+			&model.IssueArchivalSettingsScheme{}).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*model.IssueArchivalSettingsScheme).Applications = applications
+			}).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	permissionMocked := func(client *mocks.Connector, administer bool) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/mypermissions?permissions=ADMINISTER", "", nil).
+			Return(&http.Request{Host: "permissions"}, nil)
+
+		client.On("Call",
+			&http.Request{Host: "permissions"},
+			&model.MyPermissionsScheme{}).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*model.MyPermissionsScheme).Permissions = map[string]*model.MyPermissionScheme{
+					"ADMINISTER": {Key: "ADMINISTER", HavePermission: administer},
+				}
+			}).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	bulkFetchMocked := func(client *mocks.Connector, issues ...*model.IssueScheme) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPost,
+			"rest/api/3/issue/bulkfetch", "", mock.Anything).
+			Return(&http.Request{Host: "bulkfetch"}, nil)
+
+		client.On("Call",
+			&http.Request{Host: "bulkfetch"},
+			&model.IssueBulkFetchScheme{}).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*model.IssueBulkFetchScheme).Issues = issues
+			}).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	issue := func(id, key, projectType string, subtask bool) *model.IssueScheme {
+		return &model.IssueScheme{ID: id, Key: key, Fields: &model.IssueFieldsScheme{
+			Project:   &model.ProjectScheme{ProjectTypeKey: projectType},
+			IssueType: &model.IssueTypeScheme{Subtask: subtask},
+		}}
+	}
+
+	paidSoftware := &model.LicensedApplicationScheme{ID: "jira-software", Plan: model.LicensePlanPaid}
+	freeServiceDesk := &model.LicensedApplicationScheme{ID: "jira-servicedesk", Plan: model.LicensePlanFree}
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	tests := []struct {
+		name           string
+		fields         fields
+		issueIDsOrKeys []string
+		on             func(*fields)
+		wantEligible   []string
+		wantIneligible *model.IssueArchivalSyncErrorScheme
+		wantErr        bool
+		Err            error
+	}{
+		{
+			name:           "happy path - when the issues are classified",
+			fields:         fields{version: "3"},
+			issueIDsOrKeys: []string{"KP-1", "10002", "kp-3", "DESK-1", "KP-404"},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				licenseMocked(client, paidSoftware, freeServiceDesk)
+				permissionMocked(client, true)
+				bulkFetchMocked(client,
+					issue("10001", "KP-1", "software", false),
+					issue("10002", "KP-2", "software", false),
+					issue("10003", "KP-3", "software", true),
+					issue("10004", "DESK-1", "service_desk", false),
+				)
+
+				fields.c = client
+			},
+			wantEligible: []string{"KP-1", "10002"},
+			wantIneligible: &model.IssueArchivalSyncErrorScheme{
+				IssueIsSubtask: &model.IssueArchivalErrorScheme{
+					Count: 1, IssueIDsOrKeys: []string{"kp-3"}, Message: "The issues are subtasks, archived with their parents.",
+				},
+				IssuesInUnlicensedProjects: &model.IssueArchivalErrorScheme{
+					Count: 1, IssueIDsOrKeys: []string{"DESK-1"}, Message: "The issues are in projects not licensed for archiving.",
+				},
+				IssuesNotFound: &model.IssueArchivalErrorScheme{
+					Count: 1, IssueIDsOrKeys: []string{"KP-404"}, Message: "The issues don't exist, are in archived projects or can't be browsed.",
+				},
+			},
+		},
+		{
+			name:           "happy path - when the user isn't a Jira administrator",
+			fields:         fields{version: "3"},
+			issueIDsOrKeys: []string{"KP-1", "KP-2"},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				licenseMocked(client, paidSoftware)
+				permissionMocked(client, false)
+				bulkFetchMocked(client, issue("10001", "KP-1", "software", false), issue("10002", "KP-2", "software", false))
+
+				fields.c = client
+			},
+			wantIneligible: &model.IssueArchivalSyncErrorScheme{
+				UserDoesNotHavePermission: &model.IssueArchivalErrorScheme{
+					Count: 2, IssueIDsOrKeys: []string{"KP-1", "KP-2"}, Message: "The user doesn't have the Administer Jira permission.",
+				},
+			},
+		},
+		{
+			name:           "fail path - when the license can't be read",
+			fields:         fields{version: "3"},
+			issueIDsOrKeys: []string{"KP-1"},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/instance/license", "", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+		{
+			name:    "fail path - when the issues are not provided",
+			fields:  fields{version: "3"},
+			wantErr: true,
+			Err:     model.ErrNoIssuesSlice,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			if tt.on != nil {
+				tt.on(&tt.fields)
+			}
+
+			archiveService := NewIssueArchivalService(tt.fields.c, tt.fields.version)
+
+			gotEligibility, err := archiveService.CheckEligibility(context.Background(), tt.issueIDsOrKeys)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.Err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantEligible, gotEligibility.Eligible)
+			assert.Equal(t, tt.wantIneligible, gotEligibility.Ineligible)
+		})
+	}
+}
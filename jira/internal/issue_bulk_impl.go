@@ -0,0 +1,331 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+)
+
+// NewIssueBulkService creates a new instance of IssueBulkService.
+func NewIssueBulkService(client service.Connector, version string) (*IssueBulkService, error) {
+
+	if version == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoVersionProvided)
+	}
+
+	return &IssueBulkService{
+		internalClient: &internalIssueBulkImpl{c: client, version: version},
+	}, nil
+}
+
+// IssueBulkService provides methods to edit, transition, watch and move issues in bulk in Jira Cloud.
+//
+// The bulk operations are processed asynchronously by Jira: each one returns the ID of its task, to follow with
+// Progress or Wait. They're much faster than editing the issues one by one, and not rate limited per issue.
+// The issues are created in bulk with the Creates method of the issue service.
+type IssueBulkService struct {
+	// internalClient is the connector interface for bulk issue operations.
+	internalClient jira.IssueBulkConnector
+}
+
+// Fields returns the fields that can be edited in bulk on the issues.
+//
+// The page is filtered by name with the search text, and paginated with the startingAfter and endingBefore cursors.
+//
+// GET /rest/api/3/bulk/issues/fields
+func (i *IssueBulkService) Fields(ctx context.Context, issueIDsOrKeys []string, searchText, startingAfter, endingBefore string) (*model.BulkEditableFieldPageScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Fields(ctx, issueIDsOrKeys, searchText, startingAfter, endingBefore)
+}
+
+// Edit edits the fields of the issues in bulk, and returns the ID of the task of the operation.
+//
+// POST /rest/api/3/bulk/issues/fields
+func (i *IssueBulkService) Edit(ctx context.Context, payload *model.BulkEditPayloadScheme) (string, *model.ResponseScheme, error) {
+	return i.internalClient.Edit(ctx, payload)
+}
+
+// Transitions returns the transitions available on the issues, grouped by workflow.
+//
+// GET /rest/api/3/bulk/issues/transition
+func (i *IssueBulkService) Transitions(ctx context.Context, issueIDsOrKeys []string, startingAfter, endingBefore string) (*model.BulkTransitionPageScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Transitions(ctx, issueIDsOrKeys, startingAfter, endingBefore)
+}
+
+// Transition transitions the issues in bulk, and returns the ID of the task of the operation.
+//
+// POST /rest/api/3/bulk/issues/transition
+func (i *IssueBulkService) Transition(ctx context.Context, payload *model.BulkTransitionPayloadScheme) (string, *model.ResponseScheme, error) {
+	return i.internalClient.Transition(ctx, payload)
+}
+
+// Watch adds the calling user as watcher of the issues in bulk, and returns the ID of the task of the operation.
+//
+// POST /rest/api/3/bulk/issues/watch
+func (i *IssueBulkService) Watch(ctx context.Context, issueIDsOrKeys []string) (string, *model.ResponseScheme, error) {
+	return i.internalClient.Watch(ctx, issueIDsOrKeys)
+}
+
+// Unwatch removes the calling user from the watchers of the issues in bulk, and returns the ID of the task of the operation.
+//
+// POST /rest/api/3/bulk/issues/unwatch
+func (i *IssueBulkService) Unwatch(ctx context.Context, issueIDsOrKeys []string) (string, *model.ResponseScheme, error) {
+	return i.internalClient.Unwatch(ctx, issueIDsOrKeys)
+}
+
+// Move moves the issues in bulk to other projects or issue types, and returns the ID of the task of the operation.
+//
+// POST /rest/api/3/bulk/issues/move
+func (i *IssueBulkService) Move(ctx context.Context, payload *model.BulkMovePayloadScheme) (string, *model.ResponseScheme, error) {
+	return i.internalClient.Move(ctx, payload)
+}
+
+// Progress returns the progress of a bulk operation.
+//
+// GET /rest/api/3/bulk/queue/{taskId}
+func (i *IssueBulkService) Progress(ctx context.Context, taskID string) (*model.BulkOperationProgressScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Progress(ctx, taskID)
+}
+
+// Wait polls a bulk operation until it's finished, calling onProgress with each progress of the operation.
+//
+// It returns model.ErrTaskFailed when the operation is finished without completing, and the context error when
+// ctx is done first. The issues the operation failed on are reported by BulkOperationProgressScheme.Err.
+//
+// Example usage:
+//
+//	taskID, _, err := client.Issue.Bulk.Watch(ctx, []string{"KP-1", "KP-2"})
+//	if err != nil {
+//		return err
+//	}
+//
+//	progress, err := client.Issue.Bulk.Wait(ctx, taskID, nil)
+//	if err != nil {
+//		return err
+//	}
+//
+//	return progress.Err()
+//
+// GET /rest/api/3/bulk/queue/{taskId}
+func (i *IssueBulkService) Wait(ctx context.Context, taskID string, onProgress func(*model.BulkOperationProgressScheme)) (*model.BulkOperationProgressScheme, error) {
+	return i.internalClient.Wait(ctx, taskID, onProgress)
+}
+
+type internalIssueBulkImpl struct {
+	c       service.Connector
+	version string
+
+	// pollInterval is the interval used by Wait to poll the bulk operation.
+	pollInterval time.Duration
+}
+
+func (i *internalIssueBulkImpl) Fields(ctx context.Context, issueIDsOrKeys []string, searchText, startingAfter, endingBefore string) (*model.BulkEditableFieldPageScheme, *model.ResponseScheme, error) {
+
+	if len(issueIDsOrKeys) == 0 {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoIssuesSlice)
+	}
+
+	params := bulkIssueParams(issueIDsOrKeys, startingAfter, endingBefore)
+
+	if searchText != "" {
+		params.Add("searchText", searchText)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/bulk/issues/fields?%v", i.version, params.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.BulkEditableFieldPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalIssueBulkImpl) Edit(ctx context.Context, payload *model.BulkEditPayloadScheme) (string, *model.ResponseScheme, error) {
+
+	if payload == nil || len(payload.SelectedIssueIDsOrKeys) == 0 {
+		return "", nil, fmt.Errorf("jira: %w", model.ErrNoIssuesSlice)
+	}
+
+	if len(payload.SelectedActions) == 0 {
+		return "", nil, fmt.Errorf("jira: %w", model.ErrNoBulkEditActions)
+	}
+
+	return i.submit(ctx, "fields", payload)
+}
+
+func (i *internalIssueBulkImpl) Transitions(ctx context.Context, issueIDsOrKeys []string, startingAfter, endingBefore string) (*model.BulkTransitionPageScheme, *model.ResponseScheme, error) {
+
+	if len(issueIDsOrKeys) == 0 {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoIssuesSlice)
+	}
+
+	params := bulkIssueParams(issueIDsOrKeys, startingAfter, endingBefore)
+	endpoint := fmt.Sprintf("rest/api/%v/bulk/issues/transition?%v", i.version, params.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.BulkTransitionPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalIssueBulkImpl) Transition(ctx context.Context, payload *model.BulkTransitionPayloadScheme) (string, *model.ResponseScheme, error) {
+
+	if payload == nil || len(payload.BulkTransitionInputs) == 0 {
+		return "", nil, fmt.Errorf("jira: %w", model.ErrNoBulkTransitions)
+	}
+
+	for _, input := range payload.BulkTransitionInputs {
+
+		if input == nil || len(input.SelectedIssueIDsOrKeys) == 0 {
+			return "", nil, fmt.Errorf("jira: %w", model.ErrNoIssuesSlice)
+		}
+
+		if input.TransitionID == "" {
+			return "", nil, fmt.Errorf("jira: %w", model.ErrNoTransitionID)
+		}
+	}
+
+	return i.submit(ctx, "transition", payload)
+}
+
+func (i *internalIssueBulkImpl) Watch(ctx context.Context, issueIDsOrKeys []string) (string, *model.ResponseScheme, error) {
+
+	if len(issueIDsOrKeys) == 0 {
+		return "", nil, fmt.Errorf("jira: %w", model.ErrNoIssuesSlice)
+	}
+
+	return i.submit(ctx, "watch", map[string]interface{}{"selectedIssueIdsOrKeys": issueIDsOrKeys})
+}
+
+func (i *internalIssueBulkImpl) Unwatch(ctx context.Context, issueIDsOrKeys []string) (string, *model.ResponseScheme, error) {
+
+	if len(issueIDsOrKeys) == 0 {
+		return "", nil, fmt.Errorf("jira: %w", model.ErrNoIssuesSlice)
+	}
+
+	return i.submit(ctx, "unwatch", map[string]interface{}{"selectedIssueIdsOrKeys": issueIDsOrKeys})
+}
+
+func (i *internalIssueBulkImpl) Move(ctx context.Context, payload *model.BulkMovePayloadScheme) (string, *model.ResponseScheme, error) {
+
+	if payload == nil || len(payload.TargetToSourcesMapping) == 0 {
+		return "", nil, fmt.Errorf("jira: %w", model.ErrNoBulkMoveTargets)
+	}
+
+	for _, target := range payload.TargetToSourcesMapping {
+		if target == nil || len(target.IssueIDsOrKeys) == 0 {
+			return "", nil, fmt.Errorf("jira: %w", model.ErrNoIssuesSlice)
+		}
+	}
+
+	return i.submit(ctx, "move", payload)
+}
+
+func (i *internalIssueBulkImpl) Progress(ctx context.Context, taskID string) (*model.BulkOperationProgressScheme, *model.ResponseScheme, error) {
+
+	if taskID == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoTaskID)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/bulk/queue/%v", i.version, taskID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	progress := new(model.BulkOperationProgressScheme)
+	response, err := i.c.Call(request, progress)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return progress, response, nil
+}
+
+func (i *internalIssueBulkImpl) Wait(ctx context.Context, taskID string, onProgress func(*model.BulkOperationProgressScheme)) (*model.BulkOperationProgressScheme, error) {
+
+	if taskID == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoTaskID)
+	}
+
+	var progress *model.BulkOperationProgressScheme
+
+	err := pollUntilDone(ctx, i.pollInterval, func() (bool, error) {
+
+		var err error
+		progress, _, err = i.Progress(ctx, taskID)
+		if err != nil {
+			return false, err
+		}
+
+		if onProgress != nil {
+			onProgress(progress)
+		}
+
+		if progress.Done() && progress.Status != model.BulkOperationStatusComplete {
+			return true, fmt.Errorf("jira: %w: %v", model.ErrTaskFailed, progress.Status)
+		}
+
+		return progress.Done(), nil
+	})
+
+	return progress, err
+}
+
+// submit submits a bulk operation and returns the ID of its task.
+func (i *internalIssueBulkImpl) submit(ctx context.Context, operation string, payload interface{}) (string, *model.ResponseScheme, error) {
+
+	endpoint := fmt.Sprintf("rest/api/%v/bulk/issues/%v", i.version, operation)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return "", nil, err
+	}
+
+	task := new(model.BulkOperationTaskScheme)
+	response, err := i.c.Call(request, task)
+	if err != nil {
+		return "", response, err
+	}
+
+	return task.TaskID, response, nil
+}
+
+// bulkIssueParams returns the query parameters selecting the issues of a bulk operation and the page of its options.
+func bulkIssueParams(issueIDsOrKeys []string, startingAfter, endingBefore string) url.Values {
+
+	params := url.Values{}
+	params.Add("issueIdsOrKeys", strings.Join(issueIDsOrKeys, ","))
+
+	if startingAfter != "" {
+		params.Add("startingAfter", startingAfter)
+	}
+
+	if endingBefore != "" {
+		params.Add("endingBefore", endingBefore)
+	}
+
+	return params
+}
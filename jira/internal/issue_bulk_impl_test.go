@@ -0,0 +1,421 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func Test_internalIssueBulkImpl_Fields(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx                         context.Context
+		issueIDsOrKeys              []string
+		searchText                  string
+		startingAfter, endingBefore string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the parameters are correct",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				issueIDsOrKeys: []string{"KP-1", "KP-2"},
+				searchText:     "label",
+				startingAfter:  "cursor",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/bulk/issues/fields?issueIdsOrKeys=KP-1%2CKP-2&searchText=label&startingAfter=cursor",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.BulkEditableFieldPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the issues are not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssuesSlice,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				issueIDsOrKeys: []string{"KP-1"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/bulk/issues/fields?issueIdsOrKeys=KP-1",
+					"",
+					nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewIssueBulkService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := newService.Fields(testCase.args.ctx, testCase.args.issueIDsOrKeys,
+				testCase.args.searchText, testCase.args.startingAfter, testCase.args.endingBefore)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+		})
+	}
+}
+
+func Test_internalIssueBulkImpl_Edit(t *testing.T) {
+
+	payload := &model.BulkEditPayloadScheme{
+		SelectedIssueIDsOrKeys: []string{"KP-1", "KP-2"},
+		SelectedActions:        []string{"labels"},
+		EditedFieldsInput: map[string]interface{}{
+			"labelsFields": []map[string]interface{}{
+				{
+					"fieldId":                        "labels",
+					"bulkEditMultiSelectFieldOption": model.BulkEditMultiSelectAdd,
+					"labels":                         []map[string]string{{"name": "backend"}},
+				},
+			},
+		},
+	}
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx     context.Context
+		payload *model.BulkEditPayloadScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    string
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the parameters are correct",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				payload: payload,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/bulk/issues/fields",
+					"",
+					payload).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					mock.Anything).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.BulkOperationTaskScheme).TaskID = "10641"
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			want: "10641",
+		},
+
+		{
+			name:   "when the issues are not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				payload: &model.BulkEditPayloadScheme{SelectedActions: []string{"labels"}},
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssuesSlice,
+		},
+
+		{
+			name:   "when the actions are not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				payload: &model.BulkEditPayloadScheme{SelectedIssueIDsOrKeys: []string{"KP-1"}},
+			},
+			wantErr: true,
+			Err:     model.ErrNoBulkEditActions,
+		},
+
+		{
+			name:   "when the http call cannot be executed",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				payload: payload,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/bulk/issues/fields",
+					"",
+					payload).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.BulkOperationTaskScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrBadRequest)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrBadRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewIssueBulkService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			taskID, _, err := newService.Edit(testCase.args.ctx, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.Equal(t, testCase.want, taskID)
+			}
+		})
+	}
+}
+
+func Test_internalIssueBulkImpl_Submit(t *testing.T) {
+
+	client := mocks.NewConnector(t)
+
+	submissions := map[string]interface{}{
+		"transition": &model.BulkTransitionPayloadScheme{
+			BulkTransitionInputs: []*model.BulkTransitionInputScheme{{SelectedIssueIDsOrKeys: []string{"KP-1"}, TransitionID: "11"}},
+		},
+		"watch":   map[string]interface{}{"selectedIssueIdsOrKeys": []string{"KP-1"}},
+		"unwatch": map[string]interface{}{"selectedIssueIdsOrKeys": []string{"KP-1"}},
+		"move": &model.BulkMovePayloadScheme{
+			TargetToSourcesMapping: map[string]*model.BulkMoveTargetScheme{
+				model.BulkMoveTargetKey("PROJ", "10001", ""): {IssueIDsOrKeys: []string{"KP-1"}, InferFieldDefaults: true},
+			},
+		},
+	}
+
+	for operation, payload := range submissions {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPost,
+			"rest/api/3/bulk/issues/"+operation,
+			"",
+			payload).
+			Return(&http.Request{RequestURI: operation}, nil)
+
+		client.On("Call",
+			&http.Request{RequestURI: operation},
+			mock.Anything).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*model.BulkOperationTaskScheme).TaskID = operation
+			}).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	newService, err := NewIssueBulkService(client, "3")
+	assert.NoError(t, err)
+
+	taskID, _, err := newService.Transition(context.Background(), submissions["transition"].(*model.BulkTransitionPayloadScheme))
+	assert.NoError(t, err)
+	assert.Equal(t, "transition", taskID)
+
+	taskID, _, err = newService.Watch(context.Background(), []string{"KP-1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "watch", taskID)
+
+	taskID, _, err = newService.Unwatch(context.Background(), []string{"KP-1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "unwatch", taskID)
+
+	taskID, _, err = newService.Move(context.Background(), submissions["move"].(*model.BulkMovePayloadScheme))
+	assert.NoError(t, err)
+	assert.Equal(t, "move", taskID)
+
+	_, _, err = newService.Transition(context.Background(), &model.BulkTransitionPayloadScheme{
+		BulkTransitionInputs: []*model.BulkTransitionInputScheme{{SelectedIssueIDsOrKeys: []string{"KP-1"}}},
+	})
+	assert.ErrorIs(t, err, model.ErrNoTransitionID)
+
+	_, _, err = newService.Move(context.Background(), &model.BulkMovePayloadScheme{})
+	assert.ErrorIs(t, err, model.ErrNoBulkMoveTargets)
+
+	_, _, err = newService.Watch(context.Background(), nil)
+	assert.ErrorIs(t, err, model.ErrNoIssuesSlice)
+}
+
+func Test_internalIssueBulkImpl_Wait(t *testing.T) {
+
+	newClient := func(t *testing.T, statuses ...string) *mocks.Connector {
+
+		client := mocks.NewConnector(t)
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/bulk/queue/10641",
+			"",
+			nil).
+			Return(&http.Request{}, nil)
+
+		for _, status := range statuses {
+
+			client.On("Call",
+				&http.Request{},
+				mock.Anything).
+				Run(func(args mock.Arguments) {
+					progress := args.Get(1).(*model.BulkOperationProgressScheme)
+					progress.TaskID = "10641"
+					progress.Status = status
+
+					if status == model.BulkOperationStatusComplete {
+						progress.ProgressPercent = 100
+						progress.ProcessedAccessibleIssues = []int{10001}
+						progress.FailedAccessibleIssues = map[string][]string{"10002": {"The issue is locked."}}
+					}
+				}).
+				Return(&model.ResponseScheme{}, nil).
+				Once()
+		}
+
+		return client
+	}
+
+	t.Run("when the operation is complete", func(t *testing.T) {
+
+		bulk := &internalIssueBulkImpl{
+			c:            newClient(t, model.BulkOperationStatusEnqueued, model.BulkOperationStatusRunning, model.BulkOperationStatusComplete),
+			version:      "3",
+			pollInterval: time.Millisecond,
+		}
+
+		var statuses []string
+		progress, err := bulk.Wait(context.Background(), "10641", func(progress *model.BulkOperationProgressScheme) {
+			statuses = append(statuses, progress.Status)
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{model.BulkOperationStatusEnqueued, model.BulkOperationStatusRunning, model.BulkOperationStatusComplete}, statuses)
+		assert.Equal(t, 100, progress.ProgressPercent)
+		assert.EqualError(t, progress.Err(), "issue 10002: The issue is locked.")
+	})
+
+	t.Run("when the operation failed", func(t *testing.T) {
+
+		bulk := &internalIssueBulkImpl{c: newClient(t, model.BulkOperationStatusFailed), version: "3", pollInterval: time.Millisecond}
+
+		progress, err := bulk.Wait(context.Background(), "10641", nil)
+		assert.ErrorIs(t, err, model.ErrTaskFailed)
+		assert.Equal(t, model.BulkOperationStatusFailed, progress.Status)
+	})
+
+	t.Run("when the task id is not provided", func(t *testing.T) {
+
+		_, err := (&internalIssueBulkImpl{version: "3"}).Wait(context.Background(), "", nil)
+		assert.ErrorIs(t, err, model.ErrNoTaskID)
+	})
+}
@@ -2,9 +2,12 @@ package internal
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
+	"path"
+	"strings"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
@@ -48,6 +51,8 @@ type IssueServices struct {
 	WorklogRichText *WorklogRichTextService
 	// Property is the service for managing issue properties.
 	Property *IssuePropertyService
+	// Bulk is the service for managing the bulk operations on issues, REST API v3 only.
+	Bulk *IssueBulkService
 }
 
 // NewIssueService creates new instances of IssueRichTextService and IssueADFService.
@@ -89,6 +94,7 @@ func NewIssueService(client service.Connector, version string, services *IssueSe
 		adfService.Watcher = services.Watcher
 		adfService.Worklog = services.WorklogAdf
 		adfService.Property = services.Property
+		adfService.Bulk = services.Bulk
 
 		richTextService.Comment = services.CommentRT
 		richTextService.Attachment = services.Attachment
@@ -110,6 +116,9 @@ func NewIssueService(client service.Connector, version string, services *IssueSe
 	return richTextService, adfService, nil
 }
 
+// issueRedactionPageSize is the page size used by Redact to walk the comments of the issue.
+const issueRedactionPageSize = 50
+
 // -------------------------------------------
 // These private functions are used on the Issue Services implementation, as that services is segmented in the ADF and Rich Text
 // format, in order to avoid duplication, those function are injected on the ADF/Rich Text implementations.
@@ -192,3 +201,520 @@ func getTransitions(ctx context.Context, client service.Connector, version, issu
 
 	return transitions, response, nil
 }
+
+func transitionIssueTo(ctx context.Context, client service.Connector, version, issueKeyOrID, status string, options *model.IssueTransitionToOptionsScheme) (
+	*model.IssueTransitionToScheme, error) {
+
+	if issueKeyOrID == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoIssueKeyOrID)
+	}
+
+	if status == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoTargetStatus)
+	}
+
+	if options == nil {
+		options = new(model.IssueTransitionToOptionsScheme)
+	}
+
+	maxSteps := options.MaxSteps
+	if maxSteps <= 0 {
+		maxSteps = model.IssueTransitionToMaxSteps
+	}
+
+	current, err := getIssueStatus(ctx, client, version, issueKeyOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &model.IssueTransitionToScheme{IssueKeyOrID: issueKeyOrID, From: current, To: current}
+
+	for step := 0; !strings.EqualFold(current, status); step++ {
+
+		if step == maxSteps {
+			return result, fmt.Errorf("jira: %w (%v)", model.ErrTransitionLimitReached, maxSteps)
+		}
+
+		next, err := nextIssueStatus(current, status, options)
+		if err != nil {
+			return result, err
+		}
+
+		transitions, _, err := getTransitions(ctx, client, version, issueKeyOrID)
+		if err != nil {
+			return result, err
+		}
+
+		var transition *model.IssueTransitionScheme
+		for _, candidate := range transitions.Transitions {
+			if candidate.To != nil && strings.EqualFold(candidate.To.Name, next) {
+				transition = candidate
+				break
+			}
+		}
+
+		if transition == nil {
+			return result, fmt.Errorf("jira: %w (%v -> %v)", model.ErrNoTransitionToStatus, current, next)
+		}
+
+		if err = moveIssue(ctx, client, version, issueKeyOrID, transition.ID); err != nil {
+			return result, err
+		}
+
+		result.Transitions = append(result.Transitions, transition)
+
+		// The post functions of the transition can set another status, the issue is read again
+		if current, err = getIssueStatus(ctx, client, version, issueKeyOrID); err != nil {
+			return result, err
+		}
+
+		result.To = current
+	}
+
+	return result, nil
+}
+
+// nextIssueStatus returns the next status visited from the current status to reach the target status.
+func nextIssueStatus(current, status string, options *model.IssueTransitionToOptionsScheme) (string, error) {
+
+	if options.Workflow != nil {
+		return nextWorkflowStatus(current, status, options.Workflow)
+	}
+
+	// The path is resumed after the current status, or from its beginning when the issue isn't on the path
+	route := append(append([]string{}, options.Path...), status)
+	for index := len(route) - 2; index >= 0; index-- {
+		if strings.EqualFold(route[index], current) {
+			return route[index+1], nil
+		}
+	}
+
+	return route[0], nil
+}
+
+// nextWorkflowStatus returns the first status of the shortest route of the workflow graph from the current status to the target status.
+func nextWorkflowStatus(current, status string, workflow map[string][]string) (string, error) {
+
+	// The status names are compared without case
+	graph := make(map[string][]string, len(workflow))
+	for from, statuses := range workflow {
+		graph[strings.ToLower(from)] = statuses
+	}
+
+	origin := strings.ToLower(current)
+	previous := map[string]string{origin: ""}
+	queue := []string{origin}
+
+	for len(queue) != 0 {
+
+		node := queue[0]
+		queue = queue[1:]
+
+		for _, candidate := range graph[node] {
+
+			key := strings.ToLower(candidate)
+			if _, visited := previous[key]; visited {
+				continue
+			}
+
+			previous[key] = node
+
+			if key != strings.ToLower(status) {
+				queue = append(queue, key)
+				continue
+			}
+
+			// Walk the route back to the status following the current status
+			next := candidate
+			for hop := previous[key]; hop != origin; hop = previous[hop] {
+				next = hop
+			}
+
+			return next, nil
+		}
+	}
+
+	return "", fmt.Errorf("jira: %w (%v -> %v)", model.ErrNoStatusRoute, current, status)
+}
+
+func getIssueStatus(ctx context.Context, client service.Connector, version, issueKeyOrID string) (string, error) {
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/%v?fields=%v", version, issueKeyOrID, model.IssueFieldStatus)
+
+	request, err := client.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return "", err
+	}
+
+	issue := new(model.IssueScheme)
+	if _, err = client.Call(request, issue); err != nil {
+		return "", err
+	}
+
+	if issue.Fields == nil || issue.Fields.Status == nil {
+		return "", nil
+	}
+
+	return issue.Fields.Status.Name, nil
+}
+
+func moveIssue(ctx context.Context, client service.Connector, version, issueKeyOrID, transitionID string) error {
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/transitions", version, issueKeyOrID)
+
+	payload := map[string]interface{}{"transition": map[string]interface{}{"id": transitionID}}
+
+	request, err := client.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Call(request, nil)
+	return err
+}
+
+// subtaskParentScheme represents the parent of a subtask, with the raw values of its fields, custom fields included.
+type subtaskParentScheme struct {
+	Key    string                 `json:"key"`
+	Fields map[string]interface{} `json:"fields"`
+}
+
+// createSubtask creates a subtask of the parent, payload being an *IssueScheme or an *IssueSchemeV2.
+func createSubtask(ctx context.Context, client service.Connector, version, parentKey string, payload interface{}, inheritFields []string) (
+	*model.IssueResponseScheme, *model.ResponseScheme, error) {
+
+	if parentKey == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoIssueKeyOrID)
+	}
+
+	for _, field := range inheritFields {
+		if model.IsIssueFieldReadOnly(field) {
+			return nil, nil, fmt.Errorf("jira: %w: %v", model.ErrIssueFieldReadOnly, field)
+		}
+	}
+
+	params := url.Values{}
+	params.Add("fields", strings.Join(append([]string{model.IssueFieldProject}, inheritFields...), ","))
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/%v?%v", version, parentKey, params.Encode())
+
+	request, err := client.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	parent := new(subtaskParentScheme)
+	response, err := client.Call(request, parent)
+	if err != nil {
+		return nil, response, err
+	}
+
+	project, _ := parent.Fields[model.IssueFieldProject].(map[string]interface{})
+	projectID, _ := project["id"].(string)
+	if projectID == "" {
+		return nil, response, fmt.Errorf("jira: %w", model.ErrNoProjectIDOrKey)
+	}
+
+	// The payload is sent as a map, so the inherited custom fields aren't dropped by the issue models
+	payloadAsBytes, err := json.Marshal(payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var body struct {
+		Fields map[string]interface{} `json:"fields"`
+	}
+
+	if err := json.Unmarshal(payloadAsBytes, &body); err != nil {
+		return nil, nil, err
+	}
+
+	if body.Fields == nil {
+		body.Fields = make(map[string]interface{})
+	}
+
+	for _, field := range inheritFields {
+
+		value, ok := parent.Fields[field]
+		if _, set := body.Fields[field]; set || !ok || value == nil {
+			continue
+		}
+
+		body.Fields[field] = model.IssueFieldReference(value)
+	}
+
+	body.Fields[model.IssueFieldProject] = map[string]interface{}{"id": projectID}
+	body.Fields[model.IssueFieldParent] = map[string]interface{}{"key": parentKey}
+
+	if _, ok := body.Fields[model.IssueFieldIssueType]; !ok {
+
+		project, response, err := (&internalProjectImpl{c: client, version: version}).Get(ctx, projectID, nil)
+		if err != nil {
+			return nil, response, err
+		}
+
+		var issueTypeID string
+		for _, issueType := range project.IssueTypes {
+			if issueType != nil && issueType.Subtask {
+				issueTypeID = issueType.ID
+				break
+			}
+		}
+
+		if issueTypeID == "" {
+			return nil, response, fmt.Errorf("jira: %w: %v", model.ErrNoSubtaskIssueType, projectID)
+		}
+
+		body.Fields[model.IssueFieldIssueType] = map[string]interface{}{"id": issueTypeID}
+	}
+
+	endpoint = fmt.Sprintf("rest/api/%v/issue", version)
+
+	request, err = client.NewRequest(ctx, http.MethodPost, endpoint, "", body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issue := new(model.IssueResponseScheme)
+	response, err = client.Call(request, issue)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return issue, response, nil
+}
+
+// splitIssue creates a new issue from the payload, being an *IssueScheme or an *IssueSchemeV2, and moves the items
+// of the options from the original issue to the new issue.
+func splitIssue(ctx context.Context, client service.Connector, version, issueKeyOrID string, payload interface{}, options *model.IssueSplitOptionsScheme) (
+	*model.IssueSplitScheme, error) {
+
+	if issueKeyOrID == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoIssueKeyOrID)
+	}
+
+	if options == nil {
+		options = new(model.IssueSplitOptionsScheme)
+	}
+
+	request, err := client.NewRequest(ctx, http.MethodPost, fmt.Sprintf("rest/api/%v/issue", version), "", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	issue := new(model.IssueResponseScheme)
+	if _, err = client.Call(request, issue); err != nil {
+		return nil, err
+	}
+
+	split := &model.IssueSplitScheme{
+		IssueKeyOrID: issueKeyOrID,
+		Issue:        issue,
+		Comments:     make(map[string]string),
+		Attachments:  make(map[string]string),
+		Links:        make(map[string]string),
+	}
+
+	fail := func(item, id string, err error) {
+		split.Failures = append(split.Failures, &model.IssueSplitFailureScheme{Item: item, ID: id, Err: err})
+	}
+
+	for _, commentID := range options.CommentIDs {
+
+		copyID, err := copyIssueComment(ctx, client, version, issueKeyOrID, issue.Key, commentID)
+		if err != nil {
+			fail(model.IssueSplitItemComment, commentID, err)
+			continue
+		}
+
+		split.Comments[commentID] = copyID
+	}
+
+	attachments := &internalIssueAttachmentServiceImpl{c: client, version: version}
+	for _, attachmentID := range options.AttachmentIDs {
+
+		copyID, err := copyIssueAttachment(ctx, attachments, issue.Key, attachmentID)
+		if err != nil {
+			fail(model.IssueSplitItemAttachment, attachmentID, err)
+			continue
+		}
+
+		split.Attachments[attachmentID] = copyID
+
+		if options.RemoveOriginals {
+			if _, err = attachments.Delete(ctx, attachmentID); err != nil {
+				fail(model.IssueSplitItemAttachment, attachmentID, err)
+			}
+		}
+	}
+
+	links := &internalLinkADFServiceImpl{c: client, version: version}
+	for _, linkID := range options.LinkIDs {
+
+		link, _, err := links.Get(ctx, linkID)
+		if err != nil {
+			fail(model.IssueSplitItemLink, linkID, err)
+			continue
+		}
+
+		if link.Type == nil || link.InwardIssue == nil || link.OutwardIssue == nil {
+			fail(model.IssueSplitItemLink, linkID, fmt.Errorf("jira: %w: %v", model.ErrIssueLinkNotOnIssue, issueKeyOrID))
+			continue
+		}
+
+		payload := &model.LinkPayloadSchemeV3{Type: &model.LinkTypeScheme{ID: link.Type.ID, Name: link.Type.Name}}
+
+		switch {
+		case isLinkedIssue(link.InwardIssue, issueKeyOrID):
+			payload.InwardIssue = &model.LinkedIssueScheme{Key: issue.Key}
+			payload.OutwardIssue = &model.LinkedIssueScheme{Key: link.OutwardIssue.Key}
+		case isLinkedIssue(link.OutwardIssue, issueKeyOrID):
+			payload.InwardIssue = &model.LinkedIssueScheme{Key: link.InwardIssue.Key}
+			payload.OutwardIssue = &model.LinkedIssueScheme{Key: issue.Key}
+		default:
+			fail(model.IssueSplitItemLink, linkID, fmt.Errorf("jira: %w: %v", model.ErrIssueLinkNotOnIssue, issueKeyOrID))
+			continue
+		}
+
+		response, err := links.Create(ctx, payload)
+		if err != nil {
+			fail(model.IssueSplitItemLink, linkID, err)
+			continue
+		}
+
+		split.Links[linkID] = createdLinkID(response)
+
+		if options.RemoveOriginals {
+			if _, err = links.Delete(ctx, linkID); err != nil {
+				fail(model.IssueSplitItemLink, linkID, err)
+			}
+		}
+	}
+
+	for _, subtaskKey := range options.SubtaskKeys {
+
+		body := map[string]interface{}{
+			"fields": map[string]interface{}{model.IssueFieldParent: map[string]interface{}{"key": issue.Key}},
+		}
+
+		request, err := client.NewRequest(ctx, http.MethodPut, fmt.Sprintf("rest/api/%v/issue/%v", version, subtaskKey), "", body)
+		if err != nil {
+			fail(model.IssueSplitItemSubtask, subtaskKey, err)
+			continue
+		}
+
+		if _, err = client.Call(request, nil); err != nil {
+			fail(model.IssueSplitItemSubtask, subtaskKey, err)
+			continue
+		}
+
+		split.Subtasks = append(split.Subtasks, subtaskKey)
+	}
+
+	if options.LinkType != "" {
+
+		payload := &model.LinkPayloadSchemeV3{
+			Type:         &model.LinkTypeScheme{Name: options.LinkType},
+			InwardIssue:  &model.LinkedIssueScheme{Key: issueKeyOrID},
+			OutwardIssue: &model.LinkedIssueScheme{Key: issue.Key},
+		}
+
+		if _, err = links.Create(ctx, payload); err != nil {
+			fail(model.IssueSplitItemLink, options.LinkType, err)
+		}
+	}
+
+	return split, nil
+}
+
+// copyIssueAttachment streams an attachment to another issue, and returns the ID of the copy.
+// It returns ErrNoAttachmentUploaded when the upload response doesn't include the copy.
+func copyIssueAttachment(ctx context.Context, attachments *internalIssueAttachmentServiceImpl, targetKeyOrID, attachmentID string) (string, error) {
+
+	metadata, _, err := attachments.Metadata(ctx, attachmentID)
+	if err != nil {
+		return "", err
+	}
+
+	content, err := attachments.DownloadStream(ctx, attachmentID, true)
+	if err != nil {
+		return "", err
+	}
+
+	defer content.Close()
+
+	// The size is unknown when the metadata doesn't return it, the upload is then sent chunked
+	size := int64(-1)
+	if metadata.Size > 0 {
+		size = int64(metadata.Size)
+	}
+
+	uploads, _, err := attachments.AddFromReader(ctx, targetKeyOrID, metadata.Filename, size, content.Reader())
+	if err != nil {
+		return "", err
+	}
+
+	// The original is kept when nothing was uploaded, so the attachment isn't lost
+	if len(uploads) == 0 || uploads[0] == nil || uploads[0].ID == "" {
+		return "", fmt.Errorf("jira: %w", model.ErrNoAttachmentUploaded)
+	}
+
+	return uploads[0].ID, nil
+}
+
+// copyIssueComment copies the body and the visibility of a comment to another issue, and returns the ID of the copy.
+// The comment is read as raw JSON, so the body is copied as-is whatever its format.
+func copyIssueComment(ctx context.Context, client service.Connector, version, issueKeyOrID, targetKeyOrID, commentID string) (string, error) {
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/comment/%v", version, issueKeyOrID, commentID)
+
+	request, err := client.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return "", err
+	}
+
+	comment := make(map[string]interface{})
+	if _, err = client.Call(request, &comment); err != nil {
+		return "", err
+	}
+
+	body := map[string]interface{}{"body": comment["body"]}
+	if visibility, ok := comment["visibility"]; ok {
+		body["visibility"] = visibility
+	}
+
+	endpoint = fmt.Sprintf("rest/api/%v/issue/%v/comment", version, targetKeyOrID)
+
+	request, err = client.NewRequest(ctx, http.MethodPost, endpoint, "", body)
+	if err != nil {
+		return "", err
+	}
+
+	created := make(map[string]interface{})
+	if _, err = client.Call(request, &created); err != nil {
+		return "", err
+	}
+
+	createdID, _ := created["id"].(string)
+	return createdID, nil
+}
+
+// isLinkedIssue reports whether the linked issue is the issue identified by its key or ID.
+func isLinkedIssue(linked *model.LinkedIssueScheme, issueKeyOrID string) bool {
+	return linked.ID == issueKeyOrID || strings.EqualFold(linked.Key, issueKeyOrID)
+}
+
+// createdLinkID returns the ID of the issue link created, read from the location returned by Jira.
+func createdLinkID(response *model.ResponseScheme) string {
+
+	if response == nil || response.Response == nil {
+		return ""
+	}
+
+	location := response.Response.Header.Get("Location")
+	if location == "" {
+		return ""
+	}
+
+	return path.Base(location)
+}
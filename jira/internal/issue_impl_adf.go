@@ -46,6 +46,8 @@ type IssueADFService struct {
 	Worklog *WorklogADFService
 	// Property is the service for managing issue properties.
 	Property *IssuePropertyService
+	// Bulk is the service for managing the bulk operations on issues.
+	Bulk *IssueBulkService
 }
 
 // Delete deletes an issue.
@@ -125,6 +127,21 @@ func (i *IssueADFService) Creates(ctx context.Context, payload []*model.IssueBul
 	return i.internalClient.Creates(ctx, payload)
 }
 
+// CreateSubtask creates a subtask of the parent issue, in the project of the parent.
+//
+// When the payload has no issue type, the first subtask issue type of the project is used.
+//
+// The inheritFields, e.g. priority, components or a custom field ID, are copied from the parent when the payload doesn't set them.
+//
+// GET /rest/api/{2-3}/issue/{issueKeyOrID}
+//
+// GET /rest/api/{2-3}/project/{projectKeyOrID}
+//
+// POST /rest/api/{2-3}/issue
+func (i *IssueADFService) CreateSubtask(ctx context.Context, parentKey string, payload *model.IssueScheme, inheritFields []string) (*model.IssueResponseScheme, *model.ResponseScheme, error) {
+	return i.internalClient.CreateSubtask(ctx, parentKey, payload, inheritFields)
+}
+
 // Get returns the details for an issue.
 //
 // The issue is identified by its ID or key, however, if the identifier doesn't match an issue, a case-insensitive search
@@ -140,6 +157,13 @@ func (i *IssueADFService) Get(ctx context.Context, issueKeyOrID string, fields,
 	return i.internalClient.Get(ctx, issueKeyOrID, fields, expand)
 }
 
+// GetIfExists returns the issue, and false instead of an error when the issue doesn't exist.
+//
+// GET /rest/api/{2-3}/issue/{issueKeyOrID}
+func (i *IssueADFService) GetIfExists(ctx context.Context, issueKeyOrID string, fields, expand []string) (*model.IssueScheme, bool, error) {
+	return i.internalClient.GetIfExists(ctx, issueKeyOrID, fields, expand)
+}
+
 // Update edits an issue.
 //
 // Edits an issue. A transition may be applied and issue properties updated as part of the edit.
@@ -164,6 +188,51 @@ func (i *IssueADFService) Move(ctx context.Context, issueKeyOrID, transitionID s
 	return i.internalClient.Move(ctx, issueKeyOrID, transitionID, options)
 }
 
+// Redact rewrites the description and the comments of an issue, replacing the matches of the patterns.
+//
+// Only the description and the comments with matches are updated, and the watchers are notified of the updates only
+// with the NotifyUsers option.
+//
+// With the dry-run option, the number of matches is reported and the issue is left untouched.
+//
+// PUT /rest/api/{2-3}/issue/{issueKeyOrID}
+//
+// PUT /rest/api/{2-3}/issue/{issueKeyOrID}/comment/{commentID}
+func (i *IssueADFService) Redact(ctx context.Context, issueKeyOrID string, options *model.IssueRedactionOptionsScheme) (*model.IssueRedactionScheme, error) {
+	return i.internalClient.Redact(ctx, issueKeyOrID, options)
+}
+
+// Split creates a new issue from the payload and moves the selected items of the issue to the new issue.
+//
+// The comments are copied, the attachments are re-uploaded, the issue links are recreated on the new issue
+// and the subtasks are moved under the new issue. The items that can't be moved are reported without stopping the split.
+//
+// POST /rest/api/{2-3}/issue
+//
+// POST /rest/api/{2-3}/issue/{issueKeyOrID}/comment
+//
+// POST /rest/api/{2-3}/issue/{issueKeyOrID}/attachments
+//
+// POST /rest/api/{2-3}/issueLink
+//
+// PUT /rest/api/{2-3}/issue/{issueKeyOrID}
+func (i *IssueADFService) Split(ctx context.Context, issueKeyOrID string, payload *model.IssueScheme, options *model.IssueSplitOptionsScheme) (*model.IssueSplitScheme, error) {
+	return i.internalClient.Split(ctx, issueKeyOrID, payload, options)
+}
+
+// TransitionTo transitions an issue until it reaches the target status, comparing the status names without case.
+//
+// The next status is the target status, the next status of the path or the first status of the shortest route of the workflow graph.
+//
+// The transitions stop with an error when no transition leads to the next status or the maximum number of transitions is reached.
+//
+// GET /rest/api/{2-3}/issue/{issueKeyOrID}/transitions
+//
+// POST /rest/api/{2-3}/issue/{issueKeyOrID}/transitions
+func (i *IssueADFService) TransitionTo(ctx context.Context, issueKeyOrID, status string, options *model.IssueTransitionToOptionsScheme) (*model.IssueTransitionToScheme, error) {
+	return i.internalClient.TransitionTo(ctx, issueKeyOrID, status, options)
+}
+
 type internalIssueADFServiceImpl struct {
 	c       service.Connector
 	version string
@@ -185,6 +254,10 @@ func (i *internalIssueADFServiceImpl) Transitions(ctx context.Context, issueKeyO
 	return getTransitions(ctx, i.c, i.version, issueKeyOrID)
 }
 
+func (i *internalIssueADFServiceImpl) TransitionTo(ctx context.Context, issueKeyOrID, status string, options *model.IssueTransitionToOptionsScheme) (*model.IssueTransitionToScheme, error) {
+	return transitionIssueTo(ctx, i.c, i.version, issueKeyOrID, status, options)
+}
+
 func (i *internalIssueADFServiceImpl) Create(ctx context.Context, payload *model.IssueScheme, customFields *model.CustomFields) (*model.IssueResponseScheme, *model.ResponseScheme, error) {
 	var body interface{} = payload
 	var err error
@@ -217,6 +290,10 @@ func (i *internalIssueADFServiceImpl) Create(ctx context.Context, payload *model
 	return issue, response, nil
 }
 
+func (i *internalIssueADFServiceImpl) CreateSubtask(ctx context.Context, parentKey string, payload *model.IssueScheme, inheritFields []string) (*model.IssueResponseScheme, *model.ResponseScheme, error) {
+	return createSubtask(ctx, i.c, i.version, parentKey, payload, inheritFields)
+}
+
 func (i *internalIssueADFServiceImpl) Creates(ctx context.Context, payload []*model.IssueBulkSchemeV3) (*model.IssueBulkResponseScheme, *model.ResponseScheme, error) {
 
 	if len(payload) == 0 {
@@ -291,6 +368,16 @@ func (i *internalIssueADFServiceImpl) Get(ctx context.Context, issueKeyOrID stri
 	return issue, response, nil
 }
 
+func (i *internalIssueADFServiceImpl) GetIfExists(ctx context.Context, issueKeyOrID string, fields, expand []string) (*model.IssueScheme, bool, error) {
+
+	issue, _, err := i.Get(ctx, issueKeyOrID, fields, expand)
+	if err != nil {
+		return nil, false, ignoreNotFound(err)
+	}
+
+	return issue, true, nil
+}
+
 func (i *internalIssueADFServiceImpl) Update(ctx context.Context, issueKeyOrID string, notify bool, payload *model.IssueScheme, customFields *model.CustomFields, operations *model.UpdateOperations) (*model.ResponseScheme, error) {
 
 	if issueKeyOrID == "" {
@@ -405,3 +492,83 @@ func (i *internalIssueADFServiceImpl) Move(ctx context.Context, issueKeyOrID, tr
 
 	return i.c.Call(request, nil)
 }
+
+func (i *internalIssueADFServiceImpl) Redact(ctx context.Context, issueKeyOrID string, options *model.IssueRedactionOptionsScheme) (*model.IssueRedactionScheme, error) {
+
+	if issueKeyOrID == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoIssueKeyOrID)
+	}
+
+	if options == nil || len(options.Patterns) == 0 {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoRedactionPatterns)
+	}
+
+	redaction := &model.IssueRedactionScheme{IssueKeyOrID: issueKeyOrID, DryRun: options.DryRun}
+
+	issue, _, err := i.Get(ctx, issueKeyOrID, []string{model.IssueFieldDescription}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if issue.Fields != nil && issue.Fields.Description != nil {
+
+		if matches := options.RedactNode(issue.Fields.Description); matches != 0 {
+
+			change := &model.IssueRedactionChangeScheme{Field: model.IssueRedactionFieldDescription, Matches: matches}
+			redaction.Redactions = append(redaction.Redactions, change)
+
+			if !options.DryRun {
+
+				payload := &model.IssueScheme{Fields: &model.IssueFieldsScheme{Description: issue.Fields.Description}}
+				if _, err = i.Update(ctx, issueKeyOrID, options.NotifyUsers, payload, nil, nil); err != nil {
+					return redaction, err
+				}
+
+				change.Applied = true
+			}
+		}
+	}
+
+	comments := &internalAdfCommentImpl{c: i.c, version: i.version}
+	for startAt := 0; ; {
+
+		page, _, err := comments.Gets(ctx, issueKeyOrID, "created", nil, startAt, issueRedactionPageSize)
+		if err != nil {
+			return redaction, err
+		}
+
+		for _, comment := range page.Comments {
+
+			matches := options.RedactNode(comment.Body)
+			if matches == 0 {
+				continue
+			}
+
+			change := &model.IssueRedactionChangeScheme{Field: model.IssueRedactionFieldComment, CommentID: comment.ID, Matches: matches}
+			redaction.Redactions = append(redaction.Redactions, change)
+
+			if options.DryRun {
+				continue
+			}
+
+			payload := &model.CommentPayloadScheme{Visibility: comment.Visibility, Body: comment.Body}
+			if _, _, err = comments.update(ctx, issueKeyOrID, comment.ID, payload, nil, options.NotifyUsers); err != nil {
+				return redaction, err
+			}
+
+			change.Applied = true
+		}
+
+		// The server can return fewer comments than requested, the next page starts after the returned ones
+		startAt += len(page.Comments)
+		if len(page.Comments) == 0 || startAt >= page.Total {
+			break
+		}
+	}
+
+	return redaction, nil
+}
+
+func (i *internalIssueADFServiceImpl) Split(ctx context.Context, issueKeyOrID string, payload *model.IssueScheme, options *model.IssueSplitOptionsScheme) (*model.IssueSplitScheme, error) {
+	return splitIssue(ctx, i.c, i.version, issueKeyOrID, payload, options)
+}
@@ -4,9 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"github.com/stretchr/testify/mock"
+	"io"
 	"net/http"
 	"net/url"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -1050,6 +1054,162 @@ func Test_internalIssueADFServiceImpl_Get(t *testing.T) {
 	}
 }
 
+func Test_internalIssueADFServiceImpl_GetIfExists(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx            context.Context
+		issueKeyOrID   string
+		fields, expand []string
+	}
+
+	testCases := []struct {
+		name       string
+		fields     fields
+		args       args
+		on         func(*fields)
+		wantExists bool
+		wantErr    bool
+		Err        error
+	}{
+		{
+			name:   "when the issue exists",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				fields:       []string{"summary"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/issue/DUMMY-1?fields=summary",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.IssueScheme).Key = "DUMMY-1"
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantExists: true,
+		},
+
+		{
+			name:   "when the issue doesn't exist",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				fields:       []string{"summary"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/issue/DUMMY-1?fields=summary",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrNotFound)
+
+				fields.c = client
+			},
+			wantExists: false,
+		},
+
+		{
+			name:   "when the issue can't be read",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				fields:       []string{"summary"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/issue/DUMMY-1?fields=summary",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrUnauthorized)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrUnauthorized,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := &internalIssueADFServiceImpl{
+				c:       testCase.fields.c,
+				version: testCase.fields.version,
+			}
+
+			gotResult, gotExists, err := newService.GetIfExists(testCase.args.ctx, testCase.args.issueKeyOrID, testCase.args.fields,
+				testCase.args.expand)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+				assert.False(t, gotExists)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.wantExists, gotExists)
+
+			if !testCase.wantExists {
+				assert.Nil(t, gotResult)
+				return
+			}
+
+			assert.Equal(t, "DUMMY-1", gotResult.Key)
+		})
+	}
+}
+
 func Test_internalIssueADFServiceImpl_Move(t *testing.T) {
 
 	/*
@@ -1754,3 +1914,1231 @@ func Test_internalIssueADFServiceImpl_Update(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalIssueADFServiceImpl_Redact(t *testing.T) {
+
+	adfMocked := func(text string) *model.CommentNodeScheme {
+		return &model.CommentNodeScheme{
+			Version: 1,
+			Type:    "doc",
+			Content: []*model.CommentNodeScheme{
+				{Type: "paragraph", Content: []*model.CommentNodeScheme{{Type: "text", Text: text}}},
+			},
+		}
+	}
+
+	issueMocked := func(client *mocks.Connector, version string, err error) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/"+version+"/issue/KP-1?fields=description",
+			"", nil).
+			Return(&http.Request{Host: "issue"}, nil)
+
+		client.On("Call",
+			&http.Request{Host: "issue"},
+			&model.IssueScheme{}).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*model.IssueScheme).Fields = &model.IssueFieldsScheme{Description: adfMocked("The token is ghp_abc123, rotate it")}
+			}).
+			Return(&model.ResponseScheme{}, err)
+	}
+
+	commentsMocked := func(client *mocks.Connector, version string) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/"+version+"/issue/KP-1/comment?maxResults=50&orderBy=created&startAt=0",
+			"", nil).
+			Return(&http.Request{Host: "comments"}, nil)
+
+		client.On("Call",
+			&http.Request{Host: "comments"},
+			&model.IssueCommentPageScheme{}).
+			Run(func(args mock.Arguments) {
+				page := args.Get(1).(*model.IssueCommentPageScheme)
+				page.Total = 2
+				page.Comments = []*model.IssueCommentScheme{
+					{ID: "10000", Body: adfMocked("Nothing to see")},
+					{ID: "10001", Body: adfMocked("Use ghp_zzz9 instead"), Visibility: &model.CommentVisibilityScheme{Type: "role", Value: "Developers"}},
+				}
+			}).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx          context.Context
+		issueKeyOrID string
+		options      *model.IssueRedactionOptionsScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    int
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the description and comments are redacted",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				options:      &model.IssueRedactionOptionsScheme{Patterns: []*regexp.Regexp{regexp.MustCompile(`ghp_[A-Za-z0-9]+`)}},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				issueMocked(client, "3", nil)
+				commentsMocked(client, "3")
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/3/issue/KP-1?notifyUsers=false",
+					"",
+					&model.IssueScheme{Fields: &model.IssueFieldsScheme{Description: adfMocked("The token is [REDACTED], rotate it")}}).
+					Return(&http.Request{Host: "update"}, nil)
+
+				client.On("Call",
+					&http.Request{Host: "update"},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/3/issue/KP-1/comment/10001?notifyUsers=false",
+					"",
+					&model.CommentPayloadScheme{
+						Visibility: &model.CommentVisibilityScheme{Type: "role", Value: "Developers"},
+						Body:       adfMocked("Use [REDACTED] instead"),
+					}).
+					Return(&http.Request{Host: "comment"}, nil)
+
+				client.On("Call",
+					&http.Request{Host: "comment"},
+					&model.IssueCommentScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			want:    2,
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the redaction is a dry run",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				options: &model.IssueRedactionOptionsScheme{
+					Patterns: []*regexp.Regexp{regexp.MustCompile(`ghp_[A-Za-z0-9]+`)},
+					DryRun:   true,
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				issueMocked(client, "3", nil)
+				commentsMocked(client, "3")
+
+				fields.c = client
+			},
+			want:    2,
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the server returns fewer comments than requested",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				options: &model.IssueRedactionOptionsScheme{
+					Patterns: []*regexp.Regexp{regexp.MustCompile(`ghp_[A-Za-z0-9]+`)},
+					DryRun:   true,
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				issueMocked(client, "3", nil)
+
+				for startAt, comment := range []*model.IssueCommentScheme{
+					{ID: "10000", Body: adfMocked("Nothing to see")},
+					{ID: "10001", Body: adfMocked("Use ghp_zzz9 instead")},
+				} {
+
+					client.On("NewRequest",
+						context.Background(),
+						http.MethodGet,
+						fmt.Sprintf("rest/api/3/issue/KP-1/comment?maxResults=50&orderBy=created&startAt=%v", startAt),
+						"", nil).
+						Return(&http.Request{Host: comment.ID}, nil)
+
+					client.On("Call",
+						&http.Request{Host: comment.ID},
+						&model.IssueCommentPageScheme{}).
+						Run(func(args mock.Arguments) {
+							page := args.Get(1).(*model.IssueCommentPageScheme)
+							page.Total = 2
+							page.Comments = []*model.IssueCommentScheme{comment}
+						}).
+						Return(&model.ResponseScheme{}, nil)
+				}
+
+				fields.c = client
+			},
+			want:    2,
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the issue key or id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				options: &model.IssueRedactionOptionsScheme{Patterns: []*regexp.Regexp{regexp.MustCompile(`ghp_[A-Za-z0-9]+`)}},
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueKeyOrID,
+		},
+
+		{
+			name:   "when the patterns are not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				options:      &model.IssueRedactionOptionsScheme{},
+			},
+			wantErr: true,
+			Err:     model.ErrNoRedactionPatterns,
+		},
+
+		{
+			name:   "when the issue cannot be fetched",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				options:      &model.IssueRedactionOptionsScheme{Patterns: []*regexp.Regexp{regexp.MustCompile(`ghp_[A-Za-z0-9]+`)}},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				issueMocked(client, "3", model.ErrUnauthorized)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrUnauthorized,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			_, issueService, err := NewIssueService(testCase.fields.c, testCase.fields.version, nil)
+			assert.NoError(t, err)
+
+			gotResult, err := issueService.Redact(testCase.args.ctx, testCase.args.issueKeyOrID, testCase.args.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, gotResult.Redactions, testCase.want)
+			assert.Equal(t, 1, gotResult.Redactions[0].Matches)
+			assert.Equal(t, "10001", gotResult.Redactions[1].CommentID)
+
+			for _, change := range gotResult.Redactions {
+				assert.Equal(t, !testCase.args.options.DryRun, change.Applied)
+			}
+		})
+	}
+}
+
+func Test_internalIssueADFServiceImpl_TransitionTo(t *testing.T) {
+
+	statusMocked := func(client *mocks.Connector, name string) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/issue/KP-1?fields=status",
+			"", nil).
+			Return(&http.Request{Host: "status"}, nil).
+			Once()
+
+		client.On("Call",
+			&http.Request{Host: "status"},
+			&model.IssueScheme{}).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*model.IssueScheme).Fields = &model.IssueFieldsScheme{Status: &model.StatusScheme{Name: name}}
+			}).
+			Return(&model.ResponseScheme{}, nil).
+			Once()
+	}
+
+	transitionsMocked := func(client *mocks.Connector, transitions ...*model.IssueTransitionScheme) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/issue/KP-1/transitions",
+			"", nil).
+			Return(&http.Request{Host: "transitions"}, nil).
+			Once()
+
+		client.On("Call",
+			&http.Request{Host: "transitions"},
+			&model.IssueTransitionsScheme{}).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*model.IssueTransitionsScheme).Transitions = transitions
+			}).
+			Return(&model.ResponseScheme{}, nil).
+			Once()
+	}
+
+	moveMocked := func(client *mocks.Connector, transitionID string) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPost,
+			"rest/api/3/issue/KP-1/transitions",
+			"",
+			map[string]interface{}{"transition": map[string]interface{}{"id": transitionID}}).
+			Return(&http.Request{Host: "move-" + transitionID}, nil).
+			Once()
+
+		client.On("Call",
+			&http.Request{Host: "move-" + transitionID},
+			nil).
+			Return(&model.ResponseScheme{}, nil).
+			Once()
+	}
+
+	startProgress := &model.IssueTransitionScheme{ID: "11", Name: "Start Progress", To: &model.StatusScheme{Name: "In Progress"}}
+	sendToReview := &model.IssueTransitionScheme{ID: "21", Name: "Send to review", To: &model.StatusScheme{Name: "In Review"}}
+	done := &model.IssueTransitionScheme{ID: "31", Name: "Done", To: &model.StatusScheme{Name: "Done"}}
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx          context.Context
+		issueKeyOrID string
+		status       string
+		options      *model.IssueTransitionToOptionsScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    []string
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the target status is reachable with one transition",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				status:       "in progress",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				statusMocked(client, "To Do")
+				transitionsMocked(client, startProgress, done)
+				moveMocked(client, "11")
+				statusMocked(client, "In Progress")
+
+				fields.c = client
+			},
+			want: []string{"11"},
+		},
+
+		{
+			name:   "when the target status is reached through the workflow graph",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				status:       "Done",
+				options: &model.IssueTransitionToOptionsScheme{
+					Workflow: map[string][]string{
+						"To Do":       {"In Progress"},
+						"In Progress": {"To Do", "In Review"},
+						"In Review":   {"In Progress", "Done"},
+					},
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				statusMocked(client, "To Do")
+				transitionsMocked(client, startProgress)
+				moveMocked(client, "11")
+				statusMocked(client, "In Progress")
+				transitionsMocked(client, sendToReview)
+				moveMocked(client, "21")
+				statusMocked(client, "In Review")
+				transitionsMocked(client, startProgress, done)
+				moveMocked(client, "31")
+				statusMocked(client, "Done")
+
+				fields.c = client
+			},
+			want: []string{"11", "21", "31"},
+		},
+
+		{
+			name:   "when the target status is reached through the path",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				status:       "Done",
+				options:      &model.IssueTransitionToOptionsScheme{Path: []string{"In Progress", "In Review"}},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				statusMocked(client, "In Progress")
+				transitionsMocked(client, sendToReview)
+				moveMocked(client, "21")
+				statusMocked(client, "In Review")
+				transitionsMocked(client, done)
+				moveMocked(client, "31")
+				statusMocked(client, "Done")
+
+				fields.c = client
+			},
+			want: []string{"21", "31"},
+		},
+
+		{
+			name:   "when the issue is already in the target status",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				status:       "Done",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				statusMocked(client, "Done")
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when no transition leads to the target status",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				status:       "Done",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				statusMocked(client, "To Do")
+				transitionsMocked(client, startProgress)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrNoTransitionToStatus,
+		},
+
+		{
+			name:   "when the workflow graph has no route to the target status",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				status:       "Done",
+				options: &model.IssueTransitionToOptionsScheme{
+					Workflow: map[string][]string{"To Do": {"In Progress"}},
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				statusMocked(client, "To Do")
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrNoStatusRoute,
+		},
+
+		{
+			name:   "when the maximum number of transitions is reached",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				status:       "In Progress",
+				options:      &model.IssueTransitionToOptionsScheme{MaxSteps: 1},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				statusMocked(client, "To Do")
+				transitionsMocked(client, startProgress)
+				moveMocked(client, "11")
+				statusMocked(client, "Blocked")
+
+				fields.c = client
+			},
+			want:    []string{"11"},
+			wantErr: true,
+			Err:     model.ErrTransitionLimitReached,
+		},
+
+		{
+			name:   "when the target status is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNoTargetStatus,
+		},
+
+		{
+			name:   "when the issue key or id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:    context.Background(),
+				status: "Done",
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueKeyOrID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			_, issueService, err := NewIssueService(testCase.fields.c, testCase.fields.version, nil)
+			assert.NoError(t, err)
+
+			gotResult, err := issueService.TransitionTo(testCase.args.ctx, testCase.args.issueKeyOrID, testCase.args.status, testCase.args.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+
+				if testCase.want == nil {
+					return
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+
+			var gotTransitions []string
+			for _, transition := range gotResult.Transitions {
+				gotTransitions = append(gotTransitions, transition.ID)
+			}
+
+			assert.Equal(t, testCase.want, gotTransitions)
+		})
+	}
+}
+
+func Test_internalIssueADFServiceImpl_CreateSubtask(t *testing.T) {
+
+	parentMocked := func(client *mocks.Connector, fields string) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/issue/KP-1?fields="+url.QueryEscape(fields),
+			"", nil).
+			Return(&http.Request{Host: "parent"}, nil)
+
+		client.On("Call",
+			&http.Request{Host: "parent"},
+			&subtaskParentScheme{}).
+			Run(func(args mock.Arguments) {
+				parent := args.Get(1).(*subtaskParentScheme)
+				parent.Key = "KP-1"
+				parent.Fields = map[string]interface{}{
+					"project":           map[string]interface{}{"id": "10000", "key": "KP"},
+					"priority":          map[string]interface{}{"id": "2", "name": "High", "iconUrl": "https://ctreminiom.atlassian.net/high.svg"},
+					"customfield_10020": "backend",
+				}
+			}).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	projectMocked := func(client *mocks.Connector, issueTypes ...*model.IssueTypeScheme) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/project/10000",
+			"", nil).
+			Return(&http.Request{Host: "project"}, nil)
+
+		client.On("Call",
+			&http.Request{Host: "project"},
+			&model.ProjectScheme{}).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*model.ProjectScheme).IssueTypes = issueTypes
+			}).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	createMocked := func(client *mocks.Connector, want string) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPost,
+			"rest/api/3/issue",
+			"",
+			mock.MatchedBy(func(body interface{}) bool {
+
+				var got, expected interface{}
+				bodyAsBytes, _ := json.Marshal(body)
+
+				return json.Unmarshal(bodyAsBytes, &got) == nil && json.Unmarshal([]byte(want), &expected) == nil &&
+					assert.ObjectsAreEqual(expected, got)
+			})).
+			Return(&http.Request{Host: "create"}, nil)
+
+		client.On("Call",
+			&http.Request{Host: "create"},
+			&model.IssueResponseScheme{}).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx           context.Context
+		parentKey     string
+		payload       *model.IssueScheme
+		inheritFields []string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the subtask inherits fields from the parent",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:           context.Background(),
+				parentKey:     "KP-1",
+				payload:       &model.IssueScheme{Fields: &model.IssueFieldsScheme{Summary: "Write the tests"}},
+				inheritFields: []string{"priority", "customfield_10020", "components"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				parentMocked(client, "project,priority,customfield_10020,components")
+				projectMocked(client, &model.IssueTypeScheme{ID: "10001", Name: "Task"}, &model.IssueTypeScheme{ID: "10003", Name: "Sub-task", Subtask: true})
+				createMocked(client, `{"fields":{
+					"summary":"Write the tests",
+					"project":{"id":"10000"},
+					"parent":{"key":"KP-1"},
+					"issuetype":{"id":"10003"},
+					"priority":{"id":"2"},
+					"customfield_10020":"backend"
+				}}`)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the payload sets the issue type and the inherited field",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				parentKey: "KP-1",
+				payload: &model.IssueScheme{Fields: &model.IssueFieldsScheme{
+					Summary:   "Write the tests",
+					IssueType: &model.IssueTypeScheme{ID: "10004"},
+					Priority:  &model.PriorityScheme{ID: "3"},
+				}},
+				inheritFields: []string{"priority"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				parentMocked(client, "project,priority")
+				createMocked(client, `{"fields":{
+					"summary":"Write the tests",
+					"project":{"id":"10000"},
+					"parent":{"key":"KP-1"},
+					"issuetype":{"id":"10004"},
+					"priority":{"id":"3"}
+				}}`)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the project has no subtask issue type",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				parentKey: "KP-1",
+				payload:   &model.IssueScheme{Fields: &model.IssueFieldsScheme{Summary: "Write the tests"}},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				parentMocked(client, "project")
+				projectMocked(client, &model.IssueTypeScheme{ID: "10001", Name: "Task"})
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrNoSubtaskIssueType,
+		},
+
+		{
+			name:   "when an inherited field is read-only",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:           context.Background(),
+				parentKey:     "KP-1",
+				inheritFields: []string{"status"},
+			},
+			wantErr: true,
+			Err:     model.ErrIssueFieldReadOnly,
+		},
+
+		{
+			name:   "when the parent key is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueKeyOrID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			_, issueService, err := NewIssueService(testCase.fields.c, testCase.fields.version, nil)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := issueService.CreateSubtask(testCase.args.ctx, testCase.args.parentKey, testCase.args.payload,
+				testCase.args.inheritFields)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotNil(t, gotResponse)
+			assert.NotNil(t, gotResult)
+		})
+	}
+}
+
+func Test_internalIssueADFServiceImpl_Split(t *testing.T) {
+
+	payloadMocked := &model.IssueScheme{
+		Fields: &model.IssueFieldsScheme{
+			Summary:   "Split of KP-1",
+			Project:   &model.ProjectScheme{Key: "KP"},
+			IssueType: &model.IssueTypeScheme{Name: "Story"},
+		},
+	}
+
+	createMocked := func(client *mocks.Connector) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPost,
+			"rest/api/3/issue",
+			"", payloadMocked).
+			Return(&http.Request{Host: "create"}, nil)
+
+		client.On("Call",
+			&http.Request{Host: "create"},
+			&model.IssueResponseScheme{}).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*model.IssueResponseScheme).Key = "KP-2"
+			}).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	linkMocked := func(client *mocks.Connector, link *model.IssueLinkScheme) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/issueLink/10050",
+			"", nil).
+			Return(&http.Request{Host: "link"}, nil)
+
+		client.On("Call",
+			&http.Request{Host: "link"},
+			&model.IssueLinkScheme{}).
+			Run(func(args mock.Arguments) {
+				*args.Get(1).(*model.IssueLinkScheme) = *link
+			}).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx          context.Context
+		issueKeyOrID string
+		payload      *model.IssueScheme
+		options      *model.IssueSplitOptionsScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    *model.IssueSplitScheme
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the items are moved to the new issue",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				payload:      payloadMocked,
+				options: &model.IssueSplitOptionsScheme{
+					CommentIDs:      []string{"10010"},
+					AttachmentIDs:   []string{"10020"},
+					LinkIDs:         []string{"10050"},
+					SubtaskKeys:     []string{"KP-3"},
+					RemoveOriginals: true,
+					LinkType:        "Cloners",
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				createMocked(client)
+
+				// The comment is copied with its visibility
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/issue/KP-1/comment/10010",
+					"", nil).
+					Return(&http.Request{Host: "comment"}, nil)
+
+				client.On("Call",
+					&http.Request{Host: "comment"},
+					&map[string]interface{}{}).
+					Run(func(args mock.Arguments) {
+						comment := args.Get(1).(*map[string]interface{})
+						(*comment)["id"] = "10010"
+						(*comment)["body"] = map[string]interface{}{"type": "doc", "version": 1}
+						(*comment)["visibility"] = map[string]interface{}{"type": "role", "value": "Developers"}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/issue/KP-2/comment",
+					"", map[string]interface{}{
+						"body":       map[string]interface{}{"type": "doc", "version": 1},
+						"visibility": map[string]interface{}{"type": "role", "value": "Developers"},
+					}).
+					Return(&http.Request{Host: "copy"}, nil)
+
+				client.On("Call",
+					&http.Request{Host: "copy"},
+					&map[string]interface{}{}).
+					Run(func(args mock.Arguments) {
+						(*args.Get(1).(*map[string]interface{}))["id"] = "10011"
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				// The attachment is re-uploaded, then deleted
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/attachment/10020",
+					"", nil).
+					Return(&http.Request{Host: "metadata"}, nil)
+
+				client.On("Call",
+					&http.Request{Host: "metadata"},
+					&model.IssueAttachmentMetadataScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.IssueAttachmentMetadataScheme).Filename = "logs.txt"
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/attachment/content/10020",
+					"", nil).
+					Return(&http.Request{Host: "download"}, nil)
+
+				download := &model.ResponseScheme{Stream: io.NopCloser(strings.NewReader("sample logs"))}
+
+				client.On("Call",
+					&http.Request{Host: "download"},
+					&model.StreamedResponseScheme{}).
+					Return(download, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/issue/KP-2/attachments",
+					mock.Anything, mock.Anything).
+					Return(&http.Request{Host: "upload"}, nil)
+
+				client.On("Call",
+					&http.Request{Host: "upload"},
+					mock.Anything).
+					Run(func(args mock.Arguments) {
+						*args.Get(1).(*[]*model.IssueAttachmentScheme) = []*model.IssueAttachmentScheme{{ID: "10021"}}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"rest/api/3/attachment/10020",
+					"", nil).
+					Return(&http.Request{Host: "delete-attachment"}, nil)
+
+				client.On("Call",
+					&http.Request{Host: "delete-attachment"},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				// The link is recreated on the new issue, then deleted
+				linkMocked(client, &model.IssueLinkScheme{
+					ID:           "10050",
+					Type:         &model.LinkTypeScheme{ID: "10000", Name: "Blocks"},
+					InwardIssue:  &model.LinkedIssueScheme{ID: "10001", Key: "KP-1"},
+					OutwardIssue: &model.LinkedIssueScheme{ID: "10009", Key: "KP-9"},
+				})
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/issueLink",
+					"", &model.LinkPayloadSchemeV3{
+						Type:         &model.LinkTypeScheme{ID: "10000", Name: "Blocks"},
+						InwardIssue:  &model.LinkedIssueScheme{Key: "KP-2"},
+						OutwardIssue: &model.LinkedIssueScheme{Key: "KP-9"},
+					}).
+					Return(&http.Request{Host: "create-link"}, nil)
+
+				client.On("Call",
+					&http.Request{Host: "create-link"},
+					nil).
+					Return(&model.ResponseScheme{Response: &http.Response{
+						Header: http.Header{"Location": {"https://ctreminiom.atlassian.net/rest/api/3/issueLink/10051"}},
+					}}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"rest/api/3/issueLink/10050",
+					"", nil).
+					Return(&http.Request{Host: "delete-link"}, nil)
+
+				client.On("Call",
+					&http.Request{Host: "delete-link"},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				// The subtask is moved under the new issue
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/3/issue/KP-3",
+					"", map[string]interface{}{
+						"fields": map[string]interface{}{"parent": map[string]interface{}{"key": "KP-2"}},
+					}).
+					Return(&http.Request{Host: "subtask"}, nil)
+
+				client.On("Call",
+					&http.Request{Host: "subtask"},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				// The new issue is linked to the original issue
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/issueLink",
+					"", &model.LinkPayloadSchemeV3{
+						Type:         &model.LinkTypeScheme{Name: "Cloners"},
+						InwardIssue:  &model.LinkedIssueScheme{Key: "KP-1"},
+						OutwardIssue: &model.LinkedIssueScheme{Key: "KP-2"},
+					}).
+					Return(&http.Request{Host: "split-link"}, nil)
+
+				client.On("Call",
+					&http.Request{Host: "split-link"},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			want: &model.IssueSplitScheme{
+				IssueKeyOrID: "KP-1",
+				Issue:        &model.IssueResponseScheme{Key: "KP-2"},
+				Comments:     map[string]string{"10010": "10011"},
+				Attachments:  map[string]string{"10020": "10021"},
+				Links:        map[string]string{"10050": "10051"},
+				Subtasks:     []string{"KP-3"},
+			},
+		},
+
+		{
+			name:   "when the link doesn't link the issue",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				payload:      payloadMocked,
+				options:      &model.IssueSplitOptionsScheme{LinkIDs: []string{"10050"}},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				createMocked(client)
+
+				linkMocked(client, &model.IssueLinkScheme{
+					ID:           "10050",
+					Type:         &model.LinkTypeScheme{ID: "10000", Name: "Blocks"},
+					InwardIssue:  &model.LinkedIssueScheme{ID: "10008", Key: "KP-8"},
+					OutwardIssue: &model.LinkedIssueScheme{ID: "10009", Key: "KP-9"},
+				})
+
+				fields.c = client
+			},
+			want: &model.IssueSplitScheme{
+				IssueKeyOrID: "KP-1",
+				Issue:        &model.IssueResponseScheme{Key: "KP-2"},
+				Comments:     map[string]string{},
+				Attachments:  map[string]string{},
+				Links:        map[string]string{},
+				Failures: []*model.IssueSplitFailureScheme{
+					{Item: model.IssueSplitItemLink, ID: "10050", Err: fmt.Errorf("jira: %w: %v", model.ErrIssueLinkNotOnIssue, "KP-1")},
+				},
+			},
+		},
+
+		{
+			name:   "when the attachment upload returns no attachment",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				payload:      payloadMocked,
+				options:      &model.IssueSplitOptionsScheme{AttachmentIDs: []string{"10020"}, RemoveOriginals: true},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				createMocked(client)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/attachment/10020",
+					"", nil).
+					Return(&http.Request{Host: "metadata"}, nil)
+
+				client.On("Call",
+					&http.Request{Host: "metadata"},
+					&model.IssueAttachmentMetadataScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.IssueAttachmentMetadataScheme).Filename = "logs.txt"
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/attachment/content/10020",
+					"", nil).
+					Return(&http.Request{Host: "download"}, nil)
+
+				client.On("Call",
+					&http.Request{Host: "download"},
+					&model.StreamedResponseScheme{}).
+					Return(&model.ResponseScheme{Stream: io.NopCloser(strings.NewReader("sample logs"))}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/issue/KP-2/attachments",
+					mock.Anything, mock.Anything).
+					Return(&http.Request{Host: "upload"}, nil)
+
+				// The original attachment isn't deleted
+				client.On("Call",
+					&http.Request{Host: "upload"},
+					mock.Anything).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			want: &model.IssueSplitScheme{
+				IssueKeyOrID: "KP-1",
+				Issue:        &model.IssueResponseScheme{Key: "KP-2"},
+				Comments:     map[string]string{},
+				Attachments:  map[string]string{},
+				Links:        map[string]string{},
+				Failures: []*model.IssueSplitFailureScheme{
+					{Item: model.IssueSplitItemAttachment, ID: "10020", Err: fmt.Errorf("jira: %w", model.ErrNoAttachmentUploaded)},
+				},
+			},
+		},
+
+		{
+			name:   "when the new issue cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				payload:      payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/issue",
+					"", payloadMocked).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name:    "when the issue key or id is not provided",
+			fields:  fields{version: "3"},
+			args:    args{ctx: context.Background()},
+			wantErr: true,
+			Err:     model.ErrNoIssueKeyOrID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			_, issueService, err := NewIssueService(testCase.fields.c, testCase.fields.version, nil)
+			assert.NoError(t, err)
+
+			gotResult, err := issueService.Split(testCase.args.ctx, testCase.args.issueKeyOrID, testCase.args.payload,
+				testCase.args.options)
+
+			if testCase.wantErr {
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.want, gotResult)
+		})
+	}
+}
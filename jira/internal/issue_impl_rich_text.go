@@ -125,6 +125,21 @@ func (i IssueRichTextService) Creates(ctx context.Context, payload []*model.Issu
 	return i.internalClient.Creates(ctx, payload)
 }
 
+// CreateSubtask creates a subtask of the parent issue, in the project of the parent.
+//
+// When the payload has no issue type, the first subtask issue type of the project is used.
+//
+// The inheritFields, e.g. priority, components or a custom field ID, are copied from the parent when the payload doesn't set them.
+//
+// GET /rest/api/{2-3}/issue/{issueKeyOrID}
+//
+// GET /rest/api/{2-3}/project/{projectKeyOrID}
+//
+// POST /rest/api/{2-3}/issue
+func (i IssueRichTextService) CreateSubtask(ctx context.Context, parentKey string, payload *model.IssueSchemeV2, inheritFields []string) (*model.IssueResponseScheme, *model.ResponseScheme, error) {
+	return i.internalClient.CreateSubtask(ctx, parentKey, payload, inheritFields)
+}
+
 // Get returns the details for an issue.
 //
 // The issue is identified by its ID or key, however, if the identifier doesn't match an issue, a case-insensitive search
@@ -140,6 +155,13 @@ func (i IssueRichTextService) Get(ctx context.Context, issueKeyOrID string, fiel
 	return i.internalClient.Get(ctx, issueKeyOrID, fields, expand)
 }
 
+// GetIfExists returns the issue, and false instead of an error when the issue doesn't exist.
+//
+// GET /rest/api/{2-3}/issue/{issueKeyOrID}
+func (i IssueRichTextService) GetIfExists(ctx context.Context, issueKeyOrID string, fields, expand []string) (*model.IssueSchemeV2, bool, error) {
+	return i.internalClient.GetIfExists(ctx, issueKeyOrID, fields, expand)
+}
+
 // Update edits an issue.
 //
 // Edits an issue. A transition may be applied and issue properties updated as part of the edit.
@@ -164,6 +186,51 @@ func (i IssueRichTextService) Move(ctx context.Context, issueKeyOrID, transition
 	return i.internalClient.Move(ctx, issueKeyOrID, transitionID, options)
 }
 
+// Redact rewrites the description and the comments of an issue, replacing the matches of the patterns.
+//
+// Only the description and the comments with matches are updated, and the watchers are notified of the updates only
+// with the NotifyUsers option.
+//
+// With the dry-run option, the number of matches is reported and the issue is left untouched.
+//
+// PUT /rest/api/{2-3}/issue/{issueKeyOrID}
+//
+// PUT /rest/api/{2-3}/issue/{issueKeyOrID}/comment/{commentID}
+func (i IssueRichTextService) Redact(ctx context.Context, issueKeyOrID string, options *model.IssueRedactionOptionsScheme) (*model.IssueRedactionScheme, error) {
+	return i.internalClient.Redact(ctx, issueKeyOrID, options)
+}
+
+// Split creates a new issue from the payload and moves the selected items of the issue to the new issue.
+//
+// The comments are copied, the attachments are re-uploaded, the issue links are recreated on the new issue
+// and the subtasks are moved under the new issue. The items that can't be moved are reported without stopping the split.
+//
+// POST /rest/api/{2-3}/issue
+//
+// POST /rest/api/{2-3}/issue/{issueKeyOrID}/comment
+//
+// POST /rest/api/{2-3}/issue/{issueKeyOrID}/attachments
+//
+// POST /rest/api/{2-3}/issueLink
+//
+// PUT /rest/api/{2-3}/issue/{issueKeyOrID}
+func (i IssueRichTextService) Split(ctx context.Context, issueKeyOrID string, payload *model.IssueSchemeV2, options *model.IssueSplitOptionsScheme) (*model.IssueSplitScheme, error) {
+	return i.internalClient.Split(ctx, issueKeyOrID, payload, options)
+}
+
+// TransitionTo transitions an issue until it reaches the target status, comparing the status names without case.
+//
+// The next status is the target status, the next status of the path or the first status of the shortest route of the workflow graph.
+//
+// The transitions stop with an error when no transition leads to the next status or the maximum number of transitions is reached.
+//
+// GET /rest/api/{2-3}/issue/{issueKeyOrID}/transitions
+//
+// POST /rest/api/{2-3}/issue/{issueKeyOrID}/transitions
+func (i IssueRichTextService) TransitionTo(ctx context.Context, issueKeyOrID, status string, options *model.IssueTransitionToOptionsScheme) (*model.IssueTransitionToScheme, error) {
+	return i.internalClient.TransitionTo(ctx, issueKeyOrID, status, options)
+}
+
 type internalRichTextServiceImpl struct {
 	c       service.Connector
 	version string
@@ -185,6 +252,10 @@ func (i *internalRichTextServiceImpl) Transitions(ctx context.Context, issueKeyO
 	return getTransitions(ctx, i.c, i.version, issueKeyOrID)
 }
 
+func (i *internalRichTextServiceImpl) TransitionTo(ctx context.Context, issueKeyOrID, status string, options *model.IssueTransitionToOptionsScheme) (*model.IssueTransitionToScheme, error) {
+	return transitionIssueTo(ctx, i.c, i.version, issueKeyOrID, status, options)
+}
+
 func (i *internalRichTextServiceImpl) Create(ctx context.Context, payload *model.IssueSchemeV2, customFields *model.CustomFields) (*model.IssueResponseScheme, *model.ResponseScheme, error) {
 	var body interface{} = payload
 	var err error
@@ -217,6 +288,10 @@ func (i *internalRichTextServiceImpl) Create(ctx context.Context, payload *model
 	return issue, response, nil
 }
 
+func (i *internalRichTextServiceImpl) CreateSubtask(ctx context.Context, parentKey string, payload *model.IssueSchemeV2, inheritFields []string) (*model.IssueResponseScheme, *model.ResponseScheme, error) {
+	return createSubtask(ctx, i.c, i.version, parentKey, payload, inheritFields)
+}
+
 func (i *internalRichTextServiceImpl) Creates(ctx context.Context, payload []*model.IssueBulkSchemeV2) (*model.IssueBulkResponseScheme, *model.ResponseScheme, error) {
 
 	if len(payload) == 0 {
@@ -291,6 +366,16 @@ func (i *internalRichTextServiceImpl) Get(ctx context.Context, issueKeyOrID stri
 	return issue, response, nil
 }
 
+func (i *internalRichTextServiceImpl) GetIfExists(ctx context.Context, issueKeyOrID string, fields, expand []string) (*model.IssueSchemeV2, bool, error) {
+
+	issue, _, err := i.Get(ctx, issueKeyOrID, fields, expand)
+	if err != nil {
+		return nil, false, ignoreNotFound(err)
+	}
+
+	return issue, true, nil
+}
+
 func (i *internalRichTextServiceImpl) Update(ctx context.Context, issueKeyOrID string, notify bool, payload *model.IssueSchemeV2, customFields *model.CustomFields, operations *model.UpdateOperations) (*model.ResponseScheme, error) {
 
 	if issueKeyOrID == "" {
@@ -405,3 +490,84 @@ func (i *internalRichTextServiceImpl) Move(ctx context.Context, issueKeyOrID, tr
 
 	return i.c.Call(request, nil)
 }
+
+func (i *internalRichTextServiceImpl) Redact(ctx context.Context, issueKeyOrID string, options *model.IssueRedactionOptionsScheme) (*model.IssueRedactionScheme, error) {
+
+	if issueKeyOrID == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoIssueKeyOrID)
+	}
+
+	if options == nil || len(options.Patterns) == 0 {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoRedactionPatterns)
+	}
+
+	redaction := &model.IssueRedactionScheme{IssueKeyOrID: issueKeyOrID, DryRun: options.DryRun}
+
+	issue, _, err := i.Get(ctx, issueKeyOrID, []string{model.IssueFieldDescription}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if issue.Fields != nil && issue.Fields.Description != "" {
+
+		description, matches := options.RedactText(issue.Fields.Description)
+		if matches != 0 {
+
+			change := &model.IssueRedactionChangeScheme{Field: model.IssueRedactionFieldDescription, Matches: matches}
+			redaction.Redactions = append(redaction.Redactions, change)
+
+			if !options.DryRun {
+
+				payload := &model.IssueSchemeV2{Fields: &model.IssueFieldsSchemeV2{Description: description}}
+				if _, err = i.Update(ctx, issueKeyOrID, options.NotifyUsers, payload, nil, nil); err != nil {
+					return redaction, err
+				}
+
+				change.Applied = true
+			}
+		}
+	}
+
+	comments := &internalRichTextCommentImpl{c: i.c, version: i.version}
+	for startAt := 0; ; {
+
+		page, _, err := comments.Gets(ctx, issueKeyOrID, "created", nil, startAt, issueRedactionPageSize)
+		if err != nil {
+			return redaction, err
+		}
+
+		for _, comment := range page.Comments {
+
+			body, matches := options.RedactText(comment.Body)
+			if matches == 0 {
+				continue
+			}
+
+			change := &model.IssueRedactionChangeScheme{Field: model.IssueRedactionFieldComment, CommentID: comment.ID, Matches: matches}
+			redaction.Redactions = append(redaction.Redactions, change)
+
+			if options.DryRun {
+				continue
+			}
+
+			payload := &model.CommentPayloadSchemeV2{Visibility: comment.Visibility, Body: body}
+			if _, _, err = comments.update(ctx, issueKeyOrID, comment.ID, payload, nil, options.NotifyUsers); err != nil {
+				return redaction, err
+			}
+
+			change.Applied = true
+		}
+
+		// The server can return fewer comments than requested, the next page starts after the returned ones
+		startAt += len(page.Comments)
+		if len(page.Comments) == 0 || startAt >= page.Total {
+			break
+		}
+	}
+
+	return redaction, nil
+}
+
+func (i *internalRichTextServiceImpl) Split(ctx context.Context, issueKeyOrID string, payload *model.IssueSchemeV2, options *model.IssueSplitOptionsScheme) (*model.IssueSplitScheme, error) {
+	return splitIssue(ctx, i.c, i.version, issueKeyOrID, payload, options)
+}
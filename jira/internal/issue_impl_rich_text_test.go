@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"net/http"
 	"net/url"
+	"regexp"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -1050,6 +1051,162 @@ func Test_internalRichTextServiceImpl_Get(t *testing.T) {
 	}
 }
 
+func Test_internalRichTextServiceImpl_GetIfExists(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx            context.Context
+		issueKeyOrID   string
+		fields, expand []string
+	}
+
+	testCases := []struct {
+		name       string
+		fields     fields
+		args       args
+		on         func(*fields)
+		wantExists bool
+		wantErr    bool
+		Err        error
+	}{
+		{
+			name:   "when the issue exists",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				fields:       []string{"summary"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/issue/DUMMY-1?fields=summary",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueSchemeV2{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.IssueSchemeV2).Key = "DUMMY-1"
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantExists: true,
+		},
+
+		{
+			name:   "when the issue doesn't exist",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				fields:       []string{"summary"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/issue/DUMMY-1?fields=summary",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueSchemeV2{}).
+					Return(&model.ResponseScheme{}, model.ErrNotFound)
+
+				fields.c = client
+			},
+			wantExists: false,
+		},
+
+		{
+			name:   "when the issue can't be read",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				fields:       []string{"summary"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/issue/DUMMY-1?fields=summary",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueSchemeV2{}).
+					Return(&model.ResponseScheme{}, model.ErrUnauthorized)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrUnauthorized,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := &internalRichTextServiceImpl{
+				c:       testCase.fields.c,
+				version: testCase.fields.version,
+			}
+
+			gotResult, gotExists, err := newService.GetIfExists(testCase.args.ctx, testCase.args.issueKeyOrID, testCase.args.fields,
+				testCase.args.expand)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+				assert.False(t, gotExists)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.wantExists, gotExists)
+
+			if !testCase.wantExists {
+				assert.Nil(t, gotResult)
+				return
+			}
+
+			assert.Equal(t, "DUMMY-1", gotResult.Key)
+		})
+	}
+}
+
 func Test_internalRichTextServiceImpl_Move(t *testing.T) {
 
 	/*
@@ -1754,3 +1911,513 @@ func Test_internalRichTextServiceImpl_Update(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalRichTextServiceImpl_Redact(t *testing.T) {
+
+	issueMocked := func(client *mocks.Connector, version string) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/"+version+"/issue/KP-1?fields=description",
+			"", nil).
+			Return(&http.Request{Host: "issue"}, nil)
+
+		client.On("Call",
+			&http.Request{Host: "issue"},
+			&model.IssueSchemeV2{}).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*model.IssueSchemeV2).Fields = &model.IssueFieldsSchemeV2{Description: "password=hunter2"}
+			}).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	commentsMocked := func(client *mocks.Connector, version string, err error) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/"+version+"/issue/KP-1/comment?maxResults=50&orderBy=created&startAt=0",
+			"", nil).
+			Return(&http.Request{Host: "comments"}, nil)
+
+		client.On("Call",
+			&http.Request{Host: "comments"},
+			&model.IssueCommentPageSchemeV2{}).
+			Run(func(args mock.Arguments) {
+				page := args.Get(1).(*model.IssueCommentPageSchemeV2)
+				page.Total = 1
+				page.Comments = []*model.IssueCommentSchemeV2{{ID: "10000", Body: "Try password=swordfish"}}
+			}).
+			Return(&model.ResponseScheme{}, err)
+	}
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx          context.Context
+		issueKeyOrID string
+		options      *model.IssueRedactionOptionsScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the description and comments are redacted",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				options: &model.IssueRedactionOptionsScheme{
+					Patterns:    []*regexp.Regexp{regexp.MustCompile(`password=\S+`)},
+					Replacement: "password=***",
+					NotifyUsers: true,
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				issueMocked(client, "2")
+				commentsMocked(client, "2", nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/2/issue/KP-1?notifyUsers=true",
+					"",
+					&model.IssueSchemeV2{Fields: &model.IssueFieldsSchemeV2{Description: "password=***"}}).
+					Return(&http.Request{Host: "update"}, nil)
+
+				client.On("Call",
+					&http.Request{Host: "update"},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/2/issue/KP-1/comment/10000",
+					"",
+					&model.CommentPayloadSchemeV2{Body: "Try password=***"}).
+					Return(&http.Request{Host: "comment"}, nil)
+
+				client.On("Call",
+					&http.Request{Host: "comment"},
+					&model.IssueCommentSchemeV2{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the redaction is a dry run",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				options: &model.IssueRedactionOptionsScheme{
+					Patterns: []*regexp.Regexp{regexp.MustCompile(`password=\S+`)},
+					DryRun:   true,
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				issueMocked(client, "2")
+				commentsMocked(client, "2", nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the patterns are not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+			},
+			wantErr: true,
+			Err:     model.ErrNoRedactionPatterns,
+		},
+
+		{
+			name:   "when the comments cannot be fetched",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				options: &model.IssueRedactionOptionsScheme{
+					Patterns: []*regexp.Regexp{regexp.MustCompile(`password=\S+`)},
+					DryRun:   true,
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				issueMocked(client, "2")
+				commentsMocked(client, "2", model.ErrUnauthorized)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrUnauthorized,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			issueService, _, err := NewIssueService(testCase.fields.c, testCase.fields.version, nil)
+			assert.NoError(t, err)
+
+			gotResult, err := issueService.Redact(testCase.args.ctx, testCase.args.issueKeyOrID, testCase.args.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, gotResult.Redactions, 2)
+			assert.Equal(t, 1, gotResult.Redactions[1].Matches)
+		})
+	}
+}
+
+func Test_internalRichTextServiceImpl_TransitionTo(t *testing.T) {
+
+	statusMocked := func(client *mocks.Connector, name string) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/2/issue/KP-1?fields=status",
+			"", nil).
+			Return(&http.Request{Host: "status"}, nil).
+			Once()
+
+		client.On("Call",
+			&http.Request{Host: "status"},
+			&model.IssueScheme{}).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*model.IssueScheme).Fields = &model.IssueFieldsScheme{Status: &model.StatusScheme{Name: name}}
+			}).
+			Return(&model.ResponseScheme{}, nil).
+			Once()
+	}
+
+	transitionsMocked := func(client *mocks.Connector, transitions ...*model.IssueTransitionScheme) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/2/issue/KP-1/transitions",
+			"", nil).
+			Return(&http.Request{Host: "transitions"}, nil).
+			Once()
+
+		client.On("Call",
+			&http.Request{Host: "transitions"},
+			&model.IssueTransitionsScheme{}).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*model.IssueTransitionsScheme).Transitions = transitions
+			}).
+			Return(&model.ResponseScheme{}, nil).
+			Once()
+	}
+
+	moveMocked := func(client *mocks.Connector, transitionID string) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPost,
+			"rest/api/2/issue/KP-1/transitions",
+			"",
+			map[string]interface{}{"transition": map[string]interface{}{"id": transitionID}}).
+			Return(&http.Request{Host: "move-" + transitionID}, nil).
+			Once()
+
+		client.On("Call",
+			&http.Request{Host: "move-" + transitionID},
+			nil).
+			Return(&model.ResponseScheme{}, nil).
+			Once()
+	}
+
+	startProgress := &model.IssueTransitionScheme{ID: "11", Name: "Start Progress", To: &model.StatusScheme{Name: "In Progress"}}
+	sendToReview := &model.IssueTransitionScheme{ID: "21", Name: "Send to review", To: &model.StatusScheme{Name: "In Review"}}
+	done := &model.IssueTransitionScheme{ID: "31", Name: "Done", To: &model.StatusScheme{Name: "Done"}}
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx          context.Context
+		issueKeyOrID string
+		status       string
+		options      *model.IssueTransitionToOptionsScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    []string
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the target status is reachable with one transition",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				status:       "in progress",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				statusMocked(client, "To Do")
+				transitionsMocked(client, startProgress, done)
+				moveMocked(client, "11")
+				statusMocked(client, "In Progress")
+
+				fields.c = client
+			},
+			want: []string{"11"},
+		},
+
+		{
+			name:   "when the target status is reached through the workflow graph",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				status:       "Done",
+				options: &model.IssueTransitionToOptionsScheme{
+					Workflow: map[string][]string{
+						"To Do":       {"In Progress"},
+						"In Progress": {"To Do", "In Review"},
+						"In Review":   {"In Progress", "Done"},
+					},
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				statusMocked(client, "To Do")
+				transitionsMocked(client, startProgress)
+				moveMocked(client, "11")
+				statusMocked(client, "In Progress")
+				transitionsMocked(client, sendToReview)
+				moveMocked(client, "21")
+				statusMocked(client, "In Review")
+				transitionsMocked(client, startProgress, done)
+				moveMocked(client, "31")
+				statusMocked(client, "Done")
+
+				fields.c = client
+			},
+			want: []string{"11", "21", "31"},
+		},
+
+		{
+			name:   "when the target status is reached through the path",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				status:       "Done",
+				options:      &model.IssueTransitionToOptionsScheme{Path: []string{"In Progress", "In Review"}},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				statusMocked(client, "In Progress")
+				transitionsMocked(client, sendToReview)
+				moveMocked(client, "21")
+				statusMocked(client, "In Review")
+				transitionsMocked(client, done)
+				moveMocked(client, "31")
+				statusMocked(client, "Done")
+
+				fields.c = client
+			},
+			want: []string{"21", "31"},
+		},
+
+		{
+			name:   "when the issue is already in the target status",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				status:       "Done",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				statusMocked(client, "Done")
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when no transition leads to the target status",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				status:       "Done",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				statusMocked(client, "To Do")
+				transitionsMocked(client, startProgress)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrNoTransitionToStatus,
+		},
+
+		{
+			name:   "when the workflow graph has no route to the target status",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				status:       "Done",
+				options: &model.IssueTransitionToOptionsScheme{
+					Workflow: map[string][]string{"To Do": {"In Progress"}},
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				statusMocked(client, "To Do")
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrNoStatusRoute,
+		},
+
+		{
+			name:   "when the maximum number of transitions is reached",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				status:       "In Progress",
+				options:      &model.IssueTransitionToOptionsScheme{MaxSteps: 1},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				statusMocked(client, "To Do")
+				transitionsMocked(client, startProgress)
+				moveMocked(client, "11")
+				statusMocked(client, "Blocked")
+
+				fields.c = client
+			},
+			want:    []string{"11"},
+			wantErr: true,
+			Err:     model.ErrTransitionLimitReached,
+		},
+
+		{
+			name:   "when the target status is not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNoTargetStatus,
+		},
+
+		{
+			name:   "when the issue key or id is not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:    context.Background(),
+				status: "Done",
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueKeyOrID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			issueService, _, err := NewIssueService(testCase.fields.c, testCase.fields.version, nil)
+			assert.NoError(t, err)
+
+			gotResult, err := issueService.TransitionTo(testCase.args.ctx, testCase.args.issueKeyOrID, testCase.args.status, testCase.args.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+
+				if testCase.want == nil {
+					return
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+
+			var gotTransitions []string
+			for _, transition := range gotResult.Transitions {
+				gotTransitions = append(gotTransitions, transition.ID)
+			}
+
+			assert.Equal(t, testCase.want, gotTransitions)
+		})
+	}
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/jql"
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/jira"
 )
@@ -77,6 +79,17 @@ func (l *LinkTypeService) Delete(ctx context.Context, issueLinkTypeID string) (*
 	return l.internalClient.Delete(ctx, issueLinkTypeID)
 }
 
+// Rename renames an issue link type and its inward and outward descriptions, the empty values are left unchanged.
+//
+// The rename is refused when another link type has the name or the link type links more issues than allowed.
+//
+// POST /rest/api/{2-3}/search/approximate-count
+//
+// PUT /rest/api/{2-3}/issueLinkType/{issueLinkTypeID}
+func (l *LinkTypeService) Rename(ctx context.Context, issueLinkTypeID string, payload *model.LinkTypeScheme, options *model.LinkTypeRenameOptionsScheme) (*model.LinkTypeRenameScheme, error) {
+	return l.internalClient.Rename(ctx, issueLinkTypeID, payload, options)
+}
+
 type internalLinkTypeImpl struct {
 	c       service.Connector
 	version string
@@ -124,6 +137,18 @@ func (i *internalLinkTypeImpl) Get(ctx context.Context, issueLinkTypeID string)
 
 func (i *internalLinkTypeImpl) Create(ctx context.Context, payload *model.LinkTypeScheme) (*model.LinkTypeScheme, *model.ResponseScheme, error) {
 
+	if payload == nil || strings.TrimSpace(payload.Name) == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoLinkTypeName)
+	}
+
+	if strings.TrimSpace(payload.Inward) == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoLinkTypeInward)
+	}
+
+	if strings.TrimSpace(payload.Outward) == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoLinkTypeOutward)
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/issueLinkType", i.version)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
@@ -146,6 +171,10 @@ func (i *internalLinkTypeImpl) Update(ctx context.Context, issueLinkTypeID strin
 		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoLinkTypeID)
 	}
 
+	if payload == nil || (payload.Name == "" && payload.Inward == "" && payload.Outward == "") {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoLinkTypeName)
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/issueLinkType/%v", i.version, issueLinkTypeID)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
@@ -177,3 +206,63 @@ func (i *internalLinkTypeImpl) Delete(ctx context.Context, issueLinkTypeID strin
 
 	return i.c.Call(request, nil)
 }
+
+func (i *internalLinkTypeImpl) Rename(ctx context.Context, issueLinkTypeID string, payload *model.LinkTypeScheme, options *model.LinkTypeRenameOptionsScheme) (*model.LinkTypeRenameScheme, error) {
+
+	if issueLinkTypeID == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoLinkTypeID)
+	}
+
+	if payload == nil || (payload.Name == "" && payload.Inward == "" && payload.Outward == "") {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoLinkTypeName)
+	}
+
+	if options == nil {
+		options = new(model.LinkTypeRenameOptionsScheme)
+	}
+
+	linkType, _, err := i.Get(ctx, issueLinkTypeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if payload.Name != "" && !strings.EqualFold(payload.Name, linkType.Name) {
+
+		linkTypes, _, err := i.Gets(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, other := range linkTypes.IssueLinkTypes {
+			if other.ID != issueLinkTypeID && strings.EqualFold(other.Name, payload.Name) {
+				return nil, fmt.Errorf("jira: %w (%v)", model.ErrLinkTypeNameTaken, other.ID)
+			}
+		}
+	}
+
+	// The issueLinkType JQL function matches the inward and outward descriptions of the link type
+	query := jql.New().Where("issueLinkType", jql.In, linkType.Inward, linkType.Outward).String()
+
+	count, _, err := (&internalSearchADFImpl{c: i.c, version: i.version}).ApproximateCount(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	rename := &model.LinkTypeRenameScheme{Before: linkType, Usage: count.Count}
+
+	if options.MaxUsage >= 0 && rename.Usage > options.MaxUsage {
+		return rename, fmt.Errorf("jira: %w (%v > %v)", model.ErrLinkTypeInUse, rename.Usage, options.MaxUsage)
+	}
+
+	if options.DryRun {
+		return rename, nil
+	}
+
+	if rename.After, _, err = i.Update(ctx, issueLinkTypeID, payload); err != nil {
+		return rename, err
+	}
+
+	rename.Renamed = true
+
+	return rename, nil
+}
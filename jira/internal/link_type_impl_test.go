@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
@@ -581,6 +582,34 @@ func Test_internalLinkTypeImpl_Create(t *testing.T) {
 			wantErr: true,
 			Err:     model.ErrCreateHttpReq,
 		},
+
+		{
+			name:   "when the link type name is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				payload: &model.LinkTypeScheme{Inward: "blocked by", Outward: "blocks"},
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNoLinkTypeName,
+		},
+
+		{
+			name:   "when the link type outward description is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				payload: &model.LinkTypeScheme{Name: "Blocks", Inward: "blocked by"},
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNoLinkTypeOutward,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -831,3 +860,251 @@ func Test_NewLinkTypeService(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalLinkTypeImpl_Rename(t *testing.T) {
+
+	linkTypeMocked := func(client *mocks.Connector) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/issueLinkType/10000",
+			"",
+			nil).
+			Return(&http.Request{Host: "get"}, nil)
+
+		client.On("Call",
+			&http.Request{Host: "get"},
+			&model.LinkTypeScheme{}).
+			Run(func(args mock.Arguments) {
+				*args.Get(1).(*model.LinkTypeScheme) = model.LinkTypeScheme{ID: "10000", Name: "Blocks", Inward: "is blocked by", Outward: "blocks"}
+			}).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	linkTypesMocked := func(client *mocks.Connector) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/issueLinkType",
+			"",
+			nil).
+			Return(&http.Request{Host: "gets"}, nil)
+
+		client.On("Call",
+			&http.Request{Host: "gets"},
+			&model.IssueLinkTypeSearchScheme{}).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*model.IssueLinkTypeSearchScheme).IssueLinkTypes = []*model.LinkTypeScheme{
+					{ID: "10000", Name: "Blocks"},
+					{ID: "10001", Name: "Cloners"},
+				}
+			}).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	usageMocked := func(client *mocks.Connector, count int) {
+
+		payload := struct {
+			Jql string `json:"jql,omitempty"`
+		}{
+			Jql: `issueLinkType in ("is blocked by", "blocks")`,
+		}
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPost,
+			"rest/api/3/search/approximate-count",
+			"",
+			payload).
+			Return(&http.Request{Host: "count"}, nil)
+
+		client.On("Call",
+			&http.Request{Host: "count"},
+			&model.IssueSearchApproximateCountScheme{}).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*model.IssueSearchApproximateCountScheme).Count = count
+			}).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	payloadMocked := &model.LinkTypeScheme{Name: "Blockers", Inward: "is blocked by", Outward: "blocks"}
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx             context.Context
+		issueLinkTypeID string
+		payload         *model.LinkTypeScheme
+		options         *model.LinkTypeRenameOptionsScheme
+	}
+
+	testCases := []struct {
+		name        string
+		fields      fields
+		args        args
+		on          func(*fields)
+		wantUsage   int
+		wantRenamed bool
+		wantErr     bool
+		Err         error
+	}{
+		{
+			name:   "when the link type is renamed",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:             context.Background(),
+				issueLinkTypeID: "10000",
+				payload:         payloadMocked,
+				options:         &model.LinkTypeRenameOptionsScheme{MaxUsage: 100},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				linkTypeMocked(client)
+				linkTypesMocked(client)
+				usageMocked(client, 42)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/3/issueLinkType/10000",
+					"",
+					payloadMocked).
+					Return(&http.Request{Host: "update"}, nil)
+
+				client.On("Call",
+					&http.Request{Host: "update"},
+					&model.LinkTypeScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantUsage:   42,
+			wantRenamed: true,
+		},
+
+		{
+			name:   "when the rename is a dry run",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:             context.Background(),
+				issueLinkTypeID: "10000",
+				payload:         payloadMocked,
+				options:         &model.LinkTypeRenameOptionsScheme{MaxUsage: -1, DryRun: true},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				linkTypeMocked(client)
+				linkTypesMocked(client)
+				usageMocked(client, 42)
+
+				fields.c = client
+			},
+			wantUsage: 42,
+		},
+
+		{
+			name:   "when the link type links more issues than allowed",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:             context.Background(),
+				issueLinkTypeID: "10000",
+				payload:         payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				linkTypeMocked(client)
+				linkTypesMocked(client)
+				usageMocked(client, 42)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrLinkTypeInUse,
+		},
+
+		{
+			name:   "when the name is used by another link type",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:             context.Background(),
+				issueLinkTypeID: "10000",
+				payload:         &model.LinkTypeScheme{Name: "cloners"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				linkTypeMocked(client)
+				linkTypesMocked(client)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrLinkTypeNameTaken,
+		},
+
+		{
+			name:   "when the payload is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:             context.Background(),
+				issueLinkTypeID: "10000",
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNoLinkTypeName,
+		},
+
+		{
+			name:   "when the link type id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				payload: payloadMocked,
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNoLinkTypeID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			linkTypeService, err := NewLinkTypeService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, err := linkTypeService.Rename(testCase.args.ctx, testCase.args.issueLinkTypeID, testCase.args.payload, testCase.args.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.wantUsage, gotResult.Usage)
+			assert.Equal(t, testCase.wantRenamed, gotResult.Renamed)
+			assert.Equal(t, "Blocks", gotResult.Before.Name)
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package internal
+
+import (
+	"errors"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// ignoreNotFound returns nil when the error reports a resource not found, so the GetIfExists lookups return false instead.
+func ignoreNotFound(err error) error {
+
+	if errors.Is(err, model.ErrNotFound) {
+		return nil
+	}
+
+	return err
+}
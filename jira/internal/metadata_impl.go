@@ -2,10 +2,13 @@ package internal
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
+	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/tidwall/gjson"
 
@@ -109,6 +112,24 @@ func (m *MetadataService) FetchFieldMappings(ctx context.Context, projectKeyOrID
 	return m.internalClient.FetchFieldMappings(ctx, projectKeyOrID, issueTypeID, startAt, maxResults)
 }
 
+// CreateCompat returns the create metadata of the projects in the shape of the deprecated Create, built from the
+// per-project and per-issue-type endpoints, to keep the code reading the legacy response working.
+//
+// The projects must be provided by key or ID, a project provided by both is returned once. The issue types are
+// filtered by ID or name, and the fields of the issue types are included when the expand contains
+// "projects.issuetypes.fields".
+//
+// GET /rest/api/{2-3}/project/{projectKeyOrID}
+//
+// GET /rest/api/{2-3}/issue/createmeta/{projectIdOrKey}/issuetypes
+//
+// GET /rest/api/{2-3}/issue/createmeta/{projectIdOrKey}/issuetypes/{issueTypeId}
+//
+// https://docs.go-atlassian.io/jira-software-cloud/issues/metadata#get-create-issue-metadata
+func (m *MetadataService) CreateCompat(ctx context.Context, opts *model.IssueMetadataCreateOptions) (gjson.Result, error) {
+	return m.internalClient.CreateCompat(ctx, opts)
+}
+
 type internalMetadataImpl struct {
 	c       service.Connector
 	version string
@@ -230,3 +251,121 @@ func (i *internalMetadataImpl) Create(ctx context.Context, opts *model.IssueMeta
 
 	return gjson.ParseBytes(response.Bytes.Bytes()), response, nil
 }
+
+// metadataCompatPageSize is the number of issue types and fields requested per page by CreateCompat.
+const metadataCompatPageSize = 50
+
+func (i *internalMetadataImpl) CreateCompat(ctx context.Context, opts *model.IssueMetadataCreateOptions) (gjson.Result, error) {
+
+	if opts == nil || len(opts.ProjectKeys)+len(opts.ProjectIDs) == 0 {
+		return gjson.Result{}, fmt.Errorf("jira: %w", model.ErrNoProjects)
+	}
+
+	expandFields := slices.Contains(strings.Split(opts.Expand, ","), model.IssueMetadataExpandFields)
+	projectService := &internalProjectImpl{c: i.c, version: i.version}
+
+	projects := make([]map[string]interface{}, 0, len(opts.ProjectKeys)+len(opts.ProjectIDs))
+	fetched := make(map[string]bool)
+	for _, projectKeyOrID := range append(slices.Clone(opts.ProjectIDs), opts.ProjectKeys...) {
+
+		project, _, err := projectService.Get(ctx, projectKeyOrID, nil)
+		if err != nil {
+			return gjson.Result{}, fmt.Errorf("jira: project %v: %w", projectKeyOrID, err)
+		}
+
+		// The project provided by both its ID and its key is returned once
+		if fetched[project.ID] {
+			continue
+		}
+		fetched[project.ID] = true
+
+		issueTypes, err := i.compatIssueTypes(ctx, projectKeyOrID, opts, expandFields)
+		if err != nil {
+			return gjson.Result{}, fmt.Errorf("jira: project %v: %w", projectKeyOrID, err)
+		}
+
+		projects = append(projects, map[string]interface{}{
+			"self":       project.Self,
+			"id":         project.ID,
+			"key":        project.Key,
+			"name":       project.Name,
+			"avatarUrls": project.AvatarURLs,
+			"issuetypes": issueTypes,
+		})
+	}
+
+	metadata, err := json.Marshal(map[string]interface{}{"projects": projects})
+	if err != nil {
+		return gjson.Result{}, err
+	}
+
+	return gjson.ParseBytes(metadata), nil
+}
+
+// compatIssueTypes returns the issue types of the project matching the options, in the shape of the deprecated create metadata.
+func (i *internalMetadataImpl) compatIssueTypes(ctx context.Context, projectKeyOrID string, opts *model.IssueMetadataCreateOptions, expandFields bool) ([]interface{}, error) {
+
+	var issueTypes []interface{}
+	for startAt := 0; ; startAt += metadataCompatPageSize {
+
+		page, _, err := i.FetchIssueMappings(ctx, projectKeyOrID, startAt, metadataCompatPageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		values := page.Get("issueTypes").Array()
+		for _, value := range values {
+
+			if len(opts.IssueTypeIDs) != 0 && !slices.Contains(opts.IssueTypeIDs, value.Get("id").String()) {
+				continue
+			}
+
+			if len(opts.IssueTypeNames) != 0 && !slices.Contains(opts.IssueTypeNames, value.Get("name").String()) {
+				continue
+			}
+
+			issueType, ok := value.Value().(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			if expandFields {
+
+				fields, err := i.compatFields(ctx, projectKeyOrID, value.Get("id").String())
+				if err != nil {
+					return nil, err
+				}
+
+				issueType["fields"] = fields
+			}
+
+			issueTypes = append(issueTypes, issueType)
+		}
+
+		if len(values) == 0 || startAt+len(values) >= int(page.Get("total").Int()) {
+			return issueTypes, nil
+		}
+	}
+}
+
+// compatFields returns the fields of the issue type keyed by field ID, in the shape of the deprecated create metadata.
+func (i *internalMetadataImpl) compatFields(ctx context.Context, projectKeyOrID, issueTypeID string) (map[string]interface{}, error) {
+
+	fields := make(map[string]interface{})
+	for startAt := 0; ; startAt += metadataCompatPageSize {
+
+		page, _, err := i.FetchFieldMappings(ctx, projectKeyOrID, issueTypeID, startAt, metadataCompatPageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		values := page.Get("fields").Array()
+		for _, value := range values {
+			fields[value.Get("fieldId").String()] = value.Value()
+		}
+
+		if len(values) == 0 || startAt+len(values) >= int(page.Get("total").Int()) {
+			return fields, nil
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package internal
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -9,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/tidwall/gjson"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
@@ -777,3 +779,174 @@ func Test_internalMetadataImpl_FetchIssueMappings(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalMetadataImpl_CreateCompat(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx  context.Context
+		opts *model.IssueMetadataCreateOptions
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    string
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the project is provided by both its ID and its key",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+				opts: &model.IssueMetadataCreateOptions{
+					ProjectIDs:     []string{"10000"},
+					ProjectKeys:    []string{"KP"},
+					IssueTypeNames: []string{"Bug"},
+					Expand:         model.IssueMetadataExpandFields,
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/project/10000",
+					"", nil).
+					Return(&http.Request{Host: "project"}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/project/KP",
+					"", nil).
+					Return(&http.Request{Host: "project"}, nil)
+
+				client.On("Call",
+					&http.Request{Host: "project"},
+					&model.ProjectScheme{}).
+					Run(func(args mock.Arguments) {
+						project := args.Get(1).(*model.ProjectScheme)
+						project.ID, project.Key, project.Name = "10000", "KP", "Kanban Project"
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/issue/createmeta/10000/issuetypes?maxResults=50&startAt=0",
+					"", nil).
+					Return(&http.Request{Host: "issuetypes"}, nil)
+
+				client.On("Call",
+					&http.Request{Host: "issuetypes"},
+					nil).
+					Return(&model.ResponseScheme{Bytes: *bytes.NewBufferString(`{"issueTypes":[{"id":"10001","name":"Task"},{"id":"10002","name":"Bug"}],"startAt":0,"total":2}`)}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/issue/createmeta/10000/issuetypes/10002?maxResults=50&startAt=0",
+					"", nil).
+					Return(&http.Request{Host: "fields"}, nil)
+
+				client.On("Call",
+					&http.Request{Host: "fields"},
+					nil).
+					Return(&model.ResponseScheme{Bytes: *bytes.NewBufferString(`{"fields":[{"fieldId":"summary","name":"Summary","required":true}],"startAt":0,"total":1}`)}, nil)
+
+				fields.c = client
+			},
+			want: `{"projects":[{"avatarUrls":null,"id":"10000","issuetypes":[{"fields":{"summary":{"fieldId":"summary","name":"Summary","required":true}},"id":"10002","name":"Bug"}],"key":"KP","name":"Kanban Project","self":""}]}`,
+		},
+
+		{
+			name:   "when the projects are not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:  context.Background(),
+				opts: &model.IssueMetadataCreateOptions{},
+			},
+			wantErr: true,
+			Err:     model.ErrNoProjects,
+		},
+
+		{
+			name:   "when the issue types cannot be fetched",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:  context.Background(),
+				opts: &model.IssueMetadataCreateOptions{ProjectIDs: []string{"10000"}},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/project/10000",
+					"", nil).
+					Return(&http.Request{Host: "project"}, nil)
+
+				client.On("Call",
+					&http.Request{Host: "project"},
+					&model.ProjectScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/issue/createmeta/10000/issuetypes?maxResults=50&startAt=0",
+					"", nil).
+					Return(&http.Request{Host: "issuetypes"}, nil)
+
+				client.On("Call",
+					&http.Request{Host: "issuetypes"},
+					nil).
+					Return(&model.ResponseScheme{}, model.ErrUnauthorized)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrUnauthorized,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			metadataService, err := NewMetadataService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, err := metadataService.CreateCompat(testCase.args.ctx, testCase.args.opts)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.JSONEq(t, testCase.want, gotResult.Raw)
+			assert.Equal(t, "Summary", gotResult.Get("projects.0.issuetypes.0.fields.summary.name").String())
+		})
+	}
+}
@@ -8,6 +8,10 @@ import (
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/jira"
 	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
 )
 
 // NewPermissionService creates a new instance of PermissionService.
@@ -58,9 +62,40 @@ func (p *PermissionService) Projects(ctx context.Context, permissions []string)
 	return p.internalClient.Projects(ctx, permissions)
 }
 
+// Mine returns the permissions of the current user, globally or in the context of a project or an issue.
+//
+// GET /rest/api/{2-3}/mypermissions
+//
+// https://docs.go-atlassian.io/jira-software-cloud/permissions#get-my-permissions
+func (p *PermissionService) Mine(ctx context.Context, options *model.MyPermissionsOptionsScheme) (*model.MyPermissionsScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Mine(ctx, options)
+}
+
+// Can reports whether the current user has a permission, globally or in the project when projectKey is set.
+//
+// The answers are cached for a few minutes, so it can be used to gate actions without calling Jira every time.
+//
+// GET /rest/api/{2-3}/mypermissions
+func (p *PermissionService) Can(ctx context.Context, permissionKey, projectKey string) (bool, error) {
+	return p.internalClient.Can(ctx, permissionKey, projectKey)
+}
+
+// permissionCacheTTL is how long the answers of Can are cached.
+const permissionCacheTTL = 5 * time.Minute
+
 type internalPermissionImpl struct {
 	c       service.Connector
 	version string
+
+	// grants caches the answers of Can, keyed by the project key and the permission key.
+	grants   map[string]permissionGrant
+	grantsMu sync.RWMutex
+}
+
+// permissionGrant is a cached answer of Can.
+type permissionGrant struct {
+	granted bool
+	expires time.Time
 }
 
 func (i *internalPermissionImpl) Gets(ctx context.Context) ([]*model.PermissionScheme, *model.ResponseScheme, error) {
@@ -139,3 +174,80 @@ func (i *internalPermissionImpl) Projects(ctx context.Context, permissions []str
 
 	return projects, response, nil
 }
+
+func (i *internalPermissionImpl) Mine(ctx context.Context, options *model.MyPermissionsOptionsScheme) (*model.MyPermissionsScheme, *model.ResponseScheme, error) {
+
+	if options == nil || len(options.Permissions) == 0 {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoPermissionKeys)
+	}
+
+	params := url.Values{}
+	params.Add("permissions", strings.Join(options.Permissions, ","))
+
+	if options.ProjectKey != "" {
+		params.Add("projectKey", options.ProjectKey)
+	}
+
+	if options.ProjectID != "" {
+		params.Add("projectId", options.ProjectID)
+	}
+
+	if options.IssueKey != "" {
+		params.Add("issueKey", options.IssueKey)
+	}
+
+	if options.IssueID != "" {
+		params.Add("issueId", options.IssueID)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/mypermissions?%v", i.version, params.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	permissions := new(model.MyPermissionsScheme)
+	response, err := i.c.Call(request, permissions)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return permissions, response, nil
+}
+
+func (i *internalPermissionImpl) Can(ctx context.Context, permissionKey, projectKey string) (bool, error) {
+
+	if permissionKey == "" {
+		return false, fmt.Errorf("jira: %w", model.ErrNoPermissionKey)
+	}
+
+	key := projectKey + "/" + permissionKey
+
+	i.grantsMu.RLock()
+	grant, ok := i.grants[key]
+	i.grantsMu.RUnlock()
+
+	if ok && time.Now().Before(grant.expires) {
+		return grant.granted, nil
+	}
+
+	permissions, _, err := i.Mine(ctx, &model.MyPermissionsOptionsScheme{
+		Permissions: []string{permissionKey},
+		ProjectKey:  projectKey,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	granted := permissions.Has(permissionKey)
+
+	i.grantsMu.Lock()
+	if i.grants == nil {
+		i.grants = make(map[string]permissionGrant)
+	}
+	i.grants[key] = permissionGrant{granted: granted, expires: time.Now().Add(permissionCacheTTL)}
+	i.grantsMu.Unlock()
+
+	return granted, nil
+}
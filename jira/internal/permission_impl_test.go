@@ -9,6 +9,7 @@ import (
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"net/http"
 	"net/url"
 	"testing"
@@ -579,3 +580,352 @@ func Test_NewPermissionService(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalPermissionImpl_Mine(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx     context.Context
+		options *model.MyPermissionsOptionsScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+				options: &model.MyPermissionsOptionsScheme{
+					Permissions: []string{"BROWSE_PROJECTS", "EDIT_ISSUES"},
+					ProjectKey:  "KP",
+					IssueKey:    "KP-1",
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/mypermissions?issueKey=KP-1&permissions=BROWSE_PROJECTS%2CEDIT_ISSUES&projectKey=KP",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.MyPermissionsScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx: context.Background(),
+				options: &model.MyPermissionsOptionsScheme{
+					Permissions: []string{"BROWSE_PROJECTS", "EDIT_ISSUES"},
+					ProjectKey:  "KP",
+					IssueKey:    "KP-1",
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/mypermissions?issueKey=KP-1&permissions=BROWSE_PROJECTS%2CEDIT_ISSUES&projectKey=KP",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.MyPermissionsScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the permission keys are not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				options: &model.MyPermissionsOptionsScheme{ProjectKey: "KP"},
+			},
+			wantErr: true,
+			Err:     model.ErrNoPermissionKeys,
+		},
+
+		{
+			name:   "when the options are not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoPermissionKeys,
+		},
+
+		{
+			name:   "when the http call cannot be executed",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+				options: &model.MyPermissionsOptionsScheme{
+					Permissions: []string{"BROWSE_PROJECTS", "EDIT_ISSUES"},
+					ProjectKey:  "KP",
+					IssueKey:    "KP-1",
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/mypermissions?issueKey=KP-1&permissions=BROWSE_PROJECTS%2CEDIT_ISSUES&projectKey=KP",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.MyPermissionsScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrUnauthorized)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrUnauthorized,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+				options: &model.MyPermissionsOptionsScheme{
+					Permissions: []string{"BROWSE_PROJECTS", "EDIT_ISSUES"},
+					ProjectKey:  "KP",
+					IssueKey:    "KP-1",
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/mypermissions?issueKey=KP-1&permissions=BROWSE_PROJECTS%2CEDIT_ISSUES&projectKey=KP",
+					"",
+					nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewPermissionService(testCase.fields.c, testCase.fields.version, nil)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := newService.Mine(testCase.args.ctx, testCase.args.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalPermissionImpl_Can(t *testing.T) {
+
+	mineMocked := func(client *mocks.Connector, endpoint string, granted bool, err error) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			endpoint,
+			"", nil).
+			Return(&http.Request{}, nil).
+			Once()
+
+		client.On("Call",
+			&http.Request{},
+			&model.MyPermissionsScheme{}).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*model.MyPermissionsScheme).Permissions = map[string]*model.MyPermissionScheme{
+					"EDIT_ISSUES": {Key: "EDIT_ISSUES", Type: "PROJECT", HavePermission: granted},
+				}
+			}).
+			Return(&model.ResponseScheme{}, err).
+			Once()
+	}
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx                       context.Context
+		permissionKey, projectKey string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    bool
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the user has the permission in the project",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:           context.Background(),
+				permissionKey: "EDIT_ISSUES",
+				projectKey:    "KP",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				mineMocked(client, "rest/api/3/mypermissions?permissions=EDIT_ISSUES&projectKey=KP", true, nil)
+
+				fields.c = client
+			},
+			want:    true,
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the user doesn't have the global permission",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:           context.Background(),
+				permissionKey: "EDIT_ISSUES",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				mineMocked(client, "rest/api/2/mypermissions?permissions=EDIT_ISSUES", false, nil)
+
+				fields.c = client
+			},
+			want:    false,
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the permission key is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				projectKey: "KP",
+			},
+			wantErr: true,
+			Err:     model.ErrNoPermissionKey,
+		},
+
+		{
+			name:   "when the http call cannot be executed",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:           context.Background(),
+				permissionKey: "EDIT_ISSUES",
+				projectKey:    "KP",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				mineMocked(client, "rest/api/3/mypermissions?permissions=EDIT_ISSUES&projectKey=KP", false, model.ErrUnauthorized)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrUnauthorized,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewPermissionService(testCase.fields.c, testCase.fields.version, nil)
+			assert.NoError(t, err)
+
+			got, err := newService.Can(testCase.args.ctx, testCase.args.permissionKey, testCase.args.projectKey)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.want, got)
+
+			// The second answer comes from the cache, the mocked calls are only expected once.
+			got, err = newService.Can(testCase.args.ctx, testCase.args.permissionKey, testCase.args.projectKey)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.want, got)
+		})
+	}
+}
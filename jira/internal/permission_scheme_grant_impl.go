@@ -32,6 +32,8 @@ type PermissionSchemeGrantService struct {
 
 // Create creates a permission grant in a permission scheme.
 //
+// Use the model.NewPermissionGrantPayload and the permission holder constructors to build the payload.
+//
 // POST /rest/api/{2-3}/permissionscheme/{permissionSchemeID}/permission
 //
 // https://docs.go-atlassian.io/jira-software-cloud/permissions/scheme/grant#create-permission-grant
@@ -77,6 +79,14 @@ func (i *internalPermissionSchemeGrantImpl) Create(ctx context.Context, permissi
 		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoPermissionSchemeID)
 	}
 
+	if payload == nil || payload.Holder == nil || payload.Holder.Type == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoPermissionGrantHolder)
+	}
+
+	if payload.Permission == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoPermissionKeys)
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/permissionscheme/%v/permission", i.version, permissionSchemeID)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
@@ -17,13 +17,9 @@ import (
 
 func TestPermissionSchemeGrantService_Create(t *testing.T) {
 
-	payloadMocked := &model.PermissionGrantPayloadScheme{
-		Holder: &model.PermissionGrantHolderScheme{
-			Parameter: "scrum-masters",
-			Type:      "group",
-		},
-		Permission: "EDIT_ISSUES",
-	}
+	payloadMocked := model.NewPermissionGrantPayload(
+		model.PermissionEditIssues,
+		model.NewGroupPermissionHolder("276f955c-63d7-42c8-9520-92d01dca0625"))
 
 	type fields struct {
 		c       service.Connector
@@ -114,6 +110,30 @@ func TestPermissionSchemeGrantService_Create(t *testing.T) {
 			Err:     model.ErrNoPermissionSchemeID,
 		},
 
+		{
+			name:   "when the permission holder is not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:                context.Background(),
+				permissionSchemeID: 10001,
+				payload:            model.NewPermissionGrantPayload(model.PermissionEditIssues, nil),
+			},
+			wantErr: true,
+			Err:     model.ErrNoPermissionGrantHolder,
+		},
+
+		{
+			name:   "when the permission key is not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:                context.Background(),
+				permissionSchemeID: 10001,
+				payload:            model.NewPermissionGrantPayload("", model.NewProjectRolePermissionHolder("10002")),
+			},
+			wantErr: true,
+			Err:     model.ErrNoPermissionKeys,
+		},
+
 		{
 			name:   "when the http request cannot be created",
 			fields: fields{version: "2"},
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
@@ -84,6 +86,25 @@ func (p *ProjectComponentService) Get(ctx context.Context, componentID string) (
 	return p.internalClient.Get(ctx, componentID)
 }
 
+// Search returns a page of the components of the projects, of every project when none is provided.
+//
+// GET /rest/api/{2-3}/component
+//
+// https://docs.go-atlassian.io/jira-software-cloud/projects/components#find-components-for-projects
+func (p *ProjectComponentService) Search(ctx context.Context, options *model.ComponentSearchOptionsScheme, startAt, maxResults int) (*model.ComponentPageScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Search(ctx, options, startAt, maxResults)
+}
+
+// GetsByLead returns the components led by the user across the projects, of every project when none is provided,
+// e.g. to audit the ownership of the components or to reassign them when the user is offboarded.
+//
+// Every page of the components is read, the components being filtered by the account ID of their lead.
+//
+// GET /rest/api/{2-3}/component
+func (p *ProjectComponentService) GetsByLead(ctx context.Context, accountID string, projectIDsOrKeys []string) ([]*model.ComponentScheme, error) {
+	return p.internalClient.GetsByLead(ctx, accountID, projectIDsOrKeys)
+}
+
 type internalProjectComponentImpl struct {
 	c       service.Connector
 	version string
@@ -210,3 +231,84 @@ func (i *internalProjectComponentImpl) Get(ctx context.Context, componentID stri
 
 	return component, response, nil
 }
+
+func (i *internalProjectComponentImpl) Search(ctx context.Context, options *model.ComponentSearchOptionsScheme, startAt, maxResults int) (*model.ComponentPageScheme, *model.ResponseScheme, error) {
+
+	params := url.Values{}
+	params.Add("startAt", strconv.Itoa(startAt))
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	if options != nil {
+
+		for _, projectIDOrKey := range options.ProjectIDsOrKeys {
+			params.Add("projectIdsOrKeys", projectIDOrKey)
+		}
+
+		if options.Query != "" {
+			params.Add("query", options.Query)
+		}
+
+		if options.OrderBy != "" {
+			params.Add("orderBy", options.OrderBy)
+		}
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/component?%v", i.version, params.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.ComponentPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// componentSearchPageSize is the page size used by GetsByLead to search the components.
+const componentSearchPageSize = 50
+
+func (i *internalProjectComponentImpl) GetsByLead(ctx context.Context, accountID string, projectIDsOrKeys []string) ([]*model.ComponentScheme, error) {
+
+	if accountID == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoAccountID)
+	}
+
+	options := &model.ComponentSearchOptionsScheme{ProjectIDsOrKeys: projectIDsOrKeys}
+
+	var components []*model.ComponentScheme
+	for startAt := 0; ; {
+
+		page, _, err := i.Search(ctx, options, startAt, componentSearchPageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, component := range page.Values {
+			if component != nil && componentLeadAccountID(component) == accountID {
+				components = append(components, component)
+			}
+		}
+
+		startAt += len(page.Values)
+		if page.IsLast || len(page.Values) == 0 || (page.Total > 0 && startAt >= page.Total) {
+			break
+		}
+	}
+
+	return components, nil
+}
+
+// componentLeadAccountID returns the account ID of the lead of the component, read from the lead when it's not returned.
+func componentLeadAccountID(component *model.ComponentScheme) string {
+
+	if component.LeadAccountID != "" || component.Lead == nil {
+		return component.LeadAccountID
+	}
+
+	return component.Lead.AccountID
+}
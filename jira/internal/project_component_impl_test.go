@@ -985,3 +985,250 @@ func Test_NewProjectComponentService(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalProjectComponentImpl_Search(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx                 context.Context
+		options             *model.ComponentSearchOptionsScheme
+		startAt, maxResults int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+				options: &model.ComponentSearchOptionsScheme{
+					ProjectIDsOrKeys: []string{"KP", "10001"},
+					Query:            "backend",
+					OrderBy:          "name",
+				},
+				startAt:    50,
+				maxResults: 50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/component?maxResults=50&orderBy=name&projectIdsOrKeys=KP&projectIdsOrKeys=10001&query=backend&startAt=50",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ComponentPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:        context.Background(),
+				maxResults: 50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/component?maxResults=50&startAt=0",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ComponentPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				maxResults: 50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/component?maxResults=50&startAt=0",
+					"",
+					nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			componentService, err := NewProjectComponentService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := componentService.Search(testCase.args.ctx, testCase.args.options, testCase.args.startAt,
+				testCase.args.maxResults)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotNil(t, gotResponse)
+			assert.NotNil(t, gotResult)
+		})
+	}
+}
+
+func Test_internalProjectComponentImpl_GetsByLead(t *testing.T) {
+
+	pageMocked := func(client *mocks.Connector, startAt string, page *model.ComponentPageScheme) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/component?maxResults=50&projectIdsOrKeys=KP&projectIdsOrKeys=DESK&startAt="+startAt,
+			"",
+			nil).
+			Return(&http.Request{Host: startAt}, nil)
+
+		client.On("Call",
+			&http.Request{Host: startAt},
+			&model.ComponentPageScheme{}).
+			Run(func(args mock.Arguments) {
+				*args.Get(1).(*model.ComponentPageScheme) = *page
+			}).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	testCases := []struct {
+		name      string
+		accountID string
+		on        func() service.Connector
+		want      []string
+		wantErr   bool
+		Err       error
+	}{
+		{
+			name:      "when the components are led by the user",
+			accountID: "uuid-a",
+			on: func() service.Connector {
+
+				client := mocks.NewConnector(t)
+
+				pageMocked(client, "0", &model.ComponentPageScheme{Total: 3, Values: []*model.ComponentScheme{
+					{ID: "10000", Lead: &model.UserScheme{AccountID: "uuid-a"}},
+					{ID: "10001", LeadAccountID: "uuid-b"},
+				}})
+
+				pageMocked(client, "2", &model.ComponentPageScheme{Total: 3, IsLast: true, Values: []*model.ComponentScheme{
+					{ID: "10002", LeadAccountID: "uuid-a"},
+				}})
+
+				return client
+			},
+			want: []string{"10000", "10002"},
+		},
+
+		{
+			name:      "when the components cannot be searched",
+			accountID: "uuid-a",
+			on: func() service.Connector {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/component?maxResults=50&projectIdsOrKeys=KP&projectIdsOrKeys=DESK&startAt=0",
+					"",
+					nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				return client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name:    "when the account id is not provided",
+			wantErr: true,
+			Err:     model.ErrNoAccountID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			var client service.Connector
+			if testCase.on != nil {
+				client = testCase.on()
+			}
+
+			componentService, err := NewProjectComponentService(client, "3")
+			assert.NoError(t, err)
+
+			gotComponents, err := componentService.GetsByLead(context.Background(), testCase.accountID, []string{"KP", "DESK"})
+
+			if testCase.wantErr {
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+				return
+			}
+
+			assert.NoError(t, err)
+
+			var gotIDs []string
+			for _, component := range gotComponents {
+				gotIDs = append(gotIDs, component.ID)
+			}
+
+			assert.Equal(t, testCase.want, gotIDs)
+		})
+	}
+}
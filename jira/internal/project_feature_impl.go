@@ -46,6 +46,20 @@ func (p *ProjectFeatureService) Set(ctx context.Context, projectKeyOrID, feature
 	return p.internalClient.Set(ctx, projectKeyOrID, featureKey, state)
 }
 
+// SetState sets the state of a project feature after checking it can be toggled.
+//
+// The features of the project are fetched first, the feature must exist, must not be locked, and its prerequisites
+// must be enabled before it can be enabled.
+//
+// The features are returned without an update when the feature is already in the requested state.
+//
+// PUT /rest/api/{2-3}/project/{projectKeyOrID}/features/{featureKey}
+//
+// https://docs.go-atlassian.io/jira-software-cloud/projects/features#set-project-feature-state
+func (p *ProjectFeatureService) SetState(ctx context.Context, projectKeyOrID string, featureKey model.ProjectFeatureKey, state model.ProjectFeatureState) (*model.ProjectFeaturesScheme, *model.ResponseScheme, error) {
+	return p.internalClient.SetState(ctx, projectKeyOrID, featureKey, state)
+}
+
 type internalProjectFeatureImpl struct {
 	c       service.Connector
 	version string
@@ -104,3 +118,50 @@ func (i *internalProjectFeatureImpl) Set(ctx context.Context, projectKeyOrID, fe
 
 	return features, response, nil
 }
+
+func (i *internalProjectFeatureImpl) SetState(ctx context.Context, projectKeyOrID string, featureKey model.ProjectFeatureKey, state model.ProjectFeatureState) (*model.ProjectFeaturesScheme, *model.ResponseScheme, error) {
+
+	if projectKeyOrID == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoProjectIDOrKey)
+	}
+
+	if featureKey == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoProjectFeatureKey)
+	}
+
+	switch state {
+	case "":
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoProjectFeatureState)
+	case model.ProjectFeatureEnabled, model.ProjectFeatureDisabled:
+	default:
+		return nil, nil, fmt.Errorf("jira: %w: %v", model.ErrInvalidProjectFeatureState, state)
+	}
+
+	features, response, err := i.Gets(ctx, projectKeyOrID)
+	if err != nil {
+		return nil, response, err
+	}
+
+	feature := features.Feature(featureKey)
+	if feature == nil {
+		return nil, response, fmt.Errorf("jira: %w: %v", model.ErrProjectFeatureNotFound, featureKey)
+	}
+
+	if feature.FeatureState() == state {
+		return features, response, nil
+	}
+
+	if feature.ToggleLocked {
+		return nil, response, fmt.Errorf("jira: %w: %v", model.ErrProjectFeatureLocked, featureKey)
+	}
+
+	if state == model.ProjectFeatureEnabled {
+		for _, prerequisite := range feature.PrerequisiteKeys() {
+			if !features.Feature(prerequisite).Enabled() {
+				return nil, response, fmt.Errorf("jira: %w: %v requires %v", model.ErrProjectFeaturePrerequisite, featureKey, prerequisite)
+			}
+		}
+	}
+
+	return i.Set(ctx, projectKeyOrID, string(featureKey), string(state))
+}
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
@@ -405,3 +406,303 @@ func Test_NewProjectFeatureService(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalProjectFeatureImpl_SetState(t *testing.T) {
+
+	featuresMocked := []*model.ProjectFeatureScheme{
+		{Feature: "jsw.agility.backlog", State: "ENABLED"},
+		{Feature: "jsw.agility.sprints", State: "DISABLED", Prerequisites: []string{"jsw.agility.backlog"}},
+		{Feature: "jsw.agility.estimation", State: "DISABLED", Prerequisites: []string{"jsw.agility.sprints"}},
+		{Feature: "jsw.agility.code", State: "ENABLED", ToggleLocked: true},
+	}
+
+	getsMocked := func(client *mocks.Connector, version string) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/"+version+"/project/DUMMY/features",
+			"", nil).
+			Return(&http.Request{Method: http.MethodGet}, nil)
+
+		client.On("Call",
+			&http.Request{Method: http.MethodGet},
+			&model.ProjectFeaturesScheme{}).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*model.ProjectFeaturesScheme).Features = featuresMocked
+			}).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx            context.Context
+		projectKeyOrID string
+		featureKey     model.ProjectFeatureKey
+		state          model.ProjectFeatureState
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the feature is enabled",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrID: "DUMMY",
+				featureKey:     model.ProjectFeatureSprints,
+				state:          model.ProjectFeatureEnabled,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				getsMocked(client, "3")
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/3/project/DUMMY/features/jsw.agility.sprints",
+					"", map[string]interface{}{"state": "ENABLED"}).
+					Return(&http.Request{Method: http.MethodPut}, nil)
+
+				client.On("Call",
+					&http.Request{Method: http.MethodPut},
+					&model.ProjectFeaturesScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the feature is disabled with the api version v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrID: "DUMMY",
+				featureKey:     model.ProjectFeatureBacklog,
+				state:          model.ProjectFeatureDisabled,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				getsMocked(client, "2")
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/2/project/DUMMY/features/jsw.agility.backlog",
+					"", map[string]interface{}{"state": "DISABLED"}).
+					Return(&http.Request{Method: http.MethodPut}, nil)
+
+				client.On("Call",
+					&http.Request{Method: http.MethodPut},
+					&model.ProjectFeaturesScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the feature is already in the state",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrID: "DUMMY",
+				featureKey:     model.ProjectFeatureCode,
+				state:          model.ProjectFeatureEnabled,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				getsMocked(client, "3")
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the feature is locked",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrID: "DUMMY",
+				featureKey:     model.ProjectFeatureCode,
+				state:          model.ProjectFeatureDisabled,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				getsMocked(client, "3")
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrProjectFeatureLocked,
+		},
+
+		{
+			name:   "when a prerequisite of the feature is not enabled",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrID: "DUMMY",
+				featureKey:     model.ProjectFeatureEstimation,
+				state:          model.ProjectFeatureEnabled,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				getsMocked(client, "3")
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrProjectFeaturePrerequisite,
+		},
+
+		{
+			name:   "when the project doesn't have the feature",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrID: "DUMMY",
+				featureKey:     model.ProjectFeatureRoadmap,
+				state:          model.ProjectFeatureEnabled,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				getsMocked(client, "3")
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrProjectFeatureNotFound,
+		},
+
+		{
+			name:   "when the state cannot be set",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrID: "DUMMY",
+				featureKey:     model.ProjectFeatureSprints,
+				state:          model.ProjectFeatureComingSoon,
+			},
+			wantErr: true,
+			Err:     model.ErrInvalidProjectFeatureState,
+		},
+
+		{
+			name:   "when the project key or id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoProjectIDOrKey,
+		},
+
+		{
+			name:   "when the feature key is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrID: "DUMMY",
+			},
+			wantErr: true,
+			Err:     model.ErrNoProjectFeatureKey,
+		},
+
+		{
+			name:   "when the state is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrID: "DUMMY",
+				featureKey:     model.ProjectFeatureSprints,
+			},
+			wantErr: true,
+			Err:     model.ErrNoProjectFeatureState,
+		},
+
+		{
+			name:   "when the features cannot be fetched",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrID: "DUMMY",
+				featureKey:     model.ProjectFeatureSprints,
+				state:          model.ProjectFeatureEnabled,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/project/DUMMY/features",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ProjectFeaturesScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrUnauthorized)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrUnauthorized,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewProjectFeatureService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := newService.SetState(testCase.args.ctx, testCase.args.projectKeyOrID, testCase.args.featureKey,
+				testCase.args.state)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
@@ -2,11 +2,15 @@ package internal
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
@@ -98,6 +102,13 @@ func (p *ProjectService) Search(ctx context.Context, options *model.ProjectSearc
 	return p.internalClient.Search(ctx, options, startAt, maxResults)
 }
 
+// Iterate returns an iterator over the projects visible to the user, fetching the pages of maxResults projects on demand.
+//
+// GET /rest/api/{2-3}/project/search
+func (p *ProjectService) Iterate(ctx context.Context, options *model.ProjectSearchOptionsScheme, maxResults int) *model.PageIterator[*model.ProjectScheme] {
+	return p.internalClient.Iterate(ctx, options, maxResults)
+}
+
 // Get returns the project details for a project.
 //
 // GET /rest/api/{2-3}/project/{projectKeyOrID}
@@ -107,6 +118,13 @@ func (p *ProjectService) Get(ctx context.Context, projectKeyOrID string, expand
 	return p.internalClient.Get(ctx, projectKeyOrID, expand)
 }
 
+// GetIfExists returns the project, and false instead of an error when the project doesn't exist.
+//
+// GET /rest/api/{2-3}/project/{projectKeyOrID}
+func (p *ProjectService) GetIfExists(ctx context.Context, projectKeyOrID string, expand []string) (*model.ProjectScheme, bool, error) {
+	return p.internalClient.GetIfExists(ctx, projectKeyOrID, expand)
+}
+
 // Update updates the project details of a project.
 //
 // PUT /rest/api/{2-3}/project/{projectKeyOrID}
@@ -184,6 +202,41 @@ func (p *ProjectService) NotificationScheme(ctx context.Context, projectKeyOrID
 	return p.internalClient.NotificationScheme(ctx, projectKeyOrID, expand)
 }
 
+// Snapshot captures the configuration of the project in a single document, e.g. for audits or drift detection.
+//
+// It includes the issue type, issue type screen, field configuration, workflow, permission and notification schemes,
+// the issue type mappings of the schemes, the screen schemes mapped to the issue types with their screens, and the
+// project roles with their actors. The schemes are fetched in parallel.
+func (p *ProjectService) Snapshot(ctx context.Context, projectKeyOrID string) (*model.ProjectConfigurationSnapshotScheme, error) {
+	return p.internalClient.Snapshot(ctx, projectKeyOrID)
+}
+
+// SetLead changes the lead of the project, e.g. when the current lead leaves the organization.
+//
+// PUT /rest/api/{2-3}/project/{projectKeyOrID}
+func (p *ProjectService) SetLead(ctx context.Context, projectKeyOrID, accountID string) (*model.ProjectScheme, *model.ResponseScheme, error) {
+	return p.internalClient.SetLead(ctx, projectKeyOrID, accountID)
+}
+
+// SetDefaultAssignee changes the default assignee of the issues created in the project.
+//
+// PUT /rest/api/{2-3}/project/{projectKeyOrID}
+func (p *ProjectService) SetDefaultAssignee(ctx context.Context, projectKeyOrID string, assigneeType model.ProjectAssigneeType) (*model.ProjectScheme, *model.ResponseScheme, error) {
+	return p.internalClient.SetDefaultAssignee(ctx, projectKeyOrID, assigneeType)
+}
+
+// LedBy returns every project visible to the user whose lead is the account, e.g. to reassign them when offboarding the user.
+//
+// The projects are searched with the lead expanded, and filtered by the account ID of the lead.
+//
+// GET /rest/api/{2-3}/project/search
+func (p *ProjectService) LedBy(ctx context.Context, accountID string) ([]*model.ProjectScheme, error) {
+	return p.internalClient.LedBy(ctx, accountID)
+}
+
+// projectLeadPageSize is the page size used by LedBy to search the projects.
+const projectLeadPageSize = 50
+
 type internalProjectImpl struct {
 	c       service.Connector
 	version string
@@ -307,6 +360,16 @@ func (i *internalProjectImpl) Get(ctx context.Context, projectKeyOrID string, ex
 	return project, response, nil
 }
 
+func (i *internalProjectImpl) GetIfExists(ctx context.Context, projectKeyOrID string, expand []string) (*model.ProjectScheme, bool, error) {
+
+	project, _, err := i.Get(ctx, projectKeyOrID, expand)
+	if err != nil {
+		return nil, false, ignoreNotFound(err)
+	}
+
+	return project, true, nil
+}
+
 func (i *internalProjectImpl) Update(ctx context.Context, projectKeyOrID string, payload *model.ProjectUpdateScheme) (*model.ProjectScheme, *model.ResponseScheme, error) {
 
 	if projectKeyOrID == "" {
@@ -460,3 +523,328 @@ func (i *internalProjectImpl) NotificationScheme(ctx context.Context, projectKey
 
 	return notificationScheme, response, nil
 }
+
+// projectSnapshotPageSize is the number of items fetched per page by the project configuration snapshot.
+const projectSnapshotPageSize = 50
+
+func (i *internalProjectImpl) Snapshot(ctx context.Context, projectKeyOrID string) (*model.ProjectConfigurationSnapshotScheme, error) {
+
+	if projectKeyOrID == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoProjectIDOrKey)
+	}
+
+	project, _, err := i.Get(ctx, projectKeyOrID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	projectID, err := strconv.Atoi(project.ID)
+	if err != nil {
+		return nil, fmt.Errorf("jira: project id %q: %w", project.ID, err)
+	}
+
+	var (
+		snapshot      = &model.ProjectConfigurationSnapshotScheme{CapturedAt: time.Now().UTC(), Project: project}
+		typeSchemes   = &internalTypeSchemeImpl{c: i.c, version: i.version}
+		screenSchemes = &internalTypeScreenSchemeImpl{c: i.c, version: i.version}
+		fieldSchemes  = &internalIssueFieldConfigSchemeServiceImpl{c: i.c, version: i.version}
+		roles         = &internalProjectRoleImpl{c: i.c, version: i.version}
+		details       []*model.ProjectRoleDetailScheme
+	)
+
+	err = runConcurrently(
+		func() error {
+			page, _, err := typeSchemes.Projects(ctx, []int{projectID}, 0, projectSnapshotPageSize)
+			if err != nil {
+				return fmt.Errorf("jira: issue type scheme: %w", err)
+			}
+
+			for _, value := range page.Values {
+				snapshot.IssueTypeScheme = value.IssueTypeScheme
+			}
+
+			return nil
+		},
+		func() error {
+			page, _, err := screenSchemes.Projects(ctx, []int{projectID}, 0, projectSnapshotPageSize)
+			if err != nil {
+				return fmt.Errorf("jira: issue type screen scheme: %w", err)
+			}
+
+			for _, value := range page.Values {
+				snapshot.IssueTypeScreenScheme = value.IssueTypeScreenScheme
+			}
+
+			return nil
+		},
+		func() error {
+			page, _, err := fieldSchemes.Project(ctx, []int{projectID}, 0, projectSnapshotPageSize)
+			if err != nil {
+				return fmt.Errorf("jira: field configuration scheme: %w", err)
+			}
+
+			for _, value := range page.Values {
+				snapshot.FieldConfigurationScheme = value.FieldConfigurationScheme
+			}
+
+			return nil
+		},
+		func() error {
+			page, _, err := (&internalWorkflowSchemeImpl{c: i.c, version: i.version}).Associations(ctx, []int{projectID})
+			if err != nil {
+				return fmt.Errorf("jira: workflow scheme: %w", err)
+			}
+
+			for _, value := range page.Values {
+				snapshot.WorkflowScheme = value.WorkflowScheme
+			}
+
+			return nil
+		},
+		func() error {
+			scheme, _, err := (&internalProjectPermissionSchemeImpl{c: i.c, version: i.version}).Get(ctx, projectKeyOrID, []string{"all"})
+			if err != nil {
+				return fmt.Errorf("jira: permission scheme: %w", err)
+			}
+
+			snapshot.PermissionScheme = scheme
+			return nil
+		},
+		func() error {
+			scheme, _, err := i.NotificationScheme(ctx, projectKeyOrID, []string{"all"})
+			if err != nil {
+				return fmt.Errorf("jira: notification scheme: %w", err)
+			}
+
+			snapshot.NotificationScheme = scheme
+			return nil
+		},
+		func() error {
+			roleDetails, _, err := roles.Details(ctx, projectKeyOrID)
+			if err != nil {
+				return fmt.Errorf("jira: project roles: %w", err)
+			}
+
+			details = roleDetails
+			return nil
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// The issue type mappings and the role actors depend on the schemes and roles found above.
+	var mappings []func() error
+
+	if snapshot.IssueTypeScheme != nil {
+		mappings = append(mappings, func() error {
+
+			schemeID, err := strconv.Atoi(snapshot.IssueTypeScheme.ID)
+			if err != nil {
+				return fmt.Errorf("jira: issue type scheme id %q: %w", snapshot.IssueTypeScheme.ID, err)
+			}
+
+			for startAt := 0; ; startAt += projectSnapshotPageSize {
+
+				page, _, err := typeSchemes.Items(ctx, []int{schemeID}, startAt, projectSnapshotPageSize)
+				if err != nil {
+					return fmt.Errorf("jira: issue type scheme items: %w", err)
+				}
+
+				for _, item := range page.Values {
+					snapshot.IssueTypeIDs = append(snapshot.IssueTypeIDs, item.IssueTypeID)
+				}
+
+				if page.IsLast || len(page.Values) == 0 {
+					return nil
+				}
+			}
+		})
+	}
+
+	if snapshot.IssueTypeScreenScheme != nil {
+		mappings = append(mappings, func() error {
+
+			schemeID, err := strconv.Atoi(snapshot.IssueTypeScreenScheme.ID)
+			if err != nil {
+				return fmt.Errorf("jira: issue type screen scheme id %q: %w", snapshot.IssueTypeScreenScheme.ID, err)
+			}
+
+			for startAt := 0; ; startAt += projectSnapshotPageSize {
+
+				page, _, err := screenSchemes.Mapping(ctx, []int{schemeID}, startAt, projectSnapshotPageSize)
+				if err != nil {
+					return fmt.Errorf("jira: issue type screen scheme mapping: %w", err)
+				}
+
+				snapshot.ScreenSchemeMappings = append(snapshot.ScreenSchemeMappings, page.Values...)
+
+				if page.IsLast || len(page.Values) == 0 {
+					return nil
+				}
+			}
+		})
+	}
+
+	if snapshot.FieldConfigurationScheme != nil {
+		mappings = append(mappings, func() error {
+
+			schemeID, err := strconv.Atoi(snapshot.FieldConfigurationScheme.ID)
+			if err != nil {
+				return fmt.Errorf("jira: field configuration scheme id %q: %w", snapshot.FieldConfigurationScheme.ID, err)
+			}
+
+			for startAt := 0; ; startAt += projectSnapshotPageSize {
+
+				page, _, err := fieldSchemes.Mapping(ctx, []int{schemeID}, startAt, projectSnapshotPageSize)
+				if err != nil {
+					return fmt.Errorf("jira: field configuration scheme mapping: %w", err)
+				}
+
+				snapshot.FieldConfigurationMappings = append(snapshot.FieldConfigurationMappings, page.Values...)
+
+				if page.IsLast || len(page.Values) == 0 {
+					return nil
+				}
+			}
+		})
+	}
+
+	snapshot.Roles = make([]*model.ProjectRoleScheme, len(details))
+	for index, detail := range details {
+		mappings = append(mappings, func() error {
+
+			role, _, err := roles.Get(ctx, projectKeyOrID, detail.ID)
+			if err != nil {
+				return fmt.Errorf("jira: project role %v: %w", detail.Name, err)
+			}
+
+			snapshot.Roles[index] = role
+			return nil
+		})
+	}
+
+	if err = runConcurrently(mappings...); err != nil {
+		return nil, err
+	}
+
+	// The screen schemes, with their screens, are the ones mapped to the issue types by the issue type screen scheme
+	var screenSchemeIDs []int
+	for _, mapping := range snapshot.ScreenSchemeMappings {
+
+		screenSchemeID, err := strconv.Atoi(mapping.ScreenSchemeID)
+		if err != nil {
+			return nil, fmt.Errorf("jira: screen scheme id %q: %w", mapping.ScreenSchemeID, err)
+		}
+
+		if !slices.Contains(screenSchemeIDs, screenSchemeID) {
+			screenSchemeIDs = append(screenSchemeIDs, screenSchemeID)
+		}
+	}
+
+	if len(screenSchemeIDs) != 0 {
+
+		screens := &internalScreenSchemeImpl{c: i.c, version: i.version}
+		for startAt := 0; ; startAt += projectSnapshotPageSize {
+
+			page, _, err := screens.Gets(ctx, &model.ScreenSchemeParamsScheme{IDs: screenSchemeIDs}, startAt, projectSnapshotPageSize)
+			if err != nil {
+				return nil, fmt.Errorf("jira: screen schemes: %w", err)
+			}
+
+			snapshot.ScreenSchemes = append(snapshot.ScreenSchemes, page.Values...)
+
+			if page.IsLast || len(page.Values) == 0 {
+				break
+			}
+		}
+	}
+
+	slices.SortFunc(snapshot.Roles, func(a, b *model.ProjectRoleScheme) int {
+		return a.ID - b.ID
+	})
+
+	return snapshot, nil
+}
+
+func (i *internalProjectImpl) SetLead(ctx context.Context, projectKeyOrID, accountID string) (*model.ProjectScheme, *model.ResponseScheme, error) {
+
+	if accountID == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoAccountID)
+	}
+
+	return i.Update(ctx, projectKeyOrID, &model.ProjectUpdateScheme{LeadAccountID: accountID})
+}
+
+func (i *internalProjectImpl) SetDefaultAssignee(ctx context.Context, projectKeyOrID string, assigneeType model.ProjectAssigneeType) (*model.ProjectScheme, *model.ResponseScheme, error) {
+
+	switch assigneeType {
+	case model.ProjectAssigneeProjectLead, model.ProjectAssigneeUnassigned:
+	default:
+		return nil, nil, fmt.Errorf("jira: %w: %q", model.ErrInvalidProjectAssigneeType, assigneeType)
+	}
+
+	return i.Update(ctx, projectKeyOrID, &model.ProjectUpdateScheme{AssigneeType: string(assigneeType)})
+}
+
+func (i *internalProjectImpl) LedBy(ctx context.Context, accountID string) ([]*model.ProjectScheme, error) {
+
+	if accountID == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoAccountID)
+	}
+
+	options := &model.ProjectSearchOptionsScheme{Expand: []string{"lead"}}
+
+	var projects []*model.ProjectScheme
+	for startAt := 0; ; {
+
+		page, _, err := i.Search(ctx, options, startAt, projectLeadPageSize)
+		if err != nil {
+			return projects, err
+		}
+
+		for _, project := range page.Values {
+			if project.Lead != nil && project.Lead.AccountID == accountID {
+				projects = append(projects, project)
+			}
+		}
+
+		if page.IsLast || len(page.Values) == 0 {
+			return projects, nil
+		}
+
+		startAt += len(page.Values)
+	}
+}
+
+// runConcurrently calls the functions in parallel, and returns their errors joined.
+func runConcurrently(fns ...func() error) error {
+
+	errs := make([]error, len(fns))
+
+	var wg sync.WaitGroup
+	for index, fn := range fns {
+
+		wg.Add(1)
+		go func(index int, fn func() error) {
+			defer wg.Done()
+			errs[index] = fn()
+		}(index, fn)
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+func (i *internalProjectImpl) Iterate(ctx context.Context, options *model.ProjectSearchOptionsScheme, maxResults int) *model.PageIterator[*model.ProjectScheme] {
+	return model.NewPageIterator(ctx, maxResults, func(ctx context.Context, cursor model.PageCursorScheme) (*model.PageResultScheme[*model.ProjectScheme], error) {
+
+		page, _, err := i.Search(ctx, options, cursor.StartAt, cursor.MaxResults)
+		if err != nil {
+			return nil, err
+		}
+
+		return &model.PageResultScheme[*model.ProjectScheme]{Values: page.Values, IsLast: page.IsLast, Total: page.Total}, nil
+	})
+}
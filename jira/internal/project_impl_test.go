@@ -1601,3 +1601,724 @@ func Test_NewProjectService(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalProjectImpl_Snapshot(t *testing.T) {
+
+	// expect mocks the request of the endpoint, fill sets the decoded structure.
+	expect := func(client *mocks.Connector, endpoint string, fill func(structure interface{}), err error) *mock.Call {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			endpoint,
+			"",
+			nil).
+			Return(&http.Request{Host: endpoint}, nil).
+			Maybe()
+
+		return client.On("Call",
+			&http.Request{Host: endpoint},
+			mock.Anything).
+			Run(func(args mock.Arguments) {
+				if fill != nil {
+					fill(args.Get(1))
+				}
+			}).
+			Return(&model.ResponseScheme{}, err)
+	}
+
+	// expectSchemes mocks the project and scheme requests, the permission scheme request returns permissionErr.
+	expectSchemes := func(client *mocks.Connector, permissionErr error) {
+
+		expect(client, "rest/api/3/project/KP", func(structure interface{}) {
+			structure.(*model.ProjectScheme).ID = "10000"
+			structure.(*model.ProjectScheme).Key = "KP"
+		}, nil)
+
+		expect(client, "rest/api/3/issuetypescheme/project?maxResults=50&projectId=10000&startAt=0", func(structure interface{}) {
+			structure.(*model.ProjectIssueTypeSchemePageScheme).Values = []*model.IssueTypeSchemeProjectsScheme{
+				{IssueTypeScheme: &model.IssueTypeSchemeScheme{ID: "10001", Name: "KP: Scrum Issue Type Scheme"}, ProjectIDs: []string{"10000"}},
+			}
+		}, nil).Maybe()
+
+		expect(client, "rest/api/3/issuetypescreenscheme/project?maxResults=50&projectId=10000&startAt=0", func(structure interface{}) {
+			structure.(*model.IssueTypeProjectScreenSchemePageScheme).Values = []*model.IssueTypeScreenSchemesProjectScheme{
+				{IssueTypeScreenScheme: &model.IssueTypeScreenSchemeScheme{ID: "10002", Name: "KP: Scrum Issue Type Screen Scheme"}},
+			}
+		}, nil).Maybe()
+
+		expect(client, "rest/api/3/fieldconfigurationscheme/project?maxResults=50&projectId=10000&startAt=0", func(structure interface{}) {
+			structure.(*model.FieldConfigurationSchemeProjectPageScheme).Values = []*model.FieldConfigurationSchemeProjectScheme{
+				{ProjectIDs: []string{"10000"}},
+			}
+		}, nil).Maybe()
+
+		expect(client, "rest/api/3/workflowscheme/project?projectId=10000", func(structure interface{}) {
+			structure.(*model.WorkflowSchemeAssociationPageScheme).Values = []*model.WorkflowSchemeAssociationsScheme{
+				{ProjectIDs: []string{"10000"}, WorkflowScheme: &model.WorkflowSchemeScheme{ID: 10003, DefaultWorkflow: "jira"}},
+			}
+		}, nil).Maybe()
+
+		expect(client, "rest/api/3/project/KP/permissionscheme?expand=all", func(structure interface{}) {
+			structure.(*model.PermissionSchemeScheme).ID = 10004
+		}, permissionErr).Maybe()
+
+		expect(client, "rest/api/3/project/KP/notificationscheme?expand=all", func(structure interface{}) {
+			structure.(*model.NotificationSchemeScheme).ID = 10005
+		}, nil).Maybe()
+
+		expect(client, "rest/api/3/project/KP/roledetails", func(structure interface{}) {
+			*structure.(*[]*model.ProjectRoleDetailScheme) = []*model.ProjectRoleDetailScheme{{ID: 10101, Name: "Developers"}, {ID: 10100, Name: "Administrators"}}
+		}, nil).Maybe()
+	}
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx            context.Context
+		projectKeyOrID string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    *model.ProjectConfigurationSnapshotScheme
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the parameters are correct",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrID: "KP",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				expectSchemes(client, nil)
+
+				expect(client, "rest/api/3/issuetypescheme/mapping?issueTypeSchemeId=10001&maxResults=50&startAt=0", func(structure interface{}) {
+					page := structure.(*model.IssueTypeSchemeItemPageScheme)
+					page.IsLast = true
+					page.Values = []*model.IssueTypeSchemeMappingScheme{{IssueTypeSchemeID: "10001", IssueTypeID: "10010"}, {IssueTypeSchemeID: "10001", IssueTypeID: "10011"}}
+				}, nil)
+
+				expect(client, "rest/api/3/issuetypescreenscheme/mapping?issueTypeScreenSchemeId=10002&maxResults=50&startAt=0", func(structure interface{}) {
+					page := structure.(*model.IssueTypeScreenSchemeMappingScheme)
+					page.IsLast = true
+					page.Values = []*model.IssueTypeScreenSchemeItemScheme{{IssueTypeScreenSchemeID: "10002", IssueTypeID: "default", ScreenSchemeID: "1"}}
+				}, nil)
+
+				expect(client, "rest/api/3/screenscheme?=&id=1&maxResults=50&startAt=0", func(structure interface{}) {
+					page := structure.(*model.ScreenSchemePageScheme)
+					page.IsLast = true
+					page.Values = []*model.ScreenSchemeScheme{{ID: 1, Name: "Default Screen Scheme", Screens: &model.ScreenTypesScheme{Default: 1}}}
+				}, nil)
+
+				expect(client, "rest/api/3/project/KP/role/10100", func(structure interface{}) {
+					structure.(*model.ProjectRoleScheme).ID = 10100
+					structure.(*model.ProjectRoleScheme).Actors = []*model.RoleActorScheme{{ID: 1, DisplayName: "jira-administrators"}}
+				}, nil)
+
+				expect(client, "rest/api/3/project/KP/role/10101", func(structure interface{}) {
+					structure.(*model.ProjectRoleScheme).ID = 10101
+				}, nil)
+
+				fields.c = client
+			},
+			want: &model.ProjectConfigurationSnapshotScheme{
+				Project:               &model.ProjectScheme{ID: "10000", Key: "KP"},
+				IssueTypeScheme:       &model.IssueTypeSchemeScheme{ID: "10001", Name: "KP: Scrum Issue Type Scheme"},
+				IssueTypeIDs:          []string{"10010", "10011"},
+				IssueTypeScreenScheme: &model.IssueTypeScreenSchemeScheme{ID: "10002", Name: "KP: Scrum Issue Type Screen Scheme"},
+				ScreenSchemeMappings:  []*model.IssueTypeScreenSchemeItemScheme{{IssueTypeScreenSchemeID: "10002", IssueTypeID: "default", ScreenSchemeID: "1"}},
+				ScreenSchemes:         []*model.ScreenSchemeScheme{{ID: 1, Name: "Default Screen Scheme", Screens: &model.ScreenTypesScheme{Default: 1}}},
+				WorkflowScheme:        &model.WorkflowSchemeScheme{ID: 10003, DefaultWorkflow: "jira"},
+				PermissionScheme:      &model.PermissionSchemeScheme{ID: 10004},
+				NotificationScheme:    &model.NotificationSchemeScheme{ID: 10005},
+				Roles: []*model.ProjectRoleScheme{
+					{ID: 10100, Actors: []*model.RoleActorScheme{{ID: 1, DisplayName: "jira-administrators"}}},
+					{ID: 10101},
+				},
+			},
+		},
+
+		{
+			name:   "when a scheme cannot be fetched",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrID: "KP",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				expectSchemes(client, model.ErrUnauthorized)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrUnauthorized,
+		},
+
+		{
+			name:   "when the project cannot be fetched",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrID: "KP",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				expect(client, "rest/api/3/project/KP", nil, model.ErrNotFound)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrNotFound,
+		},
+
+		{
+			name:   "when the project key or id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoProjectIDOrKey,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := &internalProjectImpl{
+				c:       testCase.fields.c,
+				version: testCase.fields.version,
+			}
+
+			gotResult, err := newService.Snapshot(testCase.args.ctx, testCase.args.projectKeyOrID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.False(t, gotResult.CapturedAt.IsZero())
+
+				gotResult.CapturedAt = testCase.want.CapturedAt
+				assert.Equal(t, testCase.want, gotResult)
+			}
+		})
+	}
+}
+
+func Test_internalProjectImpl_GetIfExists(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx            context.Context
+		projectKeyOrID string
+		expand         []string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    bool
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the project exists",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrID: "KP",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/project/KP",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ProjectScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			want: true,
+		},
+
+		{
+			name:   "when the project doesn't exist",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrID: "KP",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/project/KP",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ProjectScheme{}).
+					Return(&model.ResponseScheme{Code: http.StatusNotFound}, model.ErrNotFound)
+
+				fields.c = client
+			},
+			want: false,
+		},
+
+		{
+			name:   "when the project can't be read",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrID: "KP",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/project/KP",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ProjectScheme{}).
+					Return(&model.ResponseScheme{Code: http.StatusUnauthorized}, model.ErrUnauthorized)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrUnauthorized,
+		},
+
+		{
+			name:   "when the project key or id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNoProjectIDOrKey,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewProjectService(testCase.fields.c, testCase.fields.version, &ProjectChildServices{})
+			assert.NoError(t, err)
+
+			gotResult, gotOk, err := newService.GetIfExists(testCase.args.ctx, testCase.args.projectKeyOrID, testCase.args.expand)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+				assert.False(t, gotOk)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.want, gotOk)
+			assert.Equal(t, testCase.want, gotResult != nil)
+		})
+	}
+}
+
+func Test_internalProjectImpl_SetLead(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx                       context.Context
+		projectKeyOrID, accountID string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrID: "KP",
+				accountID:      "5b86be50b8e3cb5895860d6d",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/3/project/KP",
+					"", &model.ProjectUpdateScheme{LeadAccountID: "5b86be50b8e3cb5895860d6d"}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ProjectScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the account id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrID: "KP",
+			},
+			wantErr: true,
+			Err:     model.ErrNoAccountID,
+		},
+
+		{
+			name:   "when the project key or id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				accountID: "5b86be50b8e3cb5895860d6d",
+			},
+			wantErr: true,
+			Err:     model.ErrNoProjectIDOrKey,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewProjectService(testCase.fields.c, testCase.fields.version, &ProjectChildServices{})
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := newService.SetLead(testCase.args.ctx, testCase.args.projectKeyOrID, testCase.args.accountID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotEqual(t, gotResponse, nil)
+			assert.NotEqual(t, gotResult, nil)
+		})
+	}
+}
+
+func Test_internalProjectImpl_SetDefaultAssignee(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx            context.Context
+		projectKeyOrID string
+		assigneeType   model.ProjectAssigneeType
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrID: "KP",
+				assigneeType:   model.ProjectAssigneeUnassigned,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/2/project/KP",
+					"", &model.ProjectUpdateScheme{AssigneeType: "UNASSIGNED"}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ProjectScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the assignee type is not supported",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrID: "KP",
+				assigneeType:   "COMPONENT_LEAD",
+			},
+			wantErr: true,
+			Err:     model.ErrInvalidProjectAssigneeType,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewProjectService(testCase.fields.c, testCase.fields.version, &ProjectChildServices{})
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := newService.SetDefaultAssignee(testCase.args.ctx, testCase.args.projectKeyOrID, testCase.args.assigneeType)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotEqual(t, gotResponse, nil)
+			assert.NotEqual(t, gotResult, nil)
+		})
+	}
+}
+
+func Test_internalProjectImpl_LedBy(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx       context.Context
+		accountID string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    []string
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the projects are led by the account",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				accountID: "5b86be50b8e3cb5895860d6d",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/project/search?expand=lead&maxResults=50&startAt=0",
+					"", nil).
+					Return(&http.Request{Host: "page-1"}, nil)
+
+				client.On("Call",
+					&http.Request{Host: "page-1"},
+					&model.ProjectSearchScheme{}).
+					Run(func(args mock.Arguments) {
+						page := args.Get(1).(*model.ProjectSearchScheme)
+						page.Values = []*model.ProjectScheme{
+							{Key: "KP", Lead: &model.UserScheme{AccountID: "5b86be50b8e3cb5895860d6d"}},
+							{Key: "DUMMY", Lead: &model.UserScheme{AccountID: "557058:3e1c3a4b"}},
+						}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/project/search?expand=lead&maxResults=50&startAt=2",
+					"", nil).
+					Return(&http.Request{Host: "page-2"}, nil)
+
+				client.On("Call",
+					&http.Request{Host: "page-2"},
+					&model.ProjectSearchScheme{}).
+					Run(func(args mock.Arguments) {
+						page := args.Get(1).(*model.ProjectSearchScheme)
+						page.IsLast = true
+						page.Values = []*model.ProjectScheme{
+							{Key: "OPS", Lead: &model.UserScheme{AccountID: "5b86be50b8e3cb5895860d6d"}},
+						}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			want: []string{"KP", "OPS"},
+		},
+
+		{
+			name:   "when the account id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoAccountID,
+		},
+
+		{
+			name:   "when the http call cannot be executed",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:       context.Background(),
+				accountID: "5b86be50b8e3cb5895860d6d",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/project/search?expand=lead&maxResults=50&startAt=0",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ProjectSearchScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrUnauthorized)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrUnauthorized,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewProjectService(testCase.fields.c, testCase.fields.version, &ProjectChildServices{})
+			assert.NoError(t, err)
+
+			gotResult, err := newService.LedBy(testCase.args.ctx, testCase.args.accountID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+				return
+			}
+
+			assert.NoError(t, err)
+
+			var gotKeys []string
+			for _, project := range gotResult {
+				gotKeys = append(gotKeys, project.Key)
+			}
+
+			assert.Equal(t, testCase.want, gotKeys)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
@@ -57,6 +58,44 @@ func (p *ProjectPermissionSchemeService) SecurityLevels(ctx context.Context, pro
 	return p.internalClient.SecurityLevels(ctx, projectKeyOrID)
 }
 
+// AssignSecurityScheme associates an issue security scheme with a project and remaps the security levels of its issues.
+//
+// The association runs asynchronously, the returned task can be followed with the Task service.
+//
+// PUT /rest/api/{2-3}/issuesecurityschemes/project
+func (p *ProjectPermissionSchemeService) AssignSecurityScheme(ctx context.Context, payload *model.IssueSecuritySchemeAssignPayloadScheme) (*model.TaskScheme, *model.ResponseScheme, error) {
+	return p.internalClient.AssignSecurityScheme(ctx, payload)
+}
+
+// SetSecurityLevel sets a security level on the issues matching a JQL query, with a bounded number of issues updated at the same time.
+//
+// The issues are searched before the first update, the issues already on the level are skipped,
+// and the issues that can't be updated are reported on the returned update.
+//
+// POST /rest/api/{2-3}/search/jql
+//
+// PUT /rest/api/{2-3}/issue/{issueKeyOrID}
+func (p *ProjectPermissionSchemeService) SetSecurityLevel(ctx context.Context, jql, levelID string, options *model.IssueSecurityLevelUpdateOptionsScheme) (*model.IssueSecurityLevelUpdateScheme, error) {
+	return p.internalClient.SetSecurityLevel(ctx, jql, levelID, options)
+}
+
+// issueSecurityLevelUpdatePageSize is the page size used by SetSecurityLevel to search the issues.
+const issueSecurityLevelUpdatePageSize = 100
+
+// Explain reports why the user does or doesn't hold the permission in the project, going through the grants of the
+// permission scheme of the project, the groups and application roles of the user, the project roles and the project lead.
+//
+// The grants depending on the issue, e.g. the assignee, the reporter or a custom field, are reported as conditional.
+//
+// GET /rest/api/{2-3}/project/{projectKeyOrID}/permissionscheme
+//
+// GET /rest/api/{2-3}/user
+//
+// GET /rest/api/{2-3}/project/{projectKeyOrID}/role/{id}
+func (p *ProjectPermissionSchemeService) Explain(ctx context.Context, projectKeyOrID, accountID, permission string) (*model.PermissionExplanationScheme, error) {
+	return p.internalClient.Explain(ctx, projectKeyOrID, accountID, permission)
+}
+
 type internalProjectPermissionSchemeImpl struct {
 	c       service.Connector
 	version string
@@ -135,3 +174,385 @@ func (i *internalProjectPermissionSchemeImpl) SecurityLevels(ctx context.Context
 
 	return securityLevel, response, nil
 }
+
+func (i *internalProjectPermissionSchemeImpl) AssignSecurityScheme(ctx context.Context, payload *model.IssueSecuritySchemeAssignPayloadScheme) (*model.TaskScheme, *model.ResponseScheme, error) {
+
+	if payload == nil || payload.ProjectID == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoProjectID)
+	}
+
+	if payload.SchemeID == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoIssueSecuritySchemeID)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issuesecurityschemes/project", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	task := new(model.TaskScheme)
+	response, err := i.c.Call(request, task)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return task, response, nil
+}
+
+func (i *internalProjectPermissionSchemeImpl) SetSecurityLevel(ctx context.Context, jql, levelID string, options *model.IssueSecurityLevelUpdateOptionsScheme) (*model.IssueSecurityLevelUpdateScheme, error) {
+
+	if jql == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoJQL)
+	}
+
+	if levelID == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoIssueSecurityLevelID)
+	}
+
+	if options == nil {
+		options = new(model.IssueSecurityLevelUpdateOptionsScheme)
+	}
+
+	update := &model.IssueSecurityLevelUpdateScheme{JQL: jql, LevelID: levelID, DryRun: options.DryRun}
+
+	// The issues are collected first, the updates can change the results of the search
+	search := &internalSearchADFImpl{c: i.c, version: i.version}
+
+	var pending []*model.IssueSecurityLevelIssueUpdateScheme
+	for nextPageToken := ""; ; {
+
+		page, _, err := search.SearchJQL(ctx, jql, []string{model.IssueFieldSecurity}, nil, issueSecurityLevelUpdatePageSize, nextPageToken)
+		if err != nil {
+			return update, err
+		}
+
+		for _, issue := range page.Issues {
+
+			issueUpdate := &model.IssueSecurityLevelIssueUpdateScheme{IssueKey: issue.Key}
+			if issue.Fields != nil && issue.Fields.Security != nil {
+				issueUpdate.PreviousLevelID = issue.Fields.Security.ID
+			}
+
+			issueUpdate.Skipped = issueUpdate.PreviousLevelID == levelID
+			if !issueUpdate.Skipped {
+				pending = append(pending, issueUpdate)
+			}
+
+			update.Issues = append(update.Issues, issueUpdate)
+		}
+
+		if page.NextPageToken == "" || len(page.Issues) == 0 {
+			break
+		}
+
+		nextPageToken = page.NextPageToken
+	}
+
+	if options.DryRun || len(pending) == 0 {
+		return update, nil
+	}
+
+	concurrency := options.Concurrency
+	if concurrency <= 0 {
+		concurrency = model.IssueSecurityLevelUpdateConcurrency
+	}
+
+	errs := model.RunWorkers(ctx, len(pending), concurrency, options.Rate, func(index int) error {
+
+		issue := pending[index]
+		if err := i.setIssueSecurityLevel(ctx, issue.IssueKey, levelID, options.Notify); err != nil {
+			return fmt.Errorf("jira: issue %v: %w", issue.IssueKey, err)
+		}
+
+		return nil
+	})
+
+	for index, issue := range pending {
+		issue.Err = errs[index]
+	}
+
+	return update, nil
+}
+
+// setIssueSecurityLevel sets the security level of the issue.
+func (i *internalProjectPermissionSchemeImpl) setIssueSecurityLevel(ctx context.Context, issueKeyOrID, levelID string, notify bool) error {
+
+	params := url.Values{}
+	params.Add("notifyUsers", fmt.Sprintf("%v", notify))
+	endpoint := fmt.Sprintf("rest/api/%v/issue/%v?%v", i.version, issueKeyOrID, params.Encode())
+
+	payload := map[string]interface{}{
+		"fields": map[string]interface{}{
+			model.IssueFieldSecurity: map[string]interface{}{"id": levelID},
+		},
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = i.c.Call(request, nil)
+	return err
+}
+
+func (i *internalProjectPermissionSchemeImpl) Explain(ctx context.Context, projectKeyOrID, accountID, permission string) (*model.PermissionExplanationScheme, error) {
+
+	if projectKeyOrID == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoProjectIDOrKey)
+	}
+
+	if accountID == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoAccountID)
+	}
+
+	if permission == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoPermissionKey)
+	}
+
+	scheme, _, err := i.Get(ctx, projectKeyOrID, []string{model.PermissionGrantExpandAll})
+	if err != nil {
+		return nil, err
+	}
+
+	explainer := &permissionExplainer{
+		c:              i.c,
+		version:        i.version,
+		projectKeyOrID: projectKeyOrID,
+		accountID:      accountID,
+		roles:          make(map[string]*model.ProjectRoleScheme),
+	}
+
+	user, _, err := (&internalUserImpl{c: i.c, version: i.version}).Get(ctx, accountID, []string{"groups", "applicationRoles"})
+	if err != nil {
+		return nil, err
+	}
+
+	explainer.user = user
+
+	explanation := &model.PermissionExplanationScheme{
+		ProjectKeyOrID:     projectKeyOrID,
+		AccountID:          accountID,
+		Permission:         permission,
+		PermissionSchemeID: scheme.ID,
+	}
+
+	for _, grant := range scheme.Permissions {
+
+		if grant == nil || grant.Holder == nil || grant.Permission != permission {
+			continue
+		}
+
+		grantExplanation, err := explainer.explain(ctx, grant)
+		if err != nil {
+			return nil, err
+		}
+
+		explanation.Granted = explanation.Granted || grantExplanation.Matched
+		explanation.Conditional = explanation.Conditional || grantExplanation.Conditional
+		explanation.Grants = append(explanation.Grants, grantExplanation)
+	}
+
+	explanation.Conditional = explanation.Conditional && !explanation.Granted
+
+	return explanation, nil
+}
+
+// permissionExplainer explains the grants of a permission scheme for a user, reading the project lead and roles once.
+type permissionExplainer struct {
+	c              service.Connector
+	version        string
+	projectKeyOrID string
+	accountID      string
+	user           *model.UserScheme
+	lead           *string
+	roles          map[string]*model.ProjectRoleScheme
+}
+
+// explain reports whether the grant holds the permission for the user.
+func (e *permissionExplainer) explain(ctx context.Context, grant *model.PermissionGrantScheme) (*model.PermissionGrantExplanationScheme, error) {
+
+	explanation := &model.PermissionGrantExplanationScheme{Grant: grant}
+	holder := grant.Holder
+
+	switch holder.Type {
+	case model.PermissionHolderTypeAnyone:
+
+		explanation.Matched = true
+		explanation.Reason = "granted to anyone, including the anonymous users"
+
+	case model.PermissionHolderTypeApplicationRole:
+
+		if holder.Parameter == "" {
+			explanation.Matched = true
+			explanation.Reason = "granted to any logged-in user"
+			break
+		}
+
+		explanation.Matched = e.hasApplication(holder.Parameter)
+		explanation.Reason = explainMembership(explanation.Matched, "has access to the application", holder.Parameter)
+
+	case model.PermissionHolderTypeGroup:
+
+		group := holder.Parameter
+		if holder.Group != nil && holder.Group.Name != "" {
+			group = holder.Group.Name
+		}
+
+		explanation.Matched = e.inGroup(group)
+		explanation.Reason = explainMembership(explanation.Matched, "is a member of the group", group)
+
+	case model.PermissionHolderTypeUser:
+
+		explanation.Matched = holder.Parameter == e.accountID
+		explanation.Reason = explainMembership(explanation.Matched, "is the user", holder.Parameter)
+
+	case model.PermissionHolderTypeProjectLead:
+
+		lead, err := e.projectLead(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		explanation.Matched = lead == e.accountID
+		explanation.Reason = explainMembership(explanation.Matched, "is the lead of the project", e.projectKeyOrID)
+
+	case model.PermissionHolderTypeProjectRole:
+
+		role, err := e.projectRole(ctx, holder.Parameter)
+		if err != nil {
+			return nil, err
+		}
+
+		explanation.Matched, explanation.Reason = e.explainRole(role)
+
+	case model.PermissionHolderTypeAssignee, model.PermissionHolderTypeReporter:
+
+		explanation.Conditional = true
+		explanation.Reason = fmt.Sprintf("granted to the %v, depends on the issue", holder.Type)
+
+	case model.PermissionHolderTypeUserCustomField, model.PermissionHolderTypeGroupCustomField:
+
+		explanation.Conditional = true
+		explanation.Reason = fmt.Sprintf("granted through the custom field %v, depends on the issue", holder.Parameter)
+
+	case model.PermissionHolderTypeServiceDeskCustomer:
+
+		explanation.Conditional = true
+		explanation.Reason = "granted to the service desk customers, depends on the access to the portal"
+
+	default:
+		explanation.Reason = fmt.Sprintf("the holder type %v is not supported", holder.Type)
+	}
+
+	return explanation, nil
+}
+
+// explainRole reports whether the user is an actor of the project role, directly or through a group.
+func (e *permissionExplainer) explainRole(role *model.ProjectRoleScheme) (bool, string) {
+
+	for _, actor := range role.Actors {
+
+		if actor.ActorUser != nil && actor.ActorUser.AccountID == e.accountID {
+			return true, fmt.Sprintf("the user is an actor of the project role %v", role.Name)
+		}
+
+		group := actor.Name
+		if actor.ActorGroup != nil && actor.ActorGroup.Name != "" {
+			group = actor.ActorGroup.Name
+		}
+
+		if actor.ActorUser == nil && e.inGroup(group) {
+			return true, fmt.Sprintf("the user is an actor of the project role %v through the group %v", role.Name, group)
+		}
+	}
+
+	return false, fmt.Sprintf("the user is not an actor of the project role %v", role.Name)
+}
+
+// inGroup reports whether the user is a member of the group.
+func (e *permissionExplainer) inGroup(group string) bool {
+
+	if e.user.Groups == nil || group == "" {
+		return false
+	}
+
+	for _, userGroup := range e.user.Groups.Items {
+		if userGroup != nil && userGroup.Name == group {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasApplication reports whether the user has access to the application.
+func (e *permissionExplainer) hasApplication(applicationKey string) bool {
+
+	if e.user.ApplicationRoles == nil {
+		return false
+	}
+
+	for _, role := range e.user.ApplicationRoles.Items {
+		if role != nil && role.Key == applicationKey {
+			return true
+		}
+	}
+
+	return false
+}
+
+// projectLead returns the account ID of the lead of the project, read once.
+func (e *permissionExplainer) projectLead(ctx context.Context) (string, error) {
+
+	if e.lead == nil {
+
+		project, _, err := (&internalProjectImpl{c: e.c, version: e.version}).Get(ctx, e.projectKeyOrID, nil)
+		if err != nil {
+			return "", err
+		}
+
+		var lead string
+		if project.Lead != nil {
+			lead = project.Lead.AccountID
+		}
+
+		e.lead = &lead
+	}
+
+	return *e.lead, nil
+}
+
+// projectRole returns the project role with its actors, read once.
+func (e *permissionExplainer) projectRole(ctx context.Context, roleID string) (*model.ProjectRoleScheme, error) {
+
+	if role, ok := e.roles[roleID]; ok {
+		return role, nil
+	}
+
+	id, err := strconv.Atoi(roleID)
+	if err != nil {
+		return nil, fmt.Errorf("jira: invalid project role id %q: %w", roleID, err)
+	}
+
+	role, _, err := (&internalProjectRoleImpl{c: e.c, version: e.version}).Get(ctx, e.projectKeyOrID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	e.roles[roleID] = role
+
+	return role, nil
+}
+
+// explainMembership returns the reason of a grant holding or not the permission for the user.
+func explainMembership(matched bool, relation, value string) string {
+
+	if matched {
+		return fmt.Sprintf("the user %v %v", relation, value)
+	}
+
+	return fmt.Sprintf("the user %v", strings.Replace(relation, "is ", "is not ", 1))
+}
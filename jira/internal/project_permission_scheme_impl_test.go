@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
@@ -533,3 +534,572 @@ func Test_NewProjectPermissionSchemeService(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalProjectPermissionSchemeImpl_AssignSecurityScheme(t *testing.T) {
+
+	payloadMocked := &model.IssueSecuritySchemeAssignPayloadScheme{
+		ProjectID: "10000",
+		SchemeID:  "10001",
+		OldToNewSecurityLevelMappings: []*model.IssueSecurityLevelMappingScheme{
+			{OldLevelID: "10100", NewLevelID: "10200"},
+		},
+	}
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx     context.Context
+		payload *model.IssueSecuritySchemeAssignPayloadScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				payload: payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/3/issuesecurityschemes/project",
+					"",
+					payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.TaskScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:     context.Background(),
+				payload: payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/2/issuesecurityschemes/project",
+					"",
+					payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.TaskScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the project id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				payload: &model.IssueSecuritySchemeAssignPayloadScheme{SchemeID: "10001"},
+			},
+			wantErr: true,
+			Err:     model.ErrNoProjectID,
+		},
+
+		{
+			name:   "when the scheme id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				payload: &model.IssueSecuritySchemeAssignPayloadScheme{ProjectID: "10000"},
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueSecuritySchemeID,
+		},
+
+		{
+			name:   "when the http call cannot be executed",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				payload: payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/3/issuesecurityschemes/project",
+					"",
+					payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.TaskScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrNotFound)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewProjectPermissionSchemeService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := newService.AssignSecurityScheme(testCase.args.ctx, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotEqual(t, gotResponse, nil)
+			assert.NotEqual(t, gotResult, nil)
+		})
+	}
+}
+
+func Test_internalProjectPermissionSchemeImpl_SetSecurityLevel(t *testing.T) {
+
+	searchMocked := func(client *mocks.Connector, issues map[string]string, keys ...string) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPost,
+			"rest/api/3/search/jql",
+			"",
+			mock.Anything).
+			Return(&http.Request{Host: "search"}, nil)
+
+		client.On("Call",
+			&http.Request{Host: "search"},
+			&model.IssueSearchJQLScheme{}).
+			Run(func(args mock.Arguments) {
+				page := args.Get(1).(*model.IssueSearchJQLScheme)
+				for _, key := range keys {
+
+					issue := &model.IssueScheme{Key: key, Fields: &model.IssueFieldsScheme{}}
+					if levelID := issues[key]; levelID != "" {
+						issue.Fields.Security = &model.SecurityScheme{ID: levelID}
+					}
+
+					page.Issues = append(page.Issues, issue)
+				}
+			}).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	updateMocked := func(client *mocks.Connector, key string, err error) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPut,
+			"rest/api/3/issue/"+key+"?notifyUsers=false",
+			"",
+			map[string]interface{}{"fields": map[string]interface{}{"security": map[string]interface{}{"id": "10200"}}}).
+			Return(&http.Request{Host: "update-" + key}, nil)
+
+		client.On("Call",
+			&http.Request{Host: "update-" + key},
+			nil).
+			Return(&model.ResponseScheme{}, err)
+	}
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx          context.Context
+		jql, levelID string
+		options      *model.IssueSecurityLevelUpdateOptionsScheme
+	}
+
+	testCases := []struct {
+		name        string
+		fields      fields
+		args        args
+		on          func(*fields)
+		wantIssues  []string
+		wantSkipped int
+		wantFailed  int
+		wantErr     bool
+		Err         error
+	}{
+		{
+			name:   "when the security level is set on the issues",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				jql:     "project = KP",
+				levelID: "10200",
+				options: &model.IssueSecurityLevelUpdateOptionsScheme{Concurrency: 2, Rate: 100},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				searchMocked(client, map[string]string{"KP-2": "10200", "KP-3": "10100"}, "KP-1", "KP-2", "KP-3")
+
+				updateMocked(client, "KP-1", nil)
+				updateMocked(client, "KP-3", model.ErrBadRequest)
+
+				fields.c = client
+			},
+			wantIssues:  []string{"KP-1", "KP-2", "KP-3"},
+			wantSkipped: 1,
+			wantFailed:  1,
+		},
+
+		{
+			name:   "when the update is a dry run",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				jql:     "project = KP",
+				levelID: "10200",
+				options: &model.IssueSecurityLevelUpdateOptionsScheme{DryRun: true},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				searchMocked(client, nil, "KP-1")
+
+				fields.c = client
+			},
+			wantIssues: []string{"KP-1"},
+		},
+
+		{
+			name:   "when the jql is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				levelID: "10200",
+			},
+			wantErr: true,
+			Err:     model.ErrNoJQL,
+		},
+
+		{
+			name:   "when the security level is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+				jql: "project = KP",
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueSecurityLevelID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewProjectPermissionSchemeService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, err := newService.SetSecurityLevel(testCase.args.ctx, testCase.args.jql, testCase.args.levelID, testCase.args.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+				return
+			}
+
+			assert.NoError(t, err)
+
+			var gotIssues []string
+			var gotSkipped, gotFailed int
+			for _, issue := range gotResult.Issues {
+				gotIssues = append(gotIssues, issue.IssueKey)
+
+				if issue.Skipped {
+					gotSkipped++
+				}
+
+				if issue.Err != nil {
+					gotFailed++
+				}
+			}
+
+			assert.Equal(t, testCase.wantIssues, gotIssues)
+			assert.Equal(t, testCase.wantSkipped, gotSkipped)
+			assert.Equal(t, testCase.wantFailed, gotFailed)
+
+			if testCase.wantFailed != 0 {
+				assert.True(t, errors.Is(gotResult.Err(), model.ErrBadRequest))
+			}
+		})
+	}
+}
+
+func Test_internalProjectPermissionSchemeImpl_Explain(t *testing.T) {
+
+	schemeMocked := func(client *mocks.Connector, grants ...*model.PermissionGrantScheme) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/project/KP/permissionscheme?expand=all",
+			"",
+			nil).
+			Return(&http.Request{Host: "scheme"}, nil)
+
+		client.On("Call",
+			&http.Request{Host: "scheme"},
+			&model.PermissionSchemeScheme{}).
+			Run(func(args mock.Arguments) {
+				scheme := args.Get(1).(*model.PermissionSchemeScheme)
+				scheme.ID = 10000
+				scheme.Permissions = grants
+			}).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	userMocked := func(client *mocks.Connector) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/user?accountId=account-id&expand=groups%2CapplicationRoles",
+			"",
+			nil).
+			Return(&http.Request{Host: "user"}, nil)
+
+		client.On("Call",
+			&http.Request{Host: "user"},
+			&model.UserScheme{}).
+			Run(func(args mock.Arguments) {
+				user := args.Get(1).(*model.UserScheme)
+				user.AccountID = "account-id"
+				user.Groups = &model.UserGroupsScheme{Items: []*model.UserGroupScheme{{Name: "jira-developers"}}}
+			}).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	roleMocked := func(client *mocks.Connector, actors ...*model.RoleActorScheme) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/project/KP/role/10002",
+			"",
+			nil).
+			Return(&http.Request{Host: "role"}, nil)
+
+		client.On("Call",
+			&http.Request{Host: "role"},
+			&model.ProjectRoleScheme{}).
+			Run(func(args mock.Arguments) {
+				role := args.Get(1).(*model.ProjectRoleScheme)
+				role.Name = "Developers"
+				role.Actors = actors
+			}).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	grant := func(holderType, parameter string) *model.PermissionGrantScheme {
+		return &model.PermissionGrantScheme{
+			Permission: model.PermissionBrowseProjects,
+			Holder:     &model.PermissionGrantHolderScheme{Type: holderType, Parameter: parameter},
+		}
+	}
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx                                   context.Context
+		projectKeyOrID, accountID, permission string
+	}
+
+	testCases := []struct {
+		name            string
+		fields          fields
+		args            args
+		on              func(*fields)
+		wantGranted     bool
+		wantConditional bool
+		wantMatched     []bool
+		wantErr         bool
+		Err             error
+	}{
+		{
+			name:   "when the user is granted through a group of a project role",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrID: "KP",
+				accountID:      "account-id",
+				permission:     model.PermissionBrowseProjects,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				schemeMocked(client,
+					grant(model.PermissionHolderTypeUser, "other-account-id"),
+					grant(model.PermissionHolderTypeProjectRole, "10002"),
+					&model.PermissionGrantScheme{
+						Permission: model.PermissionAdministerProjects,
+						Holder:     &model.PermissionGrantHolderScheme{Type: model.PermissionHolderTypeAnyone},
+					})
+				userMocked(client)
+				roleMocked(client, &model.RoleActorScheme{Name: "jira-developers", ActorGroup: &model.GroupScheme{Name: "jira-developers"}})
+
+				fields.c = client
+			},
+			wantGranted: true,
+			wantMatched: []bool{false, true},
+		},
+
+		{
+			name:   "when the permission depends on the issue",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrID: "KP",
+				accountID:      "account-id",
+				permission:     model.PermissionBrowseProjects,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				schemeMocked(client,
+					grant(model.PermissionHolderTypeGroup, "jira-administrators"),
+					grant(model.PermissionHolderTypeAssignee, ""))
+				userMocked(client)
+
+				fields.c = client
+			},
+			wantConditional: true,
+			wantMatched:     []bool{false, false},
+		},
+
+		{
+			name:   "when the project key or id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				accountID:  "account-id",
+				permission: model.PermissionBrowseProjects,
+			},
+			wantErr: true,
+			Err:     model.ErrNoProjectIDOrKey,
+		},
+
+		{
+			name:   "when the account id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrID: "KP",
+				permission:     model.PermissionBrowseProjects,
+			},
+			wantErr: true,
+			Err:     model.ErrNoAccountID,
+		},
+
+		{
+			name:   "when the permission is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrID: "KP",
+				accountID:      "account-id",
+			},
+			wantErr: true,
+			Err:     model.ErrNoPermissionKey,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewProjectPermissionSchemeService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, err := newService.Explain(testCase.args.ctx, testCase.args.projectKeyOrID, testCase.args.accountID,
+				testCase.args.permission)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.wantGranted, gotResult.Granted)
+			assert.Equal(t, testCase.wantConditional, gotResult.Conditional)
+			assert.Equal(t, 10000, gotResult.PermissionSchemeID)
+
+			var gotMatched []bool
+			for _, grant := range gotResult.Grants {
+				gotMatched = append(gotMatched, grant.Matched)
+			}
+
+			assert.Equal(t, testCase.wantMatched, gotMatched)
+			assert.NotEmpty(t, gotResult.Reasons())
+		})
+	}
+}
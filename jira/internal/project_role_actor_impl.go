@@ -2,6 +2,7 @@ package internal
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -48,6 +49,21 @@ func (p *ProjectRoleActorService) Delete(ctx context.Context, projectKeyOrID str
 	return p.internalClient.Delete(ctx, projectKeyOrID, roleID, accountID, group)
 }
 
+// Set replaces the actors of a project role for the project with the given users and groups.
+//
+// The missing actors are added and the extra actors are removed, one call at a time.
+//
+// When a call fails, the changes already applied are reverted and reported on the returned change.
+//
+// GET /rest/api/{2-3}/project/{projectKeyOrID}/role/{roleID}
+//
+// POST /rest/api/{2-3}/project/{projectKeyOrID}/role/{roleID}
+//
+// DELETE /rest/api/{2-3}/project/{projectKeyOrID}/role/{roleID}
+func (p *ProjectRoleActorService) Set(ctx context.Context, projectKeyOrID string, roleID int, accountIDs, groups []string) (*model.ProjectRoleActorsChangeScheme, error) {
+	return p.internalClient.Set(ctx, projectKeyOrID, roleID, accountIDs, groups)
+}
+
 type internalProjectRoleActorImpl struct {
 	c       service.Connector
 	version string
@@ -113,3 +129,126 @@ func (i *internalProjectRoleActorImpl) Delete(ctx context.Context, projectKeyOrI
 
 	return i.c.Call(request, nil)
 }
+
+func (i *internalProjectRoleActorImpl) Set(ctx context.Context, projectKeyOrID string, roleID int, accountIDs, groups []string) (*model.ProjectRoleActorsChangeScheme, error) {
+
+	if projectKeyOrID == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoProjectIDOrKey)
+	}
+
+	if roleID == 0 {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoProjectRoleID)
+	}
+
+	roles := &internalProjectRoleImpl{c: i.c, version: i.version}
+	role, _, err := roles.Get(ctx, projectKeyOrID, roleID)
+	if err != nil {
+		return nil, err
+	}
+
+	currentUsers, currentGroups := make(map[string]bool), make(map[string]bool)
+	for _, actor := range role.Actors {
+
+		if actor.ActorUser != nil && actor.ActorUser.AccountID != "" {
+			currentUsers[actor.ActorUser.AccountID] = true
+		}
+
+		if actor.ActorGroup != nil && actor.ActorGroup.Name != "" {
+			currentGroups[actor.ActorGroup.Name] = true
+		}
+	}
+
+	wantedUsers, wantedGroups := make(map[string]bool), make(map[string]bool)
+	for _, accountID := range accountIDs {
+		wantedUsers[accountID] = true
+	}
+
+	for _, group := range groups {
+		wantedGroups[group] = true
+	}
+
+	change := &model.ProjectRoleActorsChangeScheme{}
+	addedUsers, addedGroups := missingActors(accountIDs, currentUsers), missingActors(groups, currentGroups)
+
+	if len(addedUsers) != 0 || len(addedGroups) != 0 {
+
+		if _, _, err = i.Add(ctx, projectKeyOrID, roleID, addedUsers, addedGroups); err != nil {
+			return change, err
+		}
+
+		change.AddedAccountIDs, change.AddedGroups = addedUsers, addedGroups
+	}
+
+	for _, actor := range role.Actors {
+
+		if actor.ActorUser != nil && actor.ActorUser.AccountID != "" && !wantedUsers[actor.ActorUser.AccountID] {
+
+			if _, err = i.Delete(ctx, projectKeyOrID, roleID, actor.ActorUser.AccountID, ""); err != nil {
+				return change, i.rollback(ctx, projectKeyOrID, roleID, change, err)
+			}
+
+			change.RemovedAccountIDs = append(change.RemovedAccountIDs, actor.ActorUser.AccountID)
+		}
+
+		if actor.ActorGroup != nil && actor.ActorGroup.Name != "" && !wantedGroups[actor.ActorGroup.Name] {
+
+			if _, err = i.Delete(ctx, projectKeyOrID, roleID, "", actor.ActorGroup.Name); err != nil {
+				return change, i.rollback(ctx, projectKeyOrID, roleID, change, err)
+			}
+
+			change.RemovedGroups = append(change.RemovedGroups, actor.ActorGroup.Name)
+		}
+	}
+
+	return change, nil
+}
+
+// rollback reverts the changes applied by Set before the cause error, and returns the cause joined with any rollback error.
+func (i *internalProjectRoleActorImpl) rollback(ctx context.Context, projectKeyOrID string, roleID int, change *model.ProjectRoleActorsChangeScheme, cause error) error {
+
+	var errs []error
+
+	if len(change.RemovedAccountIDs) != 0 || len(change.RemovedGroups) != 0 {
+		if _, _, err := i.Add(ctx, projectKeyOrID, roleID, change.RemovedAccountIDs, change.RemovedGroups); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for _, accountID := range change.AddedAccountIDs {
+		if _, err := i.Delete(ctx, projectKeyOrID, roleID, accountID, ""); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for _, group := range change.AddedGroups {
+		if _, err := i.Delete(ctx, projectKeyOrID, roleID, "", group); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) != 0 {
+		return errors.Join(cause, fmt.Errorf("jira: %w", model.ErrRollbackFailed), errors.Join(errs...))
+	}
+
+	change.RolledBack = true
+	return cause
+}
+
+// missingActors returns the actors that aren't in current, without duplicates.
+func missingActors(actors []string, current map[string]bool) []string {
+
+	var missing []string
+	seen := make(map[string]bool)
+
+	for _, actor := range actors {
+
+		if actor == "" || current[actor] || seen[actor] {
+			continue
+		}
+
+		seen[actor] = true
+		missing = append(missing, actor)
+	}
+
+	return missing
+}
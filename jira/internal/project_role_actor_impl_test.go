@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
@@ -362,6 +363,221 @@ func Test_internalProjectRoleActorImpl_Delete(t *testing.T) {
 	}
 }
 
+func Test_internalProjectRoleActorImpl_Set(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx            context.Context
+		projectKeyOrID string
+		roleID         int
+		accountIDs     []string
+		groups         []string
+	}
+
+	onRole := func(client *mocks.Connector) {
+
+		request := &http.Request{Method: http.MethodGet}
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/project/DUMMY/role/10001",
+			"",
+			nil).
+			Return(request, nil)
+
+		client.On("Call",
+			request,
+			&model.ProjectRoleScheme{}).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*model.ProjectRoleScheme).Actors = []*model.RoleActorScheme{
+					{ActorUser: &model.RoleActorUserScheme{AccountID: "account-id-a"}},
+					{ActorGroup: &model.GroupScheme{Name: "jira-users"}},
+				}
+			}).
+			Return(&model.ResponseScheme{}, nil)
+
+		request = &http.Request{Method: http.MethodPost}
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPost,
+			"rest/api/3/project/DUMMY/role/10001",
+			"",
+			map[string]interface{}{"group": []string{"jira-admins"}, "user": []string{"account-id-b"}}).
+			Return(request, nil)
+
+		client.On("Call",
+			request,
+			&model.ProjectRoleScheme{}).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	onDelete := func(client *mocks.Connector, endpoint string, err error) {
+
+		request := &http.Request{Method: http.MethodDelete, Host: endpoint}
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodDelete,
+			endpoint,
+			"",
+			nil).
+			Return(request, nil)
+
+		client.On("Call",
+			request,
+			nil).
+			Return(&model.ResponseScheme{}, err)
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    *model.ProjectRoleActorsChangeScheme
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the actors are replaced",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrID: "DUMMY",
+				roleID:         10001,
+				accountIDs:     []string{"account-id-a", "account-id-b"},
+				groups:         []string{"jira-admins"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				onRole(client)
+				onDelete(client, "rest/api/3/project/DUMMY/role/10001?group=jira-users", nil)
+
+				fields.c = client
+			},
+			want: &model.ProjectRoleActorsChangeScheme{
+				AddedAccountIDs: []string{"account-id-b"},
+				AddedGroups:     []string{"jira-admins"},
+				RemovedGroups:   []string{"jira-users"},
+			},
+		},
+
+		{
+			name:   "when an actor cannot be removed",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrID: "DUMMY",
+				roleID:         10001,
+				accountIDs:     []string{"account-id-a", "account-id-b"},
+				groups:         []string{"jira-admins"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				onRole(client)
+				onDelete(client, "rest/api/3/project/DUMMY/role/10001?group=jira-users", model.ErrNotFound)
+				onDelete(client, "rest/api/3/project/DUMMY/role/10001?user=account-id-b", nil)
+				onDelete(client, "rest/api/3/project/DUMMY/role/10001?group=jira-admins", nil)
+
+				fields.c = client
+			},
+			want: &model.ProjectRoleActorsChangeScheme{
+				AddedAccountIDs: []string{"account-id-b"},
+				AddedGroups:     []string{"jira-admins"},
+				RolledBack:      true,
+			},
+			wantErr: true,
+			Err:     model.ErrNotFound,
+		},
+
+		{
+			name:   "when the project key is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:    context.Background(),
+				roleID: 10001,
+			},
+			wantErr: true,
+			Err:     model.ErrNoProjectIDOrKey,
+		},
+
+		{
+			name:   "when the role id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrID: "DUMMY",
+			},
+			wantErr: true,
+			Err:     model.ErrNoProjectRoleID,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrID: "DUMMY",
+				roleID:         10001,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/project/DUMMY/role/10001",
+					"",
+					nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			actorService, err := NewProjectRoleActorService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, err := actorService.Set(testCase.args.ctx, testCase.args.projectKeyOrID, testCase.args.roleID,
+				testCase.args.accountIDs, testCase.args.groups)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if testCase.want != nil {
+				assert.Equal(t, testCase.want, gotResult)
+			}
+		})
+	}
+}
+
 func Test_NewProjectRoleActorService(t *testing.T) {
 
 	type args struct {
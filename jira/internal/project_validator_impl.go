@@ -2,12 +2,14 @@ package internal
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/jira"
 	"net/http"
 	"net/url"
+	"strings"
 )
 
 // NewProjectValidatorService creates a new instance of ProjectValidatorService.
@@ -63,6 +65,21 @@ func (p *ProjectValidatorService) Name(ctx context.Context, name string) (string
 	return p.internalClient.Name(ctx, name)
 }
 
+// Availability checks the validity and availability of the keys and names of the candidates,
+//
+// and suggests the next available key and name of the candidates that can't be used.
+//
+// The candidates that can't be checked are reported on the returned availabilities.
+//
+// GET /rest/api/{2-3}/projectvalidate/key
+//
+// GET /rest/api/{2-3}/projectvalidate/validProjectKey
+//
+// GET /rest/api/{2-3}/projectvalidate/validProjectName
+func (p *ProjectValidatorService) Availability(ctx context.Context, candidates []*model.ProjectCandidateScheme) ([]*model.ProjectAvailabilityScheme, error) {
+	return p.internalClient.Availability(ctx, candidates)
+}
+
 type internalProjectValidatorImpl struct {
 	c       service.Connector
 	version string
@@ -140,3 +157,81 @@ func (i *internalProjectValidatorImpl) Name(ctx context.Context, name string) (s
 
 	return response.Bytes.String(), response, nil
 }
+
+func (i *internalProjectValidatorImpl) Availability(ctx context.Context, candidates []*model.ProjectCandidateScheme) ([]*model.ProjectAvailabilityScheme, error) {
+
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoProjectKeySlice)
+	}
+
+	availabilities := make([]*model.ProjectAvailabilityScheme, 0, len(candidates))
+	for _, candidate := range candidates {
+
+		if candidate == nil {
+			continue
+		}
+
+		availability := &model.ProjectAvailabilityScheme{Key: candidate.Key, Name: candidate.Name}
+		availability.Err = i.availability(ctx, availability)
+
+		availabilities = append(availabilities, availability)
+	}
+
+	return availabilities, nil
+}
+
+// availability checks the key and the name of the candidate.
+func (i *internalProjectValidatorImpl) availability(ctx context.Context, availability *model.ProjectAvailabilityScheme) error {
+
+	message, _, err := i.Validate(ctx, availability.Key)
+	if err != nil {
+		return err
+	}
+
+	availability.KeyErrors = append(availability.KeyErrors, message.ErrorMessages...)
+	if message.Errors.ProjectKey != "" {
+		availability.KeyErrors = append(availability.KeyErrors, message.Errors.ProjectKey)
+	}
+
+	availability.KeyAvailable = len(availability.KeyErrors) == 0
+
+	if !availability.KeyAvailable {
+
+		key, _, err := i.Key(ctx, availability.Key)
+		if err != nil {
+			return err
+		}
+
+		availability.SuggestedKey = unquoteValidation(key)
+	}
+
+	if availability.Name == "" {
+		return nil
+	}
+
+	// A 404 response reports that no valid name can be generated from the name
+	name, _, err := i.Name(ctx, availability.Name)
+	if err != nil {
+		return ignoreNotFound(err)
+	}
+
+	name = unquoteValidation(name)
+
+	availability.NameAvailable = name == availability.Name
+	if !availability.NameAvailable {
+		availability.SuggestedName = name
+	}
+
+	return nil
+}
+
+// unquoteValidation returns the key or name of the validation endpoints, which is returned as a JSON string.
+func unquoteValidation(value string) string {
+
+	var unquoted string
+	if err := json.Unmarshal([]byte(value), &unquoted); err != nil {
+		return strings.TrimSpace(value)
+	}
+
+	return unquoted
+}
@@ -1,6 +1,7 @@
 package internal
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -8,6 +9,7 @@ import (
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"net/http"
 	"net/url"
 	"testing"
@@ -463,6 +465,203 @@ func Test_internalProjectValidatorImpl_Name(t *testing.T) {
 	}
 }
 
+func Test_internalProjectValidatorImpl_Availability(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx        context.Context
+		candidates []*model.ProjectCandidateScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    []*model.ProjectAvailabilityScheme
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the key and the name are available",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				candidates: []*model.ProjectCandidateScheme{{Key: "DUMMY", Name: "Dummy"}},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/projectvalidate/key?key=DUMMY",
+					"", nil).
+					Return(&http.Request{Host: "validate"}, nil)
+
+				client.On("Call",
+					&http.Request{Host: "validate"},
+					&model.ProjectValidationMessageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/projectvalidate/validProjectName?name=Dummy",
+					"", nil).
+					Return(&http.Request{Host: "name"}, nil)
+
+				client.On("Call",
+					&http.Request{Host: "name"},
+					nil).
+					Return(&model.ResponseScheme{Bytes: *bytes.NewBufferString(`"Dummy"`)}, nil)
+
+				fields.c = client
+			},
+			want: []*model.ProjectAvailabilityScheme{
+				{Key: "DUMMY", KeyAvailable: true, Name: "Dummy", NameAvailable: true},
+			},
+		},
+
+		{
+			name:   "when the key and the name are in use",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:        context.Background(),
+				candidates: []*model.ProjectCandidateScheme{{Key: "DUMMY", Name: "Dummy"}},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/projectvalidate/key?key=DUMMY",
+					"", nil).
+					Return(&http.Request{Host: "validate"}, nil)
+
+				client.On("Call",
+					&http.Request{Host: "validate"},
+					&model.ProjectValidationMessageScheme{}).
+					Run(func(args mock.Arguments) {
+						message := args.Get(1).(*model.ProjectValidationMessageScheme)
+						message.Errors.ProjectKey = "Project 'Dummy' uses this project key."
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/projectvalidate/validProjectKey?key=DUMMY",
+					"", nil).
+					Return(&http.Request{Host: "key"}, nil)
+
+				client.On("Call",
+					&http.Request{Host: "key"},
+					nil).
+					Return(&model.ResponseScheme{Bytes: *bytes.NewBufferString(`"DUMMY1"`)}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/projectvalidate/validProjectName?name=Dummy",
+					"", nil).
+					Return(&http.Request{Host: "name"}, nil)
+
+				client.On("Call",
+					&http.Request{Host: "name"},
+					nil).
+					Return(&model.ResponseScheme{Bytes: *bytes.NewBufferString(`"Dummy (migrated)"`)}, nil)
+
+				fields.c = client
+			},
+			want: []*model.ProjectAvailabilityScheme{
+				{
+					Key:           "DUMMY",
+					KeyErrors:     []string{"Project 'Dummy' uses this project key."},
+					SuggestedKey:  "DUMMY1",
+					Name:          "Dummy",
+					SuggestedName: "Dummy (migrated)",
+				},
+			},
+		},
+
+		{
+			name:   "when the key can't be validated",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				candidates: []*model.ProjectCandidateScheme{{Key: "DUMMY"}},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/projectvalidate/key?key=DUMMY",
+					"", nil).
+					Return(&http.Request{Host: "validate"}, nil)
+
+				client.On("Call",
+					&http.Request{Host: "validate"},
+					&model.ProjectValidationMessageScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrUnauthorized)
+
+				fields.c = client
+			},
+			want: []*model.ProjectAvailabilityScheme{
+				{Key: "DUMMY", Err: model.ErrUnauthorized},
+			},
+		},
+
+		{
+			name:   "when the candidates are not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoProjectKeySlice,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			validatorService, err := NewProjectValidatorService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, err := validatorService.Availability(testCase.args.ctx, testCase.args.candidates)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err))
+				assert.Nil(t, gotResult)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.want, gotResult)
+		})
+	}
+}
+
 func Test_NewProjectValidatorService(t *testing.T) {
 
 	type args struct {
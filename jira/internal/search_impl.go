@@ -1,7 +1,11 @@
 package internal
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"time"
+
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
 )
@@ -29,3 +33,139 @@ func NewSearchService(client service.Connector, version string) (*SearchADFServi
 
 	return adfService, rtService, nil
 }
+
+// issueSyncPageSize is the default number of issues fetched per page by the incremental sync.
+const issueSyncPageSize = 100
+
+// issueSyncDateLayout is the layout of the JQL dates, which have a minute precision.
+const issueSyncDateLayout = "2006-01-02 15:04"
+
+// syncIssues returns the keys of the issues of the JQL scope created, updated or deleted since the checkpoint.
+//
+// It's shared by the ADF and rich text search services, only the issue keys and created dates are fetched.
+func syncIssues(ctx context.Context, c service.Connector, version string, options *model.IssueSearchSyncOptionsScheme) (*model.IssueSearchSyncScheme, error) {
+
+	if options == nil || options.JQL == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoJQL)
+	}
+
+	if options.Since.IsZero() {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoSyncCheckpoint)
+	}
+
+	location := options.Location
+	if location == nil {
+
+		var err error
+		if location, err = userLocation(ctx, c, version); err != nil {
+			return nil, err
+		}
+	}
+
+	pageSize := options.MaxResults
+	if pageSize <= 0 {
+		pageSize = issueSyncPageSize
+	}
+
+	result := new(model.IssueSearchSyncScheme)
+	since := options.Since.Truncate(time.Minute)
+
+	changed := fmt.Sprintf("(%v) AND updated >= \"%v\" ORDER BY updated ASC", options.JQL, since.In(location).Format(issueSyncDateLayout))
+
+	response, err := searchIssueKeys(ctx, c, version, changed, pageSize, func(issue *model.IssueScheme) {
+
+		if issue.Fields != nil && issue.Fields.Created != nil && time.Time(*issue.Fields.Created).Before(since) {
+			result.Updated = append(result.Updated, issue.Key)
+			return
+		}
+
+		result.Created = append(result.Created, issue.Key)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	// The checkpoint is taken on the clock of the server with the first search and truncated like the JQL dates,
+	// so the issues updated while the sync runs are returned again by the next sync.
+	result.Checkpoint = syncCheckpoint(response).Truncate(time.Minute)
+
+	if len(options.KnownKeys) == 0 {
+		return result, nil
+	}
+
+	current := make(map[string]bool)
+	_, err = searchIssueKeys(ctx, c, version, options.JQL, pageSize, func(issue *model.IssueScheme) {
+		current[issue.Key] = true
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range options.KnownKeys {
+		if !current[key] {
+			result.Deleted = append(result.Deleted, key)
+		}
+	}
+
+	return result, nil
+}
+
+// userLocation returns the time zone of the user, the JQL dates being written in it.
+func userLocation(ctx context.Context, c service.Connector, version string) (*time.Location, error) {
+
+	myself, _, err := (&internalMySelfImpl{c: c, version: version}).Details(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if myself.TimeZone == "" {
+		return time.UTC, nil
+	}
+
+	location, err := time.LoadLocation(myself.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("jira: %w", err)
+	}
+
+	return location, nil
+}
+
+// searchIssueKeys pages the issues matching the JQL query, and calls fn with each issue, only the created field is fetched.
+// It returns the response of the first page.
+func searchIssueKeys(ctx context.Context, c service.Connector, version, jql string, pageSize int, fn func(issue *model.IssueScheme)) (*model.ResponseScheme, error) {
+
+	endpoint := fmt.Sprintf("rest/api/%v/search/jql", version)
+	payload := &model.IssueSearchJQLPayloadScheme{JQL: jql, MaxResults: pageSize, Fields: []string{"created"}}
+
+	var first *model.ResponseScheme
+	for {
+
+		request, err := c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+		if err != nil {
+			return first, err
+		}
+
+		// The issues are decoded with the ADF model on both versions, the created field has the same format.
+		page := new(model.IssueSearchJQLScheme)
+		response, err := c.Call(request, page)
+		if err != nil {
+			return first, err
+		}
+
+		if first == nil {
+			first = response
+		}
+
+		for _, issue := range page.Issues {
+			fn(issue)
+		}
+
+		if page.NextPageToken == "" {
+			return first, nil
+		}
+
+		payload = &model.IssueSearchJQLPayloadScheme{JQL: jql, MaxResults: pageSize, Fields: []string{"created"}, NextPageToken: page.NextPageToken}
+	}
+}
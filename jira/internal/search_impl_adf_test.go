@@ -7,8 +7,10 @@ import (
 	"net/http"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
@@ -914,3 +916,700 @@ func Test_internalSearchADFImpl_BulkFetch(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalSearchADFImpl_Picker(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx     context.Context
+		options *model.IssuePickerOptionsScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+				options: &model.IssuePickerOptionsScheme{
+					Query:            "login",
+					CurrentIssueKey:  "KP-1",
+					CurrentProjectID: "10000",
+					ShowSubTasks:     true,
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/issue/picker?currentIssueKey=KP-1&currentProjectId=10000&query=login&showSubTasks=true",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssuePickerScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the options are not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				options: nil,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/issue/picker",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssuePickerScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+				options: &model.IssuePickerOptionsScheme{
+					Query: "login",
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/issue/picker?query=login",
+					"",
+					nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, _, err := NewSearchService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := newService.Picker(testCase.args.ctx, testCase.args.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalSearchADFImpl_Search(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx     context.Context
+		payload *model.IssueSearchJQLPayloadScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+				payload: &model.IssueSearchJQLPayloadScheme{
+					JQL:             "project = FOO",
+					MaxResults:      50,
+					Fields:          []string{"summary", "status"},
+					Expand:          "names,schema",
+					Properties:      []string{"myProperty"},
+					FieldsByKeys:    true,
+					ReconcileIssues: []int{10001},
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/search/jql",
+					"",
+					&model.IssueSearchJQLPayloadScheme{JQL: "project = FOO", MaxResults: 50, Fields: []string{"summary", "status"}, Expand: "names,schema", Properties: []string{"myProperty"}, FieldsByKeys: true, ReconcileIssues: []int{10001}}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueSearchJQLScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the http call cannot be executed",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+				payload: &model.IssueSearchJQLPayloadScheme{
+					JQL:             "project = FOO",
+					MaxResults:      50,
+					Fields:          []string{"summary", "status"},
+					Expand:          "names,schema",
+					Properties:      []string{"myProperty"},
+					FieldsByKeys:    true,
+					ReconcileIssues: []int{10001},
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/search/jql",
+					"",
+					&model.IssueSearchJQLPayloadScheme{JQL: "project = FOO", MaxResults: 50, Fields: []string{"summary", "status"}, Expand: "names,schema", Properties: []string{"myProperty"}, FieldsByKeys: true, ReconcileIssues: []int{10001}}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueSearchJQLScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrBadRequest)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrBadRequest,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+				payload: &model.IssueSearchJQLPayloadScheme{
+					JQL:             "project = FOO",
+					MaxResults:      50,
+					Fields:          []string{"summary", "status"},
+					Expand:          "names,schema",
+					Properties:      []string{"myProperty"},
+					FieldsByKeys:    true,
+					ReconcileIssues: []int{10001},
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/search/jql",
+					"",
+					&model.IssueSearchJQLPayloadScheme{JQL: "project = FOO", MaxResults: 50, Fields: []string{"summary", "status"}, Expand: "names,schema", Properties: []string{"myProperty"}, FieldsByKeys: true, ReconcileIssues: []int{10001}}).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := &internalSearchADFImpl{
+				c:       testCase.fields.c,
+				version: testCase.fields.version,
+			}
+
+			gotResult, gotResponse, err := newService.Search(testCase.args.ctx, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalSearchADFImpl_Sync(t *testing.T) {
+
+	since := time.Date(2024, time.January, 10, 9, 30, 45, 0, time.UTC)
+
+	newIssue := func(key string, created time.Time) *model.IssueScheme {
+		createdAt := model.DateTimeScheme(created)
+		return &model.IssueScheme{Key: key, Fields: &model.IssueFieldsScheme{Created: &createdAt}}
+	}
+
+	changedJQL := `(project = KP) AND updated >= "2024-01-10 09:30" ORDER BY updated ASC`
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx     context.Context
+		options *model.IssueSearchSyncOptionsScheme
+	}
+
+	testCases := []struct {
+		name           string
+		fields         fields
+		args           args
+		on             func(*fields)
+		want           *model.IssueSearchSyncScheme
+		wantCheckpoint time.Time
+		wantErr        bool
+		Err            error
+	}{
+		{
+			name:   "when the parameters are correct",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+				options: &model.IssueSearchSyncOptionsScheme{
+					JQL:        "project = KP",
+					Since:      since,
+					Location:   time.UTC,
+					KnownKeys:  []string{"KP-2", "KP-4", "KP-5"},
+					MaxResults: 2,
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/search/jql",
+					"",
+					&model.IssueSearchJQLPayloadScheme{JQL: changedJQL, MaxResults: 2, Fields: []string{"created"}}).
+					Return(&http.Request{Host: "changed"}, nil)
+
+				client.On("Call",
+					&http.Request{Host: "changed"},
+					&model.IssueSearchJQLScheme{}).
+					Run(func(args mock.Arguments) {
+						page := args.Get(1).(*model.IssueSearchJQLScheme)
+						page.Issues = []*model.IssueScheme{newIssue("KP-1", since.Add(time.Hour)), newIssue("KP-2", since.AddDate(-1, 0, 0))}
+						page.NextPageToken = "CAEaAggD"
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/search/jql",
+					"",
+					&model.IssueSearchJQLPayloadScheme{JQL: changedJQL, MaxResults: 2, Fields: []string{"created"}, NextPageToken: "CAEaAggD"}).
+					Return(&http.Request{Host: "changed-next"}, nil)
+
+				client.On("Call",
+					&http.Request{Host: "changed-next"},
+					&model.IssueSearchJQLScheme{}).
+					Run(func(args mock.Arguments) {
+						page := args.Get(1).(*model.IssueSearchJQLScheme)
+						page.Issues = []*model.IssueScheme{newIssue("KP-3", since.Add(time.Minute))}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/search/jql",
+					"",
+					&model.IssueSearchJQLPayloadScheme{JQL: "project = KP", MaxResults: 2, Fields: []string{"created"}}).
+					Return(&http.Request{Host: "scope"}, nil)
+
+				client.On("Call",
+					&http.Request{Host: "scope"},
+					&model.IssueSearchJQLScheme{}).
+					Run(func(args mock.Arguments) {
+						page := args.Get(1).(*model.IssueSearchJQLScheme)
+						page.Issues = []*model.IssueScheme{{Key: "KP-1"}, {Key: "KP-2"}, {Key: "KP-3"}, {Key: "KP-4"}}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			want: &model.IssueSearchSyncScheme{
+				Created: []string{"KP-1", "KP-3"},
+				Updated: []string{"KP-2"},
+				Deleted: []string{"KP-5"},
+			},
+		},
+
+		{
+			name:   "when the known keys are not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				options: &model.IssueSearchSyncOptionsScheme{JQL: "project = KP", Since: since, Location: time.UTC},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/search/jql",
+					"",
+					&model.IssueSearchJQLPayloadScheme{JQL: changedJQL, MaxResults: 100, Fields: []string{"created"}}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueSearchJQLScheme{}).
+					Run(func(args mock.Arguments) {
+						page := args.Get(1).(*model.IssueSearchJQLScheme)
+						page.Issues = []*model.IssueScheme{newIssue("KP-2", since.AddDate(0, -1, 0))}
+					}).
+					Return(&model.ResponseScheme{Response: &http.Response{Header: http.Header{"Date": {"Fri, 01 Mar 2024 14:00:03 GMT"}}}}, nil)
+
+				fields.c = client
+			},
+			want:           &model.IssueSearchSyncScheme{Updated: []string{"KP-2"}},
+			wantCheckpoint: time.Date(2024, time.March, 1, 13, 59, 0, 0, time.UTC),
+		},
+
+		{
+			name:   "when the scope search fails",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				options: &model.IssueSearchSyncOptionsScheme{JQL: "project = KP", Since: since, Location: time.UTC, KnownKeys: []string{"KP-1"}},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/search/jql",
+					"",
+					&model.IssueSearchJQLPayloadScheme{JQL: changedJQL, MaxResults: 100, Fields: []string{"created"}}).
+					Return(&http.Request{Host: "changed"}, nil)
+
+				client.On("Call",
+					&http.Request{Host: "changed"},
+					&model.IssueSearchJQLScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/search/jql",
+					"",
+					&model.IssueSearchJQLPayloadScheme{JQL: "project = KP", MaxResults: 100, Fields: []string{"created"}}).
+					Return(&http.Request{Host: "scope"}, nil)
+
+				client.On("Call",
+					&http.Request{Host: "scope"},
+					&model.IssueSearchJQLScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrBadRequest)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrBadRequest,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				options: &model.IssueSearchSyncOptionsScheme{JQL: "project = KP", Since: since, Location: time.UTC},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/search/jql",
+					"",
+					&model.IssueSearchJQLPayloadScheme{JQL: changedJQL, MaxResults: 100, Fields: []string{"created"}}).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+
+		{
+			name:   "when the jql is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				options: &model.IssueSearchSyncOptionsScheme{Since: since},
+			},
+			wantErr: true,
+			Err:     model.ErrNoJQL,
+		},
+
+		{
+			name:   "when the options are not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoJQL,
+		},
+
+		{
+			name:   "when the checkpoint is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				options: &model.IssueSearchSyncOptionsScheme{JQL: "project = KP"},
+			},
+			wantErr: true,
+			Err:     model.ErrNoSyncCheckpoint,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := &internalSearchADFImpl{
+				c:       testCase.fields.c,
+				version: testCase.fields.version,
+			}
+
+			gotResult, err := newService.Sync(testCase.args.ctx, testCase.args.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.False(t, gotResult.Checkpoint.IsZero())
+
+				if !testCase.wantCheckpoint.IsZero() {
+					assert.True(t, testCase.wantCheckpoint.Equal(gotResult.Checkpoint), "expected checkpoint: %v, got: %v",
+						testCase.wantCheckpoint, gotResult.Checkpoint)
+				}
+
+				gotResult.Checkpoint = time.Time{}
+				assert.Equal(t, testCase.want, gotResult)
+			}
+		})
+	}
+}
+
+func Test_internalSearchADFImpl_Iterate(t *testing.T) {
+
+	pageMocked := func(client *mocks.Connector, host, nextPageToken string, keys ...string) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPost,
+			"rest/api/3/search/jql",
+			"",
+			mock.Anything).
+			Return(&http.Request{Host: host}, nil).
+			Once()
+
+		client.On("Call",
+			&http.Request{Host: host},
+			&model.IssueSearchJQLScheme{}).
+			Run(func(args mock.Arguments) {
+				page := args.Get(1).(*model.IssueSearchJQLScheme)
+				page.NextPageToken = nextPageToken
+
+				for _, key := range keys {
+					page.Issues = append(page.Issues, &model.IssueScheme{Key: key})
+				}
+			}).
+			Return(&model.ResponseScheme{}, nil).
+			Once()
+	}
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	testCases := []struct {
+		name     string
+		fields   fields
+		on       func(*fields)
+		wantKeys []string
+		wantErr  bool
+		Err      error
+	}{
+		{
+			name:   "when the issues span several pages",
+			fields: fields{version: "3"},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				pageMocked(client, "page-1", "token", "KP-1", "KP-2")
+				pageMocked(client, "page-2", "", "KP-3")
+
+				fields.c = client
+			},
+			wantKeys: []string{"KP-1", "KP-2", "KP-3"},
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/search/jql",
+					"",
+					mock.Anything).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, _, err := NewSearchService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotIssues, err := newService.Iterate(context.Background(), "project = KP", []string{"key"}, nil, 2).Collect()
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+				return
+			}
+
+			assert.NoError(t, err)
+
+			var gotKeys []string
+			for _, issue := range gotIssues {
+				gotKeys = append(gotKeys, issue.Key)
+			}
+
+			assert.Equal(t, testCase.wantKeys, gotKeys)
+		})
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/google/go-querystring/query"
+
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/jira"
@@ -28,6 +30,17 @@ func (s *SearchRichTextService) Checks(ctx context.Context, payload *model.Issue
 	return s.internalClient.Checks(ctx, payload)
 }
 
+// Picker returns lists of issues matching a query string.
+//
+// Use this resource to provide auto-completion suggestions when the user is looking for an issue using a word or string.
+//
+// GET /rest/api/{2-3}/issue/picker
+//
+// https://docs.go-atlassian.io/jira-software-cloud/issues/search#get-issue-picker-suggestions
+func (s *SearchRichTextService) Picker(ctx context.Context, options *model.IssuePickerOptionsScheme) (*model.IssuePickerScheme, *model.ResponseScheme, error) {
+	return s.internalClient.Picker(ctx, options)
+}
+
 // Get search issues using JQL query under the HTTP Method GET
 //
 // GET /rest/api/2/search
@@ -59,6 +72,23 @@ func (s *SearchRichTextService) SearchJQL(ctx context.Context, jql string, field
 	return s.internalClient.SearchJQL(ctx, jql, fields, expands, maxResults, nextPageToken)
 }
 
+// Iterate returns an iterator over the issues matching the JQL query, fetching the pages of maxResults issues on demand
+// with the next page tokens of the new JQL search endpoint.
+//
+// POST /rest/api/2/search/jql
+func (s *SearchRichTextService) Iterate(ctx context.Context, jql string, fields, expands []string, maxResults int) *model.PageIterator[*model.IssueSchemeV2] {
+	return s.internalClient.Iterate(ctx, jql, fields, expands, maxResults)
+}
+
+// Search searches issues using the new JQL search endpoint, with every option of the request body.
+//
+// Use it instead of SearchJQL when the query needs issue properties, fields by keys or issue reconciliation.
+//
+// POST /rest/api/2/search/jql
+func (s *SearchRichTextService) Search(ctx context.Context, payload *model.IssueSearchJQLPayloadScheme) (*model.IssueSearchJQLSchemeV2, *model.ResponseScheme, error) {
+	return s.internalClient.Search(ctx, payload)
+}
+
 // ApproximateCount gets an approximate count of issues matching a JQL query
 //
 // POST /rest/api/2/search/approximate-count
@@ -73,6 +103,21 @@ func (s *SearchRichTextService) BulkFetch(ctx context.Context, issueIDsOrKeys []
 	return s.internalClient.BulkFetch(ctx, issueIDsOrKeys, fields)
 }
 
+// Sync returns the keys of the issues of the JQL scope created, updated or deleted since the checkpoint.
+//
+// The created and updated issues are found with the updated >= JQL clause, the deleted issues by reconciling the
+// KnownKeys with the keys of the scope, e.g. the keys stored by a data warehouse connector.
+//
+// Use the returned Checkpoint as the Since option of the next sync, it's taken on the clock of the server. The JQL
+// dates are written in the time zone of the current user, unless the Location option is provided.
+//
+// GET /rest/api/{2-3}/myself
+//
+// POST /rest/api/{2-3}/search/jql
+func (s *SearchRichTextService) Sync(ctx context.Context, options *model.IssueSearchSyncOptionsScheme) (*model.IssueSearchSyncScheme, error) {
+	return s.internalClient.Sync(ctx, options)
+}
+
 type internalSearchRichTextImpl struct {
 	c       service.Connector
 	version string
@@ -96,6 +141,36 @@ func (i *internalSearchRichTextImpl) Checks(ctx context.Context, payload *model.
 	return issues, response, nil
 }
 
+func (i *internalSearchRichTextImpl) Picker(ctx context.Context, options *model.IssuePickerOptionsScheme) (*model.IssuePickerScheme, *model.ResponseScheme, error) {
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/picker", i.version)
+
+	if options != nil {
+
+		params, err := query.Values(options)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if len(params) != 0 {
+			endpoint += "?" + params.Encode()
+		}
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	picker := new(model.IssuePickerScheme)
+	response, err := i.c.Call(request, picker)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return picker, response, nil
+}
+
 func (i *internalSearchRichTextImpl) Get(ctx context.Context, jql string, fields, expands []string, startAt, maxResults int, validate string) (*model.IssueSearchSchemeV2, *model.ResponseScheme, error) {
 
 	if jql == "" {
@@ -172,8 +247,7 @@ func (i *internalSearchRichTextImpl) Post(ctx context.Context, jql string, field
 // SearchJQL searches issues using the new JQL search endpoint
 //
 // POST /rest/api/2/search/jql
-// TODO: Missing optional parameters from API spec: properties, fieldsByKeys, failFast, reconcileIssues
-// Cannot add without breaking API compatibility. Consider adding in next major version.
+// Use Search for the optional parameters: properties, fieldsByKeys, failFast and reconcileIssues.
 func (i *internalSearchRichTextImpl) SearchJQL(ctx context.Context, jql string, fields, expands []string, maxResults int, nextPageToken string) (*model.IssueSearchJQLSchemeV2, *model.ResponseScheme, error) {
 
 	payload := struct {
@@ -206,6 +280,29 @@ func (i *internalSearchRichTextImpl) SearchJQL(ctx context.Context, jql string,
 	return issues, response, nil
 }
 
+// Search searches issues using the new JQL search endpoint, with every option of the request body.
+//
+// Use it instead of SearchJQL when the query needs issue properties, fields by keys or issue reconciliation.
+//
+// POST /rest/api/2/search/jql
+func (i *internalSearchRichTextImpl) Search(ctx context.Context, payload *model.IssueSearchJQLPayloadScheme) (*model.IssueSearchJQLSchemeV2, *model.ResponseScheme, error) {
+
+	endpoint := fmt.Sprintf("rest/api/%v/search/jql", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issues := new(model.IssueSearchJQLSchemeV2)
+	response, err := i.c.Call(request, issues)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return issues, response, nil
+}
+
 // ApproximateCount gets an approximate count of issues matching a JQL query
 //
 // POST /rest/api/2/search/approximate-count
@@ -261,3 +358,19 @@ func (i *internalSearchRichTextImpl) BulkFetch(ctx context.Context, issueIDsOrKe
 
 	return issues, response, nil
 }
+
+func (i *internalSearchRichTextImpl) Sync(ctx context.Context, options *model.IssueSearchSyncOptionsScheme) (*model.IssueSearchSyncScheme, error) {
+	return syncIssues(ctx, i.c, i.version, options)
+}
+
+func (i *internalSearchRichTextImpl) Iterate(ctx context.Context, jql string, fields, expands []string, maxResults int) *model.PageIterator[*model.IssueSchemeV2] {
+	return model.NewPageIterator(ctx, maxResults, func(ctx context.Context, cursor model.PageCursorScheme) (*model.PageResultScheme[*model.IssueSchemeV2], error) {
+
+		page, _, err := i.SearchJQL(ctx, jql, fields, expands, cursor.MaxResults, cursor.NextPageToken)
+		if err != nil {
+			return nil, err
+		}
+
+		return &model.PageResultScheme[*model.IssueSchemeV2]{Values: page.Issues, IsLast: page.NextPageToken == "", NextPageToken: page.NextPageToken}, nil
+	})
+}
@@ -7,8 +7,10 @@ import (
 	"net/http"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
@@ -914,3 +916,526 @@ func Test_internalSearchRichTextImpl_BulkFetch(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalSearchRichTextImpl_Picker(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx     context.Context
+		options *model.IssuePickerOptionsScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx: context.Background(),
+				options: &model.IssuePickerOptionsScheme{
+					Query:            "login",
+					CurrentIssueKey:  "KP-1",
+					CurrentProjectID: "10000",
+					ShowSubTasks:     true,
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/issue/picker?currentIssueKey=KP-1&currentProjectId=10000&query=login&showSubTasks=true",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssuePickerScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the options are not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:     context.Background(),
+				options: nil,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/issue/picker",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssuePickerScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "2"},
+			args: args{
+				ctx: context.Background(),
+				options: &model.IssuePickerOptionsScheme{
+					Query: "login",
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/issue/picker?query=login",
+					"",
+					nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			_, newService, err := NewSearchService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := newService.Picker(testCase.args.ctx, testCase.args.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalSearchRichTextImpl_Search(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx     context.Context
+		payload *model.IssueSearchJQLPayloadScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx: context.Background(),
+				payload: &model.IssueSearchJQLPayloadScheme{
+					JQL:             "project = FOO",
+					MaxResults:      50,
+					Fields:          []string{"summary", "status"},
+					Expand:          "names,schema",
+					Properties:      []string{"myProperty"},
+					FieldsByKeys:    true,
+					ReconcileIssues: []int{10001},
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/search/jql",
+					"",
+					&model.IssueSearchJQLPayloadScheme{JQL: "project = FOO", MaxResults: 50, Fields: []string{"summary", "status"}, Expand: "names,schema", Properties: []string{"myProperty"}, FieldsByKeys: true, ReconcileIssues: []int{10001}}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueSearchJQLSchemeV2{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the http call cannot be executed",
+			fields: fields{version: "2"},
+			args: args{
+				ctx: context.Background(),
+				payload: &model.IssueSearchJQLPayloadScheme{
+					JQL:             "project = FOO",
+					MaxResults:      50,
+					Fields:          []string{"summary", "status"},
+					Expand:          "names,schema",
+					Properties:      []string{"myProperty"},
+					FieldsByKeys:    true,
+					ReconcileIssues: []int{10001},
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/search/jql",
+					"",
+					&model.IssueSearchJQLPayloadScheme{JQL: "project = FOO", MaxResults: 50, Fields: []string{"summary", "status"}, Expand: "names,schema", Properties: []string{"myProperty"}, FieldsByKeys: true, ReconcileIssues: []int{10001}}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueSearchJQLSchemeV2{}).
+					Return(&model.ResponseScheme{}, model.ErrBadRequest)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrBadRequest,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "2"},
+			args: args{
+				ctx: context.Background(),
+				payload: &model.IssueSearchJQLPayloadScheme{
+					JQL:             "project = FOO",
+					MaxResults:      50,
+					Fields:          []string{"summary", "status"},
+					Expand:          "names,schema",
+					Properties:      []string{"myProperty"},
+					FieldsByKeys:    true,
+					ReconcileIssues: []int{10001},
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/search/jql",
+					"",
+					&model.IssueSearchJQLPayloadScheme{JQL: "project = FOO", MaxResults: 50, Fields: []string{"summary", "status"}, Expand: "names,schema", Properties: []string{"myProperty"}, FieldsByKeys: true, ReconcileIssues: []int{10001}}).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := &internalSearchRichTextImpl{
+				c:       testCase.fields.c,
+				version: testCase.fields.version,
+			}
+
+			gotResult, gotResponse, err := newService.Search(testCase.args.ctx, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalSearchRichTextImpl_Sync(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx     context.Context
+		options *model.IssueSearchSyncOptionsScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    *model.IssueSearchSyncScheme
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the user location is provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx: context.Background(),
+				options: &model.IssueSearchSyncOptionsScheme{
+					JQL:      "project = KP",
+					Since:    time.Date(2024, time.January, 10, 9, 30, 0, 0, time.UTC),
+					Location: time.FixedZone("UTC-5", -5*60*60),
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/search/jql",
+					"",
+					&model.IssueSearchJQLPayloadScheme{JQL: `(project = KP) AND updated >= "2024-01-10 04:30" ORDER BY updated ASC`, MaxResults: 100, Fields: []string{"created"}}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueSearchJQLScheme{}).
+					Run(func(args mock.Arguments) {
+						page := args.Get(1).(*model.IssueSearchJQLScheme)
+						page.Issues = []*model.IssueScheme{{Key: "KP-7"}}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			want: &model.IssueSearchSyncScheme{Created: []string{"KP-7"}},
+		},
+
+		{
+			name:   "when the user location is not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx: context.Background(),
+				options: &model.IssueSearchSyncOptionsScheme{
+					JQL:   "project = KP",
+					Since: time.Date(2024, time.January, 10, 9, 30, 0, 0, time.UTC),
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/myself",
+					"",
+					nil).
+					Return(&http.Request{Host: "myself"}, nil)
+
+				client.On("Call",
+					&http.Request{Host: "myself"},
+					&model.UserScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.UserScheme).TimeZone = "Europe/Madrid"
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/search/jql",
+					"",
+					&model.IssueSearchJQLPayloadScheme{JQL: `(project = KP) AND updated >= "2024-01-10 10:30" ORDER BY updated ASC`, MaxResults: 100, Fields: []string{"created"}}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueSearchJQLScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			want: &model.IssueSearchSyncScheme{},
+		},
+
+		{
+			name:   "when the current user cannot be fetched",
+			fields: fields{version: "2"},
+			args: args{
+				ctx: context.Background(),
+				options: &model.IssueSearchSyncOptionsScheme{
+					JQL:   "project = KP",
+					Since: time.Date(2024, time.January, 10, 9, 30, 0, 0, time.UTC),
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/myself",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.UserScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrUnauthorized)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrUnauthorized,
+		},
+
+		{
+			name:   "when the http call cannot be executed",
+			fields: fields{version: "2"},
+			args: args{
+				ctx: context.Background(),
+				options: &model.IssueSearchSyncOptionsScheme{
+					JQL:      "project = KP",
+					Since:    time.Date(2024, time.January, 10, 9, 30, 0, 0, time.UTC),
+					Location: time.UTC,
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/search/jql",
+					"",
+					&model.IssueSearchJQLPayloadScheme{JQL: `(project = KP) AND updated >= "2024-01-10 09:30" ORDER BY updated ASC`, MaxResults: 100, Fields: []string{"created"}}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueSearchJQLScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrBadRequest)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrBadRequest,
+		},
+
+		{
+			name:   "when the checkpoint is not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:     context.Background(),
+				options: &model.IssueSearchSyncOptionsScheme{JQL: "project = KP"},
+			},
+			wantErr: true,
+			Err:     model.ErrNoSyncCheckpoint,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := &internalSearchRichTextImpl{
+				c:       testCase.fields.c,
+				version: testCase.fields.version,
+			}
+
+			gotResult, err := newService.Sync(testCase.args.ctx, testCase.args.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.False(t, gotResult.Checkpoint.IsZero())
+
+				gotResult.Checkpoint = time.Time{}
+				assert.Equal(t, testCase.want, gotResult)
+			}
+		})
+	}
+}
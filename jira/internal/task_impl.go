@@ -4,12 +4,16 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/jira"
 )
 
+// defaultTaskPollInterval is the interval used to poll a task when no poll interval is provided.
+const defaultTaskPollInterval = time.Second
+
 // NewTaskService creates a new instance of TaskService.
 func NewTaskService(client service.Connector, version string) (*TaskService, error) {
 
@@ -52,6 +56,27 @@ func (t *TaskService) Cancel(ctx context.Context, taskID string) (*model.Respons
 	return t.internalClient.Cancel(ctx, taskID)
 }
 
+// WaitFor polls a task until it's done, COMPLETE, FAILED, CANCELLED or DEAD, calling progressFn with each state
+// of the task. The task is polled every second when the poll interval is zero.
+//
+// It returns model.ErrTaskFailed when the task is done without completing, and the context error when ctx is done first.
+//
+// Example usage:
+//
+//	task, _, err := client.Project.DeleteAsynchronously(ctx, "KP")
+//	if err != nil {
+//		return err
+//	}
+//
+//	task, _, err = client.Task.WaitFor(ctx, task.ID, 5*time.Second, func(progress *models.TaskProgressScheme) {
+//		log.Printf("task %v: %v%%", progress.TaskID, progress.Progress)
+//	})
+//
+// GET /rest/api/{2-3}/task/{taskID}
+func (t *TaskService) WaitFor(ctx context.Context, taskID string, pollInterval time.Duration, progressFn func(*model.TaskProgressScheme)) (*model.TaskScheme, *model.ResponseScheme, error) {
+	return t.internalClient.WaitFor(ctx, taskID, pollInterval, progressFn)
+}
+
 type internalTaskServiceImpl struct {
 	c       service.Connector
 	version string
@@ -93,3 +118,61 @@ func (i *internalTaskServiceImpl) Cancel(ctx context.Context, taskID string) (*m
 
 	return i.c.Call(request, nil)
 }
+
+func (i *internalTaskServiceImpl) WaitFor(ctx context.Context, taskID string, pollInterval time.Duration, progressFn func(*model.TaskProgressScheme)) (*model.TaskScheme, *model.ResponseScheme, error) {
+
+	if taskID == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoTaskID)
+	}
+
+	var (
+		task     *model.TaskScheme
+		response *model.ResponseScheme
+	)
+
+	err := pollUntilDone(ctx, pollInterval, func() (bool, error) {
+
+		var err error
+		task, response, err = i.Get(ctx, taskID)
+		if err != nil {
+			return false, err
+		}
+
+		if progressFn != nil {
+			progressFn(&model.TaskProgressScheme{TaskID: taskID, Status: task.Status, Progress: task.Progress, Task: task})
+		}
+
+		if task.Done() && task.Status != model.TaskStatusComplete {
+			return true, fmt.Errorf("jira: %w: %v", model.ErrTaskFailed, task.Status)
+		}
+
+		return task.Done(), nil
+	})
+
+	return task, response, err
+}
+
+// pollUntilDone calls poll every poll interval, defaultTaskPollInterval when zero, until it reports the task is done
+// or fails, or until ctx is done.
+func pollUntilDone(ctx context.Context, pollInterval time.Duration, poll func() (bool, error)) error {
+
+	if pollInterval <= 0 {
+		pollInterval = defaultTaskPollInterval
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		done, err := poll()
+		if done || err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
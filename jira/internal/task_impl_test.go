@@ -7,8 +7,10 @@ import (
 	"net/http"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
@@ -372,3 +374,80 @@ func Test_NewTaskService(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalTaskServiceImpl_WaitFor(t *testing.T) {
+
+	newClient := func(t *testing.T, statuses ...string) *mocks.Connector {
+
+		client := mocks.NewConnector(t)
+
+		client.On("NewRequest",
+			mock.Anything,
+			http.MethodGet,
+			"rest/api/3/task/1000",
+			"",
+			nil).
+			Return(&http.Request{}, nil)
+
+		for index, status := range statuses {
+
+			client.On("Call",
+				&http.Request{},
+				mock.Anything).
+				Run(func(args mock.Arguments) {
+					task := args.Get(1).(*model.TaskScheme)
+					task.ID, task.Status, task.Progress = "1000", status, (index+1)*100/len(statuses)
+				}).
+				Return(&model.ResponseScheme{}, nil).
+				Once()
+		}
+
+		return client
+	}
+
+	t.Run("when the task is complete", func(t *testing.T) {
+
+		newService, err := NewTaskService(newClient(t, model.TaskStatusEnqueued, model.TaskStatusRunning, model.TaskStatusComplete), "3")
+		assert.NoError(t, err)
+
+		var progresses []int
+		task, _, err := newService.WaitFor(context.Background(), "1000", time.Millisecond, func(progress *model.TaskProgressScheme) {
+			progresses = append(progresses, progress.Progress)
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.TaskStatusComplete, task.Status)
+		assert.Equal(t, []int{33, 66, 100}, progresses)
+	})
+
+	t.Run("when the task is cancelled", func(t *testing.T) {
+
+		newService, err := NewTaskService(newClient(t, model.TaskStatusRunning, model.TaskStatusCancelled), "3")
+		assert.NoError(t, err)
+
+		task, _, err := newService.WaitFor(context.Background(), "1000", time.Millisecond, nil)
+		assert.ErrorIs(t, err, model.ErrTaskFailed)
+		assert.Equal(t, model.TaskStatusCancelled, task.Status)
+	})
+
+	t.Run("when the context is canceled", func(t *testing.T) {
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		newService, err := NewTaskService(newClient(t, model.TaskStatusRunning), "3")
+		assert.NoError(t, err)
+
+		task, _, err := newService.WaitFor(ctx, "1000", time.Hour, func(*model.TaskProgressScheme) { cancel() })
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, model.TaskStatusRunning, task.Status)
+	})
+
+	t.Run("when the task id is not provided", func(t *testing.T) {
+
+		newService, err := NewTaskService(nil, "3")
+		assert.NoError(t, err)
+
+		_, _, err = newService.WaitFor(context.Background(), "", 0, nil)
+		assert.ErrorIs(t, err, model.ErrNoTaskID)
+	})
+}
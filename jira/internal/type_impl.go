@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
@@ -94,6 +96,21 @@ func (t *TypeService) Alternatives(ctx context.Context, issueTypeID string) ([]*
 	return t.internalClient.Alternatives(ctx, issueTypeID)
 }
 
+// Project returns the issue types of a project, including the issue types scoped to a team-managed project.
+//
+// The issue types are filtered by hierarchy level when the level is provided,
+// e.g. -1 for the subtask issue types, 0 for the standard issue types and 1 for the epic issue types.
+//
+// The issue types scoped to a team-managed project are created from the project settings,
+// the scope of the issue types created with Create is always global.
+//
+// GET /rest/api/{2-3}/issuetype/project
+//
+// https://docs.go-atlassian.io/jira-software-cloud/issues/type#get-issue-types-for-project
+func (t *TypeService) Project(ctx context.Context, projectID int, level *int) ([]*model.IssueTypeScheme, *model.ResponseScheme, error) {
+	return t.internalClient.Project(ctx, projectID, level)
+}
+
 type internalTypeImpl struct {
 	c       service.Connector
 	version string
@@ -212,3 +229,32 @@ func (i *internalTypeImpl) Alternatives(ctx context.Context, issueTypeID string)
 
 	return issueTypes, response, nil
 }
+
+func (i *internalTypeImpl) Project(ctx context.Context, projectID int, level *int) ([]*model.IssueTypeScheme, *model.ResponseScheme, error) {
+
+	if projectID == 0 {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoProjectID)
+	}
+
+	params := url.Values{}
+	params.Add("projectId", strconv.Itoa(projectID))
+
+	if level != nil {
+		params.Add("level", strconv.Itoa(*level))
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issuetype/project?%v", i.version, params.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var issueTypes []*model.IssueTypeScheme
+	response, err := i.c.Call(request, &issueTypes)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return issueTypes, response, nil
+}
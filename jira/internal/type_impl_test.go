@@ -887,6 +887,159 @@ func Test_internalTypeImpl_Delete(t *testing.T) {
 	}
 }
 
+func Test_internalTypeImpl_Project(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx       context.Context
+		projectID int
+		level     *int
+	}
+
+	level := -1
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				projectID: 10000,
+				level:     &level,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/issuetype/project?level=-1&projectId=10000",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					mock.Anything).
+					Run(func(args mock.Arguments) {
+						issueTypes := args.Get(1).(*[]*model.IssueTypeScheme)
+						*issueTypes = []*model.IssueTypeScheme{
+							{ID: "10005", Name: "Sub-task", Subtask: true, Scope: &model.IssueTypeScopeScheme{
+								Type: model.IssueTypeScopeProject, Project: &model.ProjectScheme{ID: "10000"}}},
+						}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:       context.Background(),
+				projectID: 10000,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/issuetype/project?projectId=10000",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					mock.Anything).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the project id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoProjectID,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				projectID: 10000,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/issuetype/project?projectId=10000",
+					"", nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewTypeService(testCase.fields.c, testCase.fields.version, nil, nil)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := newService.Project(testCase.args.ctx, testCase.args.projectID, testCase.args.level)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotEqual(t, gotResponse, nil)
+
+			for _, issueType := range gotResult {
+				assert.True(t, issueType.IsProjectScoped())
+				assert.Equal(t, "10000", issueType.ScopeProjectID())
+			}
+		})
+	}
+}
+
 func Test_NewTypeService(t *testing.T) {
 
 	type args struct {
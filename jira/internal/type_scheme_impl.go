@@ -67,6 +67,17 @@ func (t *TypeSchemeService) Projects(ctx context.Context, projectIDs []int, star
 	return t.internalClient.Projects(ctx, projectIDs, startAt, maxResults)
 }
 
+// ForProjects returns the issue type schemes used by the projects, with the ordered issue types of each scheme.
+//
+// The pages of the project and mapping endpoints are walked until the last one.
+//
+// GET /rest/api/{2-3}/issuetypescheme/project
+//
+// GET /rest/api/{2-3}/issuetypescheme/mapping
+func (t *TypeSchemeService) ForProjects(ctx context.Context, projectIDs []int) (*model.IssueTypeSchemeProjectMappingScheme, error) {
+	return t.internalClient.ForProjects(ctx, projectIDs)
+}
+
 // Assign assigns an issue type scheme to a project.
 //
 // PUT /rest/api/{2-3}/issuetypescheme/project
@@ -133,6 +144,9 @@ func (t *TypeSchemeService) Reorder(ctx context.Context, issueTypeSchemeId strin
 	return t.internalClient.Reorder(ctx, issueTypeSchemeId, payload)
 }
 
+// typeSchemeMappingPageSize is the page size used by ForProjects, and the number of IDs sent on each request.
+const typeSchemeMappingPageSize = 50
+
 type internalTypeSchemeImpl struct {
 	c       service.Connector
 	version string
@@ -234,6 +248,84 @@ func (i *internalTypeSchemeImpl) Projects(ctx context.Context, projectIDs []int,
 	return page, response, nil
 }
 
+func (i *internalTypeSchemeImpl) ForProjects(ctx context.Context, projectIDs []int) (*model.IssueTypeSchemeProjectMappingScheme, error) {
+
+	if len(projectIDs) == 0 {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoProjectIDs)
+	}
+
+	mapping := &model.IssueTypeSchemeProjectMappingScheme{Projects: make(map[string]*model.IssueTypeSchemeAssignmentScheme)}
+	schemes := make(map[string]*model.IssueTypeSchemeAssignmentScheme)
+
+	for chunk := range slices.Chunk(projectIDs, typeSchemeMappingPageSize) {
+		for startAt := 0; ; startAt += typeSchemeMappingPageSize {
+
+			page, _, err := i.Projects(ctx, chunk, startAt, typeSchemeMappingPageSize)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, value := range page.Values {
+
+				if value == nil || value.IssueTypeScheme == nil {
+					continue
+				}
+
+				assignment, ok := schemes[value.IssueTypeScheme.ID]
+				if !ok {
+					assignment = &model.IssueTypeSchemeAssignmentScheme{Scheme: value.IssueTypeScheme}
+					schemes[value.IssueTypeScheme.ID] = assignment
+					mapping.Schemes = append(mapping.Schemes, assignment)
+				}
+
+				for _, projectID := range value.ProjectIDs {
+					if _, ok := mapping.Projects[projectID]; !ok {
+						assignment.ProjectIDs = append(assignment.ProjectIDs, projectID)
+						mapping.Projects[projectID] = assignment
+					}
+				}
+			}
+
+			if page.IsLast || len(page.Values) == 0 {
+				break
+			}
+		}
+	}
+
+	schemeIDs := make([]int, 0, len(mapping.Schemes))
+	for _, assignment := range mapping.Schemes {
+
+		schemeID, err := strconv.Atoi(assignment.Scheme.ID)
+		if err != nil {
+			return nil, fmt.Errorf("jira: invalid issue type scheme id %q: %w", assignment.Scheme.ID, err)
+		}
+
+		schemeIDs = append(schemeIDs, schemeID)
+	}
+
+	for chunk := range slices.Chunk(schemeIDs, typeSchemeMappingPageSize) {
+		for startAt := 0; ; startAt += typeSchemeMappingPageSize {
+
+			page, _, err := i.Items(ctx, chunk, startAt, typeSchemeMappingPageSize)
+			if err != nil {
+				return nil, err
+			}
+
+			for schemeID, issueTypeIDs := range page.Order() {
+				if assignment, ok := schemes[schemeID]; ok {
+					assignment.IssueTypeIDs = append(assignment.IssueTypeIDs, issueTypeIDs...)
+				}
+			}
+
+			if page.IsLast || len(page.Values) == 0 {
+				break
+			}
+		}
+	}
+
+	return mapping, nil
+}
+
 func (i *internalTypeSchemeImpl) Assign(ctx context.Context, issueTypeSchemeID, projectID string) (*model.ResponseScheme, error) {
 
 	if issueTypeSchemeID == "" {
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
@@ -1651,3 +1652,198 @@ func Test_NewTypeSchemeService(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalTypeSchemeImpl_ForProjects(t *testing.T) {
+
+	projectsMocked := func(client *mocks.Connector, version string, err error) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/"+version+"/issuetypescheme/project?maxResults=50&projectId=10000&projectId=10001&projectId=10002&startAt=0",
+			"", nil).
+			Return(&http.Request{Host: "projects"}, nil)
+
+		client.On("Call",
+			&http.Request{Host: "projects"},
+			&model.ProjectIssueTypeSchemePageScheme{}).
+			Run(func(args mock.Arguments) {
+				page := args.Get(1).(*model.ProjectIssueTypeSchemePageScheme)
+				page.IsLast = true
+				page.Values = []*model.IssueTypeSchemeProjectsScheme{
+					{
+						IssueTypeScheme: &model.IssueTypeSchemeScheme{ID: "10000", Name: "Default Issue Type Scheme", DefaultIssueTypeID: "10001", IsDefault: true},
+						ProjectIDs:      []string{"10000", "10002"},
+					},
+					{
+						IssueTypeScheme: &model.IssueTypeSchemeScheme{ID: "10100", Name: "KP: Scrum Issue Type Scheme", DefaultIssueTypeID: "10004"},
+						ProjectIDs:      []string{"10001"},
+					},
+				}
+			}).
+			Return(&model.ResponseScheme{}, err)
+	}
+
+	itemsMocked := func(client *mocks.Connector, version string, err error) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/"+version+"/issuetypescheme/mapping?issueTypeSchemeId=10000&issueTypeSchemeId=10100&maxResults=50&startAt=0",
+			"", nil).
+			Return(&http.Request{Host: "items"}, nil)
+
+		client.On("Call",
+			&http.Request{Host: "items"},
+			&model.IssueTypeSchemeItemPageScheme{}).
+			Run(func(args mock.Arguments) {
+				page := args.Get(1).(*model.IssueTypeSchemeItemPageScheme)
+				page.IsLast = true
+				page.Values = []*model.IssueTypeSchemeMappingScheme{
+					{IssueTypeSchemeID: "10000", IssueTypeID: "10001"},
+					{IssueTypeSchemeID: "10100", IssueTypeID: "10004"},
+					{IssueTypeSchemeID: "10000", IssueTypeID: "10002"},
+					{IssueTypeSchemeID: "10100", IssueTypeID: "10003"},
+				}
+			}).
+			Return(&model.ResponseScheme{}, err)
+	}
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx        context.Context
+		projectIDs []int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				projectIDs: []int{10000, 10001, 10002},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				projectsMocked(client, "3", nil)
+				itemsMocked(client, "3", nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:        context.Background(),
+				projectIDs: []int{10000, 10001, 10002},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				projectsMocked(client, "2", nil)
+				itemsMocked(client, "2", nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the project ids are not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoProjectIDs,
+		},
+
+		{
+			name:   "when the projects cannot be fetched",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				projectIDs: []int{10000, 10001, 10002},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				projectsMocked(client, "3", model.ErrUnauthorized)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrUnauthorized,
+		},
+
+		{
+			name:   "when the issue types cannot be fetched",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				projectIDs: []int{10000, 10001, 10002},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				projectsMocked(client, "3", nil)
+				itemsMocked(client, "3", model.ErrUnauthorized)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrUnauthorized,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewTypeSchemeService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, err := newService.ForProjects(testCase.args.ctx, testCase.args.projectIDs)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, gotResult.Schemes, 2)
+
+			scheme := gotResult.Scheme("10002")
+			assert.Equal(t, "10001", scheme.Scheme.DefaultIssueTypeID)
+			assert.Equal(t, []string{"10000", "10002"}, scheme.ProjectIDs)
+			assert.Equal(t, []string{"10001", "10002"}, scheme.IssueTypeIDs)
+			assert.Equal(t, []string{"10004", "10003"}, gotResult.Scheme("10001").IssueTypeIDs)
+			assert.Nil(t, gotResult.Scheme("10003"))
+		})
+	}
+}
@@ -42,6 +42,13 @@ func (t *TypeScreenSchemeService) Gets(ctx context.Context, options *model.Scree
 	return t.internalClient.Gets(ctx, options, startAt, maxResults)
 }
 
+// Iterate returns an iterator over the issue type screen schemes, fetching the pages of maxResults schemes on demand.
+//
+// GET /rest/api/{2-3}/issuetypescreenscheme
+func (t *TypeScreenSchemeService) Iterate(ctx context.Context, options *model.ScreenSchemeParamsScheme, maxResults int) *model.PageIterator[*model.IssueTypeScreenSchemeScheme] {
+	return t.internalClient.Iterate(ctx, options, maxResults)
+}
+
 // Create creates an issue type screen scheme.
 //
 // POST /rest/api/{2-3}/issuetypescreenscheme
@@ -394,3 +401,15 @@ func (i *internalTypeScreenSchemeImpl) SchemesByProject(ctx context.Context, iss
 
 	return page, response, nil
 }
+
+func (i *internalTypeScreenSchemeImpl) Iterate(ctx context.Context, options *model.ScreenSchemeParamsScheme, maxResults int) *model.PageIterator[*model.IssueTypeScreenSchemeScheme] {
+	return model.NewPageIterator(ctx, maxResults, func(ctx context.Context, cursor model.PageCursorScheme) (*model.PageResultScheme[*model.IssueTypeScreenSchemeScheme], error) {
+
+		page, _, err := i.Gets(ctx, options, cursor.StartAt, cursor.MaxResults)
+		if err != nil {
+			return nil, err
+		}
+
+		return &model.PageResultScheme[*model.IssueTypeScreenSchemeScheme]{Values: page.Values, IsLast: page.IsLast, Total: page.Total}, nil
+	})
+}
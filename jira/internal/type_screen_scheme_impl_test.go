@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
@@ -1805,3 +1806,116 @@ func Test_NewTypeScreenSchemeService(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalTypeScreenSchemeImpl_Iterate(t *testing.T) {
+
+	pageMocked := func(client *mocks.Connector, startAt string, page *model.IssueTypeScreenSchemePageScheme) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/issuetypescreenscheme?maxResults=2&startAt="+startAt,
+			"",
+			nil).
+			Return(&http.Request{Host: startAt}, nil)
+
+		client.On("Call",
+			&http.Request{Host: startAt},
+			&model.IssueTypeScreenSchemePageScheme{}).
+			Run(func(args mock.Arguments) {
+				*args.Get(1).(*model.IssueTypeScreenSchemePageScheme) = *page
+			}).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		on      func(*fields)
+		wantIDs []string
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the schemes span several pages",
+			fields: fields{version: "3"},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				pageMocked(client, "0", &model.IssueTypeScreenSchemePageScheme{
+					Total:  3,
+					Values: []*model.IssueTypeScreenSchemeScheme{{ID: "10000"}, {ID: "10001"}},
+				})
+				pageMocked(client, "2", &model.IssueTypeScreenSchemePageScheme{
+					Total:  3,
+					IsLast: true,
+					Values: []*model.IssueTypeScreenSchemeScheme{{ID: "10002"}},
+				})
+
+				fields.c = client
+			},
+			wantIDs: []string{"10000", "10001", "10002"},
+		},
+
+		{
+			name:   "when the http call cannot be executed",
+			fields: fields{version: "3"},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/issuetypescreenscheme?maxResults=2&startAt=0",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueTypeScreenSchemePageScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrBadRequest)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrBadRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewTypeScreenSchemeService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			var gotIDs []string
+			iterator := newService.Iterate(context.Background(), nil, 2)
+			for scheme := range iterator.Values() {
+				gotIDs = append(gotIDs, scheme.ID)
+			}
+
+			if testCase.wantErr {
+
+				if iterator.Err() != nil {
+					t.Logf("error returned: %v", iterator.Err().Error())
+				}
+
+				assert.True(t, errors.Is(iterator.Err(), testCase.Err), "expected error: %v, got: %v", testCase.Err, iterator.Err())
+				return
+			}
+
+			assert.NoError(t, iterator.Err())
+			assert.Equal(t, testCase.wantIDs, gotIDs)
+		})
+	}
+}
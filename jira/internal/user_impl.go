@@ -2,11 +2,15 @@ package internal
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"maps"
 	"net/http"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
@@ -43,6 +47,13 @@ func (u *UserService) Get(ctx context.Context, accountID string, expand []string
 	return u.internalClient.Get(ctx, accountID, expand)
 }
 
+// GetIfExists returns the user, and false instead of an error when the user doesn't exist.
+//
+// GET /rest/api/{2-3}/user
+func (u *UserService) GetIfExists(ctx context.Context, accountID string, expand []string) (*model.UserScheme, bool, error) {
+	return u.internalClient.GetIfExists(ctx, accountID, expand)
+}
+
 // Create creates a user. This resource is retained for legacy compatibility.
 //
 // As soon as a more suitable alternative is available this resource will be deprecated.
@@ -100,9 +111,66 @@ func (u *UserService) Gets(ctx context.Context, startAt, maxResults int) ([]*mod
 	return u.internalClient.Gets(ctx, startAt, maxResults)
 }
 
+// ResolveEmails returns the account IDs of the users with the email addresses.
+//
+// The emails are looked up with the user search endpoint, in batches of concurrent requests,
+// and the resolved account IDs are cached for the lifetime of the client. The emails are matched exactly,
+// ignoring the case, so the users hiding their email address with their profile visibility are unresolved.
+//
+// GET /rest/api/{2-3}/user/search
+func (u *UserService) ResolveEmails(ctx context.Context, emails []string) (*model.UserEmailResolutionScheme, error) {
+	return u.internalClient.ResolveEmails(ctx, emails)
+}
+
+// Email returns the email address of a user, even when it's hidden by the profile visibility settings of the user.
+//
+// The endpoint is only available to the apps approved by Atlassian.
+//
+// GET /rest/api/{2-3}/user/email
+func (u *UserService) Email(ctx context.Context, accountID string) (*model.UserEmailScheme, *model.ResponseScheme, error) {
+	return u.internalClient.Email(ctx, accountID)
+}
+
+// Emails returns the email addresses of the users, even when they're hidden by the profile visibility settings.
+//
+// The endpoint is only available to the apps approved by Atlassian.
+//
+// GET /rest/api/{2-3}/user/email/bulk
+func (u *UserService) Emails(ctx context.Context, accountIDs []string) ([]*model.UserEmailScheme, *model.ResponseScheme, error) {
+	return u.internalClient.Emails(ctx, accountIDs)
+}
+
+// TransferOwnership transfers the filters and dashboards owned by a user, e.g. a departing user, to another user.
+//
+// The filters are found regardless of their share permissions, which requires the Administer Jira permission,
+// the dashboards are those returned by the dashboard search. With the dry run option, the filters and dashboards
+// are only reported. The transfer goes on when a filter or a dashboard can't be transferred, see
+// OwnershipTransferScheme.Err and OwnershipTransferScheme.Report.
+//
+// GET /rest/api/{2-3}/filter/search
+//
+// GET /rest/api/{2-3}/dashboard/search
+//
+// PUT /rest/api/{2-3}/filter/{id}/owner
+//
+// PUT /rest/api/{2-3}/dashboard/bulk/edit
+func (u *UserService) TransferOwnership(ctx context.Context, fromAccountID, toAccountID string, options *model.OwnershipTransferOptionsScheme) (*model.OwnershipTransferScheme, error) {
+	return u.internalClient.TransferOwnership(ctx, fromAccountID, toAccountID, options)
+}
+
+// ownershipTransferBatchSize is the number of dashboards transferred at a time by TransferOwnership.
+const ownershipTransferBatchSize = 100
+
+// userEmailConcurrency is the number of email addresses looked up at the same time by ResolveEmails.
+const userEmailConcurrency = 10
+
 type internalUserImpl struct {
 	c       service.Connector
 	version string
+
+	// emails caches the account IDs resolved by ResolveEmails, keyed by the lower-cased email address.
+	emails   map[string]string
+	emailsMu sync.RWMutex
 }
 
 func (i *internalUserImpl) Get(ctx context.Context, accountID string, expand []string) (*model.UserScheme, *model.ResponseScheme, error) {
@@ -134,6 +202,16 @@ func (i *internalUserImpl) Get(ctx context.Context, accountID string, expand []s
 	return user, response, nil
 }
 
+func (i *internalUserImpl) GetIfExists(ctx context.Context, accountID string, expand []string) (*model.UserScheme, bool, error) {
+
+	user, _, err := i.Get(ctx, accountID, expand)
+	if err != nil {
+		return nil, false, ignoreNotFound(err)
+	}
+
+	return user, true, nil
+}
+
 func (i *internalUserImpl) Create(ctx context.Context, payload *model.UserPayloadScheme) (*model.UserScheme, *model.ResponseScheme, error) {
 
 	endpoint := fmt.Sprintf("rest/api/%v/user", i.version)
@@ -245,3 +323,252 @@ func (i *internalUserImpl) Gets(ctx context.Context, startAt, maxResults int) ([
 
 	return users, response, nil
 }
+
+func (i *internalUserImpl) ResolveEmails(ctx context.Context, emails []string) (*model.UserEmailResolutionScheme, error) {
+
+	resolution := &model.UserEmailResolutionScheme{AccountIDs: make(map[string]string)}
+
+	// The emails differing only by case are looked up once, the result is reported for each of them
+	resolved := make(map[string]string)
+	var pending []string
+
+	for _, email := range emails {
+
+		key := userEmailKey(email)
+		if key == "" {
+			continue
+		}
+
+		if _, ok := resolved[key]; ok || slices.Contains(pending, key) {
+			continue
+		}
+
+		i.emailsMu.RLock()
+		accountID, ok := i.emails[key]
+		i.emailsMu.RUnlock()
+
+		if ok {
+			resolved[key] = accountID
+			continue
+		}
+
+		pending = append(pending, key)
+	}
+
+	search := &internalUserSearchImpl{c: i.c, version: i.version}
+	accountIDs := make([]string, len(pending))
+
+	errs := model.RunWorkers(ctx, len(pending), userEmailConcurrency, 0, func(index int) error {
+
+		accountID, err := resolveEmail(ctx, search, pending[index])
+		if err != nil {
+			return fmt.Errorf("jira: %v: %w", pending[index], err)
+		}
+
+		accountIDs[index] = accountID
+		return nil
+	})
+
+	failed := make(map[string]bool)
+	for index, key := range pending {
+
+		if errs[index] != nil {
+			failed[key] = true
+			continue
+		}
+
+		resolved[key] = accountIDs[index]
+
+		if accountIDs[index] == "" {
+			continue
+		}
+
+		i.emailsMu.Lock()
+		if i.emails == nil {
+			i.emails = make(map[string]string)
+		}
+		i.emails[key] = accountIDs[index]
+		i.emailsMu.Unlock()
+	}
+
+	for _, email := range emails {
+
+		key := userEmailKey(email)
+		if key == "" || failed[key] {
+			continue
+		}
+
+		if accountID := resolved[key]; accountID != "" {
+			resolution.AccountIDs[email] = accountID
+			continue
+		}
+
+		if !slices.Contains(resolution.Unresolved, email) {
+			resolution.Unresolved = append(resolution.Unresolved, email)
+		}
+	}
+
+	return resolution, errors.Join(errs...)
+}
+
+// userEmailKey returns the email address as looked up and cached by ResolveEmails, trimmed and lower-cased.
+func userEmailKey(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// resolveEmail returns the account ID of the user with the email address, or an empty string if no user matches.
+//
+// The users whose email address is hidden by their profile visibility can't be matched, and aren't resolved.
+func resolveEmail(ctx context.Context, search *internalUserSearchImpl, email string) (string, error) {
+
+	users, _, err := search.Do(ctx, "", email, 0, 2)
+	if err != nil {
+		return "", err
+	}
+
+	for _, user := range users {
+		if strings.EqualFold(user.EmailAddress, email) {
+			return user.AccountID, nil
+		}
+	}
+
+	return "", nil
+}
+
+func (i *internalUserImpl) Email(ctx context.Context, accountID string) (*model.UserEmailScheme, *model.ResponseScheme, error) {
+
+	if accountID == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoAccountID)
+	}
+
+	params := url.Values{}
+	params.Add("accountId", accountID)
+
+	endpoint := fmt.Sprintf("rest/api/%v/user/email?%v", i.version, params.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	email := new(model.UserEmailScheme)
+	response, err := i.c.Call(request, email)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return email, response, nil
+}
+
+func (i *internalUserImpl) Emails(ctx context.Context, accountIDs []string) ([]*model.UserEmailScheme, *model.ResponseScheme, error) {
+
+	if len(accountIDs) == 0 {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoAccountSlice)
+	}
+
+	params := url.Values{}
+	for _, accountID := range accountIDs {
+		params.Add("accountId", accountID)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/user/email/bulk?%v", i.version, params.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var emails []*model.UserEmailScheme
+	response, err := i.c.Call(request, &emails)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return emails, response, nil
+}
+
+func (i *internalUserImpl) TransferOwnership(ctx context.Context, fromAccountID, toAccountID string, options *model.OwnershipTransferOptionsScheme) (*model.OwnershipTransferScheme, error) {
+
+	if fromAccountID == "" || toAccountID == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoAccountID)
+	}
+
+	if options == nil {
+		options = new(model.OwnershipTransferOptionsScheme)
+	}
+
+	transfer := &model.OwnershipTransferScheme{From: fromAccountID, To: toAccountID, DryRun: options.DryRun}
+
+	filter := &internalFilterServiceImpl{c: i.c, version: i.version}
+	dashboard := &internalDashboardImpl{c: i.c, version: i.version}
+
+	if !options.SkipFilters {
+
+		filters, err := filter.SearchAll(ctx, &model.FilterSearchOptionScheme{AccountID: fromAccountID, OverrideSharePermissions: true})
+		if err != nil {
+			return transfer, err
+		}
+
+		for _, owned := range filters {
+			transfer.Filters = append(transfer.Filters, &model.OwnershipTransferItemScheme{ID: owned.ID, Name: owned.Name})
+		}
+	}
+
+	if !options.SkipDashboards {
+
+		dashboards, err := dashboard.SearchAll(ctx, &model.DashboardSearchOptionsScheme{OwnerAccountID: fromAccountID})
+		if err != nil {
+			return transfer, err
+		}
+
+		for _, owned := range dashboards {
+			transfer.Dashboards = append(transfer.Dashboards, &model.OwnershipTransferItemScheme{ID: owned.ID, Name: owned.Name})
+		}
+	}
+
+	if options.DryRun {
+		return transfer, nil
+	}
+
+	for _, item := range transfer.Filters {
+
+		filterID, err := strconv.Atoi(item.ID)
+		if err == nil {
+			_, err = filter.Change(ctx, filterID, toAccountID)
+		}
+
+		if err != nil {
+			item.Err = fmt.Errorf("jira: filter %v: %w", item.ID, err)
+		}
+	}
+
+	for batch := range slices.Chunk(transfer.Dashboards, ownershipTransferBatchSize) {
+
+		dashboardIDs := make([]string, 0, len(batch))
+		for _, item := range batch {
+			dashboardIDs = append(dashboardIDs, item.ID)
+		}
+
+		result, _, err := dashboard.ChangeOwner(ctx, dashboardIDs, toAccountID)
+
+		for _, item := range batch {
+
+			if err != nil {
+				item.Err = fmt.Errorf("jira: dashboard %v: %w", item.ID, err)
+				continue
+			}
+
+			if entityErr, ok := result.EntityErrors[item.ID]; ok && entityErr != nil {
+
+				messages := slices.Clone(entityErr.ErrorMessages)
+				for _, field := range slices.Sorted(maps.Keys(entityErr.Errors)) {
+					messages = append(messages, field+": "+entityErr.Errors[field])
+				}
+
+				item.Err = fmt.Errorf("jira: dashboard %v: %v", item.ID, strings.Join(messages, ", "))
+			}
+		}
+	}
+
+	return transfer, nil
+}
@@ -969,3 +969,502 @@ func Test_NewUserService(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalUserImpl_ResolveEmails(t *testing.T) {
+
+	onSearch := func(client *mocks.Connector, email string, users []*model.UserScheme, err error) {
+
+		request := &http.Request{Host: email}
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/user/search?"+url.Values{"maxResults": {"2"}, "query": {email}, "startAt": {"0"}}.Encode(),
+			"",
+			nil).
+			Return(request, nil).
+			Once()
+
+		client.On("Call",
+			request,
+			mock.AnythingOfType("*[]*models.UserScheme")).
+			Run(func(args mock.Arguments) {
+				*args.Get(1).(*[]*model.UserScheme) = users
+			}).
+			Return(&model.ResponseScheme{}, err).
+			Once()
+	}
+
+	t.Run("when the emails are resolved and cached", func(t *testing.T) {
+
+		client := mocks.NewConnector(t)
+		onSearch(client, "jane@example.com", []*model.UserScheme{{AccountID: "account-id-jane", EmailAddress: "Jane@example.com"}}, nil)
+		onSearch(client, "private@example.com", []*model.UserScheme{{AccountID: "account-id-private"}}, nil)
+		onSearch(client, "unknown@example.com", nil, nil)
+
+		userService, err := NewUserService(client, "3", nil)
+		assert.NoError(t, err)
+
+		gotResult, err := userService.ResolveEmails(context.Background(),
+			[]string{"jane@example.com", "private@example.com", "unknown@example.com", "Jane@Example.com", "jane@example.com"})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"jane@example.com": "account-id-jane", "Jane@Example.com": "account-id-jane"}, gotResult.AccountIDs)
+		assert.Equal(t, []string{"private@example.com", "unknown@example.com"}, gotResult.Unresolved)
+
+		// the cached emails are not looked up again, even with a different case
+		gotResult, err = userService.ResolveEmails(context.Background(), []string{"JANE@example.com"})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"JANE@example.com": "account-id-jane"}, gotResult.AccountIDs)
+	})
+
+	t.Run("when the user search fails", func(t *testing.T) {
+
+		client := mocks.NewConnector(t)
+		onSearch(client, "jane@example.com", nil, model.ErrUnauthorized)
+
+		userService, err := NewUserService(client, "3", nil)
+		assert.NoError(t, err)
+
+		gotResult, err := userService.ResolveEmails(context.Background(), []string{"jane@example.com"})
+		assert.True(t, errors.Is(err, model.ErrUnauthorized), "expected error: %v, got: %v", model.ErrUnauthorized, err)
+		assert.Empty(t, gotResult.AccountIDs)
+		assert.Empty(t, gotResult.Unresolved)
+	})
+}
+
+func Test_internalUserImpl_GetIfExists(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx       context.Context
+		accountID string
+		expand    []string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    bool
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the user exists",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				accountID: "uuid-sample",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/user?accountId=uuid-sample",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.UserScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			want: true,
+		},
+
+		{
+			name:   "when the user doesn't exist",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				accountID: "uuid-sample",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/user?accountId=uuid-sample",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.UserScheme{}).
+					Return(&model.ResponseScheme{Code: http.StatusNotFound}, model.ErrNotFound)
+
+				fields.c = client
+			},
+			want: false,
+		},
+
+		{
+			name:   "when the user can't be read",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				accountID: "uuid-sample",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/user?accountId=uuid-sample",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.UserScheme{}).
+					Return(&model.ResponseScheme{Code: http.StatusUnauthorized}, model.ErrUnauthorized)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrUnauthorized,
+		},
+
+		{
+			name:   "when the user key or id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNoAccountID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewUserService(testCase.fields.c, testCase.fields.version, nil)
+			assert.NoError(t, err)
+
+			gotResult, gotOk, err := newService.GetIfExists(testCase.args.ctx, testCase.args.accountID, testCase.args.expand)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+				assert.False(t, gotOk)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.want, gotOk)
+			assert.Equal(t, testCase.want, gotResult != nil)
+		})
+	}
+}
+
+func Test_internalUserImpl_Email(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx       context.Context
+		accountID string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				accountID: "uuid-sample-1",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/user/email?accountId=uuid-sample-1",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.UserEmailScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the account id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoAccountID,
+		},
+
+		{
+			name:   "when the http call cannot be executed",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:       context.Background(),
+				accountID: "uuid-sample-1",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/user/email?accountId=uuid-sample-1",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.UserEmailScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrUnauthorized)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrUnauthorized,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewUserService(testCase.fields.c, testCase.fields.version, nil)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := newService.Email(testCase.args.ctx, testCase.args.accountID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalUserImpl_Emails(t *testing.T) {
+
+	client := mocks.NewConnector(t)
+
+	client.On("NewRequest",
+		context.Background(),
+		http.MethodGet,
+		"rest/api/3/user/email/bulk?accountId=uuid-sample-1&accountId=uuid-sample-2",
+		"", nil).
+		Return(&http.Request{}, nil)
+
+	client.On("Call",
+		&http.Request{},
+		mock.Anything).
+		Run(func(args mock.Arguments) {
+			emails := args.Get(1).(*[]*model.UserEmailScheme)
+			*emails = []*model.UserEmailScheme{
+				{AccountID: "uuid-sample-1", Email: "sample-1@example.com"},
+				{AccountID: "uuid-sample-2", Email: "sample-2@example.com"},
+			}
+		}).
+		Return(&model.ResponseScheme{}, nil)
+
+	newService, err := NewUserService(client, "3", nil)
+	assert.NoError(t, err)
+
+	emails, _, err := newService.Emails(context.Background(), []string{"uuid-sample-1", "uuid-sample-2"})
+	assert.NoError(t, err)
+	assert.Len(t, emails, 2)
+	assert.Equal(t, "sample-2@example.com", emails[1].Email)
+
+	_, _, err = newService.Emails(context.Background(), nil)
+	assert.ErrorIs(t, err, model.ErrNoAccountSlice)
+}
+
+func Test_internalUserImpl_TransferOwnership(t *testing.T) {
+
+	newClient := func(t *testing.T, transfer bool) *mocks.Connector {
+
+		client := mocks.NewConnector(t)
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/filter/search?accountId=uuid-departing&maxResults=50&overrideSharePermissions=true&startAt=0",
+			"", nil).
+			Return(&http.Request{Method: http.MethodGet, RequestURI: "filters"}, nil)
+
+		client.On("Call",
+			&http.Request{Method: http.MethodGet, RequestURI: "filters"},
+			mock.Anything).
+			Run(func(args mock.Arguments) {
+				page := args.Get(1).(*model.FilterSearchPageScheme)
+				page.IsLast = true
+				page.Values = []*model.FilterDetailScheme{{ID: "10000", Name: "Open bugs"}, {ID: "10001", Name: "My issues"}}
+			}).
+			Return(&model.ResponseScheme{}, nil)
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/dashboard/search?accountId=uuid-departing&maxResults=50&startAt=0",
+			"", nil).
+			Return(&http.Request{Method: http.MethodGet, RequestURI: "dashboards"}, nil)
+
+		client.On("Call",
+			&http.Request{Method: http.MethodGet, RequestURI: "dashboards"},
+			mock.Anything).
+			Run(func(args mock.Arguments) {
+				page := args.Get(1).(*model.DashboardSearchPageScheme)
+				page.IsLast = true
+				page.Values = []*model.DashboardScheme{{ID: "20000", Name: "Team board"}, {ID: "20001", Name: "Releases"}}
+			}).
+			Return(&model.ResponseScheme{}, nil)
+
+		if !transfer {
+			return client
+		}
+
+		for _, filterID := range []string{"10000", "10001"} {
+
+			client.On("NewRequest",
+				context.Background(),
+				http.MethodPut,
+				"rest/api/3/filter/"+filterID+"/owner",
+				"",
+				map[string]interface{}{"accountId": "uuid-replacement"}).
+				Return(&http.Request{Method: http.MethodPut, RequestURI: filterID}, nil)
+		}
+
+		client.On("Call",
+			&http.Request{Method: http.MethodPut, RequestURI: "10000"},
+			nil).
+			Return(&model.ResponseScheme{}, nil)
+
+		client.On("Call",
+			&http.Request{Method: http.MethodPut, RequestURI: "10001"},
+			nil).
+			Return(&model.ResponseScheme{}, model.ErrNotFound)
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPut,
+			"rest/api/3/dashboard/bulk/edit",
+			"",
+			mock.Anything).
+			Return(&http.Request{Method: http.MethodPut, RequestURI: "dashboards"}, nil)
+
+		client.On("Call",
+			&http.Request{Method: http.MethodPut, RequestURI: "dashboards"},
+			mock.Anything).
+			Run(func(args mock.Arguments) {
+				result := args.Get(1).(*model.DashboardBulkEditScheme)
+				result.EntityErrors = map[string]*model.DashboardBulkEditErrorScheme{
+					"20001": {ErrorMessages: []string{"The dashboard is archived."}},
+				}
+			}).
+			Return(&model.ResponseScheme{}, nil)
+
+		return client
+	}
+
+	t.Run("when the ownership is transferred", func(t *testing.T) {
+
+		newService, err := NewUserService(newClient(t, true), "3", nil)
+		assert.NoError(t, err)
+
+		transfer, err := newService.TransferOwnership(context.Background(), "uuid-departing", "uuid-replacement", nil)
+		assert.NoError(t, err)
+
+		assert.Len(t, transfer.Filters, 2)
+		assert.Len(t, transfer.Dashboards, 2)
+
+		assert.NoError(t, transfer.Filters[0].Err)
+		assert.ErrorIs(t, transfer.Filters[1].Err, model.ErrNotFound)
+		assert.NoError(t, transfer.Dashboards[0].Err)
+		assert.ErrorContains(t, transfer.Dashboards[1].Err, "The dashboard is archived.")
+		assert.Error(t, transfer.Err())
+
+		assert.Contains(t, transfer.Report(), `filter 10000 "Open bugs": transferred`)
+		assert.Contains(t, transfer.Report(), `dashboard 20001 "Releases": failed: `)
+	})
+
+	t.Run("when the ownership transfer is a dry run", func(t *testing.T) {
+
+		newService, err := NewUserService(newClient(t, false), "3", nil)
+		assert.NoError(t, err)
+
+		transfer, err := newService.TransferOwnership(context.Background(), "uuid-departing", "uuid-replacement",
+			&model.OwnershipTransferOptionsScheme{DryRun: true})
+		assert.NoError(t, err)
+
+		assert.NoError(t, transfer.Err())
+		assert.Contains(t, transfer.Report(), "(dry run)")
+		assert.Contains(t, transfer.Report(), `dashboard 20000 "Team board": to transfer`)
+	})
+
+	t.Run("when the account ids are not provided", func(t *testing.T) {
+
+		newService, err := NewUserService(nil, "3", nil)
+		assert.NoError(t, err)
+
+		_, err = newService.TransferOwnership(context.Background(), "uuid-departing", "", nil)
+		assert.ErrorIs(t, err, model.ErrNoAccountID)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"time"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
@@ -58,6 +59,72 @@ func (w *WatcherService) Delete(ctx context.Context, issueKeyOrID, accountID str
 	return w.internalClient.Delete(ctx, issueKeyOrID, accountID)
 }
 
+// Aggregate returns the watchers of many issues, reading a bounded number of issues at the same time.
+//
+// When the users are expanded, the details of every distinct watcher are read once and shared by the issues.
+//
+// GET /rest/api/{2-3}/issue/{issueKeyOrID}/watchers
+//
+// GET /rest/api/{2-3}/user
+func (w *WatcherService) Aggregate(ctx context.Context, issueKeysOrIDs []string, options *model.IssueWatchersOptionsScheme) (*model.IssueWatchersScheme, error) {
+	return w.internalClient.Aggregate(ctx, issueKeysOrIDs, options)
+}
+
+// AddByJQL adds a user as a watcher of every issue matching the JQL query, updating a bounded number of issues at the same time.
+//
+// If no user is specified the calling user is added.
+//
+// The rate limited responses are retried after the delay announced by Jira, and the result reports the issues that couldn't be updated.
+//
+// POST /rest/api/{2-3}/search/jql
+//
+// POST /rest/api/{2-3}/issue/{issueKeyOrID}/watchers
+func (w *WatcherService) AddByJQL(ctx context.Context, jql, accountID string, options *model.IssueWatchersUpdateOptionsScheme) (*model.IssueWatchersUpdateScheme, error) {
+	return w.internalClient.AddByJQL(ctx, jql, accountID, options)
+}
+
+// DeleteByJQL deletes a user as a watcher of every issue matching the JQL query, updating a bounded number of issues at the same time.
+//
+// The rate limited responses are retried after the delay announced by Jira, and the result reports the issues that couldn't be updated.
+//
+// POST /rest/api/{2-3}/search/jql
+//
+// DELETE /rest/api/{2-3}/issue/{issueKeyOrID}/watchers
+func (w *WatcherService) DeleteByJQL(ctx context.Context, jql, accountID string, options *model.IssueWatchersUpdateOptionsScheme) (*model.IssueWatchersUpdateScheme, error) {
+	return w.internalClient.DeleteByJQL(ctx, jql, accountID, options)
+}
+
+// AddBulk adds a user as a watcher of the issues, updating a bounded number of issues at the same time.
+//
+// If no user is specified the calling user is added.
+//
+// The rate limited responses are retried after the delay announced by Jira, and the result reports the issues that couldn't be updated.
+//
+// POST /rest/api/{2-3}/issue/{issueKeyOrID}/watchers
+func (w *WatcherService) AddBulk(ctx context.Context, issueKeysOrIDs []string, accountID string, options *model.IssueWatchersUpdateOptionsScheme) (*model.IssueWatchersUpdateScheme, error) {
+	return w.internalClient.AddBulk(ctx, issueKeysOrIDs, accountID, options)
+}
+
+// DeleteBulk deletes a user as a watcher of the issues, updating a bounded number of issues at the same time.
+//
+// The rate limited responses are retried after the delay announced by Jira, and the result reports the issues that couldn't be updated.
+//
+// DELETE /rest/api/{2-3}/issue/{issueKeyOrID}/watchers
+func (w *WatcherService) DeleteBulk(ctx context.Context, issueKeysOrIDs []string, accountID string, options *model.IssueWatchersUpdateOptionsScheme) (*model.IssueWatchersUpdateScheme, error) {
+	return w.internalClient.DeleteBulk(ctx, issueKeysOrIDs, accountID, options)
+}
+
+// IsWatching returns, in a single request, whether the calling user watches each of the issues, by issue ID.
+//
+// Use Aggregate to read the watchers of many issues.
+//
+// POST /rest/api/{2-3}/issue/watching
+//
+// https://docs.go-atlassian.io/jira-software-cloud/issues/watcher#get-is-watching-issue-bulk
+func (w *WatcherService) IsWatching(ctx context.Context, issueIDs []string) (*model.IssueIsWatchingScheme, *model.ResponseScheme, error) {
+	return w.internalClient.IsWatching(ctx, issueIDs)
+}
+
 type internalWatcherImpl struct {
 	c       service.Connector
 	version string
@@ -127,3 +194,261 @@ func (i *internalWatcherImpl) Delete(ctx context.Context, issueKeyOrID, accountI
 
 	return i.c.Call(request, nil)
 }
+
+func (i *internalWatcherImpl) Aggregate(ctx context.Context, issueKeysOrIDs []string, options *model.IssueWatchersOptionsScheme) (*model.IssueWatchersScheme, error) {
+
+	if len(issueKeysOrIDs) == 0 {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoIssuesSlice)
+	}
+
+	if options == nil {
+		options = new(model.IssueWatchersOptionsScheme)
+	}
+
+	concurrency := options.Concurrency
+	if concurrency <= 0 {
+		concurrency = model.IssueWatchersConcurrency
+	}
+
+	aggregate := &model.IssueWatchersScheme{Issues: make([]*model.IssueWatchersIssueScheme, len(issueKeysOrIDs))}
+	for index, issueKeyOrID := range issueKeysOrIDs {
+		aggregate.Issues[index] = &model.IssueWatchersIssueScheme{IssueKeyOrID: issueKeyOrID}
+	}
+
+	errs := model.RunWorkers(ctx, len(aggregate.Issues), concurrency, 0, func(index int) error {
+
+		issue := aggregate.Issues[index]
+
+		watchers, _, err := i.Gets(ctx, issue.IssueKeyOrID)
+		if err != nil {
+			return fmt.Errorf("jira: issue %v: %w", issue.IssueKeyOrID, err)
+		}
+
+		issue.Watchers = watchers
+		return nil
+	})
+
+	for index, issue := range aggregate.Issues {
+		issue.Err = errs[index]
+	}
+
+	if !options.ExpandUsers {
+		return aggregate, nil
+	}
+
+	// The watchers are shared by the issues, each user is read once
+	accountIDs := aggregate.AccountIDs()
+	users := make([]*model.UserScheme, len(accountIDs))
+
+	userService := &internalUserImpl{c: i.c, version: i.version}
+	errs = model.RunWorkers(ctx, len(accountIDs), concurrency, 0, func(index int) (err error) {
+		users[index], _, err = userService.Get(ctx, accountIDs[index], nil)
+		return err
+	})
+
+	aggregate.Users = make(map[string]*model.UserScheme, len(accountIDs))
+	for index, accountID := range accountIDs {
+
+		if errs[index] != nil {
+
+			if aggregate.UserErrors == nil {
+				aggregate.UserErrors = make(map[string]error)
+			}
+
+			aggregate.UserErrors[accountID] = fmt.Errorf("jira: user %v: %w", accountID, errs[index])
+			continue
+		}
+
+		aggregate.Users[accountID] = users[index]
+	}
+
+	return aggregate, nil
+}
+
+func (i *internalWatcherImpl) IsWatching(ctx context.Context, issueIDs []string) (*model.IssueIsWatchingScheme, *model.ResponseScheme, error) {
+
+	if len(issueIDs) == 0 {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoIssuesSlice)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/watching", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", map[string]interface{}{"issueIds": issueIDs})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	watching := new(model.IssueIsWatchingScheme)
+	response, err := i.c.Call(request, watching)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return watching, response, nil
+}
+
+// issueWatchersUpdatePageSize is the page size used by AddByJQL and DeleteByJQL to search the issues.
+const issueWatchersUpdatePageSize = 100
+
+func (i *internalWatcherImpl) AddByJQL(ctx context.Context, jql, accountID string, options *model.IssueWatchersUpdateOptionsScheme) (*model.IssueWatchersUpdateScheme, error) {
+
+	if jql == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoJQL)
+	}
+
+	return i.updateByJQL(ctx, jql, accountID, false, options)
+}
+
+func (i *internalWatcherImpl) DeleteByJQL(ctx context.Context, jql, accountID string, options *model.IssueWatchersUpdateOptionsScheme) (*model.IssueWatchersUpdateScheme, error) {
+
+	if jql == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoJQL)
+	}
+
+	if accountID == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoAccountID)
+	}
+
+	return i.updateByJQL(ctx, jql, accountID, true, options)
+}
+
+// updateByJQL adds or removes the watcher of the issues matching the JQL query.
+func (i *internalWatcherImpl) updateByJQL(ctx context.Context, jql, accountID string, remove bool, options *model.IssueWatchersUpdateOptionsScheme) (*model.IssueWatchersUpdateScheme, error) {
+
+	if options == nil {
+		options = new(model.IssueWatchersUpdateOptionsScheme)
+	}
+
+	update := &model.IssueWatchersUpdateScheme{JQL: jql, AccountID: accountID, Removed: remove, DryRun: options.DryRun}
+
+	// The issues are collected first, the updates can change the results of the search
+	search := &internalSearchADFImpl{c: i.c, version: i.version}
+	for nextPageToken := ""; ; {
+
+		page, _, err := search.SearchJQL(ctx, jql, []string{"key"}, nil, issueWatchersUpdatePageSize, nextPageToken)
+		if err != nil {
+			return update, err
+		}
+
+		for _, issue := range page.Issues {
+			update.Issues = append(update.Issues, &model.IssueWatchersIssueUpdateScheme{IssueKey: issue.Key})
+		}
+
+		if page.NextPageToken == "" || len(page.Issues) == 0 {
+			break
+		}
+
+		nextPageToken = page.NextPageToken
+	}
+
+	i.updateIssues(ctx, update, options)
+
+	return update, nil
+}
+
+func (i *internalWatcherImpl) AddBulk(ctx context.Context, issueKeysOrIDs []string, accountID string, options *model.IssueWatchersUpdateOptionsScheme) (*model.IssueWatchersUpdateScheme, error) {
+
+	if len(issueKeysOrIDs) == 0 {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoIssuesSlice)
+	}
+
+	return i.updateBulk(ctx, issueKeysOrIDs, accountID, false, options), nil
+}
+
+func (i *internalWatcherImpl) DeleteBulk(ctx context.Context, issueKeysOrIDs []string, accountID string, options *model.IssueWatchersUpdateOptionsScheme) (*model.IssueWatchersUpdateScheme, error) {
+
+	if len(issueKeysOrIDs) == 0 {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoIssuesSlice)
+	}
+
+	if accountID == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoAccountID)
+	}
+
+	return i.updateBulk(ctx, issueKeysOrIDs, accountID, true, options), nil
+}
+
+// updateBulk adds or removes the watcher of the issues.
+func (i *internalWatcherImpl) updateBulk(ctx context.Context, issueKeysOrIDs []string, accountID string, remove bool, options *model.IssueWatchersUpdateOptionsScheme) *model.IssueWatchersUpdateScheme {
+
+	if options == nil {
+		options = new(model.IssueWatchersUpdateOptionsScheme)
+	}
+
+	update := &model.IssueWatchersUpdateScheme{AccountID: accountID, Removed: remove, DryRun: options.DryRun}
+	for _, issueKeyOrID := range issueKeysOrIDs {
+		update.Issues = append(update.Issues, &model.IssueWatchersIssueUpdateScheme{IssueKey: issueKeyOrID})
+	}
+
+	i.updateIssues(ctx, update, options)
+
+	return update
+}
+
+// updateIssues adds or removes the watcher of the issues of the update, updating a bounded number of issues at the same time.
+func (i *internalWatcherImpl) updateIssues(ctx context.Context, update *model.IssueWatchersUpdateScheme, options *model.IssueWatchersUpdateOptionsScheme) {
+
+	if options.DryRun || len(update.Issues) == 0 {
+		return
+	}
+
+	concurrency := options.Concurrency
+	if concurrency <= 0 {
+		concurrency = model.IssueWatchersUpdateConcurrency
+	}
+
+	errs := model.RunWorkers(ctx, len(update.Issues), concurrency, options.Rate, func(index int) error {
+
+		issue := update.Issues[index]
+		if err := i.updateWatcher(ctx, issue, update.AccountID, update.Removed, options.Attempts); err != nil {
+			return fmt.Errorf("jira: issue %v: %w", issue.IssueKey, err)
+		}
+
+		return nil
+	})
+
+	for index, issue := range update.Issues {
+		issue.Err = errs[index]
+	}
+
+	for _, issue := range update.Issues {
+		if issue.Err != nil {
+			update.Failed++
+		} else {
+			update.Updated++
+		}
+	}
+}
+
+// updateWatcher adds or removes the watcher of the issue, waiting out the rate limits between the attempts.
+func (i *internalWatcherImpl) updateWatcher(ctx context.Context, issue *model.IssueWatchersIssueUpdateScheme, accountID string, remove bool, attempts int) error {
+
+	if attempts <= 0 {
+		attempts = model.IssueWatchersUpdateAttempts
+	}
+
+	for {
+
+		issue.Attempts++
+
+		var err error
+		if remove {
+			_, err = i.Delete(ctx, issue.IssueKey, accountID)
+		} else if accountID != "" {
+			_, err = i.Add(ctx, issue.IssueKey, accountID)
+		} else {
+			_, err = i.Add(ctx, issue.IssueKey)
+		}
+
+		delay, retry := model.RetryDelay(err, issue.Attempts)
+		if err == nil || !retry || issue.Attempts >= attempts {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
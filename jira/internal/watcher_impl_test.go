@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
@@ -616,3 +617,716 @@ func Test_NewWatcherService(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalWatcherImpl_Aggregate(t *testing.T) {
+
+	watchersMocked := func(client *mocks.Connector, issueKeyOrID string, err error, accountIDs ...string) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/issue/"+issueKeyOrID+"/watchers",
+			"",
+			nil).
+			Return(&http.Request{Host: issueKeyOrID}, nil)
+
+		client.On("Call",
+			&http.Request{Host: issueKeyOrID},
+			&model.IssueWatcherScheme{}).
+			Run(func(args mock.Arguments) {
+				watchers := args.Get(1).(*model.IssueWatcherScheme)
+				for _, accountID := range accountIDs {
+					watchers.Watchers = append(watchers.Watchers, &model.UserDetailScheme{AccountID: accountID})
+				}
+			}).
+			Return(&model.ResponseScheme{}, err)
+	}
+
+	userMocked := func(client *mocks.Connector, accountID string) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/user?accountId="+accountID,
+			"",
+			nil).
+			Return(&http.Request{Host: accountID}, nil).
+			Once()
+
+		client.On("Call",
+			&http.Request{Host: accountID},
+			&model.UserScheme{}).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*model.UserScheme).EmailAddress = accountID + "@example.com"
+			}).
+			Return(&model.ResponseScheme{}, nil).
+			Once()
+	}
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx            context.Context
+		issueKeysOrIDs []string
+		options        *model.IssueWatchersOptionsScheme
+	}
+
+	testCases := []struct {
+		name      string
+		fields    fields
+		args      args
+		on        func(*fields)
+		wantUsers []string
+		wantErr   bool
+		Err       error
+	}{
+		{
+			name:   "when the users are expanded",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				issueKeysOrIDs: []string{"KP-1", "KP-2", "KP-3"},
+				options:        &model.IssueWatchersOptionsScheme{Concurrency: 2, ExpandUsers: true},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				watchersMocked(client, "KP-1", nil, "uuid-a", "uuid-b")
+				watchersMocked(client, "KP-2", nil, "uuid-b")
+				watchersMocked(client, "KP-3", model.ErrNotFound)
+				userMocked(client, "uuid-a")
+				userMocked(client, "uuid-b")
+
+				fields.c = client
+			},
+			wantUsers: []string{"uuid-a", "uuid-b"},
+		},
+
+		{
+			name:   "when the users are not expanded",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				issueKeysOrIDs: []string{"KP-1", "KP-3"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				watchersMocked(client, "KP-1", nil, "uuid-a", "uuid-b")
+				watchersMocked(client, "KP-3", model.ErrNotFound)
+
+				fields.c = client
+			},
+			wantUsers: []string{"uuid-a", "uuid-b"},
+		},
+
+		{
+			name:   "when the issues are not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssuesSlice,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			watcherService, err := NewWatcherService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, err := watcherService.Aggregate(testCase.args.ctx, testCase.args.issueKeysOrIDs, testCase.args.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, gotResult.Issues, len(testCase.args.issueKeysOrIDs))
+			assert.Equal(t, testCase.wantUsers, gotResult.AccountIDs())
+			assert.True(t, errors.Is(gotResult.Err(), model.ErrNotFound))
+
+			if testCase.args.options != nil && testCase.args.options.ExpandUsers {
+				assert.Equal(t, "uuid-b@example.com", gotResult.Users["uuid-b"].EmailAddress)
+			} else {
+				assert.Nil(t, gotResult.Users)
+			}
+		})
+	}
+}
+
+func Test_internalWatcherImpl_UpdateByJQL(t *testing.T) {
+
+	searchMocked := func(client *mocks.Connector, keys ...string) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPost,
+			"rest/api/3/search/jql",
+			"",
+			mock.Anything).
+			Return(&http.Request{Host: "search"}, nil)
+
+		client.On("Call",
+			&http.Request{Host: "search"},
+			&model.IssueSearchJQLScheme{}).
+			Run(func(args mock.Arguments) {
+				page := args.Get(1).(*model.IssueSearchJQLScheme)
+				for _, key := range keys {
+					page.Issues = append(page.Issues, &model.IssueScheme{Key: key})
+				}
+			}).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	addMocked := func(client *mocks.Connector, key, accountID string, err error) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPost,
+			"rest/api/3/issue/"+key+"/watchers",
+			"",
+			accountID).
+			Return(&http.Request{Host: "add-" + key}, nil)
+
+		client.On("Call",
+			&http.Request{Host: "add-" + key},
+			nil).
+			Return(&model.ResponseScheme{}, err)
+	}
+
+	deleteMocked := func(client *mocks.Connector, key, accountID string, err error) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodDelete,
+			"rest/api/3/issue/"+key+"/watchers?accountId="+accountID,
+			"",
+			nil).
+			Return(&http.Request{Host: "delete-" + key}, nil)
+
+		client.On("Call",
+			&http.Request{Host: "delete-" + key},
+			nil).
+			Return(&model.ResponseScheme{}, err)
+	}
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx            context.Context
+		jql, accountID string
+		remove         bool
+		options        *model.IssueWatchersUpdateOptionsScheme
+	}
+
+	testCases := []struct {
+		name        string
+		fields      fields
+		args        args
+		on          func(*fields)
+		wantIssues  []string
+		wantUpdated int
+		wantFailed  int
+		wantErr     bool
+		Err         error
+	}{
+		{
+			name:   "when the watcher is added to the issues",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				jql:       "project = KP",
+				accountID: "uuid-a",
+				options:   &model.IssueWatchersUpdateOptionsScheme{Concurrency: 2, Rate: 100},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				searchMocked(client, "KP-1", "KP-2")
+
+				addMocked(client, "KP-1", "uuid-a", nil)
+				addMocked(client, "KP-2", "uuid-a", model.ErrNotFound)
+
+				fields.c = client
+			},
+			wantIssues:  []string{"KP-1", "KP-2"},
+			wantUpdated: 1,
+			wantFailed:  1,
+		},
+
+		{
+			name:   "when the calling user is added to the issues",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+				jql: "project = KP",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				searchMocked(client, "KP-1")
+
+				addMocked(client, "KP-1", "", nil)
+
+				fields.c = client
+			},
+			wantIssues:  []string{"KP-1"},
+			wantUpdated: 1,
+		},
+
+		{
+			name:   "when the watcher is removed from the issues",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				jql:       "project = KP",
+				accountID: "uuid-a",
+				remove:    true,
+				options:   &model.IssueWatchersUpdateOptionsScheme{Attempts: 1},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				searchMocked(client, "KP-1", "KP-2")
+
+				deleteMocked(client, "KP-1", "uuid-a", nil)
+				deleteMocked(client, "KP-2", "uuid-a", &model.Error{Product: "jira", StatusCode: http.StatusTooManyRequests, Retryable: true})
+
+				fields.c = client
+			},
+			wantIssues:  []string{"KP-1", "KP-2"},
+			wantUpdated: 1,
+			wantFailed:  1,
+		},
+
+		{
+			name:   "when the update is a dry run",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				jql:       "project = KP",
+				accountID: "uuid-a",
+				options:   &model.IssueWatchersUpdateOptionsScheme{DryRun: true},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				searchMocked(client, "KP-1")
+
+				fields.c = client
+			},
+			wantIssues: []string{"KP-1"},
+		},
+
+		{
+			name:   "when the jql is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				accountID: "uuid-a",
+			},
+			wantErr: true,
+			Err:     model.ErrNoJQL,
+		},
+
+		{
+			name:   "when the account id is not provided to remove the watcher",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:    context.Background(),
+				jql:    "project = KP",
+				remove: true,
+			},
+			wantErr: true,
+			Err:     model.ErrNoAccountID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			watcherService, err := NewWatcherService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			var gotResult *model.IssueWatchersUpdateScheme
+			if testCase.args.remove {
+				gotResult, err = watcherService.DeleteByJQL(testCase.args.ctx, testCase.args.jql, testCase.args.accountID, testCase.args.options)
+			} else {
+				gotResult, err = watcherService.AddByJQL(testCase.args.ctx, testCase.args.jql, testCase.args.accountID, testCase.args.options)
+			}
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.args.remove, gotResult.Removed)
+			assert.Equal(t, testCase.wantUpdated, gotResult.Updated)
+			assert.Equal(t, testCase.wantFailed, gotResult.Failed)
+
+			var gotIssues []string
+			for _, issue := range gotResult.Issues {
+				gotIssues = append(gotIssues, issue.IssueKey)
+			}
+
+			assert.Equal(t, testCase.wantIssues, gotIssues)
+			assert.Equal(t, testCase.wantFailed != 0, gotResult.Err() != nil)
+		})
+	}
+}
+
+func Test_internalWatcherImpl_UpdateBulk(t *testing.T) {
+
+	addMocked := func(client *mocks.Connector, key, accountID string, err error) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPost,
+			"rest/api/3/issue/"+key+"/watchers",
+			"",
+			accountID).
+			Return(&http.Request{Host: "add-" + key}, nil)
+
+		client.On("Call",
+			&http.Request{Host: "add-" + key},
+			nil).
+			Return(&model.ResponseScheme{}, err)
+	}
+
+	deleteMocked := func(client *mocks.Connector, key, accountID string, err error) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodDelete,
+			"rest/api/3/issue/"+key+"/watchers?accountId="+accountID,
+			"",
+			nil).
+			Return(&http.Request{Host: "delete-" + key}, nil)
+
+		client.On("Call",
+			&http.Request{Host: "delete-" + key},
+			nil).
+			Return(&model.ResponseScheme{}, err)
+	}
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx            context.Context
+		issueKeysOrIDs []string
+		accountID      string
+		remove         bool
+		options        *model.IssueWatchersUpdateOptionsScheme
+	}
+
+	testCases := []struct {
+		name        string
+		fields      fields
+		args        args
+		on          func(*fields)
+		wantUpdated int
+		wantFailed  int
+		wantErr     bool
+		Err         error
+	}{
+		{
+			name:   "when the watcher is added to the issues",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				issueKeysOrIDs: []string{"KP-1", "KP-2", "KP-3"},
+				accountID:      "uuid-a",
+				options:        &model.IssueWatchersUpdateOptionsScheme{Concurrency: 2, Attempts: 1},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				addMocked(client, "KP-1", "uuid-a", nil)
+				addMocked(client, "KP-2", "uuid-a", nil)
+				addMocked(client, "KP-3", "uuid-a", model.ErrNotFound)
+
+				fields.c = client
+			},
+			wantUpdated: 2,
+			wantFailed:  1,
+		},
+
+		{
+			name:   "when the watcher is removed from the issues",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				issueKeysOrIDs: []string{"KP-1"},
+				accountID:      "uuid-a",
+				remove:         true,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				deleteMocked(client, "KP-1", "uuid-a", nil)
+
+				fields.c = client
+			},
+			wantUpdated: 1,
+		},
+
+		{
+			name:   "when the update is a dry run",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				issueKeysOrIDs: []string{"KP-1"},
+				options:        &model.IssueWatchersUpdateOptionsScheme{DryRun: true},
+			},
+		},
+
+		{
+			name:   "when the issues are not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				accountID: "uuid-a",
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssuesSlice,
+		},
+
+		{
+			name:   "when the account id is not provided to remove the watcher",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				issueKeysOrIDs: []string{"KP-1"},
+				remove:         true,
+			},
+			wantErr: true,
+			Err:     model.ErrNoAccountID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			watcherService, err := NewWatcherService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			var gotResult *model.IssueWatchersUpdateScheme
+			if testCase.args.remove {
+				gotResult, err = watcherService.DeleteBulk(testCase.args.ctx, testCase.args.issueKeysOrIDs, testCase.args.accountID, testCase.args.options)
+			} else {
+				gotResult, err = watcherService.AddBulk(testCase.args.ctx, testCase.args.issueKeysOrIDs, testCase.args.accountID, testCase.args.options)
+			}
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Empty(t, gotResult.JQL)
+			assert.Equal(t, testCase.args.remove, gotResult.Removed)
+			assert.Equal(t, testCase.wantUpdated, gotResult.Updated)
+			assert.Equal(t, testCase.wantFailed, gotResult.Failed)
+
+			var gotIssues []string
+			for _, issue := range gotResult.Issues {
+				gotIssues = append(gotIssues, issue.IssueKey)
+			}
+
+			assert.Equal(t, testCase.args.issueKeysOrIDs, gotIssues)
+		})
+	}
+}
+
+func Test_internalWatcherImpl_IsWatching(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx      context.Context
+		issueIDs []string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    *model.IssueIsWatchingScheme
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:      context.Background(),
+				issueIDs: []string{"10001", "10002"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/issue/watching",
+					"",
+					map[string]interface{}{"issueIds": []string{"10001", "10002"}}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueIsWatchingScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.IssueIsWatchingScheme).IssuesIsWatching = map[string]bool{"10001": true, "10002": false}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			want: &model.IssueIsWatchingScheme{IssuesIsWatching: map[string]bool{"10001": true, "10002": false}},
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:      context.Background(),
+				issueIDs: []string{"10001"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/issue/watching",
+					"",
+					map[string]interface{}{"issueIds": []string{"10001"}}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueIsWatchingScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			want: &model.IssueIsWatchingScheme{},
+		},
+
+		{
+			name:   "when the issue ids are not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssuesSlice,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:      context.Background(),
+				issueIDs: []string{"10001"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/issue/watching",
+					"",
+					map[string]interface{}{"issueIds": []string{"10001"}}).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			watcherService, err := NewWatcherService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := watcherService.IsWatching(testCase.args.ctx, testCase.args.issueIDs)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotNil(t, gotResponse)
+			assert.Equal(t, testCase.want, gotResult)
+		})
+	}
+}
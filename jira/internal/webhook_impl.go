@@ -0,0 +1,204 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+)
+
+// NewWebhookService creates a new instance of WebhookService.
+func NewWebhookService(client service.Connector, version string) (*WebhookService, error) {
+
+	if version == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoVersionProvided)
+	}
+
+	return &WebhookService{
+		internalClient: &internalWebhookImpl{c: client, version: version},
+	}, nil
+}
+
+// WebhookService provides methods to manage the dynamic webhooks registered by OAuth 2.0 and Connect apps.
+type WebhookService struct {
+	// internalClient is the connector interface for webhook operations.
+	internalClient jira.WebhookConnector
+}
+
+// Gets returns a paginated list of the webhooks registered by the calling app.
+//
+// GET /rest/api/{2-3}/webhook
+//
+// https://docs.go-atlassian.io/jira-software-cloud/webhooks#get-dynamic-webhooks-for-app
+func (w *WebhookService) Gets(ctx context.Context, startAt, maxResults int) (*model.WebhookPageScheme, *model.ResponseScheme, error) {
+	return w.internalClient.Gets(ctx, startAt, maxResults)
+}
+
+// Register registers webhooks, the result of each webhook is returned in the order of the payload.
+//
+// POST /rest/api/{2-3}/webhook
+//
+// https://docs.go-atlassian.io/jira-software-cloud/webhooks#register-dynamic-webhooks
+func (w *WebhookService) Register(ctx context.Context, payload *model.WebhookRegisterPayloadScheme) (*model.WebhookRegistrationResultScheme, *model.ResponseScheme, error) {
+	return w.internalClient.Register(ctx, payload)
+}
+
+// Delete removes webhooks by ID, only the webhooks registered by the calling app are removed.
+//
+// DELETE /rest/api/{2-3}/webhook
+//
+// https://docs.go-atlassian.io/jira-software-cloud/webhooks#delete-webhooks-by-id
+func (w *WebhookService) Delete(ctx context.Context, webhookIDs []int) (*model.ResponseScheme, error) {
+	return w.internalClient.Delete(ctx, webhookIDs)
+}
+
+// Refresh extends the life of the webhooks, the webhooks expire 30 days after the refresh.
+//
+// PUT /rest/api/{2-3}/webhook/refresh
+//
+// https://docs.go-atlassian.io/jira-software-cloud/webhooks#extend-webhook-life
+func (w *WebhookService) Refresh(ctx context.Context, webhookIDs []int) (*model.WebhookRefreshScheme, *model.ResponseScheme, error) {
+	return w.internalClient.Refresh(ctx, webhookIDs)
+}
+
+// Failed returns the webhooks that Jira failed to deliver to the calling app after the retries, the oldest first.
+//
+// The failures that occurred after the date are returned, every failure of the last 72 hours when the date is zero.
+// Use FailedWebhookPageScheme.NextAfter to read the next page.
+//
+// GET /rest/api/{2-3}/webhook/failed
+//
+// https://docs.go-atlassian.io/jira-software-cloud/webhooks#get-failed-webhooks
+func (w *WebhookService) Failed(ctx context.Context, after time.Time, maxResults int) (*model.FailedWebhookPageScheme, *model.ResponseScheme, error) {
+	return w.internalClient.Failed(ctx, after, maxResults)
+}
+
+// Keeper returns a WebhookKeeper that keeps alive the webhooks persisted in the store.
+func (w *WebhookService) Keeper(store jira.WebhookStore) (*WebhookKeeper, error) {
+
+	if store == nil {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoWebhookStore)
+	}
+
+	return &WebhookKeeper{webhook: w.internalClient, store: store}, nil
+}
+
+type internalWebhookImpl struct {
+	c       service.Connector
+	version string
+}
+
+func (i *internalWebhookImpl) Gets(ctx context.Context, startAt, maxResults int) (*model.WebhookPageScheme, *model.ResponseScheme, error) {
+
+	params := url.Values{}
+	params.Add("startAt", strconv.Itoa(startAt))
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	endpoint := fmt.Sprintf("rest/api/%v/webhook?%v", i.version, params.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.WebhookPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalWebhookImpl) Register(ctx context.Context, payload *model.WebhookRegisterPayloadScheme) (*model.WebhookRegistrationResultScheme, *model.ResponseScheme, error) {
+
+	if payload == nil || payload.URL == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoWebhookURL)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/webhook", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(model.WebhookRegistrationResultScheme)
+	response, err := i.c.Call(request, result)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return result, response, nil
+}
+
+func (i *internalWebhookImpl) Delete(ctx context.Context, webhookIDs []int) (*model.ResponseScheme, error) {
+
+	if len(webhookIDs) == 0 {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoWebhookIDs)
+	}
+
+	payload := map[string]interface{}{"webhookIds": webhookIDs}
+	endpoint := fmt.Sprintf("rest/api/%v/webhook", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalWebhookImpl) Refresh(ctx context.Context, webhookIDs []int) (*model.WebhookRefreshScheme, *model.ResponseScheme, error) {
+
+	if len(webhookIDs) == 0 {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoWebhookIDs)
+	}
+
+	payload := map[string]interface{}{"webhookIds": webhookIDs}
+	endpoint := fmt.Sprintf("rest/api/%v/webhook/refresh", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	refresh := new(model.WebhookRefreshScheme)
+	response, err := i.c.Call(request, refresh)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return refresh, response, nil
+}
+
+func (i *internalWebhookImpl) Failed(ctx context.Context, after time.Time, maxResults int) (*model.FailedWebhookPageScheme, *model.ResponseScheme, error) {
+
+	params := url.Values{}
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	if !after.IsZero() {
+		params.Add("after", strconv.FormatInt(after.UnixMilli(), 10))
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/webhook/failed?%v", i.version, params.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.FailedWebhookPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
@@ -0,0 +1,136 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+)
+
+// defaultWebhookRefreshInterval is the interval used by the WebhookKeeper when no interval is provided,
+// well before the 30 days expiration of the dynamic webhooks.
+const defaultWebhookRefreshInterval = 24 * time.Hour
+
+// webhookRefreshBatchSize is the maximum number of webhooks extended per refresh request.
+const webhookRefreshBatchSize = 100
+
+// WebhookKeeper keeps alive the dynamic webhooks registered by an OAuth 2.0 or Connect app.
+//
+// The dynamic webhooks silently expire 30 days after they're registered or refreshed. The keeper persists
+// the IDs of the webhooks it registers in a jira.WebhookStore, and extends them in the background with Run.
+type WebhookKeeper struct {
+	// Interval is the interval between the refreshes of Run, defaults to 24 hours.
+	Interval time.Duration
+	// OnError, when set, is called with the errors of the refreshes of Run.
+	OnError func(err error)
+
+	webhook jira.WebhookConnector
+	store   jira.WebhookStore
+}
+
+// Register registers the webhooks, and adds the IDs of the registered webhooks to the store.
+//
+// The webhooks that couldn't be registered are reported on the result, as returned by the Register method.
+func (k *WebhookKeeper) Register(ctx context.Context, payload *model.WebhookRegisterPayloadScheme) (*model.WebhookRegistrationResultScheme, error) {
+
+	result, _, err := k.webhook.Register(ctx, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	webhookIDs, err := k.store.Load(ctx)
+	if err != nil {
+		return result, fmt.Errorf("jira: load webhooks: %w", err)
+	}
+
+	for _, registration := range result.WebhookRegistrationResult {
+		if registration.CreatedWebhookID != 0 && !slices.Contains(webhookIDs, registration.CreatedWebhookID) {
+			webhookIDs = append(webhookIDs, registration.CreatedWebhookID)
+		}
+	}
+
+	if err = k.store.Save(ctx, webhookIDs); err != nil {
+		return result, fmt.Errorf("jira: save webhooks: %w", err)
+	}
+
+	return result, nil
+}
+
+// Delete removes the webhooks, and removes their IDs from the store.
+func (k *WebhookKeeper) Delete(ctx context.Context, webhookIDs []int) error {
+
+	if _, err := k.webhook.Delete(ctx, webhookIDs); err != nil {
+		return err
+	}
+
+	stored, err := k.store.Load(ctx)
+	if err != nil {
+		return fmt.Errorf("jira: load webhooks: %w", err)
+	}
+
+	stored = slices.DeleteFunc(stored, func(webhookID int) bool {
+		return slices.Contains(webhookIDs, webhookID)
+	})
+
+	if err = k.store.Save(ctx, stored); err != nil {
+		return fmt.Errorf("jira: save webhooks: %w", err)
+	}
+
+	return nil
+}
+
+// Refresh extends the life of the webhooks of the store, and returns the earliest new expiration date.
+//
+// It returns nil when the store has no webhooks.
+func (k *WebhookKeeper) Refresh(ctx context.Context) (*model.WebhookRefreshScheme, error) {
+
+	webhookIDs, err := k.store.Load(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("jira: load webhooks: %w", err)
+	}
+
+	var expiration *model.WebhookRefreshScheme
+	for batch := range slices.Chunk(webhookIDs, webhookRefreshBatchSize) {
+
+		refresh, _, err := k.webhook.Refresh(ctx, batch)
+		if err != nil {
+			return nil, err
+		}
+
+		if expiration == nil || refresh.ExpirationDate < expiration.ExpirationDate {
+			expiration = refresh
+		}
+	}
+
+	return expiration, nil
+}
+
+// Run refreshes the webhooks of the store right away and then on every interval, until ctx is done.
+//
+// The refresh errors are reported to OnError and don't stop Run, the error of ctx is returned.
+func (k *WebhookKeeper) Run(ctx context.Context) error {
+
+	interval := k.Interval
+	if interval <= 0 {
+		interval = defaultWebhookRefreshInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+
+		if _, err := k.Refresh(ctx); err != nil && k.OnError != nil {
+			k.OnError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,185 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+// memoryWebhookStore is an in-memory jira.WebhookStore.
+type memoryWebhookStore struct {
+	mu         sync.Mutex
+	webhookIDs []int
+	err        error
+}
+
+func (m *memoryWebhookStore) Load(_ context.Context) ([]int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]int(nil), m.webhookIDs...), m.err
+}
+
+func (m *memoryWebhookStore) Save(_ context.Context, webhookIDs []int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.webhookIDs = webhookIDs
+	return m.err
+}
+
+func newWebhookKeeper(t *testing.T, client *mocks.Connector, store *memoryWebhookStore) *WebhookKeeper {
+
+	webhookService, err := NewWebhookService(client, "3")
+	assert.NoError(t, err)
+
+	keeper, err := webhookService.Keeper(store)
+	assert.NoError(t, err)
+
+	return keeper
+}
+
+func TestWebhookService_Keeper(t *testing.T) {
+
+	webhookService, err := NewWebhookService(nil, "3")
+	assert.NoError(t, err)
+
+	keeper, err := webhookService.Keeper(nil)
+	assert.ErrorIs(t, err, model.ErrNoWebhookStore)
+	assert.Nil(t, keeper)
+}
+
+func TestWebhookKeeper_Register(t *testing.T) {
+
+	payload := &model.WebhookRegisterPayloadScheme{
+		URL: "https://example.com/webhook",
+		Webhooks: []*model.WebhookDetailScheme{
+			{JQLFilter: "project = KP", Events: []string{"jira:issue_created"}},
+			{JQLFilter: "invalid jql", Events: []string{"jira:issue_updated"}},
+		},
+	}
+
+	client := mocks.NewConnector(t)
+
+	client.On("NewRequest", context.Background(), http.MethodPost, "rest/api/3/webhook", "", payload).
+		Return(&http.Request{}, nil)
+
+	client.On("Call", &http.Request{}, &model.WebhookRegistrationResultScheme{}).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*model.WebhookRegistrationResultScheme).WebhookRegistrationResult = []*model.WebhookRegistrationScheme{
+				{CreatedWebhookID: 10001},
+				{Errors: []string{"The JQL query is invalid."}},
+			}
+		}).
+		Return(&model.ResponseScheme{}, nil)
+
+	store := &memoryWebhookStore{webhookIDs: []int{10000}}
+
+	result, err := newWebhookKeeper(t, client, store).Register(context.Background(), payload)
+	assert.NoError(t, err)
+	assert.Len(t, result.WebhookRegistrationResult, 2)
+	assert.Equal(t, []int{10000, 10001}, store.webhookIDs)
+}
+
+func TestWebhookKeeper_Delete(t *testing.T) {
+
+	client := mocks.NewConnector(t)
+
+	client.On("NewRequest", context.Background(), http.MethodDelete, "rest/api/3/webhook", "", map[string]interface{}{"webhookIds": []int{10001}}).
+		Return(&http.Request{}, nil)
+
+	client.On("Call", &http.Request{}, nil).
+		Return(&model.ResponseScheme{}, nil)
+
+	store := &memoryWebhookStore{webhookIDs: []int{10000, 10001, 10002}}
+
+	assert.NoError(t, newWebhookKeeper(t, client, store).Delete(context.Background(), []int{10001}))
+	assert.Equal(t, []int{10000, 10002}, store.webhookIDs)
+}
+
+func TestWebhookKeeper_Refresh(t *testing.T) {
+
+	webhookIDs := make([]int, 150)
+	for index := range webhookIDs {
+		webhookIDs[index] = 10000 + index
+	}
+
+	t.Run("when the webhooks are refreshed in batches", func(t *testing.T) {
+
+		client := mocks.NewConnector(t)
+
+		client.On("NewRequest", context.Background(), http.MethodPut, "rest/api/3/webhook/refresh", "", map[string]interface{}{"webhookIds": webhookIDs[:100]}).
+			Return(&http.Request{Host: "first"}, nil)
+
+		client.On("Call", &http.Request{Host: "first"}, &model.WebhookRefreshScheme{}).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*model.WebhookRefreshScheme).ExpirationDate = 1700000060000
+			}).
+			Return(&model.ResponseScheme{}, nil)
+
+		client.On("NewRequest", context.Background(), http.MethodPut, "rest/api/3/webhook/refresh", "", map[string]interface{}{"webhookIds": webhookIDs[100:]}).
+			Return(&http.Request{Host: "second"}, nil)
+
+		client.On("Call", &http.Request{Host: "second"}, &model.WebhookRefreshScheme{}).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*model.WebhookRefreshScheme).ExpirationDate = 1700000000000
+			}).
+			Return(&model.ResponseScheme{}, nil)
+
+		refresh, err := newWebhookKeeper(t, client, &memoryWebhookStore{webhookIDs: webhookIDs}).Refresh(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, time.UnixMilli(1700000000000), refresh.Expiration())
+	})
+
+	t.Run("when the store has no webhooks", func(t *testing.T) {
+
+		refresh, err := newWebhookKeeper(t, mocks.NewConnector(t), &memoryWebhookStore{}).Refresh(context.Background())
+		assert.NoError(t, err)
+		assert.Nil(t, refresh)
+	})
+
+	t.Run("when the store cannot be loaded", func(t *testing.T) {
+
+		store := &memoryWebhookStore{err: errors.New("database unavailable")}
+
+		_, err := newWebhookKeeper(t, mocks.NewConnector(t), store).Refresh(context.Background())
+		assert.ErrorIs(t, err, store.err)
+	})
+}
+
+func TestWebhookKeeper_Run(t *testing.T) {
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := mocks.NewConnector(t)
+
+	client.On("NewRequest", ctx, http.MethodPut, "rest/api/3/webhook/refresh", "", map[string]interface{}{"webhookIds": []int{10000}}).
+		Return(&http.Request{}, nil)
+
+	client.On("Call", &http.Request{}, &model.WebhookRefreshScheme{}).
+		Return(&model.ResponseScheme{}, model.ErrUnauthorized).
+		Twice()
+
+	keeper := newWebhookKeeper(t, client, &memoryWebhookStore{webhookIDs: []int{10000}})
+	keeper.Interval = time.Millisecond
+
+	var errs []error
+	keeper.OnError = func(err error) {
+		errs = append(errs, err)
+		if len(errs) == 2 {
+			cancel()
+		}
+	}
+
+	assert.ErrorIs(t, keeper.Run(ctx), context.Canceled)
+	assert.Len(t, errs, 2)
+	assert.ErrorIs(t, errs[0], model.ErrUnauthorized)
+}
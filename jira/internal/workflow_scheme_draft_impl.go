@@ -0,0 +1,457 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+)
+
+// NewWorkflowSchemeDraftService creates a new instance of WorkflowSchemeDraftService.
+func NewWorkflowSchemeDraftService(client service.Connector, version string) *WorkflowSchemeDraftService {
+
+	return &WorkflowSchemeDraftService{
+		internalClient: &internalWorkflowSchemeDraftImpl{c: client, version: version},
+	}
+}
+
+// WorkflowSchemeDraftService provides methods to manage the drafts of active workflow schemes.
+type WorkflowSchemeDraftService struct {
+	// internalClient is the connector interface for workflow scheme draft operations.
+	internalClient jira.WorkflowSchemeDraftConnector
+}
+
+// Create creates a draft workflow scheme from an active workflow scheme.
+//
+// An active workflow scheme can only have one draft workflow scheme.
+//
+// POST /rest/api/{2-3}/workflowscheme/{id}/createdraft
+//
+// https://docs.go-atlassian.io/jira-software-cloud/workflow/scheme/draft#create-draft-workflow-scheme
+func (w *WorkflowSchemeDraftService) Create(ctx context.Context, schemeID int) (*model.WorkflowSchemeScheme, *model.ResponseScheme, error) {
+	return w.internalClient.Create(ctx, schemeID)
+}
+
+// Get returns the draft workflow scheme for an active workflow scheme.
+//
+// GET /rest/api/{2-3}/workflowscheme/{id}/draft
+//
+// https://docs.go-atlassian.io/jira-software-cloud/workflow/scheme/draft#get-draft-workflow-scheme
+func (w *WorkflowSchemeDraftService) Get(ctx context.Context, schemeID int) (*model.WorkflowSchemeScheme, *model.ResponseScheme, error) {
+	return w.internalClient.Get(ctx, schemeID)
+}
+
+// Update updates a draft workflow scheme.
+//
+// If a draft workflow scheme does not exist for the active workflow scheme, then a draft is created.
+//
+// PUT /rest/api/{2-3}/workflowscheme/{id}/draft
+//
+// https://docs.go-atlassian.io/jira-software-cloud/workflow/scheme/draft#update-draft-workflow-scheme
+func (w *WorkflowSchemeDraftService) Update(ctx context.Context, schemeID int, payload *model.WorkflowSchemePayloadScheme) (*model.WorkflowSchemeScheme, *model.ResponseScheme, error) {
+	return w.internalClient.Update(ctx, schemeID, payload)
+}
+
+// Delete deletes a draft workflow scheme.
+//
+// DELETE /rest/api/{2-3}/workflowscheme/{id}/draft
+//
+// https://docs.go-atlassian.io/jira-software-cloud/workflow/scheme/draft#delete-draft-workflow-scheme
+func (w *WorkflowSchemeDraftService) Delete(ctx context.Context, schemeID int) (*model.ResponseScheme, error) {
+	return w.internalClient.Delete(ctx, schemeID)
+}
+
+// Publish publishes a draft workflow scheme.
+//
+// Where the draft workflow includes new workflow statuses for an issue type, mappings are provided to update issues with the original workflow status to the new workflow status.
+//
+// The publication runs asynchronously, the task is returned when Jira starts it, otherwise the task is nil.
+//
+// POST /rest/api/{2-3}/workflowscheme/{id}/draft/publish
+//
+// https://docs.go-atlassian.io/jira-software-cloud/workflow/scheme/draft#publish-draft-workflow-scheme
+func (w *WorkflowSchemeDraftService) Publish(ctx context.Context, schemeID int, payload *model.WorkflowSchemeDraftPublishPayloadScheme, validateOnly bool) (*model.TaskScheme, *model.ResponseScheme, error) {
+	return w.internalClient.Publish(ctx, schemeID, payload, validateOnly)
+}
+
+// Default returns the default workflow for a workflow scheme's draft.
+//
+// The default workflow is the workflow that is assigned any issue types that have not been mapped to any other workflow.
+//
+// GET /rest/api/{2-3}/workflowscheme/{id}/draft/default
+//
+// https://docs.go-atlassian.io/jira-software-cloud/workflow/scheme/draft#get-draft-default-workflow
+func (w *WorkflowSchemeDraftService) Default(ctx context.Context, schemeID int) (*model.WorkflowSchemeDefaultScheme, *model.ResponseScheme, error) {
+	return w.internalClient.Default(ctx, schemeID)
+}
+
+// SetDefault sets the default workflow for a workflow scheme's draft.
+//
+// PUT /rest/api/{2-3}/workflowscheme/{id}/draft/default
+//
+// https://docs.go-atlassian.io/jira-software-cloud/workflow/scheme/draft#update-draft-default-workflow
+func (w *WorkflowSchemeDraftService) SetDefault(ctx context.Context, schemeID int, workflowName string) (*model.WorkflowSchemeScheme, *model.ResponseScheme, error) {
+	return w.internalClient.SetDefault(ctx, schemeID, workflowName)
+}
+
+// DeleteDefault resets the default workflow for a workflow scheme's draft.
+//
+// That is, the default workflow is set to Jira's system workflow (the jira workflow).
+//
+// DELETE /rest/api/{2-3}/workflowscheme/{id}/draft/default
+//
+// https://docs.go-atlassian.io/jira-software-cloud/workflow/scheme/draft#delete-draft-default-workflow
+func (w *WorkflowSchemeDraftService) DeleteDefault(ctx context.Context, schemeID int) (*model.WorkflowSchemeScheme, *model.ResponseScheme, error) {
+	return w.internalClient.DeleteDefault(ctx, schemeID)
+}
+
+// IssueType returns the issue type-workflow mapping for an issue type in a workflow scheme's draft.
+//
+// GET /rest/api/{2-3}/workflowscheme/{id}/draft/issuetype/{issueType}
+//
+// https://docs.go-atlassian.io/jira-software-cloud/workflow/scheme/draft#get-workflow-for-issue-type-in-draft-workflow-scheme
+func (w *WorkflowSchemeDraftService) IssueType(ctx context.Context, schemeID int, issueTypeID string) (*model.IssueTypeWorkflowMappingScheme, *model.ResponseScheme, error) {
+	return w.internalClient.IssueType(ctx, schemeID, issueTypeID)
+}
+
+// SetIssueType sets the workflow for an issue type in a workflow scheme's draft.
+//
+// PUT /rest/api/{2-3}/workflowscheme/{id}/draft/issuetype/{issueType}
+//
+// https://docs.go-atlassian.io/jira-software-cloud/workflow/scheme/draft#set-workflow-for-issue-type-in-draft-workflow-scheme
+func (w *WorkflowSchemeDraftService) SetIssueType(ctx context.Context, schemeID int, issueTypeID string, payload *model.IssueTypeWorkflowPayloadScheme) (*model.WorkflowSchemeScheme, *model.ResponseScheme, error) {
+	return w.internalClient.SetIssueType(ctx, schemeID, issueTypeID, payload)
+}
+
+// DeleteIssueType deletes the issue type-workflow mapping for an issue type in a workflow scheme's draft.
+//
+// DELETE /rest/api/{2-3}/workflowscheme/{id}/draft/issuetype/{issueType}
+//
+// https://docs.go-atlassian.io/jira-software-cloud/workflow/scheme/draft#delete-workflow-for-issue-type-in-draft-workflow-scheme
+func (w *WorkflowSchemeDraftService) DeleteIssueType(ctx context.Context, schemeID int, issueTypeID string) (*model.WorkflowSchemeScheme, *model.ResponseScheme, error) {
+	return w.internalClient.DeleteIssueType(ctx, schemeID, issueTypeID)
+}
+
+// Mapping returns the workflow-issue type mappings for a workflow scheme's draft.
+//
+// GET /rest/api/{2-3}/workflowscheme/{id}/draft/workflow
+//
+// https://docs.go-atlassian.io/jira-software-cloud/workflow/scheme/draft#get-issue-types-for-workflows-in-draft-workflow-scheme
+func (w *WorkflowSchemeDraftService) Mapping(ctx context.Context, schemeID int, workflowName string) ([]*model.IssueTypesWorkflowMappingScheme, *model.ResponseScheme, error) {
+	return w.internalClient.Mapping(ctx, schemeID, workflowName)
+}
+
+type internalWorkflowSchemeDraftImpl struct {
+	c       service.Connector
+	version string
+}
+
+func (i *internalWorkflowSchemeDraftImpl) Create(ctx context.Context, schemeID int) (*model.WorkflowSchemeScheme, *model.ResponseScheme, error) {
+
+	if schemeID == 0 {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoWorkflowSchemeID)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/workflowscheme/%v/createdraft", i.version, schemeID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(model.WorkflowSchemeScheme)
+	response, err := i.c.Call(request, scheme)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return scheme, response, nil
+}
+
+func (i *internalWorkflowSchemeDraftImpl) Get(ctx context.Context, schemeID int) (*model.WorkflowSchemeScheme, *model.ResponseScheme, error) {
+
+	if schemeID == 0 {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoWorkflowSchemeID)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/workflowscheme/%v/draft", i.version, schemeID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(model.WorkflowSchemeScheme)
+	response, err := i.c.Call(request, scheme)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return scheme, response, nil
+}
+
+func (i *internalWorkflowSchemeDraftImpl) Update(ctx context.Context, schemeID int, payload *model.WorkflowSchemePayloadScheme) (*model.WorkflowSchemeScheme, *model.ResponseScheme, error) {
+
+	if schemeID == 0 {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoWorkflowSchemeID)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/workflowscheme/%v/draft", i.version, schemeID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(model.WorkflowSchemeScheme)
+	response, err := i.c.Call(request, scheme)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return scheme, response, nil
+}
+
+func (i *internalWorkflowSchemeDraftImpl) Delete(ctx context.Context, schemeID int) (*model.ResponseScheme, error) {
+
+	if schemeID == 0 {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoWorkflowSchemeID)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/workflowscheme/%v/draft", i.version, schemeID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalWorkflowSchemeDraftImpl) Publish(ctx context.Context, schemeID int, payload *model.WorkflowSchemeDraftPublishPayloadScheme, validateOnly bool) (*model.TaskScheme, *model.ResponseScheme, error) {
+
+	if schemeID == 0 {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoWorkflowSchemeID)
+	}
+
+	var endpoint strings.Builder
+	fmt.Fprintf(&endpoint, "rest/api/%v/workflowscheme/%v/draft/publish", i.version, schemeID)
+
+	if validateOnly {
+		params := url.Values{}
+		params.Add("validateOnly", "true")
+
+		fmt.Fprintf(&endpoint, "?%v", params.Encode())
+	}
+
+	if payload == nil {
+		payload = &model.WorkflowSchemeDraftPublishPayloadScheme{}
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint.String(), "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Jira answers with no content when the draft is published straight away,
+	// or redirects to the task tracking the publication otherwise.
+	response, err := i.c.Call(request, nil)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response == nil || response.Bytes.Len() == 0 {
+		return nil, response, nil
+	}
+
+	task := new(model.TaskScheme)
+	if err = json.Unmarshal(response.Bytes.Bytes(), task); err != nil {
+		return nil, response, model.NewDecodeError(response, task, err)
+	}
+
+	return task, response, nil
+}
+
+func (i *internalWorkflowSchemeDraftImpl) Default(ctx context.Context, schemeID int) (*model.WorkflowSchemeDefaultScheme, *model.ResponseScheme, error) {
+
+	if schemeID == 0 {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoWorkflowSchemeID)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/workflowscheme/%v/draft/default", i.version, schemeID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	workflow := new(model.WorkflowSchemeDefaultScheme)
+	response, err := i.c.Call(request, workflow)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return workflow, response, nil
+}
+
+func (i *internalWorkflowSchemeDraftImpl) SetDefault(ctx context.Context, schemeID int, workflowName string) (*model.WorkflowSchemeScheme, *model.ResponseScheme, error) {
+
+	if schemeID == 0 {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoWorkflowSchemeID)
+	}
+
+	if workflowName == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoWorkflowName)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/workflowscheme/%v/draft/default", i.version, schemeID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", &model.WorkflowSchemeDefaultScheme{Workflow: workflowName})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(model.WorkflowSchemeScheme)
+	response, err := i.c.Call(request, scheme)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return scheme, response, nil
+}
+
+func (i *internalWorkflowSchemeDraftImpl) DeleteDefault(ctx context.Context, schemeID int) (*model.WorkflowSchemeScheme, *model.ResponseScheme, error) {
+
+	if schemeID == 0 {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoWorkflowSchemeID)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/workflowscheme/%v/draft/default", i.version, schemeID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(model.WorkflowSchemeScheme)
+	response, err := i.c.Call(request, scheme)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return scheme, response, nil
+}
+
+func (i *internalWorkflowSchemeDraftImpl) IssueType(ctx context.Context, schemeID int, issueTypeID string) (*model.IssueTypeWorkflowMappingScheme, *model.ResponseScheme, error) {
+
+	if schemeID == 0 {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoWorkflowSchemeID)
+	}
+
+	if issueTypeID == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoIssueTypeID)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/workflowscheme/%v/draft/issuetype/%v", i.version, schemeID, issueTypeID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mapping := new(model.IssueTypeWorkflowMappingScheme)
+	response, err := i.c.Call(request, mapping)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return mapping, response, nil
+}
+
+func (i *internalWorkflowSchemeDraftImpl) SetIssueType(ctx context.Context, schemeID int, issueTypeID string, payload *model.IssueTypeWorkflowPayloadScheme) (*model.WorkflowSchemeScheme, *model.ResponseScheme, error) {
+
+	if schemeID == 0 {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoWorkflowSchemeID)
+	}
+
+	if issueTypeID == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoIssueTypeID)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/workflowscheme/%v/draft/issuetype/%v", i.version, schemeID, issueTypeID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(model.WorkflowSchemeScheme)
+	response, err := i.c.Call(request, scheme)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return scheme, response, nil
+}
+
+func (i *internalWorkflowSchemeDraftImpl) DeleteIssueType(ctx context.Context, schemeID int, issueTypeID string) (*model.WorkflowSchemeScheme, *model.ResponseScheme, error) {
+
+	if schemeID == 0 {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoWorkflowSchemeID)
+	}
+
+	if issueTypeID == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoIssueTypeID)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/workflowscheme/%v/draft/issuetype/%v", i.version, schemeID, issueTypeID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(model.WorkflowSchemeScheme)
+	response, err := i.c.Call(request, scheme)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return scheme, response, nil
+}
+
+func (i *internalWorkflowSchemeDraftImpl) Mapping(ctx context.Context, schemeID int, workflowName string) ([]*model.IssueTypesWorkflowMappingScheme, *model.ResponseScheme, error) {
+
+	if schemeID == 0 {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoWorkflowSchemeID)
+	}
+
+	var endpoint strings.Builder
+	fmt.Fprintf(&endpoint, "rest/api/%v/workflowscheme/%v/draft/workflow", i.version, schemeID)
+
+	if workflowName != "" {
+		params := url.Values{}
+		params.Add("workflowName", workflowName)
+
+		fmt.Fprintf(&endpoint, "?%v", params.Encode())
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint.String(), "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var mapping []*model.IssueTypesWorkflowMappingScheme
+	response, err := i.c.Call(request, &mapping)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return mapping, response, nil
+}
@@ -14,11 +14,12 @@ import (
 )
 
 // NewWorkflowSchemeService creates a new instance of WorkflowSchemeService.
-func NewWorkflowSchemeService(client service.Connector, version string, issueType *WorkflowSchemeIssueTypeService) *WorkflowSchemeService {
+func NewWorkflowSchemeService(client service.Connector, version string, issueType *WorkflowSchemeIssueTypeService, draft *WorkflowSchemeDraftService) *WorkflowSchemeService {
 
 	return &WorkflowSchemeService{
 		internalClient: &internalWorkflowSchemeImpl{c: client, version: version},
 		IssueType:      issueType,
+		Draft:          draft,
 	}
 }
 
@@ -28,6 +29,8 @@ type WorkflowSchemeService struct {
 	internalClient jira.WorkflowSchemeConnector
 	// IssueType is the service for managing workflow scheme issue types.
 	IssueType *WorkflowSchemeIssueTypeService
+	// Draft is the service for managing the drafts of active workflow schemes.
+	Draft *WorkflowSchemeDraftService
 }
 
 // Gets returns a paginated list of all workflow schemes, not including draft workflow schemes.
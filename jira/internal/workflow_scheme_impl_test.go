@@ -128,7 +128,7 @@ func Test_internalWorkflowSchemeImpl_Gets(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			newService := NewWorkflowSchemeService(testCase.fields.c, testCase.fields.version, nil)
+			newService := NewWorkflowSchemeService(testCase.fields.c, testCase.fields.version, nil, nil)
 
 			gotResult, gotResponse, err := newService.Gets(testCase.args.ctx, testCase.args.startAt, testCase.args.maxResults)
 
@@ -281,7 +281,7 @@ func Test_internalWorkflowSchemeImpl_Get(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			newService := NewWorkflowSchemeService(testCase.fields.c, testCase.fields.version, nil)
+			newService := NewWorkflowSchemeService(testCase.fields.c, testCase.fields.version, nil, nil)
 
 			gotResult, gotResponse, err := newService.Get(testCase.args.ctx, testCase.args.schemeID, testCase.args.returnDraftIfExists)
 
@@ -430,7 +430,7 @@ func Test_internalWorkflowSchemeImpl_Delete(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			newService := NewWorkflowSchemeService(testCase.fields.c, testCase.fields.version, nil)
+			newService := NewWorkflowSchemeService(testCase.fields.c, testCase.fields.version, nil, nil)
 
 			gotResponse, err := newService.Delete(testCase.args.ctx, testCase.args.schemeID)
 
@@ -578,7 +578,7 @@ func Test_internalWorkflowSchemeImpl_Associations(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			newService := NewWorkflowSchemeService(testCase.fields.c, testCase.fields.version, nil)
+			newService := NewWorkflowSchemeService(testCase.fields.c, testCase.fields.version, nil, nil)
 
 			gotResult, gotResponse, err := newService.Associations(testCase.args.ctx, testCase.args.projectIDs)
 
@@ -743,7 +743,7 @@ func Test_internalWorkflowSchemeImpl_Assign(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			newService := NewWorkflowSchemeService(testCase.fields.c, testCase.fields.version, nil)
+			newService := NewWorkflowSchemeService(testCase.fields.c, testCase.fields.version, nil, nil)
 
 			gotResponse, err := newService.Assign(testCase.args.ctx, testCase.args.schemeID, testCase.args.projectID)
 
@@ -904,7 +904,7 @@ func Test_internalWorkflowSchemeImpl_Update(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			newService := NewWorkflowSchemeService(testCase.fields.c, testCase.fields.version, nil)
+			newService := NewWorkflowSchemeService(testCase.fields.c, testCase.fields.version, nil, nil)
 
 			gotResult, gotResponse, err := newService.Update(testCase.args.ctx, testCase.args.schemeID, testCase.args.payload)
 
@@ -1053,7 +1053,7 @@ func Test_internalWorkflowSchemeImpl_Create(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			newService := NewWorkflowSchemeService(testCase.fields.c, testCase.fields.version, nil)
+			newService := NewWorkflowSchemeService(testCase.fields.c, testCase.fields.version, nil, nil)
 
 			gotResult, gotResponse, err := newService.Create(testCase.args.ctx, testCase.args.payload)
 
@@ -1116,7 +1116,7 @@ func Test_NewWorkflowSchemeService(t *testing.T) {
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			got := NewWorkflowSchemeService(testCase.args.client, testCase.args.version, nil)
+			got := NewWorkflowSchemeService(testCase.args.client, testCase.args.version, nil, nil)
 			assert.NotEqual(t, got, nil)
 		})
 	}
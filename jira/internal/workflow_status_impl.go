@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/jql"
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/jira"
 )
@@ -102,6 +103,47 @@ func (w *WorkflowStatusService) Get(ctx context.Context, idOrName string) (*mode
 	return w.internalClient.Get(ctx, idOrName)
 }
 
+// Categories returns a list of all status categories.
+//
+// GET /rest/api/{2-3}/statuscategory
+//
+// https://docs.go-atlassian.io/jira-software-cloud/workflow/status#get-all-status-categories
+func (w *WorkflowStatusService) Categories(ctx context.Context) ([]*model.StatusCategoryScheme, *model.ResponseScheme, error) {
+	return w.internalClient.Categories(ctx)
+}
+
+// Category returns a status category.
+//
+// Status categories provided a mechanism for categorizing statuses.
+//
+// GET /rest/api/{2-3}/statuscategory/{idOrKey}
+//
+// https://docs.go-atlassian.io/jira-software-cloud/workflow/status#get-status-category
+func (w *WorkflowStatusService) Category(ctx context.Context, idOrKey string) (*model.StatusCategoryScheme, *model.ResponseScheme, error) {
+	return w.internalClient.Category(ctx, idOrKey)
+}
+
+// Usage returns the approximate number of issues in a status, in total and per project when projects are provided.
+//
+// POST /rest/api/{2-3}/search/approximate-count
+func (w *WorkflowStatusService) Usage(ctx context.Context, status string, projectKeys []string) (*model.WorkflowStatusUsageScheme, error) {
+	return w.internalClient.Usage(ctx, status, projectKeys)
+}
+
+// Migrate transitions the issues in a status to another status, with a bounded number of issues migrated at the same time.
+//
+// The issues are searched before the first transition, the issues that can't be migrated are reported on the returned migration.
+//
+// POST /rest/api/{2-3}/search/jql
+//
+// POST /rest/api/{2-3}/issue/{issueKeyOrID}/transitions
+func (w *WorkflowStatusService) Migrate(ctx context.Context, from, to string, options *model.WorkflowStatusMigrationOptionsScheme) (*model.WorkflowStatusMigrationScheme, error) {
+	return w.internalClient.Migrate(ctx, from, to, options)
+}
+
+// workflowStatusMigrationPageSize is the page size used by Migrate to search the issues in the status.
+const workflowStatusMigrationPageSize = 100
+
 type internalWorkflowStatusImpl struct {
 	c       service.Connector
 	version string
@@ -278,3 +320,152 @@ func (i *internalWorkflowStatusImpl) Search(ctx context.Context, options *model.
 
 	return page, response, nil
 }
+
+func (i *internalWorkflowStatusImpl) Categories(ctx context.Context) ([]*model.StatusCategoryScheme, *model.ResponseScheme, error) {
+
+	endpoint := fmt.Sprintf("rest/api/%v/statuscategory", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var categories []*model.StatusCategoryScheme
+	response, err := i.c.Call(request, &categories)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return categories, response, nil
+}
+
+func (i *internalWorkflowStatusImpl) Category(ctx context.Context, idOrKey string) (*model.StatusCategoryScheme, *model.ResponseScheme, error) {
+
+	if idOrKey == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoStatusCategoryIDOrKey)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/statuscategory/%v", i.version, idOrKey)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	category := new(model.StatusCategoryScheme)
+	response, err := i.c.Call(request, category)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return category, response, nil
+}
+
+func (i *internalWorkflowStatusImpl) Usage(ctx context.Context, status string, projectKeys []string) (*model.WorkflowStatusUsageScheme, error) {
+
+	if status == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoStatusName)
+	}
+
+	search := &internalSearchADFImpl{c: i.c, version: i.version}
+	usage := &model.WorkflowStatusUsageScheme{Status: status}
+
+	if len(projectKeys) == 0 {
+
+		count, _, err := search.ApproximateCount(ctx, workflowStatusJQL(status, nil))
+		if err != nil {
+			return nil, err
+		}
+
+		usage.Count = count.Count
+		return usage, nil
+	}
+
+	for _, projectKey := range projectKeys {
+
+		count, _, err := search.ApproximateCount(ctx, workflowStatusJQL(status, []string{projectKey}))
+		if err != nil {
+			return usage, err
+		}
+
+		usage.Count += count.Count
+		usage.Projects = append(usage.Projects, &model.WorkflowStatusProjectUsageScheme{ProjectKey: projectKey, Count: count.Count})
+	}
+
+	return usage, nil
+}
+
+func (i *internalWorkflowStatusImpl) Migrate(ctx context.Context, from, to string, options *model.WorkflowStatusMigrationOptionsScheme) (*model.WorkflowStatusMigrationScheme, error) {
+
+	if from == "" || to == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoStatusName)
+	}
+
+	if options == nil {
+		options = new(model.WorkflowStatusMigrationOptionsScheme)
+	}
+
+	migration := &model.WorkflowStatusMigrationScheme{From: from, To: to, DryRun: options.DryRun}
+
+	// The issues are collected first, the transitions change the results of the search
+	search := &internalSearchADFImpl{c: i.c, version: i.version}
+	query := workflowStatusJQL(from, options.ProjectKeys)
+
+	for nextPageToken := ""; ; {
+
+		page, _, err := search.SearchJQL(ctx, query, []string{model.IssueFieldStatus}, nil, workflowStatusMigrationPageSize, nextPageToken)
+		if err != nil {
+			return migration, err
+		}
+
+		for _, issue := range page.Issues {
+			migration.Issues = append(migration.Issues, &model.WorkflowStatusMigrationIssueScheme{IssueKey: issue.Key})
+		}
+
+		if page.NextPageToken == "" || len(page.Issues) == 0 {
+			break
+		}
+
+		nextPageToken = page.NextPageToken
+	}
+
+	if options.DryRun || len(migration.Issues) == 0 {
+		return migration, nil
+	}
+
+	concurrency := options.Concurrency
+	if concurrency <= 0 {
+		concurrency = model.WorkflowStatusMigrationConcurrency
+	}
+
+	errs := model.RunWorkers(ctx, len(migration.Issues), concurrency, options.Rate, func(index int) error {
+
+		issue := migration.Issues[index]
+
+		transition, err := transitionIssueTo(ctx, i.c, i.version, issue.IssueKey, to, options.Transition)
+		issue.Transition = transition
+
+		if err != nil {
+			return fmt.Errorf("jira: issue %v: %w", issue.IssueKey, err)
+		}
+
+		return nil
+	})
+
+	for index, issue := range migration.Issues {
+		issue.Err = errs[index]
+	}
+
+	return migration, nil
+}
+
+// workflowStatusJQL returns the JQL query of the issues in the status, restricted to the projects when provided.
+func workflowStatusJQL(status string, projectKeys []string) string {
+
+	query := jql.New()
+	if len(projectKeys) != 0 {
+		query.Project(projectKeys...)
+	}
+
+	return query.Status(jql.Equals, status).String()
+}
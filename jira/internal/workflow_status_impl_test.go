@@ -1173,3 +1173,626 @@ func Test_NewWorkflowStatusService(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalWorkflowStatusImpl_Categories(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx context.Context
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/statuscategory",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					mock.Anything).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx: context.Background(),
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/statuscategory",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					mock.Anything).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/statuscategory",
+					"",
+					nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewWorkflowStatusService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := newService.Categories(testCase.args.ctx)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalWorkflowStatusImpl_Category(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx     context.Context
+		idOrKey string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				idOrKey: "done",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/statuscategory/done",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.StatusCategoryScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:     context.Background(),
+				idOrKey: "done",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/statuscategory/done",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.StatusCategoryScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+			Err:     nil,
+		},
+
+		{
+			name:   "when the status category id or key is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				idOrKey: "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoStatusCategoryIDOrKey,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				idOrKey: "done",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/statuscategory/done",
+					"",
+					nil).
+					Return(&http.Request{}, model.ErrCreateHttpReq)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrCreateHttpReq,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewWorkflowStatusService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := newService.Category(testCase.args.ctx, testCase.args.idOrKey)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalWorkflowStatusImpl_Usage(t *testing.T) {
+
+	countMocked := func(client *mocks.Connector, jql string, count int) {
+
+		payload := struct {
+			Jql string `json:"jql,omitempty"`
+		}{
+			Jql: jql,
+		}
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPost,
+			"rest/api/3/search/approximate-count",
+			"",
+			payload).
+			Return(&http.Request{Host: jql}, nil)
+
+		client.On("Call",
+			&http.Request{Host: jql},
+			&model.IssueSearchApproximateCountScheme{}).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*model.IssueSearchApproximateCountScheme).Count = count
+			}).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx         context.Context
+		status      string
+		projectKeys []string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    *model.WorkflowStatusUsageScheme
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the projects are provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:         context.Background(),
+				status:      "In Review",
+				projectKeys: []string{"KP", "DUMMY"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				countMocked(client, `project = "KP" AND status = "In Review"`, 12)
+				countMocked(client, `project = "DUMMY" AND status = "In Review"`, 3)
+
+				fields.c = client
+			},
+			want: &model.WorkflowStatusUsageScheme{
+				Status: "In Review",
+				Count:  15,
+				Projects: []*model.WorkflowStatusProjectUsageScheme{
+					{ProjectKey: "KP", Count: 12},
+					{ProjectKey: "DUMMY", Count: 3},
+				},
+			},
+		},
+
+		{
+			name:   "when the projects are not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:    context.Background(),
+				status: "In Review",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				countMocked(client, `status = "In Review"`, 42)
+
+				fields.c = client
+			},
+			want: &model.WorkflowStatusUsageScheme{Status: "In Review", Count: 42},
+		},
+
+		{
+			name:   "when the status is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNoStatusName,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewWorkflowStatusService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, err := newService.Usage(testCase.args.ctx, testCase.args.status, testCase.args.projectKeys)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.want, gotResult)
+		})
+	}
+}
+
+func Test_internalWorkflowStatusImpl_Migrate(t *testing.T) {
+
+	searchMocked := func(client *mocks.Connector, keys ...string) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPost,
+			"rest/api/3/search/jql",
+			"",
+			mock.Anything).
+			Return(&http.Request{Host: "search"}, nil)
+
+		client.On("Call",
+			&http.Request{Host: "search"},
+			&model.IssueSearchJQLScheme{}).
+			Run(func(args mock.Arguments) {
+				page := args.Get(1).(*model.IssueSearchJQLScheme)
+				for _, key := range keys {
+					page.Issues = append(page.Issues, &model.IssueScheme{Key: key})
+				}
+			}).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	issueMocked := func(client *mocks.Connector, key string, statuses []string, transitions []*model.IssueTransitionScheme) {
+
+		for _, status := range statuses {
+
+			client.On("NewRequest",
+				context.Background(),
+				http.MethodGet,
+				"rest/api/3/issue/"+key+"?fields=status",
+				"", nil).
+				Return(&http.Request{Host: "status-" + key}, nil).
+				Once()
+
+			status := status
+			client.On("Call",
+				&http.Request{Host: "status-" + key},
+				&model.IssueScheme{}).
+				Run(func(args mock.Arguments) {
+					args.Get(1).(*model.IssueScheme).Fields = &model.IssueFieldsScheme{Status: &model.StatusScheme{Name: status}}
+				}).
+				Return(&model.ResponseScheme{}, nil).
+				Once()
+		}
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/issue/"+key+"/transitions",
+			"", nil).
+			Return(&http.Request{Host: "transitions-" + key}, nil)
+
+		client.On("Call",
+			&http.Request{Host: "transitions-" + key},
+			&model.IssueTransitionsScheme{}).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*model.IssueTransitionsScheme).Transitions = transitions
+			}).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	moveMocked := func(client *mocks.Connector, key, transitionID string) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPost,
+			"rest/api/3/issue/"+key+"/transitions",
+			"",
+			map[string]interface{}{"transition": map[string]interface{}{"id": transitionID}}).
+			Return(&http.Request{Host: "move-" + key}, nil)
+
+		client.On("Call",
+			&http.Request{Host: "move-" + key},
+			nil).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	done := &model.IssueTransitionScheme{ID: "31", Name: "Done", To: &model.StatusScheme{Name: "Done"}}
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx      context.Context
+		from, to string
+		options  *model.WorkflowStatusMigrationOptionsScheme
+	}
+
+	testCases := []struct {
+		name       string
+		fields     fields
+		args       args
+		on         func(*fields)
+		wantIssues []string
+		wantFailed int
+		wantErr    bool
+		Err        error
+	}{
+		{
+			name:   "when the issues are migrated",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				from:    "In Review",
+				to:      "Done",
+				options: &model.WorkflowStatusMigrationOptionsScheme{ProjectKeys: []string{"KP"}, Concurrency: 2, Rate: 100},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				searchMocked(client, "KP-1", "KP-2")
+
+				issueMocked(client, "KP-1", []string{"In Review", "Done"}, []*model.IssueTransitionScheme{done})
+				moveMocked(client, "KP-1", "31")
+
+				issueMocked(client, "KP-2", []string{"In Review"}, nil)
+
+				fields.c = client
+			},
+			wantIssues: []string{"KP-1", "KP-2"},
+			wantFailed: 1,
+		},
+
+		{
+			name:   "when the migration is a dry run",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				from:    "In Review",
+				to:      "Done",
+				options: &model.WorkflowStatusMigrationOptionsScheme{DryRun: true},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+				searchMocked(client, "KP-1", "KP-2")
+
+				fields.c = client
+			},
+			wantIssues: []string{"KP-1", "KP-2"},
+		},
+
+		{
+			name:   "when the target status is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:  context.Background(),
+				from: "In Review",
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNoStatusName,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewWorkflowStatusService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, err := newService.Migrate(testCase.args.ctx, testCase.args.from, testCase.args.to, testCase.args.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+				return
+			}
+
+			assert.NoError(t, err)
+
+			var gotIssues []string
+			var gotFailed int
+			for _, issue := range gotResult.Issues {
+				gotIssues = append(gotIssues, issue.IssueKey)
+
+				if issue.Err != nil {
+					gotFailed++
+				}
+			}
+
+			assert.Equal(t, testCase.wantIssues, gotIssues)
+			assert.Equal(t, testCase.wantFailed, gotFailed)
+
+			if testCase.wantFailed != 0 {
+				assert.True(t, errors.Is(gotResult.Err(), model.ErrNoTransitionToStatus))
+			}
+		})
+	}
+}
+
+func Test_workflowStatusJQL(t *testing.T) {
+
+	assert.Equal(t, `status = "In Review"`, workflowStatusJQL("In Review", nil))
+	assert.Equal(t, `project = "KP" AND status = "Done \"QA\""`, workflowStatusJQL(`Done "QA"`, []string{"KP"}))
+	assert.Equal(t, `project in ("KP", "DUMMY") AND status = "C:\\temp"`, workflowStatusJQL(`C:\temp`, []string{"KP", "DUMMY"}))
+}
@@ -27,6 +27,8 @@ func NewWorklogADFService(client service.Connector, version string) (*WorklogADF
 
 // WorklogADFService provides methods to manage worklogs in Jira Service Management.
 type WorklogADFService struct {
+	// Property is the service for managing worklog properties.
+	Property *WorklogPropertyService
 	// internalClient is the connector interface for worklog operations.
 	internalClient jira.WorklogADFConnector
 }
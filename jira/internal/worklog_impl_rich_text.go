@@ -27,6 +27,8 @@ func NewWorklogRichTextService(client service.Connector, version string) (*Workl
 
 // WorklogRichTextService provides methods to manage worklogs in Jira Service Management.
 type WorklogRichTextService struct {
+	// Property is the service for managing worklog properties.
+	Property *WorklogPropertyService
 	// internalClient is the connector interface for worklog operations.
 	internalClient jira.WorklogRichTextConnector
 }
@@ -0,0 +1,210 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+)
+
+// NewWorklogPropertyService creates a new instance of the WorklogPropertyService.
+func NewWorklogPropertyService(client service.Connector, version string) (*WorklogPropertyService, error) {
+
+	if version == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoVersionProvided)
+	}
+
+	return &WorklogPropertyService{
+		internalClient: &internalWorklogPropertyImpl{c: client, version: version},
+	}, nil
+}
+
+// WorklogPropertyService handles the worklog property methods for the Jira Cloud REST API.
+type WorklogPropertyService struct {
+	internalClient jira.WorklogPropertyConnector
+}
+
+/*
+Gets returns the keys of all properties for a worklog.
+
+Permissions required:
+  - Browse projects project permission for the project that the issue is in.
+  - If issue-level security is configured, issue-level security permission to view the issue.
+  - If the worklog has visibility restrictions, belongs to the group or has the role visibility is restricted to.
+
+Endpoint: GET /rest/api/{apiVersion}/issue/{issueKeyOrID}/worklog/{worklogID}/properties
+
+You can refer to the documentation: [Get worklog property keys]
+
+[Get worklog property keys]: https://docs.go-atlassian.io/jira-software-cloud/issues/worklogs/properties#get-worklog-property-keys
+*/
+func (w *WorklogPropertyService) Gets(ctx context.Context, issueKeyOrID, worklogID string) (*model.PropertyPageScheme, *model.ResponseScheme, error) {
+	return w.internalClient.Gets(ctx, issueKeyOrID, worklogID)
+}
+
+/*
+Get returns the value of a worklog property.
+
+Permissions required:
+  - Browse projects project permission for the project that the issue is in.
+  - If issue-level security is configured, issue-level security permission to view the issue.
+  - If the worklog has visibility restrictions, belongs to the group or has the role visibility is restricted to.
+
+Endpoint: GET /rest/api/{apiVersion}/issue/{issueKeyOrID}/worklog/{worklogID}/properties/{propertyKey}
+
+You can refer to the documentation: [Get worklog property]
+
+[Get worklog property]: https://docs.go-atlassian.io/jira-software-cloud/issues/worklogs/properties#get-worklog-property
+*/
+func (w *WorklogPropertyService) Get(ctx context.Context, issueKeyOrID, worklogID, propertyKey string) (*model.EntityPropertyScheme, *model.ResponseScheme, error) {
+	return w.internalClient.Get(ctx, issueKeyOrID, worklogID, propertyKey)
+}
+
+/*
+Set sets the value of a worklog property. Use this operation to store custom data against the worklog.
+  - The value of the request body must be a valid, non-empty JSON blob. The maximum length is 32768 characters.
+
+Permissions required:
+  - Browse projects and Edit all worklogs project permission, or Edit own worklogs to update worklogs created by the user.
+  - If issue-level security is configured, issue-level security permission to view the issue.
+  - If the worklog has visibility restrictions, belongs to the group or has the role visibility is restricted to.
+
+Endpoint: PUT /rest/api/{apiVersion}/issue/{issueKeyOrID}/worklog/{worklogID}/properties/{propertyKey}
+
+You can refer to the documentation: [Set worklog property]
+
+[Set worklog property]: https://docs.go-atlassian.io/jira-software-cloud/issues/worklogs/properties#set-worklog-property
+*/
+func (w *WorklogPropertyService) Set(ctx context.Context, issueKeyOrID, worklogID, propertyKey string, payload interface{}) (*model.ResponseScheme, error) {
+	return w.internalClient.Set(ctx, issueKeyOrID, worklogID, propertyKey, payload)
+}
+
+/*
+Delete deletes a worklog property.
+
+Permissions required:
+  - Browse projects and Edit all worklogs project permission, or Edit own worklogs to update worklogs created by the user.
+  - If issue-level security is configured, issue-level security permission to view the issue.
+  - If the worklog has visibility restrictions, belongs to the group or has the role visibility is restricted to.
+
+Endpoint: DELETE /rest/api/{apiVersion}/issue/{issueKeyOrID}/worklog/{worklogID}/properties/{propertyKey}
+
+You can refer to the documentation: [Delete worklog property]
+
+[Delete worklog property]: https://docs.go-atlassian.io/jira-software-cloud/issues/worklogs/properties#delete-worklog-property
+*/
+func (w *WorklogPropertyService) Delete(ctx context.Context, issueKeyOrID, worklogID, propertyKey string) (*model.ResponseScheme, error) {
+	return w.internalClient.Delete(ctx, issueKeyOrID, worklogID, propertyKey)
+}
+
+type internalWorklogPropertyImpl struct {
+	c       service.Connector
+	version string
+}
+
+func (i *internalWorklogPropertyImpl) Gets(ctx context.Context, issueKeyOrID, worklogID string) (*model.PropertyPageScheme, *model.ResponseScheme, error) {
+
+	if issueKeyOrID == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoIssueKeyOrID)
+	}
+
+	if worklogID == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoWorklogID)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/worklog/%v/properties", i.version, issueKeyOrID, worklogID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	properties := new(model.PropertyPageScheme)
+	response, err := i.c.Call(request, properties)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return properties, response, nil
+}
+
+func (i *internalWorklogPropertyImpl) Get(ctx context.Context, issueKeyOrID, worklogID, propertyKey string) (*model.EntityPropertyScheme, *model.ResponseScheme, error) {
+
+	if issueKeyOrID == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoIssueKeyOrID)
+	}
+
+	if worklogID == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoWorklogID)
+	}
+
+	if propertyKey == "" {
+		return nil, nil, fmt.Errorf("jira: %w", model.ErrNoPropertyKey)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/worklog/%v/properties/%v", i.version, issueKeyOrID, worklogID, propertyKey)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	property := new(model.EntityPropertyScheme)
+	response, err := i.c.Call(request, property)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return property, response, nil
+}
+
+func (i *internalWorklogPropertyImpl) Set(ctx context.Context, issueKeyOrID, worklogID, propertyKey string, payload interface{}) (*model.ResponseScheme, error) {
+
+	if issueKeyOrID == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoIssueKeyOrID)
+	}
+
+	if worklogID == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoWorklogID)
+	}
+
+	if propertyKey == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoPropertyKey)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/worklog/%v/properties/%v", i.version, issueKeyOrID, worklogID, propertyKey)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalWorklogPropertyImpl) Delete(ctx context.Context, issueKeyOrID, worklogID, propertyKey string) (*model.ResponseScheme, error) {
+
+	if issueKeyOrID == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoIssueKeyOrID)
+	}
+
+	if worklogID == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoWorklogID)
+	}
+
+	if propertyKey == "" {
+		return nil, fmt.Errorf("jira: %w", model.ErrNoPropertyKey)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/worklog/%v/properties/%v", i.version, issueKeyOrID, worklogID, propertyKey)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
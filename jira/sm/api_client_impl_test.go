@@ -553,3 +553,18 @@ func TestNew(t *testing.T) {
 		})
 	}
 }
+
+func TestWithExperimentalAPIs(t *testing.T) {
+
+	client, err := New(http.DefaultClient, "https://ctreminiom.atlassian.net")
+	assert.NoError(t, err)
+	assert.False(t, client.ExperimentalAPIs())
+
+	client, err = New(http.DefaultClient, "https://ctreminiom.atlassian.net", WithExperimentalAPIs())
+	assert.NoError(t, err)
+	assert.True(t, client.ExperimentalAPIs())
+
+	request, err := client.NewRequest(context.Background(), http.MethodGet, "rest/servicedeskapi/knowledgebase/article", "", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "opt-in", request.Header.Get("X-ExperimentalApi"))
+}
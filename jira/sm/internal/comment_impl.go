@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -51,6 +52,15 @@ func (s *CommentService) Get(ctx context.Context, issueKeyOrID string, commentID
 	return s.internalClient.Get(ctx, issueKeyOrID, commentID, expand)
 }
 
+// GetWithAttachments returns a customer request's comment with its rendered body and attachments metadata in a single call.
+//
+// GET /rest/servicedeskapi/request/{issueKeyOrID}/comment/{commentID}?expand=attachment,renderedBody
+//
+// https://docs.go-atlassian.io/jira-service-management-cloud/request/comments#get-request-comment-by-id
+func (s *CommentService) GetWithAttachments(ctx context.Context, issueKeyOrID string, commentID int) (*model.RequestCommentScheme, *model.ResponseScheme, error) {
+	return s.internalClient.GetWithAttachments(ctx, issueKeyOrID, commentID)
+}
+
 // Create creates a public or private (internal) comment on a customer request, with the comment visibility set by public.
 //
 // POST /rest/servicedeskapi/request/{issueKeyOrID}/comment
@@ -88,6 +98,19 @@ func (i *internalServiceRequestCommentImpl) Gets(ctx context.Context, issueKeyOr
 			return nil, nil, err
 		}
 
+		switch options.Visibility {
+		case model.RequestCommentVisibilityPublic:
+			q.Set("public", "true")
+			q.Set("internal", "false")
+		case model.RequestCommentVisibilityInternal:
+			q.Set("public", "false")
+			q.Set("internal", "true")
+		}
+
+		if options.RenderedBody && !slices.Contains(options.Expand, model.RequestCommentExpandRenderedBody) {
+			q.Add("expand", model.RequestCommentExpandRenderedBody)
+		}
+
 		endpoint += "?" + q.Encode()
 	}
 
@@ -139,6 +162,10 @@ func (i *internalServiceRequestCommentImpl) Get(ctx context.Context, issueKeyOrI
 	return comment, res, nil
 }
 
+func (i *internalServiceRequestCommentImpl) GetWithAttachments(ctx context.Context, issueKeyOrID string, commentID int) (*model.RequestCommentScheme, *model.ResponseScheme, error) {
+	return i.Get(ctx, issueKeyOrID, commentID, []string{model.RequestCommentExpandAttachment, model.RequestCommentExpandRenderedBody})
+}
+
 func (i *internalServiceRequestCommentImpl) Create(ctx context.Context, issueKeyOrID, body string, public bool) (*model.RequestCommentScheme, *model.ResponseScheme, error) {
 
 	if issueKeyOrID == "" {
@@ -70,6 +70,39 @@ func Test_internalServiceRequestCommentImpl_Gets(t *testing.T) {
 			},
 		},
 
+		{
+			name: "when the visibility and the rendered body are provided",
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-2",
+				options: &model.RequestCommentOptionsScheme{
+					Public:       &truePtr,
+					Visibility:   model.RequestCommentVisibilityInternal,
+					RenderedBody: true,
+					Expand:       []string{model.RequestCommentExpandAttachment},
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/servicedeskapi/request/DUMMY-2/comment?expand=attachment&expand=renderedBody&internal=true&public=false",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.RequestCommentPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
 		{
 			name: "when the http call cannot be executed",
 			args: args{
@@ -352,6 +385,126 @@ func Test_internalServiceRequestCommentImpl_Get(t *testing.T) {
 	}
 }
 
+func Test_internalServiceRequestCommentImpl_GetWithAttachments(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx          context.Context
+		issueKeyOrID string
+		commentID    int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-2",
+				commentID:    10001,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/servicedeskapi/request/DUMMY-2/comment/10001?expand=attachment%2CrenderedBody",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.RequestCommentScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http call cannot be executed",
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-2",
+				commentID:    10001,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/servicedeskapi/request/DUMMY-2/comment/10001?expand=attachment%2CrenderedBody",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.RequestCommentScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrNoHttpResponse)
+
+				fields.c = client
+			},
+			Err:     model.ErrNoHttpResponse,
+			wantErr: true,
+		},
+
+		{
+			name: "when the comment id is not provided",
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-2",
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			Err:     model.ErrNoCommentID,
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			commentService := NewCommentService(testCase.fields.c, "latest")
+
+			gotResult, gotResponse, err := commentService.GetWithAttachments(testCase.args.ctx, testCase.args.issueKeyOrID, testCase.args.commentID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+		})
+	}
+}
+
 func Test_internalServiceRequestCommentImpl_Create(t *testing.T) {
 
 	type fields struct {
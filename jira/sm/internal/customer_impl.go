@@ -2,10 +2,12 @@ package internal
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
@@ -41,6 +43,24 @@ func (c *CustomerService) Create(ctx context.Context, email, displayName string)
 	return c.internalClient.Create(ctx, email, displayName)
 }
 
+// Ensure returns the customer with the email provided, creating it when missing, and adds it to the service desk.
+//
+// When the account already exists on the site, the 409 conflict is handled by searching the users of the site by
+// exact email address, so an existing account is added to the service desk even when it isn't a customer of it yet.
+// An account whose email is hidden can't be matched and is reported with ErrCustomerNotFound, it's added by account
+// ID with Add instead.
+//
+// POST /rest/servicedeskapi/customer
+//
+// GET /rest/api/3/user/search
+//
+// POST /rest/servicedeskapi/servicedesk/{serviceDeskId}/customer
+//
+// https://docs.go-atlassian.io/jira-service-management-cloud/customer#create-customer
+func (c *CustomerService) Ensure(ctx context.Context, serviceDeskID, email, displayName string) (*model.CustomerScheme, *model.ResponseScheme, error) {
+	return c.internalClient.Ensure(ctx, serviceDeskID, email, displayName)
+}
+
 // Gets  returns a list of the customers on a service desk.
 //
 // GET /rest/servicedeskapi/servicedesk/{serviceDeskId}/customer
@@ -96,6 +116,82 @@ func (i *internalCustomerImpl) Create(ctx context.Context, email, displayName st
 	return customer, res, nil
 }
 
+func (i *internalCustomerImpl) Ensure(ctx context.Context, serviceDeskID, email, displayName string) (*model.CustomerScheme, *model.ResponseScheme, error) {
+
+	if serviceDeskID == "" {
+		return nil, nil, fmt.Errorf("sm: %w", model.ErrNoServiceDeskID)
+	}
+
+	if email == "" {
+		return nil, nil, fmt.Errorf("sm: %w", model.ErrNoCustomerEmail)
+	}
+
+	if displayName == "" {
+		displayName = email
+	}
+
+	customer, res, err := i.Create(ctx, email, displayName)
+	if err != nil {
+
+		if !errors.Is(err, model.ErrConflict) {
+			return nil, res, err
+		}
+
+		customer, res, err = i.lookup(ctx, email)
+		if err != nil {
+			return nil, res, err
+		}
+	}
+
+	res, err = i.Add(ctx, serviceDeskID, []string{customer.AccountID})
+	if err != nil {
+		return nil, res, err
+	}
+
+	return customer, res, nil
+}
+
+// lookup returns the account of the site with the email provided.
+//
+// A 409 conflict means the account exists on the site, not necessarily on the service desk, so the account is searched
+// with the user search of the platform and matched on the exact email address. An account whose email is hidden by
+// its profile visibility is not found.
+func (i *internalCustomerImpl) lookup(ctx context.Context, email string) (*model.CustomerScheme, *model.ResponseScheme, error) {
+
+	params := url.Values{}
+	params.Add("query", email)
+	params.Add("maxResults", "50")
+
+	endpoint := fmt.Sprintf("rest/api/3/user/search?%v", params.Encode())
+
+	req, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var users []*model.UserScheme
+	res, err := i.c.Call(req, &users)
+	if err != nil {
+		return nil, res, err
+	}
+
+	for _, user := range users {
+		if user != nil && strings.EqualFold(user.EmailAddress, email) {
+			return &model.CustomerScheme{
+				AccountID:    user.AccountID,
+				Name:         user.Name,
+				Key:          user.Key,
+				EmailAddress: user.EmailAddress,
+				DisplayName:  user.DisplayName,
+				Active:       user.Active,
+				TimeZone:     user.TimeZone,
+			}, res, nil
+		}
+	}
+
+	return nil, res, fmt.Errorf("sm: %w: %v", model.ErrCustomerNotFound, email)
+}
+
 func (i *internalCustomerImpl) Gets(ctx context.Context, serviceDeskID string, query string, start, limit int) (*model.CustomerPageScheme, *model.ResponseScheme, error) {
 
 	params := url.Values{}
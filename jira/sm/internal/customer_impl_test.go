@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
@@ -153,6 +154,239 @@ func Test_internalCustomerImpl_Create(t *testing.T) {
 	}
 }
 
+func Test_internalCustomerImpl_Ensure(t *testing.T) {
+
+	mockCreate := func(client *mocks.Connector, err error) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPost,
+			"rest/servicedeskapi/customer",
+			"",
+			map[string]interface{}{"displayName": "Jane", "email": "jane@example.com"}).
+			Return(&http.Request{}, nil)
+
+		client.On("Call",
+			&http.Request{},
+			&model.CustomerScheme{}).
+			Run(func(args mock.Arguments) {
+				if err == nil {
+					args.Get(1).(*model.CustomerScheme).AccountID = "account-id-sample"
+				}
+			}).
+			Return(&model.ResponseScheme{}, err)
+	}
+
+	mockLookup := func(client *mocks.Connector, users ...*model.UserScheme) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/api/3/user/search?maxResults=50&query=jane%40example.com",
+			"",
+			nil).
+			Return(&http.Request{Host: "search"}, nil)
+
+		client.On("Call",
+			&http.Request{Host: "search"},
+			new([]*model.UserScheme)).
+			Run(func(args mock.Arguments) {
+				*args.Get(1).(*[]*model.UserScheme) = users
+			}).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	mockAdd := func(client *mocks.Connector) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPost,
+			"rest/servicedeskapi/servicedesk/29990/customer",
+			"",
+			map[string]interface{}{"accountIds": []string{"account-id-sample"}}).
+			Return(&http.Request{}, nil)
+
+		client.On("Call",
+			&http.Request{},
+			nil).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx                               context.Context
+		serviceDeskID, email, displayName string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the customer is created",
+			args: args{
+				ctx:           context.Background(),
+				serviceDeskID: "29990",
+				email:         "jane@example.com",
+				displayName:   "Jane",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				mockCreate(client, nil)
+				mockAdd(client)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the account exists on the site but not on the service desk",
+			args: args{
+				ctx:           context.Background(),
+				serviceDeskID: "29990",
+				email:         "jane@example.com",
+				displayName:   "Jane",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				mockCreate(client, model.ErrConflict)
+				mockLookup(client, &model.UserScheme{AccountID: "account-id-other", EmailAddress: "jane.doe@example.com"},
+					&model.UserScheme{AccountID: "account-id-sample", EmailAddress: "Jane@Example.com"})
+				mockAdd(client)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the existing customer cannot be found",
+			args: args{
+				ctx:           context.Background(),
+				serviceDeskID: "29990",
+				email:         "jane@example.com",
+				displayName:   "Jane",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				mockCreate(client, model.ErrConflict)
+				mockLookup(client)
+
+				fields.c = client
+			},
+			Err:     model.ErrCustomerNotFound,
+			wantErr: true,
+		},
+
+		{
+			name: "when the email of the existing customer is hidden",
+			args: args{
+				ctx:           context.Background(),
+				serviceDeskID: "29990",
+				email:         "jane@example.com",
+				displayName:   "Jane",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				mockCreate(client, model.ErrConflict)
+				mockLookup(client, &model.UserScheme{AccountID: "account-id-other"})
+
+				fields.c = client
+			},
+			Err:     model.ErrCustomerNotFound,
+			wantErr: true,
+		},
+
+		{
+			name: "when the customer cannot be created",
+			args: args{
+				ctx:           context.Background(),
+				serviceDeskID: "29990",
+				email:         "jane@example.com",
+				displayName:   "Jane",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				mockCreate(client, model.ErrUnauthorized)
+
+				fields.c = client
+			},
+			Err:     model.ErrUnauthorized,
+			wantErr: true,
+		},
+
+		{
+			name: "when the email is not provided",
+			args: args{
+				ctx:           context.Background(),
+				serviceDeskID: "29990",
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			Err:     model.ErrNoCustomerEmail,
+			wantErr: true,
+		},
+
+		{
+			name: "when the service desk id is not provided",
+			args: args{
+				ctx:   context.Background(),
+				email: "jane@example.com",
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			Err:     model.ErrNoServiceDeskID,
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			customerService := NewCustomerService(testCase.fields.c, "latest")
+
+			gotResult, gotResponse, err := customerService.Ensure(testCase.args.ctx, testCase.args.serviceDeskID, testCase.args.email,
+				testCase.args.displayName)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.Equal(t, "account-id-sample", gotResult.AccountID)
+			}
+		})
+	}
+}
+
 func Test_internalCustomerImpl_Gets(t *testing.T) {
 
 	type fields struct {
@@ -0,0 +1,25 @@
+package internal
+
+import (
+	"fmt"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+)
+
+// experimentalConnector is implemented by the connectors that know if the experimental APIs are enabled, e.g. the sm.Client.
+type experimentalConnector interface {
+	ExperimentalAPIs() bool
+}
+
+// checkExperimental returns ErrExperimentalAPI when the connector reports the experimental APIs as disabled.
+//
+// The connectors that don't implement experimentalConnector are not gated.
+func checkExperimental(c service.Connector) error {
+
+	if gate, ok := c.(experimentalConnector); ok && !gate.ExperimentalAPIs() {
+		return fmt.Errorf("sm: %w", model.ErrExperimentalAPI)
+	}
+
+	return nil
+}
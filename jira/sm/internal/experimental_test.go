@@ -0,0 +1,38 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+// experimentalConnectorMock is a connector that reports if the experimental APIs are enabled.
+type experimentalConnectorMock struct {
+	*mocks.Connector
+	enabled bool
+}
+
+func (e *experimentalConnectorMock) ExperimentalAPIs() bool {
+	return e.enabled
+}
+
+func Test_checkExperimental(t *testing.T) {
+
+	assert.NoError(t, checkExperimental(mocks.NewConnector(t)))
+	assert.NoError(t, checkExperimental(&experimentalConnectorMock{Connector: mocks.NewConnector(t), enabled: true}))
+	assert.ErrorIs(t, checkExperimental(&experimentalConnectorMock{Connector: mocks.NewConnector(t)}), model.ErrExperimentalAPI)
+}
+
+func Test_internalWorkSpaceImpl_Gets_Experimental(t *testing.T) {
+
+	client := &experimentalConnectorMock{Connector: mocks.NewConnector(t)}
+
+	page, response, err := NewWorkSpaceService(client, "latest").Gets(context.Background())
+
+	assert.ErrorIs(t, err, model.ErrExperimentalAPI)
+	assert.Nil(t, page)
+	assert.Nil(t, response)
+}
@@ -25,6 +25,8 @@ type FeedbackService struct {
 
 // Get retrieves a feedback of a request using it's requestKey or requestId
 //
+// This endpoint is experimental, it requires the WithExperimentalAPIs client option.
+//
 // GET /rest/servicedeskapi/request/{requestIdOrKey}/feedback
 //
 // https://docs.go-atlassian.io/jira-service-management-cloud/request/feedback#get-feedback
@@ -34,6 +36,8 @@ func (s *FeedbackService) Get(ctx context.Context, requestIDOrKey string) (*mode
 
 // Post adds a feedback on a request using its requestKey or requestId
 //
+// This endpoint is experimental, it requires the WithExperimentalAPIs client option.
+//
 // POST /rest/servicedeskapi/request/{requestIdOrKey}/feedback
 //
 // https://docs.go-atlassian.io/jira-service-management-cloud/request/feedback#post-feedback
@@ -43,6 +47,8 @@ func (s *FeedbackService) Post(ctx context.Context, requestIDOrKey string, ratin
 
 // Delete deletes the feedback of request using its requestKey or requestId
 //
+// This endpoint is experimental, it requires the WithExperimentalAPIs client option.
+//
 // DELETE /rest/servicedeskapi/request/{requestIdOrKey}/feedback
 //
 // https://docs.go-atlassian.io/jira-service-management-cloud/request/feedback#delete-feedback
@@ -57,6 +63,10 @@ type internalServiceRequestFeedbackImpl struct {
 
 func (i *internalServiceRequestFeedbackImpl) Get(ctx context.Context, requestIDOrKey string) (*model.CustomerFeedbackScheme, *model.ResponseScheme, error) {
 
+	if err := checkExperimental(i.c); err != nil {
+		return nil, nil, err
+	}
+
 	if requestIDOrKey == "" {
 		return nil, nil, fmt.Errorf("sm: %w", model.ErrNoIssueKeyOrID)
 	}
@@ -79,6 +89,10 @@ func (i *internalServiceRequestFeedbackImpl) Get(ctx context.Context, requestIDO
 
 func (i *internalServiceRequestFeedbackImpl) Post(ctx context.Context, requestIDOrKey string, rating int, comment string) (*model.CustomerFeedbackScheme, *model.ResponseScheme, error) {
 
+	if err := checkExperimental(i.c); err != nil {
+		return nil, nil, err
+	}
+
 	if requestIDOrKey == "" {
 		return nil, nil, fmt.Errorf("sm: %w", model.ErrNoIssueKeyOrID)
 	}
@@ -109,6 +123,10 @@ func (i *internalServiceRequestFeedbackImpl) Post(ctx context.Context, requestID
 
 func (i *internalServiceRequestFeedbackImpl) Delete(ctx context.Context, requestIDOrKey string) (*model.ResponseScheme, error) {
 
+	if err := checkExperimental(i.c); err != nil {
+		return nil, err
+	}
+
 	if requestIDOrKey == "" {
 		return nil, fmt.Errorf("sm: %w", model.ErrNoIssueKeyOrID)
 	}
@@ -27,6 +27,8 @@ type KnowledgebaseService struct {
 
 // Search returns articles which match the given query string across all service desks.
 //
+// This endpoint is experimental, it requires the WithExperimentalAPIs client option.
+//
 // GET /rest/servicedeskapi/knowledgebase/article
 //
 // https://docs.go-atlassian.io/jira-service-management-cloud/knowledgebase#search-articles
@@ -36,6 +38,8 @@ func (k *KnowledgebaseService) Search(ctx context.Context, query string, highlig
 
 // Gets returns articles which match the given query string across all service desks.
 //
+// This endpoint is experimental, it requires the WithExperimentalAPIs client option.
+//
 // GET /rest/servicedeskapi/servicedesk/{serviceDeskId}/knowledgebase/article
 //
 // https://docs.go-atlassian.io/jira-service-management-cloud/knowledgebase#get-articles
@@ -50,6 +54,10 @@ type internalKnowledgebaseImpl struct {
 
 func (i *internalKnowledgebaseImpl) Search(ctx context.Context, query string, highlight bool, start, limit int) (*model.ArticlePageScheme, *model.ResponseScheme, error) {
 
+	if err := checkExperimental(i.c); err != nil {
+		return nil, nil, err
+	}
+
 	if query == "" {
 		return nil, nil, fmt.Errorf("sm: %w", model.ErrNoKBQuery)
 	}
@@ -78,6 +86,10 @@ func (i *internalKnowledgebaseImpl) Search(ctx context.Context, query string, hi
 
 func (i *internalKnowledgebaseImpl) Gets(ctx context.Context, serviceDeskID int, query string, highlight bool, start, limit int) (*model.ArticlePageScheme, *model.ResponseScheme, error) {
 
+	if err := checkExperimental(i.c); err != nil {
+		return nil, nil, err
+	}
+
 	if serviceDeskID == 0 {
 		return nil, nil, fmt.Errorf("sm: %w", model.ErrNoServiceDeskID)
 	}
@@ -2,15 +2,23 @@ package internal
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/sm"
 	"net/http"
 	"net/url"
+	"slices"
 	"strconv"
 )
 
+// organizationUserBatchSize is the maximum number of users added to an organization per request by BulkAdd.
+const organizationUserBatchSize = 50
+
+// organizationUserConcurrency is the maximum number of batches added at the same time by BulkAdd.
+const organizationUserConcurrency = 5
+
 // NewOrganizationService creates a new instance of OrganizationService.
 // It takes a service.Connector and a version string as input and returns a pointer to OrganizationService.
 func NewOrganizationService(client service.Connector, version string) *OrganizationService {
@@ -93,6 +101,17 @@ func (o *OrganizationService) Add(ctx context.Context, organizationID int, accou
 	return o.internalClient.Add(ctx, organizationID, accountIDs)
 }
 
+// BulkAdd adds a large list of users to an organization.
+//
+// The account IDs are split in batches of 50 users, added with bounded concurrency, and the errors of the failed batches are joined.
+//
+// POST /rest/servicedeskapi/organization/{organizationId}/user
+//
+// https://docs.go-atlassian.io/jira-service-management-cloud/organization#add-users-to-organization
+func (o *OrganizationService) BulkAdd(ctx context.Context, organizationID int, accountIDs []string) error {
+	return o.internalClient.BulkAdd(ctx, organizationID, accountIDs)
+}
+
 // Remove removes users from an organization.
 //
 // DELETE /rest/servicedeskapi/organization/{organizationId}/user
@@ -274,6 +293,31 @@ func (i *internalOrganizationImpl) Add(ctx context.Context, organizationID int,
 	return i.c.Call(req, nil)
 }
 
+func (i *internalOrganizationImpl) BulkAdd(ctx context.Context, organizationID int, accountIDs []string) error {
+
+	if organizationID == 0 {
+		return fmt.Errorf("sm: %w", model.ErrNoOrganizationID)
+	}
+
+	if len(accountIDs) == 0 {
+		return fmt.Errorf("sm: %w", model.ErrNoAccountSlice)
+	}
+
+	batches := slices.Collect(slices.Chunk(accountIDs, organizationUserBatchSize))
+
+	errs := model.RunWorkers(ctx, len(batches), organizationUserConcurrency, 0, func(index int) error {
+
+		batch := batches[index]
+		if _, err := i.Add(ctx, organizationID, batch); err != nil {
+			return fmt.Errorf("sm: accounts %v to %v: %w", batch[0], batch[len(batch)-1], err)
+		}
+
+		return nil
+	})
+
+	return errors.Join(errs...)
+}
+
 func (i *internalOrganizationImpl) Remove(ctx context.Context, organizationID int, accountIDs []string) (*model.ResponseScheme, error) {
 
 	if organizationID == 0 {
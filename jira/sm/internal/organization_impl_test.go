@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
@@ -841,6 +842,146 @@ func Test_internalOrganizationImpl_Add(t *testing.T) {
 	}
 }
 
+func Test_internalOrganizationImpl_BulkAdd(t *testing.T) {
+
+	accountIDs := make([]string, 120)
+	for index := range accountIDs {
+		accountIDs[index] = fmt.Sprintf("account-id-%v", index)
+	}
+
+	mockBatches := func(client *mocks.Connector, failed error) {
+
+		for index, batch := range [][]string{accountIDs[:50], accountIDs[50:100], accountIDs[100:]} {
+
+			request := &http.Request{Host: fmt.Sprintf("batch-%v", index)}
+
+			client.On("NewRequest",
+				context.Background(),
+				http.MethodPost,
+				"rest/servicedeskapi/organization/10001/user",
+				"",
+				map[string]interface{}{"accountIds": batch}).
+				Return(request, nil)
+
+			var err error
+			if index == 1 {
+				err = failed
+			}
+
+			client.On("Call",
+				request,
+				nil).
+				Return(&model.ResponseScheme{}, err)
+		}
+	}
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx            context.Context
+		organizationID int
+		accountIDs     []string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:            context.Background(),
+				organizationID: 10001,
+				accountIDs:     accountIDs,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				mockBatches(client, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when a batch cannot be added",
+			args: args{
+				ctx:            context.Background(),
+				organizationID: 10001,
+				accountIDs:     accountIDs,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				mockBatches(client, model.ErrNoHttpResponse)
+
+				fields.c = client
+			},
+			Err:     model.ErrNoHttpResponse,
+			wantErr: true,
+		},
+
+		{
+			name: "when the account ids are not provided",
+			args: args{
+				ctx:            context.Background(),
+				organizationID: 10001,
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			Err:     model.ErrNoAccountSlice,
+			wantErr: true,
+		},
+
+		{
+			name: "when the organization id is not provided",
+			args: args{
+				ctx:        context.Background(),
+				accountIDs: accountIDs,
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			Err:     model.ErrNoOrganizationID,
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			organizationService := NewOrganizationService(testCase.fields.c, "latest")
+
+			err := organizationService.BulkAdd(testCase.args.ctx, testCase.args.organizationID, testCase.args.accountIDs)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func Test_internalOrganizationImpl_Remove(t *testing.T) {
 
 	type fields struct {
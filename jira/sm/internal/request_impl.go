@@ -88,6 +88,17 @@ func (s *RequestService) Create(ctx context.Context, payload *model.CreateCustom
 	return s.internalClient.Create(ctx, payload)
 }
 
+// CreateOnBehalfOf creates a customer request on behalf of the customer identified by email.
+//
+// The customer is looked up in the service desk of the payload and, when permitted by CreateCustomer, created and added to the service desk.
+//
+// POST /rest/servicedeskapi/request
+//
+// https://docs.go-atlassian.io/jira-service-management/request#create-customer-request
+func (s *RequestService) CreateOnBehalfOf(ctx context.Context, payload *model.CreateCustomerRequestPayloadScheme, customer *model.RequestOnBehalfOfScheme) (*model.CustomerRequestScheme, *model.ResponseScheme, error) {
+	return s.internalClient.CreateOnBehalfOf(ctx, payload, customer)
+}
+
 // Gets returns all customer requests for the user executing the query.
 //
 // The returned customer requests are ordered chronologically by the latest activity on each request. For example, the latest status transition or comment.
@@ -169,6 +180,56 @@ func (i *internalServiceRequestImpl) Create(ctx context.Context, payload *model.
 	return serviceRequest, res, nil
 }
 
+func (i *internalServiceRequestImpl) CreateOnBehalfOf(ctx context.Context, payload *model.CreateCustomerRequestPayloadScheme, customer *model.RequestOnBehalfOfScheme) (*model.CustomerRequestScheme, *model.ResponseScheme, error) {
+
+	if payload == nil || payload.ServiceDeskID == "" {
+		return nil, nil, fmt.Errorf("sm: %w", model.ErrNoServiceDeskID)
+	}
+
+	if customer == nil || customer.Email == "" {
+		return nil, nil, fmt.Errorf("sm: %w", model.ErrNoCustomerEmail)
+	}
+
+	accountID, res, err := i.resolveCustomer(ctx, payload.ServiceDeskID, customer)
+	if err != nil {
+		return nil, res, err
+	}
+
+	onBehalfOf := *payload
+	onBehalfOf.RaiseOnBehalfOf = accountID
+
+	return i.Create(ctx, &onBehalfOf)
+}
+
+// resolveCustomer returns the account ID of the service desk customer matching the email,
+// creating the customer, or adding the existing account of the site to the service desk, when permitted.
+func (i *internalServiceRequestImpl) resolveCustomer(ctx context.Context, serviceDeskID string, customer *model.RequestOnBehalfOfScheme) (string, *model.ResponseScheme, error) {
+
+	customerService := &internalCustomerImpl{c: i.c, version: i.version}
+
+	page, res, err := customerService.Gets(ctx, serviceDeskID, customer.Email, 0, 50)
+	if err != nil {
+		return "", res, err
+	}
+
+	for _, value := range page.Values {
+		if strings.EqualFold(value.EmailAddress, customer.Email) {
+			return value.AccountID, res, nil
+		}
+	}
+
+	if !customer.CreateCustomer {
+		return "", res, fmt.Errorf("sm: %w: %v", model.ErrCustomerNotFound, customer.Email)
+	}
+
+	created, res, err := customerService.Ensure(ctx, serviceDeskID, customer.Email, customer.DisplayName)
+	if err != nil {
+		return "", res, err
+	}
+
+	return created.AccountID, res, nil
+}
+
 func (i *internalServiceRequestImpl) Gets(ctx context.Context, options *model.ServiceRequestOptionScheme, start, limit int) (*model.CustomerRequestPageScheme, *model.ResponseScheme, error) {
 
 	params := url.Values{}
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
@@ -1135,3 +1136,213 @@ func Test_internalServiceRequestImpl_Create(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalServiceRequestImpl_CreateOnBehalfOf(t *testing.T) {
+
+	payloadMocked := &model.CreateCustomerRequestPayloadScheme{
+		ServiceDeskID: "29990",
+		RequestTypeID: "28881",
+	}
+
+	onBehalfOfMocked := &model.CreateCustomerRequestPayloadScheme{
+		RaiseOnBehalfOf: "account-id-sample",
+		ServiceDeskID:   "29990",
+		RequestTypeID:   "28881",
+	}
+
+	mockCustomers := func(client *mocks.Connector, customers ...*model.CustomerScheme) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodGet,
+			"rest/servicedeskapi/servicedesk/29990/customer?limit=50&query=jane%40example.com&start=0",
+			"",
+			nil).
+			Return(&http.Request{}, nil)
+
+		client.On("Call",
+			&http.Request{},
+			&model.CustomerPageScheme{}).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*model.CustomerPageScheme).Values = customers
+			}).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	mockRequest := func(client *mocks.Connector) {
+
+		client.On("NewRequest",
+			context.Background(),
+			http.MethodPost,
+			"rest/servicedeskapi/request",
+			"",
+			onBehalfOfMocked).
+			Return(&http.Request{}, nil)
+
+		client.On("Call",
+			&http.Request{},
+			&model.CustomerRequestScheme{}).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx      context.Context
+		payload  *model.CreateCustomerRequestPayloadScheme
+		customer *model.RequestOnBehalfOfScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the customer exists",
+			args: args{
+				ctx:      context.Background(),
+				payload:  payloadMocked,
+				customer: &model.RequestOnBehalfOfScheme{Email: "jane@example.com"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				mockCustomers(client, &model.CustomerScheme{AccountID: "account-id-sample", EmailAddress: "Jane@Example.com"})
+				mockRequest(client)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the customer is created",
+			args: args{
+				ctx:      context.Background(),
+				payload:  payloadMocked,
+				customer: &model.RequestOnBehalfOfScheme{Email: "jane@example.com", DisplayName: "Jane", CreateCustomer: true},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				mockCustomers(client, &model.CustomerScheme{AccountID: "account-id-other", EmailAddress: "jane.doe@example.com"})
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/servicedeskapi/customer",
+					"",
+					map[string]interface{}{"displayName": "Jane", "email": "jane@example.com"}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.CustomerScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.CustomerScheme).AccountID = "account-id-sample"
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/servicedeskapi/servicedesk/29990/customer",
+					"",
+					map[string]interface{}{"accountIds": []string{"account-id-sample"}}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				mockRequest(client)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the customer doesn't exist and cannot be created",
+			args: args{
+				ctx:      context.Background(),
+				payload:  payloadMocked,
+				customer: &model.RequestOnBehalfOfScheme{Email: "jane@example.com"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				mockCustomers(client)
+
+				fields.c = client
+			},
+			Err:     model.ErrCustomerNotFound,
+			wantErr: true,
+		},
+
+		{
+			name: "when the customer email is not provided",
+			args: args{
+				ctx:      context.Background(),
+				payload:  payloadMocked,
+				customer: &model.RequestOnBehalfOfScheme{},
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			Err:     model.ErrNoCustomerEmail,
+			wantErr: true,
+		},
+
+		{
+			name: "when the service desk id is not provided",
+			args: args{
+				ctx:      context.Background(),
+				payload:  &model.CreateCustomerRequestPayloadScheme{},
+				customer: &model.RequestOnBehalfOfScheme{Email: "jane@example.com"},
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			Err:     model.ErrNoServiceDeskID,
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			smService, err := NewRequestService(testCase.fields.c, "latest", nil)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := smService.CreateOnBehalfOf(testCase.args.ctx, testCase.args.payload, testCase.args.customer)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+				assert.Empty(t, testCase.args.payload.RaiseOnBehalfOf)
+			}
+		})
+	}
+}
@@ -48,6 +48,8 @@ func (t *TypeService) Gets(ctx context.Context, serviceDeskID, groupID, start, l
 
 // Create enables a customer request type to be added to a service desk based on an issue type.
 //
+// This endpoint is experimental, it requires the WithExperimentalAPIs client option.
+//
 // POST /rest/servicedeskapi/servicedesk/{serviceDeskId}/requesttype
 //
 // https://docs.go-atlassian.io/jira-service-management-cloud/request/types#create-request-type
@@ -66,6 +68,8 @@ func (t *TypeService) Get(ctx context.Context, serviceDeskID, requestTypeID int)
 
 // Delete deletes a customer request type from a service desk, and removes it from all customer requests.
 //
+// This endpoint is experimental, it requires the WithExperimentalAPIs client option.
+//
 // DELETE /rest/servicedeskapi/servicedesk/{serviceDeskId}/requesttype/{requestTypeId}
 //
 // https://docs.go-atlassian.io/jira-service-management-cloud/request/types#delete-request-type
@@ -154,6 +158,10 @@ func (i *internalTypeImpl) Gets(ctx context.Context, serviceDeskID, groupID, sta
 
 func (i *internalTypeImpl) Create(ctx context.Context, serviceDeskID int, payload *model.RequestTypePayloadScheme) (*model.RequestTypeScheme, *model.ResponseScheme, error) {
 
+	if err := checkExperimental(i.c); err != nil {
+		return nil, nil, err
+	}
+
 	if serviceDeskID == 0 {
 		return nil, nil, fmt.Errorf("sm: %w", model.ErrNoServiceDeskID)
 	}
@@ -202,6 +210,10 @@ func (i *internalTypeImpl) Get(ctx context.Context, serviceDeskID, requestTypeID
 
 func (i *internalTypeImpl) Delete(ctx context.Context, serviceDeskID, requestTypeID int) (*model.ResponseScheme, error) {
 
+	if err := checkExperimental(i.c); err != nil {
+		return nil, err
+	}
+
 	if serviceDeskID == 0 {
 		return nil, fmt.Errorf("sm: %w", model.ErrNoServiceDeskID)
 	}
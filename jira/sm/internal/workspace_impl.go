@@ -29,6 +29,8 @@ type WorkSpaceService struct {
 //
 // These assets may include knowledge base articles, request types, request fields, customer portals, queues, etc.
 //
+// This endpoint is experimental, it requires the WithExperimentalAPIs client option.
+//
 // GET /rest/servicedeskapi/assets/workspace
 //
 // https://docs.go-atlassian.io/jira-service-management/workspaces#get-workspaces
@@ -43,6 +45,10 @@ type internalWorkSpaceImpl struct {
 
 func (i *internalWorkSpaceImpl) Gets(ctx context.Context) (*model.WorkSpacePageScheme, *model.ResponseScheme, error) {
 
+	if err := checkExperimental(i.c); err != nil {
+		return nil, nil, err
+	}
+
 	endpoint := "/rest/servicedeskapi/assets/workspace"
 
 	req, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
@@ -10,6 +10,7 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -66,6 +67,15 @@ func TestClient_Call(t *testing.T) {
 		},
 	}
 
+	invalidBodyResponse := &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader("Hello, world!")),
+		Request: &http.Request{
+			Method: http.MethodGet,
+			URL:    &url.URL{},
+		},
+	}
+
 	type fields struct {
 		HTTP common.HTTPClient
 		Site *url.URL
@@ -109,6 +119,25 @@ func TestClient_Call(t *testing.T) {
 			wantErr: false,
 		},
 
+		{
+			name: "when the response body cannot be decoded",
+			on: func(fields *fields) {
+
+				client := mocks.NewHTTPClient(t)
+
+				client.On("Do", (*http.Request)(nil)).
+					Return(invalidBodyResponse, nil)
+
+				fields.HTTP = client
+			},
+			args: args{
+				request:   nil,
+				structure: &model.IssueScheme{},
+			},
+			wantErr: true,
+			Err:     model.ErrDecode,
+		},
+
 		{
 			name: "when the response status is a bad request",
 			on: func(fields *fields) {
@@ -396,6 +425,35 @@ func TestClient_NewRequest(t *testing.T) {
 	}
 }
 
+func TestClient_NewRequestWithHeader(t *testing.T) {
+
+	authMocked := internal.NewAuthenticationService(nil)
+	authMocked.SetUserAgent("firefox")
+
+	siteAsURL, err := url.Parse("https://ctreminiom.atlassian.net")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := &Client{
+		HTTP: http.DefaultClient,
+		Auth: authMocked,
+		Site: siteAsURL,
+	}
+
+	ctx := model.WithHeader(context.Background(), model.HeaderForceAcceptLanguage, "true")
+	ctx = model.WithHeader(ctx, "Accept-Language", "es")
+	ctx = model.WithHeader(ctx, "user-agent", "go-atlassian")
+
+	got, err := c.NewRequest(ctx, http.MethodGet, "rest/api/3/issue/KP-1", "", nil)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "true", got.Header.Get("X-Force-Accept-Language"))
+	assert.Equal(t, "es", got.Header.Get("Accept-Language"))
+	assert.Equal(t, "go-atlassian", got.Header.Get("User-Agent"))
+	assert.Equal(t, "application/json", got.Header.Get("Accept"))
+}
+
 func TestClient_processResponse(t *testing.T) {
 
 	expectedJSONResponse := `
@@ -415,6 +473,19 @@ func TestClient_processResponse(t *testing.T) {
 		},
 	}
 
+	conflictRequest, err := http.NewRequestWithContext(model.WithExpectedStatus(context.Background(), http.StatusConflict), http.MethodPut, "rest/api/3/issue/KP-1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newConflictResponse := func(request *http.Request) *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusConflict,
+			Body:       io.NopCloser(strings.NewReader(`{"errorMessages":["The issue was updated by another user."]}`)),
+			Request:    request,
+		}
+	}
+
 	type fields struct {
 		HTTP           common.HTTPClient
 		Site           *url.URL
@@ -448,6 +519,25 @@ func TestClient_processResponse(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:   "when the status is declared as expected",
+			fields: fields{},
+			args: args{
+				response:  newConflictResponse(conflictRequest),
+				structure: model.BoardScheme{},
+			},
+			wantErr: false,
+		},
+		{
+			name:   "when the status is not declared as expected",
+			fields: fields{},
+			args: args{
+				response:  newConflictResponse(&http.Request{Method: http.MethodPut, URL: &url.URL{}}),
+				structure: model.BoardScheme{},
+			},
+			wantErr: true,
+			Err:     model.ErrConflict,
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -570,3 +660,254 @@ func TestNew(t *testing.T) {
 		})
 	}
 }
+
+func TestWithUnknownFieldsReporter(t *testing.T) {
+
+	response := &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(`{"id":"10001","key":"KP-1","newField":true}`)),
+		Request: &http.Request{
+			Method: http.MethodGet,
+			URL:    &url.URL{},
+		},
+	}
+
+	httpClient := mocks.NewHTTPClient(t)
+	httpClient.On("Do", (*http.Request)(nil)).
+		Return(response, nil)
+
+	var reported []string
+	client, err := New(httpClient, "https://ctreminiom.atlassian.net", WithUnknownFieldsReporter(
+		func(response *model.ResponseScheme, err *model.UnknownFieldsError) {
+			reported = append(reported, err.Fields...)
+		}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issue := new(model.IssueScheme)
+	_, err = client.Call(nil, issue)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"newField"}, reported)
+	assert.Equal(t, "KP-1", issue.Key)
+
+	_, err = New(httpClient, "https://ctreminiom.atlassian.net", WithUnknownFieldsReporter(nil))
+	assert.Error(t, err)
+}
+
+func TestWithDumpRedaction(t *testing.T) {
+
+	response := &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"X-Tenant-Token": {"secret"}},
+		Body:       io.NopCloser(strings.NewReader(`{"id":"10001","key":"KP-1"}`)),
+		Request: &http.Request{
+			Method: http.MethodGet,
+			URL:    &url.URL{},
+		},
+	}
+
+	httpClient := mocks.NewHTTPClient(t)
+	httpClient.On("Do", (*http.Request)(nil)).
+		Return(response, nil)
+
+	redaction := &model.DumpRedactionScheme{SensitiveHeaders: []string{"X-Tenant-Token"}}
+
+	client, err := New(httpClient, "https://ctreminiom.atlassian.net", WithDumpRedaction(redaction))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := client.Call(nil, new(model.IssueScheme))
+	assert.NoError(t, err)
+	assert.Equal(t, redaction, res.Redaction)
+
+	var trace strings.Builder
+	assert.NoError(t, res.Dump(&trace, false))
+	assert.Contains(t, trace.String(), "< X-Tenant-Token: [REDACTED]")
+
+	_, err = New(httpClient, "https://ctreminiom.atlassian.net", WithDumpRedaction(nil))
+	assert.Error(t, err)
+}
+
+func TestWithoutResponseBytes(t *testing.T) {
+
+	response := &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(`{"id":"10001","key":"KP-1"}`)),
+		Request: &http.Request{
+			Method: http.MethodGet,
+			URL:    &url.URL{},
+		},
+	}
+
+	httpClient := mocks.NewHTTPClient(t)
+	httpClient.On("Do", (*http.Request)(nil)).
+		Return(response, nil)
+
+	client, err := New(httpClient, "https://ctreminiom.atlassian.net", WithoutResponseBytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issue := new(model.IssueScheme)
+	res, err := client.Call(nil, issue)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "KP-1", issue.Key)
+	assert.Zero(t, res.Bytes.Len())
+}
+
+func TestWithRetryPolicy(t *testing.T) {
+
+	request, err := http.NewRequest(http.MethodGet, "https://ctreminiom.atlassian.net/rest/api/3/issue/KP-1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rateLimited := &http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"Retry-After": []string{"0"}},
+		Body:       io.NopCloser(strings.NewReader(`{"errorMessages":["Rate limit exceeded."]}`)),
+		Request:    request,
+	}
+
+	success := &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(`{"id":"10001","key":"KP-1"}`)),
+		Request:    request,
+	}
+
+	httpClient := mocks.NewHTTPClient(t)
+	httpClient.On("Do", request).Return(rateLimited, nil).Once()
+	httpClient.On("Do", request).Return(success, nil).Once()
+
+	client, err := New(httpClient, "https://ctreminiom.atlassian.net", WithRetryPolicy(3, time.Millisecond, time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issue := new(model.IssueScheme)
+	res, err := client.Call(request, issue)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.Code)
+	assert.Equal(t, "KP-1", issue.Key)
+
+	_, err = New(httpClient, "https://ctreminiom.atlassian.net", WithRetryPolicy(0, time.Second, time.Second))
+	assert.Error(t, err)
+}
+
+func TestWithRateLimiter(t *testing.T) {
+
+	request, err := http.NewRequest(http.MethodGet, "https://ctreminiom.atlassian.net/rest/api/3/myself", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	httpClient := mocks.NewHTTPClient(t)
+	httpClient.On("Do", request).
+		Return(func(*http.Request) *http.Response {
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{}`)), Request: request}
+		}, nil).
+		Times(3)
+
+	client, err := New(httpClient, "https://ctreminiom.atlassian.net", WithRateLimiter(100, 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for range 3 {
+		_, err = client.Call(request, nil)
+		assert.NoError(t, err)
+	}
+
+	metrics := client.RateLimiter().Metrics()
+	assert.Equal(t, int64(3), metrics.Requests)
+	assert.Equal(t, int64(1), metrics.Delayed)
+
+	_, err = New(httpClient, "https://ctreminiom.atlassian.net", WithRateLimiter(0, 2))
+	assert.Error(t, err)
+}
+
+func TestClient_processResponse_Streamed(t *testing.T) {
+
+	request, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "rest/api/3/attachment/content/10001", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body := &closeRecorder{Reader: strings.NewReader("attachment content")}
+
+	c := &Client{}
+	res, err := c.processResponse(&http.Response{StatusCode: http.StatusOK, Body: body, Request: request}, new(model.StreamedResponseScheme))
+	assert.NoError(t, err)
+	assert.Zero(t, res.Bytes.Len())
+	assert.False(t, body.closed)
+
+	content, err := io.ReadAll(res.Reader())
+	assert.NoError(t, err)
+	assert.Equal(t, "attachment content", string(content))
+
+	assert.NoError(t, res.Close())
+	assert.True(t, body.closed)
+
+	// The unsuccessful responses are read to build the error
+	body = &closeRecorder{Reader: strings.NewReader(`{"errorMessages":["The attachment does not exist."]}`)}
+
+	res, err = c.processResponse(&http.Response{StatusCode: http.StatusNotFound, Body: body, Request: request}, new(model.StreamedResponseScheme))
+	assert.True(t, errors.Is(err, model.ErrNotFound))
+	assert.Nil(t, res.Stream)
+	assert.True(t, body.closed)
+
+	// The calls without the marker read the body as usual
+	body = &closeRecorder{Reader: strings.NewReader("attachment content")}
+
+	res, err = c.processResponse(&http.Response{StatusCode: http.StatusOK, Body: body, Request: request}, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, res.Stream)
+	assert.Equal(t, "attachment content", res.Bytes.String())
+	assert.True(t, body.closed)
+}
+
+// closeRecorder is a response body recording whether it was closed.
+type closeRecorder struct {
+	io.Reader
+	closed bool
+}
+
+func (c *closeRecorder) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestWithMaxConnsPerHost(t *testing.T) {
+
+	client, err := New(nil, "https://ctreminiom.atlassian.net", WithMaxConnsPerHost(16), WithIdleTimeout(30*time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	httpClient, ok := client.HTTP.(*http.Client)
+	if !assert.True(t, ok) {
+		return
+	}
+
+	transport, ok := httpClient.Transport.(*http.Transport)
+	if !assert.True(t, ok) {
+		return
+	}
+
+	assert.Equal(t, 16, transport.MaxConnsPerHost)
+	assert.Equal(t, 16, transport.MaxIdleConnsPerHost)
+	assert.Equal(t, 30*time.Second, transport.IdleConnTimeout)
+	assert.True(t, transport.ForceAttemptHTTP2)
+
+	// The shared default client and transport are left untouched
+	assert.Nil(t, http.DefaultClient.Transport)
+	assert.Zero(t, http.DefaultTransport.(*http.Transport).MaxConnsPerHost)
+
+	_, err = New(nil, "https://ctreminiom.atlassian.net", WithMaxConnsPerHost(0))
+	assert.Error(t, err)
+}
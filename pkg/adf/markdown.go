@@ -0,0 +1,735 @@
+// Package adf converts text formats to and from the Atlassian Document Format (ADF), the rich text format of the Jira
+// Cloud REST API v3, e.g. the description of an issue or the body of a comment, and rewrites the references of the ADF
+// documents, e.g. their media when the attachments are copied to another issue.
+package adf
+
+import (
+	"html"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+var (
+	markdownATXHeading    = regexp.MustCompile(`^ {0,3}(#{1,6})(?:[ \t]+(.*?))?(?:[ \t]+#+)?[ \t]*$`)
+	markdownSetextHeading = regexp.MustCompile(`^ {0,3}(=+|-+)[ \t]*$`)
+	markdownRule          = regexp.MustCompile(`^ {0,3}(?:(?:-[ \t]*){3,}|(?:\*[ \t]*){3,}|(?:_[ \t]*){3,})$`)
+	markdownFence         = regexp.MustCompile("^( {0,3})(`{3,}|~{3,})[ \t]*([^`\\s]*)")
+	markdownQuote         = regexp.MustCompile(`^ {0,3}> ?(.*)$`)
+	markdownListItem      = regexp.MustCompile(`^( {0,3})([-*+]|(\d{1,9})[.)])( +|$)`)
+	markdownAutolink      = regexp.MustCompile(`^<([a-zA-Z][a-zA-Z0-9+.-]{1,31}:[^<>\s]*)>`)
+	markdownEmailAutolink = regexp.MustCompile(`^<([^<>\s@]+@[^<>\s@]+)>`)
+)
+
+// markdownPunctuation are the characters that can be escaped with a backslash.
+const markdownPunctuation = "!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~"
+
+// FromMarkdown converts CommonMark to an ADF document, e.g. the description of Issue.Create or the body of Comment.Add.
+//
+// The conversion supports the ATX and setext headings, the paragraphs and their hard line breaks, the fenced and
+// indented code blocks, the nested bullet and ordered lists, the block quotes, the thematic breaks, and the strong,
+// emphasis, strikethrough, code span, link, autolink and image inline elements. The images are converted to links,
+// the ADF media having to be uploaded to Jira first, and the raw HTML is kept as text.
+//
+// The blocks are nested as the ADF schema allows, e.g. the headings of a list item become paragraphs, so the document
+// passes the CommentNodeScheme.Validate check.
+//
+// Example usage:
+//
+//	comment, _, err := client.Issue.Comment.Add(ctx, "KP-1", &models.CommentPayloadScheme{
+//		Body: adf.FromMarkdown("The **deployment** failed, see the [logs](https://ci.example.com/builds/42)."),
+//	}, nil)
+func FromMarkdown(markdown string) *model.CommentNodeScheme {
+	return model.NewCommentDocument(markdownBlocks(markdownLines(markdown))...)
+}
+
+// markdownLines splits the Markdown in lines, expanding the tabs of the indentation.
+func markdownLines(markdown string) []string {
+
+	lines := strings.Split(strings.NewReplacer("\r\n", "\n", "\r", "\n").Replace(markdown), "\n")
+
+	for index, line := range lines {
+
+		indent := len(line) - len(strings.TrimLeft(line, " \t"))
+		if !strings.Contains(line[:indent], "\t") {
+			continue
+		}
+
+		var expanded strings.Builder
+		for _, char := range line[:indent] {
+			if char == '\t' {
+				expanded.WriteString(strings.Repeat(" ", 4-expanded.Len()%4))
+			} else {
+				expanded.WriteRune(char)
+			}
+		}
+
+		lines[index] = expanded.String() + line[indent:]
+	}
+
+	return lines
+}
+
+// markdownBlocks converts the lines to ADF block nodes.
+func markdownBlocks(lines []string) []*model.CommentNodeScheme {
+
+	var (
+		nodes     []*model.CommentNodeScheme
+		paragraph []string
+	)
+
+	flush := func() {
+
+		if len(paragraph) != 0 {
+			nodes = append(nodes, model.NewCommentParagraph(markdownInline(strings.TrimRight(strings.Join(paragraph, "\n"), " \t"))...))
+			paragraph = nil
+		}
+	}
+
+	for index := 0; index < len(lines); index++ {
+
+		line := lines[index]
+
+		if strings.TrimSpace(line) == "" {
+			flush()
+			continue
+		}
+
+		// An indented line can't interrupt a paragraph, it's a continuation of it
+		if len(paragraph) == 0 && markdownIndentation(line) >= 4 {
+
+			var code []string
+			for ; index < len(lines) && (markdownIndentation(lines[index]) >= 4 || strings.TrimSpace(lines[index]) == ""); index++ {
+				code = append(code, markdownStrip(lines[index], 4))
+			}
+			index--
+
+			for len(code) != 0 && strings.TrimSpace(code[len(code)-1]) == "" {
+				code = code[:len(code)-1]
+			}
+
+			nodes = append(nodes, markdownCodeBlock("", code))
+			continue
+		}
+
+		if match := markdownFence.FindStringSubmatch(line); match != nil {
+			flush()
+
+			indent, fence := len(match[1]), match[2]
+
+			var code []string
+			for index++; index < len(lines); index++ {
+
+				closing := strings.TrimSpace(lines[index])
+				if strings.HasPrefix(closing, fence) && strings.Trim(closing, fence[:1]) == "" && markdownIndentation(lines[index]) < 4 {
+					break
+				}
+
+				code = append(code, markdownStrip(lines[index], indent))
+			}
+
+			nodes = append(nodes, markdownCodeBlock(html.UnescapeString(match[3]), code))
+			continue
+		}
+
+		if match := markdownSetextHeading.FindStringSubmatch(line); match != nil && len(paragraph) != 0 {
+
+			level := 2
+			if match[1][0] == '=' {
+				level = 1
+			}
+
+			nodes = append(nodes, markdownHeading(level, strings.TrimSpace(strings.Join(paragraph, "\n"))))
+			paragraph = nil
+			continue
+		}
+
+		if match := markdownATXHeading.FindStringSubmatch(line); match != nil {
+			flush()
+			nodes = append(nodes, markdownHeading(len(match[1]), strings.TrimSpace(match[2])))
+			continue
+		}
+
+		if markdownRule.MatchString(line) {
+			flush()
+			nodes = append(nodes, &model.CommentNodeScheme{Type: "rule"})
+			continue
+		}
+
+		if markdownQuote.MatchString(line) {
+			flush()
+
+			var quote []string
+			for ; index < len(lines); index++ {
+
+				if match := markdownQuote.FindStringSubmatch(lines[index]); match != nil {
+					quote = append(quote, match[1])
+					continue
+				}
+
+				// A line following a quoted paragraph is a lazy continuation of it
+				if strings.TrimSpace(lines[index]) == "" || markdownInterrupts(lines[index]) || strings.TrimSpace(quote[len(quote)-1]) == "" {
+					break
+				}
+
+				quote = append(quote, lines[index])
+			}
+			index--
+
+			nodes = append(nodes, &model.CommentNodeScheme{Type: "blockquote", Content: markdownNest(markdownBlocks(quote))})
+			continue
+		}
+
+		if match := markdownListItem.FindStringSubmatch(line); match != nil {
+
+			// Only an ordered list starting at one can interrupt a paragraph, e.g. a year is kept in the text
+			if len(paragraph) == 0 || match[3] == "" || match[3] == "1" {
+				flush()
+
+				var list *model.CommentNodeScheme
+				list, index = markdownList(lines, index)
+				nodes = append(nodes, list)
+				continue
+			}
+		}
+
+		paragraph = append(paragraph, strings.TrimLeft(line, " "))
+	}
+
+	flush()
+
+	return nodes
+}
+
+// markdownList converts the list starting at the line, and returns the index of its last line.
+func markdownList(lines []string, index int) (*model.CommentNodeScheme, int) {
+
+	first := markdownListItem.FindStringSubmatch(lines[index])
+
+	list := &model.CommentNodeScheme{Type: "bulletList"}
+	if first[3] != "" {
+
+		list.Type = "orderedList"
+		if order, _ := strconv.Atoi(first[3]); order != 1 {
+			list.Attrs = map[string]interface{}{"order": order}
+		}
+	}
+
+	for index < len(lines) {
+
+		match := markdownListItem.FindStringSubmatch(lines[index])
+		if match == nil || markdownRule.MatchString(lines[index]) || markdownListDelimiter(match) != markdownListDelimiter(first) {
+			break
+		}
+
+		// The content of the item is indented as the text following the marker
+		spaces := len(match[4])
+		if spaces == 0 || spaces > 4 {
+			spaces = 1
+		}
+
+		offset := len(match[1]) + len(match[2]) + spaces
+		item := []string{lines[index][min(offset, len(lines[index])):]}
+
+		for index++; index < len(lines); index++ {
+
+			line := lines[index]
+
+			if strings.TrimSpace(line) == "" {
+
+				// The blank lines are part of the item when it continues after them
+				next := index + 1
+				for next < len(lines) && strings.TrimSpace(lines[next]) == "" {
+					next++
+				}
+
+				if next < len(lines) && markdownIndentation(lines[next]) >= offset {
+					item = append(item, "")
+					continue
+				}
+
+				// The blank lines between two items are skipped
+				if next < len(lines) && markdownListItem.MatchString(lines[next]) {
+					index = next
+				}
+
+				break
+			}
+
+			if markdownIndentation(line) >= offset {
+				item = append(item, markdownStrip(line, offset))
+				continue
+			}
+
+			// A line following the paragraph of the item is a lazy continuation of it
+			if markdownListItem.MatchString(line) || markdownInterrupts(line) || strings.TrimSpace(item[len(item)-1]) == "" {
+				break
+			}
+
+			item = append(item, strings.TrimLeft(line, " "))
+		}
+
+		content := markdownNest(markdownBlocks(item))
+
+		// The first node of a list item must be a paragraph or a code block
+		if len(content) == 0 || (content[0].Type != "paragraph" && content[0].Type != "codeBlock") {
+			content = append([]*model.CommentNodeScheme{model.NewCommentParagraph()}, content...)
+		}
+
+		list.AppendNode(&model.CommentNodeScheme{Type: "listItem", Content: content})
+	}
+
+	return list, index - 1
+}
+
+// markdownListDelimiter returns the delimiter of the list item, the items of a list sharing the same delimiter.
+func markdownListDelimiter(match []string) string {
+	return match[2][len(match[2])-1:]
+}
+
+// markdownInterrupts reports whether the line starts a block, ending the paragraph before it.
+func markdownInterrupts(line string) bool {
+
+	if match := markdownListItem.FindStringSubmatch(line); match != nil && (match[3] == "" || match[3] == "1") {
+		return true
+	}
+
+	return markdownATXHeading.MatchString(line) || markdownFence.MatchString(line) || markdownRule.MatchString(line) ||
+		markdownQuote.MatchString(line)
+}
+
+// markdownNest adapts the blocks to the list items and the block quotes, allowing only the paragraphs, the lists and
+// the code blocks: the headings become paragraphs, the nested block quotes are unwrapped and the rules are dropped.
+func markdownNest(nodes []*model.CommentNodeScheme) []*model.CommentNodeScheme {
+
+	var nested []*model.CommentNodeScheme
+	for _, node := range nodes {
+
+		switch node.Type {
+		case "heading":
+			nested = append(nested, model.NewCommentParagraph(node.Content...))
+		case "blockquote":
+			nested = append(nested, node.Content...)
+		case "rule":
+		default:
+			nested = append(nested, node)
+		}
+	}
+
+	return nested
+}
+
+// markdownHeading returns an ADF heading of the level.
+func markdownHeading(level int, text string) *model.CommentNodeScheme {
+	return &model.CommentNodeScheme{Type: "heading", Attrs: map[string]interface{}{"level": level}, Content: markdownInline(text)}
+}
+
+// markdownCodeBlock returns an ADF code block of the lines, in the language when provided.
+func markdownCodeBlock(language string, lines []string) *model.CommentNodeScheme {
+
+	block := &model.CommentNodeScheme{Type: "codeBlock"}
+
+	if language != "" {
+		block.Attrs = map[string]interface{}{"language": language}
+	}
+
+	if code := strings.Join(lines, "\n"); code != "" {
+		block.AppendNode(model.NewCommentText(code))
+	}
+
+	return block
+}
+
+// markdownIndentation returns the number of spaces indenting the line.
+func markdownIndentation(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " "))
+}
+
+// markdownStrip removes up to width spaces of indentation from the line.
+func markdownStrip(line string, width int) string {
+	return line[min(width, markdownIndentation(line)):]
+}
+
+// markdownInline converts the Markdown inline elements of the text to ADF inline nodes carrying the marks.
+func markdownInline(text string, marks ...*model.MarkScheme) []*model.CommentNodeScheme {
+
+	var (
+		nodes []*model.CommentNodeScheme
+		plain strings.Builder
+	)
+
+	emit := func() {
+
+		if plain.Len() != 0 {
+			nodes = markdownAppendText(nodes, html.UnescapeString(plain.String()), marks)
+			plain.Reset()
+		}
+	}
+
+	hardBreak := func() {
+
+		trimmed := strings.TrimRight(plain.String(), " ")
+		plain.Reset()
+		plain.WriteString(trimmed)
+
+		emit()
+		nodes = append(nodes, &model.CommentNodeScheme{Type: "hardBreak"})
+	}
+
+	for index := 0; index < len(text); {
+
+		char := text[index]
+
+		switch {
+		case char == '\\' && index+1 < len(text) && text[index+1] == '\n':
+			hardBreak()
+			index = markdownSkipIndentation(text, index+2)
+			continue
+
+		case char == '\\' && index+1 < len(text) && strings.IndexByte(markdownPunctuation, text[index+1]) >= 0:
+			plain.WriteString(html.EscapeString(text[index+1 : index+2]))
+			index += 2
+			continue
+
+		case char == '\n':
+
+			// Two trailing spaces make a hard line break, the other line breaks are rendered as spaces
+			if strings.HasSuffix(plain.String(), "  ") {
+				hardBreak()
+			} else {
+				trimmed := strings.TrimRight(plain.String(), " ")
+				plain.Reset()
+				plain.WriteString(trimmed + " ")
+			}
+
+			index = markdownSkipIndentation(text, index+1)
+			continue
+
+		case char == '`':
+
+			run := markdownRun(text, index)
+			if code, end, ok := markdownCodeSpan(text, index); ok {
+				emit()
+				nodes = markdownAppendText(nodes, code, markdownCodeMarks(marks))
+				index = end
+				continue
+			}
+
+			// An unmatched run of backticks is literal
+			plain.WriteString(text[index : index+run])
+			index += run
+			continue
+
+		case char == '!' && index+1 < len(text) && text[index+1] == '[':
+
+			if label, href, end, ok := markdownLink(text, index+1); ok {
+				if label == "" {
+					label = href
+				}
+
+				emit()
+				nodes = append(nodes, markdownInline(label, markdownMark(marks, markdownLinkMark(href))...)...)
+				index = end
+				continue
+			}
+
+		case char == '[':
+
+			if label, href, end, ok := markdownLink(text, index); ok {
+				emit()
+				nodes = append(nodes, markdownInline(label, markdownMark(marks, markdownLinkMark(href))...)...)
+				index = end
+				continue
+			}
+
+		case char == '<':
+
+			if match := markdownAutolink.FindStringSubmatch(text[index:]); match != nil {
+				emit()
+				nodes = markdownAppendText(nodes, match[1], markdownMark(marks, markdownLinkMark(match[1])))
+				index += len(match[0])
+				continue
+			}
+
+			if match := markdownEmailAutolink.FindStringSubmatch(text[index:]); match != nil {
+				emit()
+				nodes = markdownAppendText(nodes, match[1], markdownMark(marks, markdownLinkMark("mailto:"+match[1])))
+				index += len(match[0])
+				continue
+			}
+
+		case char == '*' || char == '_' || char == '~':
+
+			run := markdownRun(text, index)
+			if inner, end, markType, ok := markdownEmphasis(text, index); ok {
+				emit()
+				nodes = append(nodes, markdownInline(inner, markdownMark(marks, &model.MarkScheme{Type: markType})...)...)
+				index = end
+				continue
+			}
+
+			// An unmatched run of delimiters is literal
+			plain.WriteString(text[index : index+run])
+			index += run
+			continue
+		}
+
+		plain.WriteByte(char)
+		index++
+	}
+
+	emit()
+
+	return nodes
+}
+
+// markdownSkipIndentation returns the index of the first character of the line starting at the index that isn't a space.
+func markdownSkipIndentation(text string, index int) int {
+
+	for index < len(text) && text[index] == ' ' {
+		index++
+	}
+
+	return index
+}
+
+// markdownRun returns the number of times the character at the index is repeated.
+func markdownRun(text string, index int) int {
+
+	run := 1
+	for index+run < len(text) && text[index+run] == text[index] {
+		run++
+	}
+
+	return run
+}
+
+// markdownCodeSpan returns the content of the code span starting at the index and the index following it.
+func markdownCodeSpan(text string, start int) (string, int, bool) {
+
+	run := markdownRun(text, start)
+
+	for index := start + run; index < len(text); {
+
+		if text[index] != '`' {
+			index++
+			continue
+		}
+
+		closing := markdownRun(text, index)
+		if closing != run {
+			index += closing
+			continue
+		}
+
+		code := strings.ReplaceAll(text[start+run:index], "\n", " ")
+
+		// A single space is stripped on both sides, so the code can start or end with a backtick
+		if len(code) >= 2 && code[0] == ' ' && code[len(code)-1] == ' ' && strings.TrimSpace(code) != "" {
+			code = code[1 : len(code)-1]
+		}
+
+		return code, index + closing, true
+	}
+
+	return "", 0, false
+}
+
+// markdownLink returns the label and the destination of the inline link starting with the bracket at the index,
+// and the index following it. The title of the link is ignored.
+func markdownLink(text string, start int) (label, href string, end int, ok bool) {
+
+	depth, closing := 0, -1
+	for index := start; index < len(text) && closing < 0; index++ {
+
+		switch text[index] {
+		case '\\':
+			index++
+		case '`':
+			if _, end, ok := markdownCodeSpan(text, index); ok {
+				index = end - 1
+			}
+		case '[':
+			depth++
+		case ']':
+			if depth--; depth == 0 {
+				closing = index
+			}
+		}
+	}
+
+	if closing < 0 || closing+1 >= len(text) || text[closing+1] != '(' {
+		return "", "", 0, false
+	}
+
+	depth = 1
+	for index := closing + 2; index < len(text); index++ {
+
+		switch text[index] {
+		case '\\':
+			index++
+		case '(':
+			depth++
+		case ')':
+			if depth--; depth != 0 {
+				continue
+			}
+
+			destination := strings.TrimSpace(text[closing+2 : index])
+
+			if strings.HasPrefix(destination, "<") {
+				if end := strings.IndexByte(destination, '>'); end > 0 {
+					destination = destination[1:end]
+				}
+			} else if fields := strings.Fields(destination); len(fields) != 0 {
+				destination = fields[0]
+			}
+
+			return text[start+1 : closing], html.UnescapeString(destination), index + 1, true
+		}
+	}
+
+	return "", "", 0, false
+}
+
+// markdownEmphasis returns the content and the mark type of the emphasis starting with the delimiter at the index,
+// and the index following it.
+//
+// The delimiters follow a subset of the CommonMark rules: the doubled delimiters are strong, the single ones are
+// emphasis, the doubled tildes are strikethrough, and the underscores inside a word are kept as text.
+func markdownEmphasis(text string, start int) (inner string, end int, markType string, ok bool) {
+
+	char, run := text[start], markdownRun(text, start)
+
+	width := 1
+	switch {
+	case char == '~' && run < 2:
+		return "", 0, "", false
+	case char == '~':
+		width, markType = 2, "strike"
+	case run >= 2:
+		width, markType = 2, "strong"
+	default:
+		markType = "em"
+	}
+
+	open := start + width
+
+	// The opening delimiter is followed by a text, and can't be inside a word when it's an underscore
+	if open >= len(text) || markdownSpace(text[open]) || (char == '_' && start > 0 && markdownWord(text[start-1])) {
+		return "", 0, "", false
+	}
+
+	for index := open; index < len(text); index++ {
+
+		switch {
+		case text[index] == '\\':
+			index++
+
+		case text[index] == '`':
+			if _, end, ok := markdownCodeSpan(text, index); ok {
+				index = end - 1
+			}
+
+		case text[index] == char:
+
+			closing := markdownRun(text, index)
+			after := index + closing
+
+			// A run of the other width opens or closes a nested emphasis, e.g. a strong text inside an emphasis
+			matches := closing == width || closing >= 3
+			if !matches || index == open || markdownSpace(text[index-1]) || (char == '_' && after < len(text) && markdownWord(text[after])) {
+				index = after - 1
+				continue
+			}
+
+			return text[open : after-width], after, markType, true
+		}
+	}
+
+	return "", 0, "", false
+}
+
+// markdownSpace reports whether the character is a whitespace.
+func markdownSpace(char byte) bool {
+	return char == ' ' || char == '\t' || char == '\n'
+}
+
+// markdownWord reports whether the character is a letter or a digit, the non-ASCII characters being letters.
+func markdownWord(char byte) bool {
+	return char >= 0x80 || (char >= 'a' && char <= 'z') || (char >= 'A' && char <= 'Z') || (char >= '0' && char <= '9')
+}
+
+// markdownLinkMark returns an ADF link mark to the destination.
+func markdownLinkMark(href string) *model.MarkScheme {
+	return &model.MarkScheme{Type: "link", Attrs: map[string]interface{}{"href": href}}
+}
+
+// markdownMark returns the marks with the mark added, replacing the mark of the same type.
+func markdownMark(marks []*model.MarkScheme, mark *model.MarkScheme) []*model.MarkScheme {
+
+	combined := make([]*model.MarkScheme, 0, len(marks)+1)
+	for _, existing := range marks {
+		if existing.Type != mark.Type {
+			combined = append(combined, existing)
+		}
+	}
+
+	return append(combined, mark)
+}
+
+// markdownCodeMarks returns the marks of a code span, the code mark only being combined with the link mark in ADF.
+func markdownCodeMarks(marks []*model.MarkScheme) []*model.MarkScheme {
+
+	var combined []*model.MarkScheme
+	for _, mark := range marks {
+		if mark.Type == "link" {
+			combined = append(combined, mark)
+		}
+	}
+
+	return markdownMark(combined, &model.MarkScheme{Type: "code"})
+}
+
+// markdownAppendText appends a text node with the marks, merged with the previous text node when it has the same marks.
+func markdownAppendText(nodes []*model.CommentNodeScheme, text string, marks []*model.MarkScheme) []*model.CommentNodeScheme {
+
+	if text == "" {
+		return nodes
+	}
+
+	if len(nodes) != 0 {
+
+		last := nodes[len(nodes)-1]
+		if last.Type == "text" && markdownSameMarks(last.Marks, marks) {
+			last.Text += text
+			return nodes
+		}
+	}
+
+	node := model.NewCommentText(text)
+	if len(marks) != 0 {
+		node.Marks = append([]*model.MarkScheme(nil), marks...)
+	}
+
+	return append(nodes, node)
+}
+
+// markdownSameMarks reports whether the marks have the same types and attributes, e.g. the destination of a link.
+func markdownSameMarks(marks, others []*model.MarkScheme) bool {
+
+	if len(marks) != len(others) {
+		return false
+	}
+
+	for index, mark := range marks {
+		if mark.Type != others[index].Type || !reflect.DeepEqual(mark.Attrs, others[index].Attrs) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,160 @@
+package adf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+func TestFromMarkdown(t *testing.T) {
+
+	text := model.NewCommentText
+	paragraph := model.NewCommentParagraph
+
+	marked := func(value string, marks ...*model.MarkScheme) *model.CommentNodeScheme {
+		node := text(value)
+		node.Marks = marks
+		return node
+	}
+
+	link := func(href string) *model.MarkScheme {
+		return &model.MarkScheme{Type: "link", Attrs: map[string]interface{}{"href": href}}
+	}
+
+	hardBreak := &model.CommentNodeScheme{Type: "hardBreak"}
+	strong, em, code := &model.MarkScheme{Type: "strong"}, &model.MarkScheme{Type: "em"}, &model.MarkScheme{Type: "code"}
+
+	item := func(nodes ...*model.CommentNodeScheme) *model.CommentNodeScheme {
+		return &model.CommentNodeScheme{Type: "listItem", Content: nodes}
+	}
+
+	testCases := []struct {
+		name     string
+		markdown string
+		want     []*model.CommentNodeScheme
+	}{
+		{
+			name:     "when the markdown is empty",
+			markdown: "",
+		},
+
+		{
+			name:     "when the markdown has headings",
+			markdown: "# Release notes #\n\nSummary\n-------",
+			want: []*model.CommentNodeScheme{
+				{Type: "heading", Attrs: map[string]interface{}{"level": 1}, Content: []*model.CommentNodeScheme{text("Release notes")}},
+				{Type: "heading", Attrs: map[string]interface{}{"level": 2}, Content: []*model.CommentNodeScheme{text("Summary")}},
+			},
+		},
+
+		{
+			name:     "when the paragraph has inline elements",
+			markdown: "The **build** of *main* failed in `make test`, see [the logs](https://ci.example.com/42 \"CI\").",
+			want: []*model.CommentNodeScheme{
+				paragraph(
+					text("The "), marked("build", strong), text(" of "), marked("main", em), text(" failed in "),
+					marked("make test", code), text(", see "), marked("the logs", link("https://ci.example.com/42")), text("."),
+				),
+			},
+		},
+
+		{
+			name:     "when the inline elements are nested",
+			markdown: "***urgent*** **see [`KP-1`](https://example.com)** ~~done~~",
+			want: []*model.CommentNodeScheme{
+				paragraph(
+					marked("urgent", strong, em), text(" "),
+					marked("see ", strong), marked("KP-1", link("https://example.com"), code),
+					text(" "), marked("done", &model.MarkScheme{Type: "strike"}),
+				),
+			},
+		},
+
+		{
+			name:     "when the text has escapes, entities, autolinks and intraword underscores",
+			markdown: "\\*not emphasis\\* &amp; snake_case_name <https://example.com> <ops@example.com>",
+			want: []*model.CommentNodeScheme{
+				paragraph(
+					text("*not emphasis* & snake_case_name "), marked("https://example.com", link("https://example.com")),
+					text(" "), marked("ops@example.com", link("mailto:ops@example.com")),
+				),
+			},
+		},
+
+		{
+			name:     "when the paragraph has line breaks",
+			markdown: "first  \nsecond\\\nthird\nfourth",
+			want: []*model.CommentNodeScheme{
+				paragraph(text("first"), hardBreak, text("second"), hardBreak, text("third fourth")),
+			},
+		},
+
+		{
+			name:     "when the markdown has code blocks",
+			markdown: "```go\nfunc main() {}\n```\n\n    indented\n    code",
+			want: []*model.CommentNodeScheme{
+				{Type: "codeBlock", Attrs: map[string]interface{}{"language": "go"}, Content: []*model.CommentNodeScheme{text("func main() {}")}},
+				{Type: "codeBlock", Content: []*model.CommentNodeScheme{text("indented\ncode")}},
+			},
+		},
+
+		{
+			name:     "when the markdown has nested lists",
+			markdown: "- first\n- second\n\n  3. nested\n  4. list\n\n* * *",
+			want: []*model.CommentNodeScheme{
+				{Type: "bulletList", Content: []*model.CommentNodeScheme{
+					item(paragraph(text("first"))),
+					item(paragraph(text("second")), &model.CommentNodeScheme{
+						Type:    "orderedList",
+						Attrs:   map[string]interface{}{"order": 3},
+						Content: []*model.CommentNodeScheme{item(paragraph(text("nested"))), item(paragraph(text("list")))},
+					}),
+				}},
+				{Type: "rule"},
+			},
+		},
+
+		{
+			name:     "when a list item has blocks not allowed in ADF list items",
+			markdown: "- # heading\n-\n- > quote",
+			want: []*model.CommentNodeScheme{
+				{Type: "bulletList", Content: []*model.CommentNodeScheme{
+					item(paragraph(text("heading"))),
+					item(paragraph()),
+					item(paragraph(text("quote"))),
+				}},
+			},
+		},
+
+		{
+			name:     "when the markdown has a block quote",
+			markdown: "> quoted\nlazy line\n> - item",
+			want: []*model.CommentNodeScheme{
+				{Type: "blockquote", Content: []*model.CommentNodeScheme{
+					paragraph(text("quoted lazy line")),
+					{Type: "bulletList", Content: []*model.CommentNodeScheme{item(paragraph(text("item")))}},
+				}},
+			},
+		},
+
+		{
+			name:     "when a number starts a line of a paragraph",
+			markdown: "Released in\n2024. Deprecated since.",
+			want: []*model.CommentNodeScheme{
+				paragraph(text("Released in 2024. Deprecated since.")),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			got := FromMarkdown(testCase.markdown)
+
+			assert.Equal(t, model.NewCommentDocument(testCase.want...), got)
+			assert.NoError(t, got.Validate())
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package adf
+
+import (
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// Media reference type constants, the type attribute of the media nodes.
+const (
+	MediaTypeFile     = "file"     // A file uploaded to the media services, e.g. an attachment.
+	MediaTypeLink     = "link"     // A link stored in the media services.
+	MediaTypeExternal = "external" // An image referenced by URL.
+)
+
+// MediaReference represents a reference to a file in an ADF document, a media or mediaInline node.
+type MediaReference struct {
+	NodeType   string // The type of the node, media or mediaInline.
+	Type       string // The type of the media, see the MediaType constants.
+	ID         string // The ID of the file in the media services, for the file and link media.
+	Collection string // The media collection of the file.
+	URL        string // The URL of the image, for the external media.
+	Alt        string // The alternative text, the file name for the images uploaded in Jira.
+}
+
+// MediaReferences returns the media references of the ADF document, in the order of the document.
+func MediaReferences(document *model.CommentNodeScheme) []*MediaReference {
+
+	var references []*MediaReference
+	walkMedia(document, func(node *model.CommentNodeScheme) {
+		references = append(references, mediaReference(node))
+	})
+
+	return references
+}
+
+// RewriteMedia rewrites the media references of the ADF document in place.
+//
+// The rewrite function changes the reference and returns true to apply the change to the node. The empty fields of
+// the reference are removed from the node, except the collection of the file and link media, which is kept empty.
+// It returns the number of rewritten nodes.
+//
+// The file and link media reference the media services file IDs, which the Jira REST API doesn't return for the
+// attachments: this library can't resolve the file ID of an uploaded attachment, the caller has to provide it.
+//
+// Example usage:
+//
+//	// The images are pointed to their URL, e.g. a public copy of the attachments, matched by file name
+//	adf.RewriteMedia(issue.Fields.Description, func(reference *adf.MediaReference) bool {
+//		url, ok := published[reference.Alt]
+//		reference.Type, reference.ID, reference.Collection, reference.URL = adf.MediaTypeExternal, "", "", url
+//		return ok
+//	})
+func RewriteMedia(document *model.CommentNodeScheme, rewrite func(reference *MediaReference) bool) int {
+
+	var rewritten int
+	walkMedia(document, func(node *model.CommentNodeScheme) {
+
+		reference := mediaReference(node)
+		if !rewrite(reference) {
+			return
+		}
+
+		if node.Attrs == nil {
+			node.Attrs = make(map[string]interface{})
+		}
+
+		for attr, value := range map[string]string{
+			"type":       reference.Type,
+			"id":         reference.ID,
+			"collection": reference.Collection,
+			"url":        reference.URL,
+			"alt":        reference.Alt,
+		} {
+			// The collection is required by the file and link media, even empty
+			if value == "" && (attr != "collection" || reference.Type == MediaTypeExternal) {
+				delete(node.Attrs, attr)
+			} else {
+				node.Attrs[attr] = value
+			}
+		}
+
+		rewritten++
+	})
+
+	return rewritten
+}
+
+// RewriteMediaIDs replaces the IDs of the file and link media of the ADF document in place, with the new media ID
+// of each old media ID. The collections of the rewritten media are cleared. It returns the number of rewritten nodes.
+//
+// The new IDs are media services file IDs, not attachment IDs, see RewriteMedia.
+func RewriteMediaIDs(document *model.CommentNodeScheme, mediaIDs map[string]string) int {
+	return RewriteMedia(document, func(reference *MediaReference) bool {
+
+		id, ok := mediaIDs[reference.ID]
+		if !ok || reference.ID == "" {
+			return false
+		}
+
+		reference.ID, reference.Collection = id, ""
+		return true
+	})
+}
+
+// RewriteURLs rewrites the URLs of the ADF document in place: the links, the smart link cards and the external
+// media, e.g. the links to the attachments of an issue on another Jira instance.
+//
+// The rewrite function returns the new URL and true to replace it. It returns the number of rewritten URLs.
+func RewriteURLs(document *model.CommentNodeScheme, rewrite func(url string) (string, bool)) int {
+
+	var rewritten int
+
+	replace := func(attrs map[string]interface{}, attr string) {
+
+		url, ok := attrs[attr].(string)
+		if !ok || url == "" {
+			return
+		}
+
+		if replaced, ok := rewrite(url); ok {
+			attrs[attr] = replaced
+			rewritten++
+		}
+	}
+
+	walkNodes(document, func(node *model.CommentNodeScheme) {
+
+		for _, mark := range node.Marks {
+			if mark != nil && mark.Type == "link" && mark.Attrs != nil {
+				replace(mark.Attrs, "href")
+			}
+		}
+
+		if node.Attrs == nil {
+			return
+		}
+
+		switch node.Type {
+		case "inlineCard", "blockCard", "embedCard":
+			replace(node.Attrs, "url")
+		case "media", "mediaInline":
+			if node.Attrs["type"] == MediaTypeExternal {
+				replace(node.Attrs, "url")
+			}
+		}
+	})
+
+	return rewritten
+}
+
+// walkMedia calls fn with each media and mediaInline node of the document.
+func walkMedia(document *model.CommentNodeScheme, fn func(node *model.CommentNodeScheme)) {
+	walkNodes(document, func(node *model.CommentNodeScheme) {
+		if node.Type == "media" || node.Type == "mediaInline" {
+			fn(node)
+		}
+	})
+}
+
+// walkNodes calls fn with each node of the document, depth first.
+func walkNodes(node *model.CommentNodeScheme, fn func(node *model.CommentNodeScheme)) {
+
+	if node == nil {
+		return
+	}
+
+	fn(node)
+
+	for _, child := range node.Content {
+		walkNodes(child, fn)
+	}
+}
+
+// mediaReference returns the reference of a media node.
+func mediaReference(node *model.CommentNodeScheme) *MediaReference {
+
+	attr := func(name string) string {
+		value, _ := node.Attrs[name].(string)
+		return value
+	}
+
+	return &MediaReference{
+		NodeType:   node.Type,
+		Type:       attr("type"),
+		ID:         attr("id"),
+		Collection: attr("collection"),
+		URL:        attr("url"),
+		Alt:        attr("alt"),
+	}
+}
@@ -0,0 +1,107 @@
+package adf
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// mediaDocument returns a document embedding an uploaded image, an inline file, an external image and links.
+func mediaDocument() *model.CommentNodeScheme {
+
+	link := model.NewCommentText("logs")
+	link.Marks = []*model.MarkScheme{
+		{Type: "link", Attrs: map[string]interface{}{"href": "https://old.atlassian.net/rest/api/3/attachment/content/10001"}},
+	}
+
+	return model.NewCommentDocument(
+		&model.CommentNodeScheme{
+			Type:  "mediaSingle",
+			Attrs: map[string]interface{}{"layout": "center"},
+			Content: []*model.CommentNodeScheme{
+				{Type: "media", Attrs: map[string]interface{}{
+					"type": "file", "id": "6e7c7f2c-uuid-old-1", "collection": "jira-10000-field-description", "alt": "screenshot.png", "width": 640.0,
+				}},
+			},
+		},
+		model.NewCommentParagraph(
+			model.NewCommentText("See "),
+			&model.CommentNodeScheme{Type: "mediaInline", Attrs: map[string]interface{}{"type": "file", "id": "6e7c7f2c-uuid-old-2", "collection": ""}},
+			model.NewCommentText(" and the "),
+			link,
+		),
+		&model.CommentNodeScheme{
+			Type: "mediaSingle",
+			Content: []*model.CommentNodeScheme{
+				{Type: "media", Attrs: map[string]interface{}{"type": "external", "url": "https://old.atlassian.net/secure/attachment/10002/chart.png"}},
+			},
+		},
+		&model.CommentNodeScheme{Type: "blockCard", Attrs: map[string]interface{}{"url": "https://old.atlassian.net/browse/KP-1"}},
+	)
+}
+
+func TestMediaReferences(t *testing.T) {
+
+	references := MediaReferences(mediaDocument())
+
+	assert.Equal(t, []*MediaReference{
+		{NodeType: "media", Type: MediaTypeFile, ID: "6e7c7f2c-uuid-old-1", Collection: "jira-10000-field-description", Alt: "screenshot.png"},
+		{NodeType: "mediaInline", Type: MediaTypeFile, ID: "6e7c7f2c-uuid-old-2"},
+		{NodeType: "media", Type: MediaTypeExternal, URL: "https://old.atlassian.net/secure/attachment/10002/chart.png"},
+	}, references)
+
+	assert.Empty(t, MediaReferences(nil))
+}
+
+func TestRewriteMediaIDs(t *testing.T) {
+
+	document := mediaDocument()
+
+	rewritten := RewriteMediaIDs(document, map[string]string{"6e7c7f2c-uuid-old-1": "9a1b2c3d-uuid-new-1"})
+	assert.Equal(t, 1, rewritten)
+
+	media := document.Content[0].Content[0]
+	assert.Equal(t, map[string]interface{}{
+		"type": "file", "id": "9a1b2c3d-uuid-new-1", "collection": "", "alt": "screenshot.png", "width": 640.0,
+	}, media.Attrs)
+
+	assert.Equal(t, "6e7c7f2c-uuid-old-2", document.Content[1].Content[1].Attrs["id"])
+	assert.NoError(t, document.Validate())
+}
+
+func TestRewriteMedia(t *testing.T) {
+
+	document := mediaDocument()
+
+	// The external image is uploaded as an attachment of the copied issue
+	rewritten := RewriteMedia(document, func(reference *MediaReference) bool {
+
+		if reference.Type != MediaTypeExternal {
+			return false
+		}
+
+		reference.Type, reference.ID, reference.URL, reference.Alt = MediaTypeFile, "9a1b2c3d-uuid-new-3", "", "chart.png"
+		return true
+	})
+	assert.Equal(t, 1, rewritten)
+
+	assert.Equal(t, map[string]interface{}{"type": "file", "id": "9a1b2c3d-uuid-new-3", "collection": "", "alt": "chart.png"},
+		document.Content[2].Content[0].Attrs)
+}
+
+func TestRewriteURLs(t *testing.T) {
+
+	document := mediaDocument()
+
+	rewritten := RewriteURLs(document, func(url string) (string, bool) {
+		return strings.Replace(url, "https://old.atlassian.net", "https://new.atlassian.net", 1), strings.HasPrefix(url, "https://old.atlassian.net/")
+	})
+	assert.Equal(t, 3, rewritten)
+
+	assert.Equal(t, "https://new.atlassian.net/rest/api/3/attachment/content/10001", document.Content[1].Content[3].Marks[0].Attrs["href"])
+	assert.Equal(t, "https://new.atlassian.net/secure/attachment/10002/chart.png", document.Content[2].Content[0].Attrs["url"])
+	assert.Equal(t, "https://new.atlassian.net/browse/KP-1", document.Content[3].Attrs["url"])
+}
@@ -0,0 +1,239 @@
+package adf
+
+import (
+	"fmt"
+	"html"
+	"strings"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// storageCDATAEscape splits the CDATA end marker of a code block, so the code can contain it.
+var storageCDATAEscape = strings.NewReplacer("]]>", "]]]]><![CDATA[>")
+
+// MarkdownToStorage converts CommonMark to the Confluence storage format, e.g. the body of Page.Publish.
+//
+// The Markdown is parsed as FromMarkdown does, then converted with ToStorage: the images are converted to links.
+func MarkdownToStorage(markdown string) string {
+	return ToStorage(FromMarkdown(markdown))
+}
+
+// ToStorage converts an ADF document to the Confluence storage format.
+//
+// The conversion supports the headings, the paragraphs and their hard line breaks, the bullet and ordered lists, the
+// block quotes, the rules, the tables, and the strong, emphasis, strikethrough, underline, code and link marks. The
+// code blocks are converted to the code macro, with the language of the block as the macro language. The text of the
+// other nodes is kept, and the media are dropped.
+//
+// Example usage:
+//
+//	body := &models.PageBodyRepresentationScheme{Representation: "storage", Value: adf.ToStorage(issue.Fields.Description)}
+func ToStorage(document *model.CommentNodeScheme) string {
+
+	if document == nil {
+		return ""
+	}
+
+	var storage strings.Builder
+	storageBlocksOf(&storage, document.Content)
+
+	return storage.String()
+}
+
+// storageBlocksOf writes the ADF block nodes in the storage format.
+func storageBlocksOf(storage *strings.Builder, nodes []*model.CommentNodeScheme) {
+	for _, node := range nodes {
+		storageBlock(storage, node)
+	}
+}
+
+// storageBlock writes an ADF block node in the storage format.
+func storageBlock(storage *strings.Builder, node *model.CommentNodeScheme) {
+
+	if node == nil {
+		return
+	}
+
+	switch node.Type {
+	case "paragraph":
+		fmt.Fprintf(storage, "<p>%v</p>", storageInlineOf(node.Content))
+
+	case "heading":
+		fmt.Fprintf(storage, "<h%[1]v>%[2]v</h%[1]v>", min(max(wikiInt(node.Attrs["level"]), 1), 6), storageInlineOf(node.Content))
+
+	case "bulletList", "orderedList":
+
+		tag, start := "ul", ""
+		if node.Type == "orderedList" {
+			tag = "ol"
+
+			if order := wikiInt(node.Attrs["order"]); order > 1 {
+				start = fmt.Sprintf(` start="%v"`, order)
+			}
+		}
+
+		fmt.Fprintf(storage, "<%v%v>", tag, start)
+		for _, item := range node.Content {
+
+			storage.WriteString("<li>")
+
+			// An item made of a single paragraph is written inline, as in the storage format of the editor
+			if len(item.Content) == 1 && item.Content[0] != nil && item.Content[0].Type == "paragraph" {
+				storage.WriteString(storageInlineOf(item.Content[0].Content))
+			} else {
+				storageBlocksOf(storage, item.Content)
+			}
+
+			storage.WriteString("</li>")
+		}
+		fmt.Fprintf(storage, "</%v>", tag)
+
+	case "codeBlock":
+
+		var code strings.Builder
+		for _, child := range node.Content {
+			code.WriteString(child.Text)
+		}
+
+		storage.WriteString(`<ac:structured-macro ac:name="code">`)
+		if language, _ := node.Attrs["language"].(string); language != "" {
+			fmt.Fprintf(storage, `<ac:parameter ac:name="language">%v</ac:parameter>`, html.EscapeString(language))
+		}
+		fmt.Fprintf(storage, "<ac:plain-text-body><![CDATA[%v]]></ac:plain-text-body></ac:structured-macro>",
+			storageCDATAEscape.Replace(code.String()))
+
+	case "blockquote":
+		storage.WriteString("<blockquote>")
+		storageBlocksOf(storage, node.Content)
+		storage.WriteString("</blockquote>")
+
+	case "rule":
+		storage.WriteString("<hr />")
+
+	case "table":
+		storage.WriteString("<table><tbody>")
+		for _, row := range node.Content {
+
+			storage.WriteString("<tr>")
+			for _, cell := range row.Content {
+
+				tag := "td"
+				if cell.Type == "tableHeader" {
+					tag = "th"
+				}
+
+				fmt.Fprintf(storage, "<%v>", tag)
+				storageBlocksOf(storage, cell.Content)
+				fmt.Fprintf(storage, "</%v>", tag)
+			}
+			storage.WriteString("</tr>")
+		}
+		storage.WriteString("</tbody></table>")
+
+	case "blockCard", "embedCard":
+		if href, _ := node.Attrs["url"].(string); href != "" {
+			fmt.Fprintf(storage, `<p><a href="%[1]v">%[1]v</a></p>`, html.EscapeString(href))
+		}
+
+	case "mediaSingle", "mediaGroup", "media":
+
+	default:
+
+		// The content of the other nodes is kept, e.g. the blocks of a panel or the text of a task
+		if len(node.Content) != 0 && (node.Type == "taskItem" || node.Type == "decisionItem" || node.Type == "caption") {
+			fmt.Fprintf(storage, "<p>%v</p>", storageInlineOf(node.Content))
+			return
+		}
+
+		storageBlocksOf(storage, node.Content)
+	}
+}
+
+// storageInlineOf converts ADF inline nodes to the storage format.
+func storageInlineOf(nodes []*model.CommentNodeScheme) string {
+
+	var storage strings.Builder
+	for index := 0; index < len(nodes); index++ {
+
+		node := nodes[index]
+		if node == nil {
+			continue
+		}
+
+		switch node.Type {
+		case "text":
+
+			href := storageHref(node)
+			if href == "" {
+				storage.WriteString(storageTextOf(node))
+				continue
+			}
+
+			// The following text nodes linking to the same destination are written in the same link
+			fmt.Fprintf(&storage, `<a href="%v">`, html.EscapeString(href))
+			for ; index < len(nodes) && nodes[index] != nil && nodes[index].Type == "text" && storageHref(nodes[index]) == href; index++ {
+				storage.WriteString(storageTextOf(nodes[index]))
+			}
+			storage.WriteString("</a>")
+			index--
+
+		case "hardBreak":
+			storage.WriteString("<br />")
+
+		case "inlineCard":
+			if href, _ := node.Attrs["url"].(string); href != "" {
+				fmt.Fprintf(&storage, `<a href="%[1]v">%[1]v</a>`, html.EscapeString(href))
+			}
+
+		case "mention", "emoji", "status":
+			text, _ := node.Attrs["text"].(string)
+			if shortName, _ := node.Attrs["shortName"].(string); text == "" {
+				text = shortName
+			}
+
+			storage.WriteString(html.EscapeString(text))
+
+		default:
+			storage.WriteString(html.EscapeString(node.Text))
+			storage.WriteString(storageInlineOf(node.Content))
+		}
+	}
+
+	return storage.String()
+}
+
+// storageTextOf converts an ADF text node and its marks other than the link to the storage format.
+func storageTextOf(node *model.CommentNodeScheme) string {
+
+	text := html.EscapeString(node.Text)
+
+	for _, tag := range []struct{ mark, element string }{
+		{"code", "code"},
+		{"underline", "u"},
+		{"strike", "del"},
+		{"em", "em"},
+		{"strong", "strong"},
+	} {
+		for _, mark := range node.Marks {
+			if mark != nil && mark.Type == tag.mark {
+				text = fmt.Sprintf("<%[1]v>%[2]v</%[1]v>", tag.element, text)
+				break
+			}
+		}
+	}
+
+	return text
+}
+
+// storageHref returns the destination of the link mark of the text node, empty when it isn't a link.
+func storageHref(node *model.CommentNodeScheme) string {
+
+	for _, mark := range node.Marks {
+		if mark != nil && mark.Type == "link" {
+			href, _ := mark.Attrs["href"].(string)
+			return href
+		}
+	}
+
+	return ""
+}
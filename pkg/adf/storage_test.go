@@ -0,0 +1,75 @@
+package adf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+func TestMarkdownToStorage(t *testing.T) {
+
+	testCases := []struct {
+		name     string
+		markdown string
+		want     string
+	}{
+		{
+			name:     "when the markdown has headings and paragraphs",
+			markdown: "# Release notes\n\nThe **new** _parser_ handles `a<b`\nand ~~old~~ [docs](https://example.com?a=1&b=2).",
+			want:     `<h1>Release notes</h1><p>The <strong>new</strong> <em>parser</em> handles <code>a&lt;b</code> and <del>old</del> <a href="https://example.com?a=1&amp;b=2">docs</a>.</p>`,
+		},
+		{
+			name:     "when the link and code spans contain emphasis delimiters",
+			markdown: "See [the *guide*](https://example.com/a_b_c/d*e*f) and `snake_case_name`.",
+			want:     `<p>See <a href="https://example.com/a_b_c/d*e*f">the <em>guide</em></a> and <code>snake_case_name</code>.</p>`,
+		},
+		{
+			name:     "when the markdown has lists, quotes and rules",
+			markdown: "- one\n- *two*\n\n3. first\n4. second\n\n> quoted\ntext\n\n---",
+			want:     `<ul><li>one</li><li><em>two</em></li></ul><ol start="3"><li>first</li><li>second</li></ol><blockquote><p>quoted text</p></blockquote><hr />`,
+		},
+		{
+			name:     "when the markdown has a fenced code block",
+			markdown: "```go\nfmt.Println(\"]]>\")\n```\n![logo](https://example.com/logo.png)",
+			want: `<ac:structured-macro ac:name="code"><ac:parameter ac:name="language">go</ac:parameter>` +
+				`<ac:plain-text-body><![CDATA[fmt.Println("]]]]><![CDATA[>")]]></ac:plain-text-body></ac:structured-macro>` +
+				`<p><a href="https://example.com/logo.png">logo</a></p>`,
+		},
+		{
+			name:     "when the markdown is empty",
+			markdown: "",
+			want:     "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.want, MarkdownToStorage(testCase.markdown))
+		})
+	}
+}
+
+func TestToStorage(t *testing.T) {
+
+	document := model.NewCommentDocument(
+		&model.CommentNodeScheme{Type: "table", Content: []*model.CommentNodeScheme{
+			{Type: "tableRow", Content: []*model.CommentNodeScheme{
+				{Type: "tableHeader", Content: []*model.CommentNodeScheme{model.NewCommentParagraph(model.NewCommentText("Key"))}},
+				{Type: "tableCell", Content: []*model.CommentNodeScheme{model.NewCommentParagraph(model.NewCommentText("KP-1"))}},
+			}},
+		}},
+		model.NewCommentParagraph(
+			&model.CommentNodeScheme{Type: "text", Text: "underlined", Marks: []*model.MarkScheme{{Type: "underline"}}},
+			&model.CommentNodeScheme{Type: "hardBreak"},
+			&model.CommentNodeScheme{Type: "mention", Attrs: map[string]interface{}{"id": "account-id", "text": "@Carlos"}},
+		),
+		&model.CommentNodeScheme{Type: "mediaSingle", Content: []*model.CommentNodeScheme{{Type: "media"}}},
+	)
+
+	assert.Equal(t, `<table><tbody><tr><th><p>Key</p></th><td><p>KP-1</p></td></tr></tbody></table>`+
+		`<p><u>underlined</u><br />@Carlos</p>`, ToStorage(document))
+
+	assert.Empty(t, ToStorage(nil))
+}
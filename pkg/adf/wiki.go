@@ -0,0 +1,1052 @@
+package adf
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+var (
+	wikiHeading   = regexp.MustCompile(`^\s*h([1-6])\.\s+(.*)$`)
+	wikiQuoteLine = regexp.MustCompile(`^\s*bq\.\s+(.*)$`)
+	wikiListItem  = regexp.MustCompile(`^\s*([*#]+|-)\s+(.*)$`)
+	wikiRule      = regexp.MustCompile(`^\s*-{4,}\s*$`)
+	wikiMacro     = regexp.MustCompile(`^\s*\{(code|noformat|quote)(?::([^}]*))?\}(.*)$`)
+	wikiColor     = regexp.MustCompile(`^\{color:([^}]*)\}`)
+	wikiHexColor  = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+	wikiURL       = regexp.MustCompile(`^(?:[a-zA-Z][a-zA-Z0-9+.-]*://|mailto:)`)
+	wikiEscapedAt = regexp.MustCompile(`^(\s*(?:h[1-6]|bq))(\.\s)`)
+)
+
+// wikiDelimiters are the characters delimiting the text effects, e.g. *strong* or -strike-.
+const wikiDelimiters = "*_-+^~"
+
+// FromWiki converts the wiki markup of the Jira Cloud REST API v2 to an ADF document, e.g. the body of a comment read
+// with the v2 client to add it with the v3 client.
+//
+// The conversion supports the headings, the paragraphs and their line breaks, the nested bullet and numbered lists,
+// the tables, the {code}, {noformat} and {quote} macros, the bq. quotes, the horizontal rules, the strong, emphasis,
+// strikethrough, underline, superscript, subscript, monospaced and hex {color} text effects, the links and the
+// [~accountid:...] mentions. The images, the attachments and the other macros are kept as text.
+//
+// Example usage:
+//
+//	comment, _, err := client.Issue.Comment.Add(ctx, "KP-1", &models.CommentPayloadScheme{
+//		Body: adf.FromWiki("The *deployment* failed, see [the logs|https://ci.example.com/builds/42]."),
+//	}, nil)
+func FromWiki(markup string) *model.CommentNodeScheme {
+	return model.NewCommentDocument(wikiBlocks(strings.Split(strings.NewReplacer("\r\n", "\n", "\r", "\n").Replace(markup), "\n"))...)
+}
+
+// ToWiki converts an ADF document to the wiki markup of the Jira Cloud REST API v2, e.g. the body of a comment read
+// with the v3 client to add it with the v2 client.
+//
+// The conversion supports the nodes and the marks produced by FromWiki. The text of the other nodes is kept, e.g. the
+// content of the panels, the dates and the statuses, and the media are dropped. Use IsWikiSafe to check that nothing
+// is lost, the wiki markup having no equivalent for e.g. the attributes of a list or the text of a mention.
+func ToWiki(document *model.CommentNodeScheme) string {
+
+	if document == nil {
+		return ""
+	}
+
+	return strings.Join(wikiBlocksOf(document.Content), "\n\n")
+}
+
+// IsWikiSafe reports whether the document converted with ToWiki then FromWiki is unchanged, the order of the marks
+// of a text aside, so it can round-trip between the v2 and v3 clients.
+func IsWikiSafe(document *model.CommentNodeScheme) bool {
+
+	if document == nil {
+		return true
+	}
+
+	original, err := wikiCanonical(document)
+	if err != nil {
+		return false
+	}
+
+	converted, err := wikiCanonical(FromWiki(ToWiki(document)))
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(original, converted)
+}
+
+// CommentFromWiki converts the payload of a comment of the v2 client, written in wiki markup, to the payload of the v3 client.
+func CommentFromWiki(payload *model.CommentPayloadSchemeV2) *model.CommentPayloadScheme {
+
+	if payload == nil {
+		return nil
+	}
+
+	return &model.CommentPayloadScheme{Visibility: payload.Visibility, Body: FromWiki(payload.Body)}
+}
+
+// CommentToWiki converts the payload of a comment of the v3 client, written in ADF, to the payload of the v2 client.
+func CommentToWiki(payload *model.CommentPayloadScheme) *model.CommentPayloadSchemeV2 {
+
+	if payload == nil {
+		return nil
+	}
+
+	return &model.CommentPayloadSchemeV2{Visibility: payload.Visibility, Body: ToWiki(payload.Body)}
+}
+
+// wikiBlocks converts the lines of wiki markup to ADF block nodes.
+func wikiBlocks(lines []string) []*model.CommentNodeScheme {
+
+	var (
+		nodes     []*model.CommentNodeScheme
+		paragraph []string
+	)
+
+	flush := func() {
+
+		if len(paragraph) != 0 {
+			nodes = append(nodes, model.NewCommentParagraph(wikiInline(strings.Join(paragraph, "\n"))...))
+			paragraph = nil
+		}
+	}
+
+	for index := 0; index < len(lines); index++ {
+
+		line := lines[index]
+
+		if strings.TrimSpace(line) == "" {
+			flush()
+			continue
+		}
+
+		if match := wikiMacro.FindStringSubmatch(line); match != nil {
+			flush()
+
+			var content []string
+			content, index = wikiMacroContent(lines, index, match[1], match[3])
+
+			switch match[1] {
+			case "quote":
+				nodes = append(nodes, &model.CommentNodeScheme{Type: "blockquote", Content: wikiNest(wikiBlocks(content))})
+			case "code":
+				nodes = append(nodes, markdownCodeBlock(wikiCodeLanguage(match[2]), content))
+			default:
+				nodes = append(nodes, markdownCodeBlock("", content))
+			}
+
+			continue
+		}
+
+		if match := wikiHeading.FindStringSubmatch(line); match != nil {
+			flush()
+
+			level := int(match[1][0] - '0')
+			nodes = append(nodes, &model.CommentNodeScheme{Type: "heading", Attrs: map[string]interface{}{"level": level}, Content: wikiInline(strings.TrimSpace(match[2]))})
+			continue
+		}
+
+		if wikiRule.MatchString(line) {
+			flush()
+			nodes = append(nodes, &model.CommentNodeScheme{Type: "rule"})
+			continue
+		}
+
+		if match := wikiQuoteLine.FindStringSubmatch(line); match != nil {
+			flush()
+			nodes = append(nodes, &model.CommentNodeScheme{Type: "blockquote", Content: []*model.CommentNodeScheme{model.NewCommentParagraph(wikiInline(match[1])...)}})
+			continue
+		}
+
+		if wikiListItem.MatchString(line) {
+			flush()
+
+			var lists []*model.CommentNodeScheme
+			lists, index = wikiLists(lines, index)
+			nodes = append(nodes, lists...)
+			continue
+		}
+
+		if strings.HasPrefix(strings.TrimSpace(line), "|") {
+			flush()
+
+			var table *model.CommentNodeScheme
+			table, index = wikiTable(lines, index)
+			if len(table.Content) != 0 {
+				nodes = append(nodes, table)
+			}
+
+			continue
+		}
+
+		paragraph = append(paragraph, strings.TrimLeft(line, " \t"))
+	}
+
+	flush()
+
+	return nodes
+}
+
+// wikiMacroContent returns the lines of the macro starting at the line, and the index of its closing line.
+// The rest is the text following the opening tag.
+func wikiMacroContent(lines []string, index int, name, rest string) ([]string, int) {
+
+	closing := "{" + name + "}"
+
+	if end := strings.Index(rest, closing); end >= 0 {
+		return []string{rest[:end]}, index
+	}
+
+	var content []string
+	if strings.TrimSpace(rest) != "" {
+		content = append(content, rest)
+	}
+
+	for index++; index < len(lines); index++ {
+
+		if end := strings.Index(lines[index], closing); end >= 0 {
+
+			if before := lines[index][:end]; strings.TrimSpace(before) != "" {
+				content = append(content, before)
+			}
+
+			return content, index
+		}
+
+		content = append(content, lines[index])
+	}
+
+	return content, index
+}
+
+// wikiCodeLanguage returns the language of the parameters of a {code} macro, e.g. {code:go} or {code:title=main.go|language=go}.
+func wikiCodeLanguage(parameters string) string {
+
+	for index, parameter := range strings.Split(parameters, "|") {
+
+		key, value, found := strings.Cut(parameter, "=")
+		if !found && index == 0 {
+			return strings.TrimSpace(key)
+		}
+
+		if strings.TrimSpace(key) == "language" {
+			return strings.TrimSpace(value)
+		}
+	}
+
+	return ""
+}
+
+// wikiNest adapts the blocks to the block quotes, the rows of the tables becoming paragraphs, then as markdownNest.
+func wikiNest(nodes []*model.CommentNodeScheme) []*model.CommentNodeScheme {
+
+	var nested []*model.CommentNodeScheme
+	for _, node := range nodes {
+
+		if node.Type != "table" {
+			nested = append(nested, node)
+			continue
+		}
+
+		for _, row := range node.Content {
+
+			paragraph := model.NewCommentParagraph()
+			for _, cell := range row.Content {
+				for _, child := range cell.Content {
+					if len(paragraph.Content) != 0 {
+						paragraph.AppendNode(model.NewCommentText(" "))
+					}
+					paragraph.Content = append(paragraph.Content, child.Content...)
+				}
+			}
+
+			nested = append(nested, paragraph)
+		}
+	}
+
+	return markdownNest(nested)
+}
+
+// wikiItem represents an item of a wiki list, e.g. "*# text" has the markers "*#".
+type wikiItem struct {
+	markers string
+	text    string
+}
+
+// wikiLists converts the list items starting at the line, and returns the index of the last line of the list.
+func wikiLists(lines []string, index int) ([]*model.CommentNodeScheme, int) {
+
+	var items []*wikiItem
+	for ; index < len(lines); index++ {
+
+		line := lines[index]
+
+		if match := wikiListItem.FindStringSubmatch(line); match != nil && !wikiRule.MatchString(line) {
+			items = append(items, &wikiItem{markers: strings.ReplaceAll(match[1], "-", "*"), text: match[2]})
+			continue
+		}
+
+		// A line following an item is the next line of its text
+		if strings.TrimSpace(line) == "" || wikiInterrupts(line) {
+			break
+		}
+
+		items[len(items)-1].text += "\n" + strings.TrimLeft(line, " \t")
+	}
+
+	return wikiListNodes(items, 0), index - 1
+}
+
+// wikiListNodes returns the lists of the items at the depth, the deeper items being nested in the previous item.
+func wikiListNodes(items []*wikiItem, depth int) []*model.CommentNodeScheme {
+
+	var (
+		lists []*model.CommentNodeScheme
+		list  *model.CommentNodeScheme
+	)
+
+	for index := 0; index < len(items); {
+
+		listType := "bulletList"
+		if items[index].markers[depth] == '#' {
+			listType = "orderedList"
+		}
+
+		if list == nil || list.Type != listType {
+			list = &model.CommentNodeScheme{Type: listType}
+			lists = append(lists, list)
+		}
+
+		listItem := &model.CommentNodeScheme{Type: "listItem"}
+
+		// An item skipping a level is nested in an empty item
+		if len(items[index].markers) == depth+1 {
+			listItem.AppendNode(model.NewCommentParagraph(wikiInline(items[index].text)...))
+			index++
+		} else {
+			listItem.AppendNode(model.NewCommentParagraph())
+		}
+
+		end := index
+		for end < len(items) && len(items[end].markers) > depth+1 {
+			end++
+		}
+
+		listItem.Content = append(listItem.Content, wikiListNodes(items[index:end], depth+1)...)
+		list.AppendNode(listItem)
+
+		index = end
+	}
+
+	return lists
+}
+
+// wikiInterrupts reports whether the line starts a block, ending the list or the paragraph before it.
+func wikiInterrupts(line string) bool {
+	return wikiMacro.MatchString(line) || wikiHeading.MatchString(line) || wikiRule.MatchString(line) ||
+		wikiQuoteLine.MatchString(line) || strings.HasPrefix(strings.TrimSpace(line), "|")
+}
+
+// wikiTable converts the rows starting at the line, and returns the index of the last row.
+func wikiTable(lines []string, index int) (*model.CommentNodeScheme, int) {
+
+	table := &model.CommentNodeScheme{Type: "table"}
+	for ; index < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[index]), "|"); index++ {
+
+		if cells := wikiCells(strings.TrimSpace(lines[index])); len(cells) != 0 {
+			table.AppendNode(&model.CommentNodeScheme{Type: "tableRow", Content: cells})
+		}
+	}
+
+	return table, index - 1
+}
+
+// wikiCells returns the cells of a row, the cells following a || being headers.
+func wikiCells(row string) []*model.CommentNodeScheme {
+
+	var (
+		cells  []*model.CommentNodeScheme
+		header bool
+		start  = -1
+		depth  int
+	)
+
+	cell := func(end int) {
+
+		if start < 0 {
+			return
+		}
+
+		cellType := "tableCell"
+		if header {
+			cellType = "tableHeader"
+		}
+
+		cells = append(cells, &model.CommentNodeScheme{Type: cellType, Content: []*model.CommentNodeScheme{
+			model.NewCommentParagraph(wikiInline(strings.TrimSpace(row[start:end]))...),
+		}})
+	}
+
+	for index := 0; index < len(row); {
+
+		switch row[index] {
+		case '\\':
+			index += 2
+			continue
+
+		case '[':
+			depth++
+
+		case ']':
+			depth = max(depth-1, 0)
+
+		case '{':
+			if strings.HasPrefix(row[index:], "{{") {
+				if end := strings.Index(row[index+2:], "}}"); end >= 0 {
+					index += end + 4
+					continue
+				}
+			}
+
+		case '|':
+			if depth != 0 {
+				break
+			}
+
+			cell(index)
+
+			header = strings.HasPrefix(row[index:], "||")
+			if header {
+				index += 2
+			} else {
+				index++
+			}
+
+			start = index
+			continue
+		}
+
+		index++
+	}
+
+	if start >= 0 && start < len(row) {
+		cell(len(row))
+	}
+
+	return cells
+}
+
+// wikiInline converts the wiki inline elements of the text to ADF inline nodes carrying the marks.
+func wikiInline(text string, marks ...*model.MarkScheme) []*model.CommentNodeScheme {
+
+	var (
+		nodes []*model.CommentNodeScheme
+		plain strings.Builder
+	)
+
+	emit := func() {
+
+		if plain.Len() != 0 {
+			nodes = markdownAppendText(nodes, plain.String(), marks)
+			plain.Reset()
+		}
+	}
+
+	for index := 0; index < len(text); {
+
+		char := text[index]
+
+		switch {
+		case char == '\n' || strings.HasPrefix(text[index:], `\\`):
+			emit()
+			nodes = append(nodes, &model.CommentNodeScheme{Type: "hardBreak"})
+
+			if char == '\n' {
+				index++
+			} else {
+				index += 2
+			}
+
+			continue
+
+		case char == '\\' && index+1 < len(text) && strings.IndexByte(markdownPunctuation, text[index+1]) >= 0:
+			plain.WriteByte(text[index+1])
+			index += 2
+			continue
+
+		case strings.HasPrefix(text[index:], "{{"):
+
+			if end := strings.Index(text[index+2:], "}}"); end > 0 {
+				emit()
+				nodes = markdownAppendText(nodes, text[index+2:index+2+end], markdownCodeMarks(marks))
+				index += end + 4
+				continue
+			}
+
+		case char == '{':
+
+			if match := wikiColor.FindStringSubmatch(text[index:]); match != nil {
+				if end := strings.Index(text[index+len(match[0]):], "{color}"); end >= 0 {
+
+					// Only the hex colors have an ADF equivalent, the text of the others is kept
+					colored := marks
+					if wikiHexColor.MatchString(match[1]) {
+						colored = markdownMark(marks, &model.MarkScheme{Type: "textColor", Attrs: map[string]interface{}{"color": strings.ToLower(match[1])}})
+					}
+
+					emit()
+					nodes = wikiAppend(nodes, wikiInline(text[index+len(match[0]):index+len(match[0])+end], colored...))
+					index += len(match[0]) + end + len("{color}")
+					continue
+				}
+			}
+
+			// The braced effects, e.g. {*}strong{*}, can be used inside a word
+			if index+2 < len(text) && strings.IndexByte(wikiDelimiters, text[index+1]) >= 0 && text[index+2] == '}' {
+
+				delimiter := text[index : index+3]
+				if end := strings.Index(text[index+3:], delimiter); end > 0 {
+					emit()
+					nodes = wikiAppend(nodes, wikiInline(text[index+3:index+3+end], markdownMark(marks, wikiMark(text[index+1]))...))
+					index += end + 6
+					continue
+				}
+			}
+
+		case char == '[':
+
+			if linked, end, ok := wikiLink(text, index, marks); ok {
+				emit()
+				nodes = wikiAppend(nodes, linked)
+				index = end
+				continue
+			}
+
+		case strings.IndexByte(wikiDelimiters, char) >= 0:
+
+			if inner, end, ok := wikiEffect(text, index); ok {
+				emit()
+				nodes = wikiAppend(nodes, wikiInline(inner, markdownMark(marks, wikiMark(char))...))
+				index = end
+				continue
+			}
+		}
+
+		plain.WriteByte(char)
+		index++
+	}
+
+	emit()
+
+	return nodes
+}
+
+// wikiAppend appends the inline nodes, the text nodes being merged with the previous text node when they have the same marks.
+func wikiAppend(nodes, others []*model.CommentNodeScheme) []*model.CommentNodeScheme {
+
+	for _, other := range others {
+
+		if other.Type == "text" {
+			nodes = markdownAppendText(nodes, other.Text, other.Marks)
+			continue
+		}
+
+		nodes = append(nodes, other)
+	}
+
+	return nodes
+}
+
+// wikiEffect returns the content of the text effect starting with the delimiter at the index, and the index following it.
+//
+// The delimiter opens the effect when it doesn't follow a letter or a digit and precedes a text, and closes it when
+// it follows a text and doesn't precede a letter or a digit, the effects spanning a single line.
+func wikiEffect(text string, start int) (string, int, bool) {
+
+	char := text[start]
+
+	if !wikiOpens(text, start) || start+1 == len(text) {
+		return "", 0, false
+	}
+
+	for index := start + 1; index < len(text); index++ {
+
+		switch {
+		case text[index] == '\n':
+			return "", 0, false
+
+		case text[index] == '\\':
+			index++
+
+		case strings.HasPrefix(text[index:], "{{"):
+			if end := strings.Index(text[index+2:], "}}"); end > 0 {
+				index += end + 3
+			}
+
+		case text[index] == char && index > start+1 && wikiCloses(text, index):
+			return text[start+1 : index], index + 1, true
+		}
+	}
+
+	return "", 0, false
+}
+
+// wikiOpens reports whether the delimiter at the index can open a text effect.
+func wikiOpens(text string, index int) bool {
+	return (index == 0 || !markdownWord(text[index-1])) && (index+1 == len(text) || !markdownSpace(text[index+1]))
+}
+
+// wikiCloses reports whether the delimiter at the index can close a text effect.
+func wikiCloses(text string, index int) bool {
+	return (index == 0 || !markdownSpace(text[index-1])) && (index+1 == len(text) || !markdownWord(text[index+1]))
+}
+
+// wikiMark returns the ADF mark of the text effect delimiter.
+func wikiMark(delimiter byte) *model.MarkScheme {
+
+	switch delimiter {
+	case '*':
+		return &model.MarkScheme{Type: "strong"}
+	case '_':
+		return &model.MarkScheme{Type: "em"}
+	case '-':
+		return &model.MarkScheme{Type: "strike"}
+	case '+':
+		return &model.MarkScheme{Type: "underline"}
+	case '^':
+		return &model.MarkScheme{Type: "subsup", Attrs: map[string]interface{}{"type": "sup"}}
+	default:
+		return &model.MarkScheme{Type: "subsup", Attrs: map[string]interface{}{"type": "sub"}}
+	}
+}
+
+// wikiLink returns the nodes of the link or the mention starting with the bracket at the index, and the index following it.
+// The brackets without a URL, e.g. the anchors and the attachments, are kept as text.
+func wikiLink(text string, start int, marks []*model.MarkScheme) ([]*model.CommentNodeScheme, int, bool) {
+
+	closing, separator := -1, -1
+	for index := start + 1; index < len(text) && closing < 0; index++ {
+
+		switch text[index] {
+		case '\\':
+			index++
+		case '\n', '[':
+			return nil, 0, false
+		case '|':
+			if separator < 0 {
+				separator = index
+			}
+		case ']':
+			closing = index
+		}
+	}
+
+	if closing < 0 {
+		return nil, 0, false
+	}
+
+	content := text[start+1 : closing]
+
+	if accountID, found := strings.CutPrefix(content, "~accountid:"); found && accountID != "" {
+		return []*model.CommentNodeScheme{model.NewCommentMention(accountID, "")}, closing + 1, true
+	}
+
+	if separator < 0 {
+
+		if !wikiURL.MatchString(content) {
+			return nil, 0, false
+		}
+
+		return markdownAppendText(nil, content, markdownMark(marks, markdownLinkMark(content))), closing + 1, true
+	}
+
+	href := strings.TrimSpace(text[separator+1 : closing])
+	if !wikiURL.MatchString(href) {
+		return nil, 0, false
+	}
+
+	return wikiInline(strings.TrimSpace(text[start+1:separator]), markdownMark(marks, markdownLinkMark(href))...), closing + 1, true
+}
+
+// wikiBlocksOf converts the ADF block nodes to wiki markup blocks, dropping the empty ones.
+func wikiBlocksOf(nodes []*model.CommentNodeScheme) []string {
+
+	var blocks []string
+	for _, node := range nodes {
+		if block := wikiBlock(node); block != "" {
+			blocks = append(blocks, block)
+		}
+	}
+
+	return blocks
+}
+
+// wikiBlock converts an ADF block node to wiki markup.
+func wikiBlock(node *model.CommentNodeScheme) string {
+
+	if node == nil {
+		return ""
+	}
+
+	switch node.Type {
+	case "paragraph":
+		return wikiEscapeLines(wikiInlineOf(node.Content, "\n"))
+
+	case "heading":
+		return fmt.Sprintf("h%v. %v", min(max(wikiInt(node.Attrs["level"]), 1), 6), wikiInlineOf(node.Content, `\\`))
+
+	case "bulletList", "orderedList":
+		return wikiListOf(node, "")
+
+	case "codeBlock":
+
+		var code strings.Builder
+		for _, child := range node.Content {
+			code.WriteString(child.Text)
+		}
+
+		if language, _ := node.Attrs["language"].(string); language != "" {
+			return "{code:" + language + "}\n" + code.String() + "\n{code}"
+		}
+
+		return "{noformat}\n" + code.String() + "\n{noformat}"
+
+	case "blockquote":
+		return "{quote}\n" + strings.Join(wikiBlocksOf(node.Content), "\n\n") + "\n{quote}"
+
+	case "rule":
+		return "----"
+
+	case "table":
+		return wikiTableOf(node)
+
+	case "blockCard", "embedCard":
+		if href, _ := node.Attrs["url"].(string); href != "" {
+			return "[" + href + "]"
+		}
+
+		return ""
+
+	case "mediaSingle", "mediaGroup", "media":
+		return ""
+	}
+
+	// The content of the other nodes is kept, e.g. the blocks of a panel or the text of a task
+	if len(node.Content) != 0 && slices.Contains([]string{"taskItem", "decisionItem", "caption"}, node.Type) {
+		return wikiEscapeLines(wikiInlineOf(node.Content, "\n"))
+	}
+
+	return strings.Join(wikiBlocksOf(node.Content), "\n\n")
+}
+
+// wikiListOf converts an ADF list to wiki markup, the items being prefixed with the markers of the parent lists.
+func wikiListOf(list *model.CommentNodeScheme, prefix string) string {
+
+	marker := "*"
+	if list.Type == "orderedList" {
+		marker = "#"
+	}
+
+	var lines []string
+	for _, item := range list.Content {
+
+		var text, nested []string
+		for _, child := range item.Content {
+
+			switch child.Type {
+			case "bulletList", "orderedList":
+				nested = append(nested, wikiListOf(child, prefix+marker))
+			case "paragraph":
+				text = append(text, wikiInlineOf(child.Content, "\n"))
+			default:
+				text = append(text, wikiBlock(child))
+			}
+		}
+
+		lines = append(lines, prefix+marker+" "+wikiEscapeLines(strings.Join(text, "\n")))
+		lines = append(lines, nested...)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// wikiTableOf converts an ADF table to wiki markup, a row per line.
+func wikiTableOf(table *model.CommentNodeScheme) string {
+
+	var rows []string
+	for _, row := range table.Content {
+
+		var line strings.Builder
+		delimiter := "|"
+
+		for _, cell := range row.Content {
+
+			delimiter = "|"
+			if cell.Type == "tableHeader" {
+				delimiter = "||"
+			}
+
+			var paragraphs []string
+			for _, child := range cell.Content {
+				if child.Type == "paragraph" {
+					paragraphs = append(paragraphs, wikiInlineOf(child.Content, `\\`))
+				} else if block := wikiBlock(child); block != "" {
+					paragraphs = append(paragraphs, strings.ReplaceAll(block, "\n", `\\`))
+				}
+			}
+
+			// An empty cell is written as a space, || being the delimiter of the headers
+			content := strings.Join(paragraphs, `\\`)
+			if content == "" {
+				content = " "
+			}
+
+			line.WriteString(delimiter + content)
+		}
+
+		if line.Len() != 0 {
+			rows = append(rows, line.String()+delimiter)
+		}
+	}
+
+	return strings.Join(rows, "\n")
+}
+
+// wikiInlineOf converts ADF inline nodes to wiki markup, the hard breaks being written as the line break.
+func wikiInlineOf(nodes []*model.CommentNodeScheme, lineBreak string) string {
+
+	var markup strings.Builder
+	for index, node := range nodes {
+
+		if node == nil {
+			continue
+		}
+
+		switch node.Type {
+		case "text":
+
+			var before, after byte
+			if markup.Len() != 0 {
+				before = markup.String()[markup.Len()-1]
+			}
+
+			if index+1 < len(nodes) && nodes[index+1] != nil && nodes[index+1].Text != "" {
+				after = nodes[index+1].Text[0]
+			}
+
+			markup.WriteString(wikiTextOf(node, before, after))
+
+		case "hardBreak":
+			markup.WriteString(lineBreak)
+
+		case "mention":
+			if accountID, _ := node.Attrs["id"].(string); accountID != "" {
+				markup.WriteString("[~accountid:" + accountID + "]")
+			}
+
+		case "inlineCard":
+			if href, _ := node.Attrs["url"].(string); href != "" {
+				markup.WriteString("[" + href + "]")
+			}
+
+		case "emoji", "status":
+			text, _ := node.Attrs["text"].(string)
+			if shortName, _ := node.Attrs["shortName"].(string); text == "" {
+				text = shortName
+			}
+
+			markup.WriteString(wikiEscape(text))
+
+		case "date":
+			if timestamp := wikiInt(node.Attrs["timestamp"]); timestamp != 0 {
+				markup.WriteString(time.UnixMilli(int64(timestamp)).UTC().Format("2006-01-02"))
+			}
+
+		default:
+			markup.WriteString(wikiEscape(node.Text))
+			markup.WriteString(wikiInlineOf(node.Content, lineBreak))
+		}
+	}
+
+	return markup.String()
+}
+
+// wikiTextOf converts an ADF text node and its marks to wiki markup. The text effects are braced, e.g. {*}strong{*},
+// when they're inside a word, the characters before and after the node being provided.
+func wikiTextOf(node *model.CommentNodeScheme, before, after byte) string {
+
+	var (
+		href, color string
+		code        bool
+		delimiters  []string
+	)
+
+	for _, mark := range node.Marks {
+
+		if mark == nil {
+			continue
+		}
+
+		switch mark.Type {
+		case "strong":
+			delimiters = append(delimiters, "*")
+		case "em":
+			delimiters = append(delimiters, "_")
+		case "strike":
+			delimiters = append(delimiters, "-")
+		case "underline":
+			delimiters = append(delimiters, "+")
+		case "subsup":
+			if mark.Attrs["type"] == "sub" {
+				delimiters = append(delimiters, "~")
+			} else {
+				delimiters = append(delimiters, "^")
+			}
+		case "code":
+			code = true
+		case "link":
+			href, _ = mark.Attrs["href"].(string)
+		case "textColor":
+			color, _ = mark.Attrs["color"].(string)
+		}
+	}
+
+	if code {
+		text := "{{" + node.Text + "}}"
+		if href != "" {
+			return "[" + text + "|" + href + "]"
+		}
+
+		return text
+	}
+
+	if href != "" && node.Text == href && len(delimiters) == 0 && color == "" {
+		return "[" + href + "]"
+	}
+
+	text := wikiEscape(node.Text)
+
+	if len(delimiters) != 0 {
+
+		braced := markdownWord(before) || markdownWord(after) || strings.TrimSpace(node.Text) != node.Text
+		if href != "" || color != "" {
+			braced = strings.TrimSpace(node.Text) != node.Text
+		}
+
+		for index := len(delimiters) - 1; index >= 0; index-- {
+
+			delimiter := delimiters[index]
+			if braced {
+				delimiter = "{" + delimiter + "}"
+			}
+
+			text = delimiter + text + delimiter
+		}
+	}
+
+	if color != "" {
+		text = "{color:" + color + "}" + text + "{color}"
+	}
+
+	if href != "" {
+		text = "[" + text + "|" + href + "]"
+	}
+
+	return text
+}
+
+// wikiEscape escapes the characters of the text having a meaning in wiki markup.
+func wikiEscape(text string) string {
+
+	var escaped strings.Builder
+	for index := 0; index < len(text); index++ {
+
+		char := text[index]
+
+		switch {
+		case strings.IndexByte("[]{}|", char) >= 0:
+			escaped.WriteByte('\\')
+		case char == '!' && wikiOpens(text, index):
+			escaped.WriteByte('\\')
+		case strings.IndexByte(wikiDelimiters, char) >= 0 && (wikiOpens(text, index) || wikiCloses(text, index)):
+			escaped.WriteByte('\\')
+		}
+
+		escaped.WriteByte(char)
+	}
+
+	return escaped.String()
+}
+
+// wikiEscapeLines escapes the lines of a paragraph starting as a block, e.g. a list item or a heading.
+func wikiEscapeLines(text string) string {
+
+	lines := strings.Split(text, "\n")
+	for index, line := range lines {
+
+		switch {
+		case wikiEscapedAt.MatchString(line):
+			lines[index] = wikiEscapedAt.ReplaceAllString(line, `$1\$2`)
+		case wikiListItem.MatchString(line) || wikiRule.MatchString(line):
+			indent := len(line) - len(strings.TrimLeft(line, " \t"))
+			lines[index] = line[:indent] + `\` + line[indent:]
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// wikiInt returns the integer of an attribute, decoded from JSON as a float64.
+func wikiInt(value interface{}) int {
+
+	switch number := value.(type) {
+	case int:
+		return number
+	case int64:
+		return int(number)
+	case float64:
+		return int(number)
+	}
+
+	return 0
+}
+
+// wikiCanonical returns the JSON of the document, the marks of its text nodes being sorted by type.
+func wikiCanonical(document *model.CommentNodeScheme) ([]byte, error) {
+
+	raw, err := json.Marshal(document)
+	if err != nil {
+		return nil, err
+	}
+
+	canonical := new(model.CommentNodeScheme)
+	if err := json.Unmarshal(raw, canonical); err != nil {
+		return nil, err
+	}
+
+	var sortMarks func(node *model.CommentNodeScheme)
+	sortMarks = func(node *model.CommentNodeScheme) {
+
+		if node == nil {
+			return
+		}
+
+		node.Marks = slices.DeleteFunc(node.Marks, func(mark *model.MarkScheme) bool { return mark == nil })
+		slices.SortStableFunc(node.Marks, func(a, b *model.MarkScheme) int {
+			return strings.Compare(a.Type, b.Type)
+		})
+
+		for _, child := range node.Content {
+			sortMarks(child)
+		}
+	}
+
+	sortMarks(canonical)
+
+	return json.Marshal(canonical)
+}
@@ -0,0 +1,240 @@
+package adf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+func TestFromWiki(t *testing.T) {
+
+	text := model.NewCommentText
+	paragraph := model.NewCommentParagraph
+
+	marked := func(value string, marks ...*model.MarkScheme) *model.CommentNodeScheme {
+		node := text(value)
+		node.Marks = marks
+		return node
+	}
+
+	link := func(href string) *model.MarkScheme {
+		return &model.MarkScheme{Type: "link", Attrs: map[string]interface{}{"href": href}}
+	}
+
+	item := func(nodes ...*model.CommentNodeScheme) *model.CommentNodeScheme {
+		return &model.CommentNodeScheme{Type: "listItem", Content: nodes}
+	}
+
+	cell := func(cellType string, nodes ...*model.CommentNodeScheme) *model.CommentNodeScheme {
+		return &model.CommentNodeScheme{Type: cellType, Content: []*model.CommentNodeScheme{paragraph(nodes...)}}
+	}
+
+	hardBreak := &model.CommentNodeScheme{Type: "hardBreak"}
+	strong, em, code := &model.MarkScheme{Type: "strong"}, &model.MarkScheme{Type: "em"}, &model.MarkScheme{Type: "code"}
+
+	testCases := []struct {
+		name   string
+		markup string
+		want   []*model.CommentNodeScheme
+	}{
+		{
+			name:   "when the markup is empty",
+			markup: "",
+		},
+
+		{
+			name:   "when the paragraph has text effects",
+			markup: "The *build* of _main_ failed in {{make test}}, see [the logs|https://ci.example.com/42].\nRetry with{*}out{*} -cache-\\\\now",
+			want: []*model.CommentNodeScheme{
+				paragraph(
+					text("The "), marked("build", strong), text(" of "), marked("main", em), text(" failed in "),
+					marked("make test", code), text(", see "), marked("the logs", link("https://ci.example.com/42")), text("."),
+					hardBreak, text("Retry with"), marked("out", strong), text(" "), marked("cache", &model.MarkScheme{Type: "strike"}),
+					hardBreak, text("now"),
+				),
+			},
+		},
+
+		{
+			name:   "when the text has delimiters inside the words and escaped characters",
+			markup: "well-known a*b C++ \\*literal\\* [not a link] [^file.txt]",
+			want: []*model.CommentNodeScheme{
+				paragraph(text("well-known a*b C++ *literal* [not a link] [^file.txt]")),
+			},
+		},
+
+		{
+			name:   "when the paragraph has mentions, colors and scripts",
+			markup: "[~accountid:5b10a2844c20165700ede21g] {color:#FF0000}red{color} {color:red}named{color} ^up^ ~down~",
+			want: []*model.CommentNodeScheme{
+				paragraph(
+					model.NewCommentMention("5b10a2844c20165700ede21g", ""), text(" "),
+					marked("red", &model.MarkScheme{Type: "textColor", Attrs: map[string]interface{}{"color": "#ff0000"}}),
+					text(" named "),
+					marked("up", &model.MarkScheme{Type: "subsup", Attrs: map[string]interface{}{"type": "sup"}}), text(" "),
+					marked("down", &model.MarkScheme{Type: "subsup", Attrs: map[string]interface{}{"type": "sub"}}),
+				),
+			},
+		},
+
+		{
+			name:   "when the markup has headings, rules and quotes",
+			markup: "h2. Release *notes*\n----\nbq. quoted\n{quote}\nfirst\n\nsecond\n{quote}",
+			want: []*model.CommentNodeScheme{
+				{Type: "heading", Attrs: map[string]interface{}{"level": 2}, Content: []*model.CommentNodeScheme{text("Release "), marked("notes", strong)}},
+				{Type: "rule"},
+				{Type: "blockquote", Content: []*model.CommentNodeScheme{paragraph(text("quoted"))}},
+				{Type: "blockquote", Content: []*model.CommentNodeScheme{paragraph(text("first")), paragraph(text("second"))}},
+			},
+		},
+
+		{
+			name:   "when the markup has code macros",
+			markup: "{code:title=main.go|language=go}\nfunc main() {}\n{code}\n{noformat}raw *text*{noformat}",
+			want: []*model.CommentNodeScheme{
+				{Type: "codeBlock", Attrs: map[string]interface{}{"language": "go"}, Content: []*model.CommentNodeScheme{text("func main() {}")}},
+				{Type: "codeBlock", Content: []*model.CommentNodeScheme{text("raw *text*")}},
+			},
+		},
+
+		{
+			name:   "when the markup has nested lists",
+			markup: "* one\n** nested\n*# numbered\n- two\ncontinued\n# first",
+			want: []*model.CommentNodeScheme{
+				{Type: "bulletList", Content: []*model.CommentNodeScheme{
+					item(
+						paragraph(text("one")),
+						&model.CommentNodeScheme{Type: "bulletList", Content: []*model.CommentNodeScheme{item(paragraph(text("nested")))}},
+						&model.CommentNodeScheme{Type: "orderedList", Content: []*model.CommentNodeScheme{item(paragraph(text("numbered")))}},
+					),
+					item(paragraph(text("two"), hardBreak, text("continued"))),
+				}},
+				{Type: "orderedList", Content: []*model.CommentNodeScheme{item(paragraph(text("first")))}},
+			},
+		},
+
+		{
+			name:   "when the list skips a level",
+			markup: "** deep",
+			want: []*model.CommentNodeScheme{
+				{Type: "bulletList", Content: []*model.CommentNodeScheme{
+					item(paragraph(), &model.CommentNodeScheme{Type: "bulletList", Content: []*model.CommentNodeScheme{item(paragraph(text("deep")))}}),
+				}},
+			},
+		},
+
+		{
+			name:   "when the markup has a table",
+			markup: "||Key||Summary||\n|KP-1|a [link|https://x.io] \\| pipe|\n| |empty|",
+			want: []*model.CommentNodeScheme{
+				{Type: "table", Content: []*model.CommentNodeScheme{
+					{Type: "tableRow", Content: []*model.CommentNodeScheme{cell("tableHeader", text("Key")), cell("tableHeader", text("Summary"))}},
+					{Type: "tableRow", Content: []*model.CommentNodeScheme{
+						cell("tableCell", text("KP-1")),
+						cell("tableCell", text("a "), marked("link", link("https://x.io")), text(" | pipe")),
+					}},
+					{Type: "tableRow", Content: []*model.CommentNodeScheme{cell("tableCell"), cell("tableCell", text("empty"))}},
+				}},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			got := FromWiki(testCase.markup)
+
+			assert.Equal(t, model.NewCommentDocument(testCase.want...), got)
+			assert.NoError(t, got.Validate())
+		})
+	}
+}
+
+func TestToWiki(t *testing.T) {
+
+	testCases := []struct {
+		name     string
+		document *model.CommentNodeScheme
+		want     string
+	}{
+		{
+			name: "when the document is nil",
+		},
+
+		{
+			name:     "when the document is converted from markdown",
+			document: FromMarkdown("# Title\n\n- a\n  - b\n\n1. x\n\n```go\nx := 1\n```\n\n> quoted\n\n---\n\nword**bold**word, *em*, ~~gone~~, `code` and [logs](https://x.io)"),
+			want: "h1. Title\n\n* a\n** b\n\n# x\n\n{code:go}\nx := 1\n{code}\n\n{quote}\nquoted\n{quote}\n\n----\n\n" +
+				"word{*}bold{*}word, _em_, -gone-, {{code}} and [logs|https://x.io]",
+		},
+
+		{
+			name: "when the text looks like wiki markup",
+			document: model.NewCommentDocument(model.NewCommentParagraph(
+				model.NewCommentText("* not a list"), &model.CommentNodeScheme{Type: "hardBreak"},
+				model.NewCommentText("h1. not a heading"), &model.CommentNodeScheme{Type: "hardBreak"},
+				model.NewCommentText("cost -5, *stars*, [brackets], {braces}, x|y, well-known"),
+			)),
+			want: "\\* not a list\nh1\\. not a heading\ncost \\-5, \\*stars\\*, \\[brackets\\], \\{braces\\}, x\\|y, well-known",
+		},
+
+		{
+			name: "when the document has nodes without a wiki equivalent",
+			document: model.NewCommentDocument(
+				&model.CommentNodeScheme{Type: "panel", Attrs: map[string]interface{}{"panelType": "info"}, Content: []*model.CommentNodeScheme{
+					model.NewCommentParagraph(
+						model.NewCommentMention("5b10a2844c20165700ede21g", "Jane"),
+						model.NewCommentText(" "),
+						&model.CommentNodeScheme{Type: "emoji", Attrs: map[string]interface{}{"shortName": ":smile:"}},
+						model.NewCommentText(" "),
+						&model.CommentNodeScheme{Type: "inlineCard", Attrs: map[string]interface{}{"url": "https://x.io"}},
+					),
+				}},
+				&model.CommentNodeScheme{Type: "mediaSingle", Content: []*model.CommentNodeScheme{{Type: "media", Attrs: map[string]interface{}{"type": "file", "id": "1"}}}},
+				&model.CommentNodeScheme{Type: "table", Content: []*model.CommentNodeScheme{
+					{Type: "tableRow", Content: []*model.CommentNodeScheme{
+						{Type: "tableHeader", Content: []*model.CommentNodeScheme{model.NewCommentParagraph(model.NewCommentText("Key"))}},
+						{Type: "tableCell"},
+					}},
+				}},
+			),
+			want: "[~accountid:5b10a2844c20165700ede21g] :smile: [https://x.io]\n\n||Key| |",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.want, ToWiki(testCase.document))
+		})
+	}
+}
+
+func TestIsWikiSafe(t *testing.T) {
+
+	assert.True(t, IsWikiSafe(nil))
+	assert.True(t, IsWikiSafe(FromWiki("h2. Notes\n\nThe *build* of _main_ {color:#ff0000}failed{color}, see [logs|https://x.io].\n\n* one\n*# two\n\n||Key||\n|KP-1|")))
+	assert.True(t, IsWikiSafe(FromMarkdown("word**bold**word, `code` and [`c`](https://x.io)\n\n> quoted")))
+	assert.True(t, IsWikiSafe(model.NewCommentDocument(model.NewCommentParagraph(model.NewCommentText("* cost -5, *stars*, [brackets] {braces} x|y ^up^")))))
+
+	// The wiki markup has no equivalent for the text of a mention or the start of an ordered list
+	assert.False(t, IsWikiSafe(model.NewCommentDocument(model.NewCommentParagraph(model.NewCommentMention("5b10a2844c20165700ede21g", "Jane")))))
+	assert.False(t, IsWikiSafe(FromMarkdown("3. three\n4. four")))
+}
+
+func TestCommentFromWiki(t *testing.T) {
+
+	visibility := &model.CommentVisibilityScheme{Type: "role", Value: "Administrators"}
+
+	payload := CommentFromWiki(&model.CommentPayloadSchemeV2{Visibility: visibility, Body: "The *build* failed"})
+	assert.Equal(t, visibility, payload.Visibility)
+	assert.Equal(t, FromWiki("The *build* failed"), payload.Body)
+
+	payloadV2 := CommentToWiki(payload)
+	assert.Equal(t, visibility, payloadV2.Visibility)
+	assert.Equal(t, "The *build* failed", payloadV2.Body)
+
+	assert.Nil(t, CommentFromWiki(nil))
+	assert.Nil(t, CommentToWiki(nil))
+}
@@ -18,6 +18,14 @@ type OrganizationPolicyScheme struct {
 	Data OrganizationPolicyData `json:"data,omitempty"` // The organization policy data.
 }
 
+const (
+	// OrganizationPolicyTypeIPAllowlist is the type of the IP allowlist policies.
+	OrganizationPolicyTypeIPAllowlist = "ip-allowlist"
+
+	// OrganizationPolicyTypeDataResidency is the type of the data residency policies.
+	OrganizationPolicyTypeDataResidency = "data-residency"
+)
+
 // OrganizationPolicyResource represents a resource in an organization policy.
 type OrganizationPolicyResource struct {
 	ID                string `json:"id,omitempty"`                // The ID of the resource.
@@ -30,6 +38,7 @@ type OrganizationPolicyAttributes struct {
 	Name      string                        `json:"name,omitempty"`      // The name of the policy.
 	Status    string                        `json:"status,omitempty"`    // The status of the policy.
 	Resources []*OrganizationPolicyResource `json:"resources,omitempty"` // The resources of the policy.
+	Rule      *OrganizationPolicyRuleScheme `json:"rule,omitempty"`      // The rule of the policy, e.g. the IP addresses of an IP allowlist policy.
 	CreatedAt time.Time                     `json:"createdAt,omitempty"` // The creation time of the policy.
 	UpdatedAt time.Time                     `json:"updatedAt,omitempty"` // The update time of the policy.
 }
@@ -40,3 +49,30 @@ type OrganizationPolicyData struct {
 	Type       string                        `json:"type,omitempty"`       // The type of the policy.
 	Attributes *OrganizationPolicyAttributes `json:"attributes,omitempty"` // The attributes of the policy.
 }
+
+// OrganizationPolicyRuleScheme represents the rule of an organization policy.
+type OrganizationPolicyRuleScheme struct {
+	IPs []string `json:"ips,omitempty"` // The IP addresses and CIDR ranges allowed by an IP allowlist policy.
+}
+
+// OrganizationPolicyValidationScheme represents the result of the validation of an organization policy.
+type OrganizationPolicyValidationScheme struct {
+	Data []*OrganizationPolicyValidationErrorScheme `json:"data,omitempty"` // The validation errors of the policy, empty when the policy is valid.
+}
+
+// OrganizationPolicyValidationErrorScheme represents a validation error of an organization policy.
+type OrganizationPolicyValidationErrorScheme struct {
+	ID     string `json:"id,omitempty"`     // The ID of the error.
+	Status string `json:"status,omitempty"` // The HTTP status of the error.
+	Code   string `json:"code,omitempty"`   // The code of the error.
+	Title  string `json:"title,omitempty"`  // The title of the error.
+	Detail string `json:"detail,omitempty"` // The detail of the error.
+}
+
+// DataResidencyResourceScheme represents the data residency status of a resource of an organization.
+type DataResidencyResourceScheme struct {
+	PolicyID          string // The ID of the data residency policy of the resource.
+	PolicyName        string // The name of the data residency policy of the resource.
+	ResourceID        string // The ID of the resource.
+	ApplicationStatus string // The application status of the policy to the resource, it reports the progress of a data residency move.
+}
@@ -2,6 +2,29 @@ package models
 
 import "time"
 
+// Board types supported by the Jira Software boards.
+const (
+	BoardTypeScrum  = "scrum"
+	BoardTypeKanban = "kanban"
+	BoardTypeSimple = "simple"
+)
+
+// Board location types, a board can be located in a project or in the user's profile.
+const (
+	BoardLocationTypeProject = "project"
+	BoardLocationTypeUser    = "user"
+)
+
+// NewProjectBoardLocation returns the location used to create a board located in the project.
+func NewProjectBoardLocation(projectKeyOrID string) *BoardPayloadLocationScheme {
+	return &BoardPayloadLocationScheme{Type: BoardLocationTypeProject, ProjectKeyOrID: projectKeyOrID}
+}
+
+// NewUserBoardLocation returns the location used to create a board located in the user's profile.
+func NewUserBoardLocation() *BoardPayloadLocationScheme {
+	return &BoardPayloadLocationScheme{Type: BoardLocationTypeUser}
+}
+
 type BoardPayloadScheme struct {
 	Name     string                      `json:"name,omitempty"`
 	Type     string                      `json:"type,omitempty"`
@@ -215,3 +238,21 @@ type BoardBacklogPayloadScheme struct {
 	RankAfterIssue    string   `json:"rankAfterIssue,omitempty"`
 	RankCustomFieldID int      `json:"rankCustomFieldId,omitempty"`
 }
+
+// BoardFilterPayloadScheme represents the payload to change the filter of a board in Jira Agile.
+type BoardFilterPayloadScheme struct {
+	ID            int `json:"id"`            // The ID of the board.
+	SavedFilterID int `json:"savedFilterId"` // The ID of the filter used by the board.
+}
+
+// BoardAdminsScheme represents the administrators of a board in Jira Agile.
+type BoardAdminsScheme struct {
+	Users  []*BoardAdminScheme `json:"userKeys,omitempty"`  // The users administering the board.
+	Groups []*BoardAdminScheme `json:"groupKeys,omitempty"` // The groups administering the board.
+}
+
+// BoardAdminScheme represents a user or a group administering a board in Jira Agile.
+type BoardAdminScheme struct {
+	Key         string `json:"key,omitempty"`         // The key of the user or the name of the group.
+	DisplayName string `json:"displayName,omitempty"` // The display name of the user or the group.
+}
@@ -0,0 +1,53 @@
+package models
+
+// BoardQuickFilterPageScheme represents a page of quick filters of a board in Jira Agile.
+type BoardQuickFilterPageScheme struct {
+	MaxResults int                       `json:"maxResults,omitempty"` // The maximum number of quick filters in the page.
+	StartAt    int                       `json:"startAt,omitempty"`    // The index of the first quick filter of the page.
+	Total      int                       `json:"total,omitempty"`      // The total number of quick filters.
+	IsLast     bool                      `json:"isLast,omitempty"`     // Indicates if this is the last page.
+	Values     []*BoardQuickFilterScheme `json:"values,omitempty"`     // The quick filters of the page.
+}
+
+// BoardQuickFilterScheme represents a quick filter of a board in Jira Agile.
+type BoardQuickFilterScheme struct {
+	ID          int    `json:"id,omitempty"`          // The ID of the quick filter.
+	BoardID     int    `json:"boardId,omitempty"`     // The ID of the board.
+	Name        string `json:"name,omitempty"`        // The name of the quick filter.
+	JQL         string `json:"jql,omitempty"`         // The JQL of the quick filter.
+	Description string `json:"description,omitempty"` // The description of the quick filter.
+	Position    int    `json:"position,omitempty"`    // The position of the quick filter on the board.
+}
+
+// The board features that can be toggled in Jira Agile.
+const (
+	BoardFeatureEstimation = "ESTIMATION" // The estimation of the issues.
+	BoardFeatureSprints    = "SPRINTS"    // The sprints of a scrum board.
+	BoardFeatureBacklog    = "BACKLOG"    // The backlog of a kanban board.
+)
+
+// BoardFeaturesScheme represents the features of a board in Jira Agile.
+type BoardFeaturesScheme struct {
+	Features []*BoardFeatureScheme `json:"features,omitempty"` // The features of the board.
+}
+
+// BoardFeatureScheme represents a feature of a board in Jira Agile.
+type BoardFeatureScheme struct {
+	BoardFeature         string `json:"boardFeature,omitempty"`         // The key of the feature, e.g. ESTIMATION.
+	BoardID              int    `json:"boardId,omitempty"`              // The ID of the board.
+	State                string `json:"state,omitempty"`                // The state of the feature, ENABLED, DISABLED or COMING_SOON.
+	LocalisedName        string `json:"localisedName,omitempty"`        // The name of the feature.
+	LocalisedDescription string `json:"localisedDescription,omitempty"` // The description of the feature.
+	LearnMoreLink        string `json:"learnMoreLink,omitempty"`        // The link to the documentation of the feature.
+	ImageURI             string `json:"imageUri,omitempty"`             // The URI of the image of the feature.
+	FeatureType          string `json:"featureType,omitempty"`          // The type of the feature, e.g. BASIC or ESTIMATION.
+	FeatureID            string `json:"featureId,omitempty"`            // The ID of the feature.
+	ToggleLocked         bool   `json:"toggleLocked,omitempty"`         // Indicates if the feature can't be toggled.
+}
+
+// BoardFeatureTogglePayloadScheme represents the payload to enable or disable a feature of a board in Jira Agile.
+type BoardFeatureTogglePayloadScheme struct {
+	BoardID  int    `json:"boardId,omitempty"` // The ID of the board, set from the board ID of the request.
+	Feature  string `json:"feature,omitempty"` // The key of the feature, see the BoardFeature constants.
+	Enabling bool   `json:"enabling"`          // Enables the feature when true, disables it when false.
+}
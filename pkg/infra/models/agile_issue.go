@@ -0,0 +1,33 @@
+package models
+
+// IssueEstimationScheme represents the estimation of an issue on a board.
+type IssueEstimationScheme struct {
+	FieldID string      `json:"fieldId,omitempty"` // The ID of the field used as the board estimation statistic.
+	Value   interface{} `json:"value,omitempty"`   // The estimation value, e.g. the story points or the time in seconds.
+}
+
+// IssueEstimationPayloadScheme represents the payload used to update the estimation of an issue on a board.
+type IssueEstimationPayloadScheme struct {
+	Value string `json:"value"` // The estimation value, time tracking values use the Jira duration format, e.g. "1w 2d".
+}
+
+// IssueRankPayloadScheme represents the payload used to rank issues.
+type IssueRankPayloadScheme struct {
+	Issues            []string `json:"issues,omitempty"`            // The issue keys or IDs to rank, up to 50 issues.
+	RankBeforeIssue   string   `json:"rankBeforeIssue,omitempty"`   // The issue key or ID the issues are ranked before.
+	RankAfterIssue    string   `json:"rankAfterIssue,omitempty"`    // The issue key or ID the issues are ranked after.
+	RankCustomFieldID int      `json:"rankCustomFieldId,omitempty"` // The ID of the rank custom field, the default rank field is used if omitted.
+}
+
+// IssueRankResultScheme represents the result of a partial rank operation.
+type IssueRankResultScheme struct {
+	Entries []*IssueRankEntryScheme `json:"entries,omitempty"` // The result of each ranked issue.
+}
+
+// IssueRankEntryScheme represents the rank result of an issue.
+type IssueRankEntryScheme struct {
+	IssueID  int      `json:"issueId,omitempty"`  // The ID of the issue.
+	IssueKey string   `json:"issueKey,omitempty"` // The key of the issue.
+	Status   int      `json:"status,omitempty"`   // The HTTP status of the issue rank operation.
+	Errors   []string `json:"errors,omitempty"`   // The errors found while ranking the issue.
+}
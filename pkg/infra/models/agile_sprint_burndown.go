@@ -0,0 +1,355 @@
+package models
+
+import (
+	"encoding/csv"
+	"io"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SprintBurndownEventType represents the type of a change of the remaining work of a sprint.
+type SprintBurndownEventType string
+
+// The types of the changes of the remaining work of a sprint.
+const (
+	SprintBurndownIssueAdded      SprintBurndownEventType = "ISSUE_ADDED"      // The issue was added to the sprint after it started.
+	SprintBurndownIssueRemoved    SprintBurndownEventType = "ISSUE_REMOVED"    // The issue was removed from the sprint.
+	SprintBurndownIssueCompleted  SprintBurndownEventType = "ISSUE_COMPLETED"  // The issue was completed.
+	SprintBurndownIssueReopened   SprintBurndownEventType = "ISSUE_REOPENED"   // The completed issue was reopened.
+	SprintBurndownEstimateChanged SprintBurndownEventType = "ESTIMATE_CHANGED" // The estimate of an open issue was changed.
+)
+
+// SprintBurndownFieldSprint is the name of the sprint field in the changelogs.
+const SprintBurndownFieldSprint = "Sprint"
+
+// SprintBurndownOptionsScheme represents the options to compute the burndown of a sprint.
+type SprintBurndownOptionsScheme struct {
+	// EstimationField is the ID of the field estimating the issues, e.g. the story points custom field.
+	// The issues are counted when empty.
+	EstimationField string
+
+	// DoneStatuses are the names of the statuses completing the issues, e.g. the statuses of the last column of the board.
+	// The resolved issues are completed when empty.
+	DoneStatuses []string
+}
+
+// SprintBurndownIssueScheme represents an issue of a sprint with its changelog, as used to compute the burndown.
+type SprintBurndownIssueScheme struct {
+	Key       string                         // The key of the issue.
+	Created   time.Time                      // The creation date of the issue.
+	Estimate  float64                        // The current estimate of the issue.
+	Done      bool                           // Indicates if the issue is currently completed.
+	Removed   bool                           // Indicates if the issue is currently out of the sprint, e.g. removed from it.
+	Histories []*IssueChangelogHistoryScheme // The changelog of the issue.
+}
+
+// SprintBurndownScheme represents the raw data of the burndown chart of a sprint, derived from the changelogs of its issues.
+type SprintBurndownScheme struct {
+	SprintID        int                          // The ID of the sprint.
+	Start           time.Time                    // The start date of the sprint.
+	End             time.Time                    // The complete date of the sprint, or its planned end date while it's active.
+	EstimationField string                       // The ID of the field estimating the issues, empty when the issues are counted.
+	InitialIssues   []string                     // The keys of the issues in the sprint when it started.
+	InitialScope    float64                      // The remaining work of the sprint when it started.
+	Remaining       float64                      // The remaining work of the sprint after the last event.
+	Events          []*SprintBurndownEventScheme // The changes of the remaining work, in chronological order.
+}
+
+// SprintBurndownEventScheme represents a change of the remaining work of a sprint.
+type SprintBurndownEventScheme struct {
+	Time      time.Time               // The date of the change.
+	IssueKey  string                  // The key of the changed issue.
+	Type      SprintBurndownEventType // The type of the change.
+	Change    float64                 // The change of the remaining work, negative when the work was burnt down.
+	Remaining float64                 // The remaining work of the sprint after the change.
+}
+
+// NewSprintBurndown computes the burndown of a started sprint from the current state and the changelog of its issues.
+//
+// The state of every issue at the start of the sprint is derived by undoing the changes made after it,
+// then the changes made during the sprint become the events of the burndown.
+func NewSprintBurndown(sprint *SprintScheme, issues []*SprintBurndownIssueScheme, options *SprintBurndownOptionsScheme) (*SprintBurndownScheme, error) {
+
+	if sprint == nil || sprint.ID == 0 {
+		return nil, ErrNoSprintID
+	}
+
+	if sprint.StartDate.IsZero() {
+		return nil, ErrSprintNotStarted
+	}
+
+	if options == nil {
+		options = new(SprintBurndownOptionsScheme)
+	}
+
+	burndown := &SprintBurndownScheme{
+		SprintID:        sprint.ID,
+		Start:           sprint.StartDate,
+		End:             sprint.EndDate,
+		EstimationField: options.EstimationField,
+	}
+
+	if !sprint.CompleteDate.IsZero() {
+		burndown.End = sprint.CompleteDate
+	}
+
+	tracker := &sprintBurndownTracker{sprintID: strconv.Itoa(sprint.ID), options: options}
+
+	for _, issue := range issues {
+
+		if issue == nil {
+			continue
+		}
+
+		events, initial := tracker.issueEvents(issue, burndown.Start, burndown.End)
+		if initial != nil {
+			burndown.InitialIssues = append(burndown.InitialIssues, issue.Key)
+			burndown.InitialScope += *initial
+		}
+
+		burndown.Events = append(burndown.Events, events...)
+	}
+
+	sort.SliceStable(burndown.Events, func(i, j int) bool {
+		return burndown.Events[i].Time.Before(burndown.Events[j].Time)
+	})
+
+	burndown.Remaining = burndown.InitialScope
+	for _, event := range burndown.Events {
+		burndown.Remaining += event.Change
+		event.Remaining = burndown.Remaining
+	}
+
+	return burndown, nil
+}
+
+// WriteCSV writes the burndown as CSV, one row for the start of the sprint and one row per event,
+// with the time, issue, event, change and remaining columns.
+func (b *SprintBurndownScheme) WriteCSV(w io.Writer) error {
+
+	writer := csv.NewWriter(w)
+
+	rows := [][]string{
+		{"time", "issue", "event", "change", "remaining"},
+		{b.Start.Format(time.RFC3339), "", "SPRINT_START", formatBurndownValue(b.InitialScope), formatBurndownValue(b.InitialScope)},
+	}
+
+	for _, event := range b.Events {
+		rows = append(rows, []string{
+			event.Time.Format(time.RFC3339),
+			event.IssueKey,
+			string(event.Type),
+			formatBurndownValue(event.Change),
+			formatBurndownValue(event.Remaining),
+		})
+	}
+
+	if err := writer.WriteAll(rows); err != nil {
+		return err
+	}
+
+	return writer.Error()
+}
+
+// formatBurndownValue formats the work without trailing zeros.
+func formatBurndownValue(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// sprintBurndownTracker derives the burndown events of the issues of a sprint.
+type sprintBurndownTracker struct {
+	sprintID string
+	options  *SprintBurndownOptionsScheme
+}
+
+// sprintBurndownState represents the state of an issue relevant to the burndown.
+type sprintBurndownState struct {
+	inSprint bool
+	done     bool
+	estimate float64
+}
+
+// sprintBurndownChange represents a changelog item with its date.
+type sprintBurndownChange struct {
+	time time.Time
+	item *IssueChangelogHistoryItemScheme
+}
+
+// issueEvents returns the burndown events of the issue, and its remaining work when it was in the sprint at the start.
+func (t *sprintBurndownTracker) issueEvents(issue *SprintBurndownIssueScheme, start, end time.Time) ([]*SprintBurndownEventScheme, *float64) {
+
+	state := sprintBurndownState{inSprint: !issue.Removed, done: issue.Done, estimate: issue.Estimate}
+	if t.options.EstimationField == "" {
+		state.estimate = 1
+	}
+
+	// The issues created during the sprint join it when they're created
+	pivot := start
+	if issue.Created.After(start) {
+		pivot = issue.Created
+	}
+
+	changes := t.changes(issue.Histories)
+
+	// Undo the changes made after the pivot to get the state of the issue at the pivot
+	for index := len(changes) - 1; index >= 0 && changes[index].time.After(pivot); index-- {
+		t.apply(&state, changes[index].item, true)
+	}
+
+	var events []*SprintBurndownEventScheme
+	var initial *float64
+
+	switch {
+	case !state.inSprint:
+	case pivot.Equal(start):
+		remaining := state.remaining()
+		initial = &remaining
+	case end.IsZero() || !pivot.After(end):
+		events = append(events, &SprintBurndownEventScheme{Time: pivot, IssueKey: issue.Key, Type: SprintBurndownIssueAdded, Change: state.remaining()})
+	}
+
+	for _, change := range changes {
+
+		if !change.time.After(pivot) {
+			continue
+		}
+
+		if !end.IsZero() && change.time.After(end) {
+			break
+		}
+
+		before := state
+		t.apply(&state, change.item, false)
+
+		if event := burndownEvent(before, state); event != nil {
+			event.Time, event.IssueKey = change.time, issue.Key
+			events = append(events, event)
+		}
+	}
+
+	return events, initial
+}
+
+// changes returns the changelog items of the issue relevant to the burndown, in chronological order.
+func (t *sprintBurndownTracker) changes(histories []*IssueChangelogHistoryScheme) []*sprintBurndownChange {
+
+	var changes []*sprintBurndownChange
+	for _, history := range histories {
+
+		if history == nil {
+			continue
+		}
+
+		created, err := time.Parse("2006-01-02T15:04:05.000-0700", history.Created)
+		if err != nil {
+			continue
+		}
+
+		for _, item := range history.Items {
+			if item != nil && t.relevant(item) {
+				changes = append(changes, &sprintBurndownChange{time: created, item: item})
+			}
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].time.Before(changes[j].time)
+	})
+
+	return changes
+}
+
+// relevant reports whether the changelog item changes the sprints, the completion or the estimate of the issue.
+func (t *sprintBurndownTracker) relevant(item *IssueChangelogHistoryItemScheme) bool {
+
+	switch {
+	case item.Field == SprintBurndownFieldSprint:
+		return true
+	case t.options.EstimationField != "" && item.FieldID == t.options.EstimationField:
+		return true
+	case len(t.options.DoneStatuses) != 0:
+		return item.Field == IssueFieldStatus || item.FieldID == IssueFieldStatus
+	default:
+		return item.Field == IssueFieldResolution || item.FieldID == IssueFieldResolution
+	}
+}
+
+// apply applies the changelog item to the state of the issue, or undoes it.
+func (t *sprintBurndownTracker) apply(state *sprintBurndownState, item *IssueChangelogHistoryItemScheme, undo bool) {
+
+	from, to := item.From, item.To
+	fromString, toString := item.FromString, item.ToString
+	if undo {
+		from, to = to, from
+		fromString, toString = toString, fromString
+	}
+
+	switch {
+	case item.Field == SprintBurndownFieldSprint:
+
+		wasIn, isIn := t.inSprint(from), t.inSprint(to)
+		if wasIn != isIn {
+			state.inSprint = isIn
+		}
+
+	case t.options.EstimationField != "" && item.FieldID == t.options.EstimationField:
+
+		estimate, _ := strconv.ParseFloat(strings.TrimSpace(toString), 64)
+		state.estimate = estimate
+
+	case len(t.options.DoneStatuses) != 0:
+
+		state.done = slices.ContainsFunc(t.options.DoneStatuses, func(status string) bool {
+			return strings.EqualFold(status, toString)
+		})
+
+	default:
+		state.done = toString != ""
+	}
+}
+
+// inSprint reports whether the value of the sprint field, a comma-separated list of sprint IDs, includes the sprint.
+func (t *sprintBurndownTracker) inSprint(value string) bool {
+
+	for _, sprintID := range strings.Split(value, ",") {
+		if strings.TrimSpace(sprintID) == t.sprintID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// remaining returns the remaining work of the issue.
+func (s sprintBurndownState) remaining() float64 {
+
+	if s.done {
+		return 0
+	}
+
+	return s.estimate
+}
+
+// burndownEvent returns the event changing the remaining work of the sprint between two states of an issue, if any.
+func burndownEvent(before, after sprintBurndownState) *SprintBurndownEventScheme {
+
+	switch {
+	case !before.inSprint && after.inSprint:
+		return &SprintBurndownEventScheme{Type: SprintBurndownIssueAdded, Change: after.remaining()}
+	case before.inSprint && !after.inSprint:
+		return &SprintBurndownEventScheme{Type: SprintBurndownIssueRemoved, Change: -before.remaining()}
+	case !before.inSprint:
+		return nil
+	case !before.done && after.done:
+		return &SprintBurndownEventScheme{Type: SprintBurndownIssueCompleted, Change: -before.remaining()}
+	case before.done && !after.done:
+		return &SprintBurndownEventScheme{Type: SprintBurndownIssueReopened, Change: after.remaining()}
+	case !after.done && before.estimate != after.estimate:
+		return &SprintBurndownEventScheme{Type: SprintBurndownEstimateChanged, Change: after.estimate - before.estimate}
+	}
+
+	return nil
+}
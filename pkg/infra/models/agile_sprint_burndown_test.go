@@ -0,0 +1,181 @@
+package models
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSprintBurndown(t *testing.T) {
+
+	start := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	sprint := &SprintScheme{
+		ID:           10,
+		StartDate:    start,
+		EndDate:      start.Add(14 * 24 * time.Hour),
+		CompleteDate: start.Add(10 * 24 * time.Hour),
+	}
+
+	issues := []*SprintBurndownIssueScheme{
+		{
+			// In the sprint at the start, re-estimated and then completed
+			Key:      "KP-1",
+			Created:  start.Add(-48 * time.Hour),
+			Estimate: 5,
+			Done:     true,
+			Histories: []*IssueChangelogHistoryScheme{
+				{Created: "2024-02-28T10:00:00.000+0000", Items: []*IssueChangelogHistoryItemScheme{
+					{Field: "Sprint", To: "10", ToString: "KP Sprint 10"},
+				}},
+				{Created: "2024-03-02T10:00:00.000+0000", Items: []*IssueChangelogHistoryItemScheme{
+					{Field: "Story Points", FieldID: "customfield_10016", FromString: "3", ToString: "5"},
+				}},
+				{Created: "2024-03-04T10:00:00.000+0000", Items: []*IssueChangelogHistoryItemScheme{
+					{Field: "status", FieldID: "status", FromString: "In Progress", ToString: "Done"},
+				}},
+			},
+		},
+		{
+			// Moved from the previous sprint after the start
+			Key:      "KP-2",
+			Created:  start.Add(-96 * time.Hour),
+			Estimate: 2,
+			Histories: []*IssueChangelogHistoryScheme{
+				{Created: "2024-03-02T09:00:00.000+0000", Items: []*IssueChangelogHistoryItemScheme{
+					{Field: "Sprint", From: "9", To: "9, 10", FromString: "KP Sprint 9", ToString: "KP Sprint 9, KP Sprint 10"},
+				}},
+			},
+		},
+		{
+			// Created in the sprint after the start, re-estimated after the sprint
+			Key:      "KP-3",
+			Created:  start.Add(72 * time.Hour),
+			Estimate: 8,
+			Histories: []*IssueChangelogHistoryScheme{
+				{Created: "2024-03-20T09:00:00.000+0000", Items: []*IssueChangelogHistoryItemScheme{
+					{Field: "Story Points", FieldID: "customfield_10016", FromString: "1", ToString: "8"},
+				}},
+			},
+		},
+		{
+			// In the sprint at the start, removed from it during the sprint
+			Key:      "KP-4",
+			Created:  start.Add(-48 * time.Hour),
+			Estimate: 4,
+			Removed:  true,
+			Histories: []*IssueChangelogHistoryScheme{
+				{Created: "2024-03-03T09:00:00.000+0000", Items: []*IssueChangelogHistoryItemScheme{
+					{Field: "Sprint", From: "10", To: "", FromString: "KP Sprint 10", ToString: ""},
+				}},
+			},
+		},
+	}
+
+	type event struct {
+		issueKey  string
+		eventType SprintBurndownEventType
+		change    float64
+		remaining float64
+	}
+
+	testCases := []struct {
+		name          string
+		sprint        *SprintScheme
+		options       *SprintBurndownOptionsScheme
+		wantInitial   float64
+		wantRemaining float64
+		wantEvents    []event
+		wantErr       bool
+		Err           error
+	}{
+		{
+			name:          "when the issues are estimated",
+			sprint:        sprint,
+			options:       &SprintBurndownOptionsScheme{EstimationField: "customfield_10016", DoneStatuses: []string{"done"}},
+			wantInitial:   7,
+			wantRemaining: 3,
+			wantEvents: []event{
+				{"KP-2", SprintBurndownIssueAdded, 2, 9},
+				{"KP-1", SprintBurndownEstimateChanged, 2, 11},
+				{"KP-4", SprintBurndownIssueRemoved, -4, 7},
+				{"KP-3", SprintBurndownIssueAdded, 1, 8},
+				{"KP-1", SprintBurndownIssueCompleted, -5, 3},
+			},
+		},
+
+		{
+			name:          "when the issues are counted",
+			sprint:        sprint,
+			options:       &SprintBurndownOptionsScheme{DoneStatuses: []string{"Done"}},
+			wantInitial:   2,
+			wantRemaining: 2,
+			wantEvents: []event{
+				{"KP-2", SprintBurndownIssueAdded, 1, 3},
+				{"KP-4", SprintBurndownIssueRemoved, -1, 2},
+				{"KP-3", SprintBurndownIssueAdded, 1, 3},
+				{"KP-1", SprintBurndownIssueCompleted, -1, 2},
+			},
+		},
+
+		{
+			name:    "when the sprint is not started",
+			sprint:  &SprintScheme{ID: 11},
+			wantErr: true,
+			Err:     ErrSprintNotStarted,
+		},
+
+		{
+			name:    "when the sprint is not provided",
+			wantErr: true,
+			Err:     ErrNoSprintID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			got, err := NewSprintBurndown(testCase.sprint, issues, testCase.options)
+
+			if testCase.wantErr {
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, sprint.CompleteDate, got.End)
+			assert.Equal(t, []string{"KP-1", "KP-4"}, got.InitialIssues)
+			assert.Equal(t, testCase.wantInitial, got.InitialScope)
+			assert.Equal(t, testCase.wantRemaining, got.Remaining)
+
+			var events []event
+			for _, e := range got.Events {
+				events = append(events, event{e.IssueKey, e.Type, e.Change, e.Remaining})
+			}
+
+			assert.Equal(t, testCase.wantEvents, events)
+		})
+	}
+}
+
+func TestSprintBurndownScheme_WriteCSV(t *testing.T) {
+
+	start := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	burndown := &SprintBurndownScheme{
+		Start:        start,
+		InitialScope: 3,
+		Events: []*SprintBurndownEventScheme{
+			{Time: start.Add(time.Hour), IssueKey: "KP-1", Type: SprintBurndownIssueCompleted, Change: -1.5, Remaining: 1.5},
+		},
+	}
+
+	var buffer bytes.Buffer
+	assert.NoError(t, burndown.WriteCSV(&buffer))
+	assert.Equal(t, "time,issue,event,change,remaining\n"+
+		"2024-03-01T09:00:00Z,,SPRINT_START,3,3\n"+
+		"2024-03-01T10:00:00Z,KP-1,ISSUE_COMPLETED,-1.5,1.5\n", buffer.String())
+}
@@ -0,0 +1,80 @@
+package models
+
+import "time"
+
+const (
+	// ConfluenceAuditExportFormatCSV exports the audit records as a CSV file.
+	ConfluenceAuditExportFormatCSV = "csv"
+	// ConfluenceAuditExportFormatZIP exports the audit records as a zipped CSV file.
+	ConfluenceAuditExportFormatZIP = "zip"
+)
+
+// ConfluenceAuditRecordPageScheme represents a page of audit records in Confluence.
+type ConfluenceAuditRecordPageScheme struct {
+	Results []*ConfluenceAuditRecordScheme `json:"results,omitempty"` // The audit records in the page.
+	Start   int                            `json:"start,omitempty"`   // The start index of the audit records in the page.
+	Limit   int                            `json:"limit,omitempty"`   // The limit of the audit records in the page.
+	Size    int                            `json:"size,omitempty"`    // The size of the audit records in the page.
+	Links   *LinkScheme                    `json:"_links,omitempty"`  // The links of the page.
+}
+
+// ConfluenceAuditRecordScheme represents an audit record in Confluence.
+type ConfluenceAuditRecordScheme struct {
+	Author            *ConfluenceAuditAuthorScheme           `json:"author,omitempty"`            // The user who made the change.
+	RemoteAddress     string                                 `json:"remoteAddress,omitempty"`     // The IP address of the author.
+	CreationDate      int64                                  `json:"creationDate,omitempty"`      // The creation date of the record, as epoch time in milliseconds.
+	Summary           string                                 `json:"summary,omitempty"`           // The summary of the record.
+	Description       string                                 `json:"description,omitempty"`       // The description of the record.
+	Category          string                                 `json:"category,omitempty"`          // The category of the record.
+	SysAdmin          bool                                   `json:"sysAdmin,omitempty"`          // Indicates if the change was made by a system administrator.
+	SuperAdmin        bool                                   `json:"superAdmin,omitempty"`        // Indicates if the change was made by a super administrator.
+	AffectedObject    *ConfluenceAuditAffectedObjectScheme   `json:"affectedObject,omitempty"`    // The object affected by the change.
+	ChangedValues     []*ConfluenceAuditChangedValueScheme   `json:"changedValues,omitempty"`     // The values changed by the record.
+	AssociatedObjects []*ConfluenceAuditAffectedObjectScheme `json:"associatedObjects,omitempty"` // The objects associated with the record.
+}
+
+// ConfluenceAuditAuthorScheme represents the author of an audit record in Confluence.
+type ConfluenceAuditAuthorScheme struct {
+	Type                   string `json:"type,omitempty"`                   // The type of the author, e.g. user.
+	DisplayName            string `json:"displayName,omitempty"`            // The display name of the author.
+	Username               string `json:"username,omitempty"`               // The username of the author.
+	UserKey                string `json:"userKey,omitempty"`                // The user key of the author.
+	AccountID              string `json:"accountId,omitempty"`              // The account ID of the author.
+	AccountType            string `json:"accountType,omitempty"`            // The account type of the author.
+	PublicName             string `json:"publicName,omitempty"`             // The public name of the author.
+	IsExternalCollaborator bool   `json:"isExternalCollaborator,omitempty"` // Indicates if the author is an external collaborator.
+}
+
+// ConfluenceAuditAffectedObjectScheme represents an object affected by or associated with an audit record in Confluence.
+type ConfluenceAuditAffectedObjectScheme struct {
+	Name       string `json:"name,omitempty"`       // The name of the object.
+	ObjectType string `json:"objectType,omitempty"` // The type of the object, e.g. Space or Page.
+}
+
+// ConfluenceAuditChangedValueScheme represents a value changed by an audit record in Confluence.
+type ConfluenceAuditChangedValueScheme struct {
+	Name     string `json:"name,omitempty"`     // The name of the changed value.
+	OldValue string `json:"oldValue,omitempty"` // The previous value.
+	NewValue string `json:"newValue,omitempty"` // The new value.
+}
+
+// ConfluenceAuditRecordPayloadScheme represents the payload used to create an audit record in Confluence.
+type ConfluenceAuditRecordPayloadScheme struct {
+	Author            *ConfluenceAuditAuthorScheme           `json:"author,omitempty"`            // The user who made the change.
+	RemoteAddress     string                                 `json:"remoteAddress"`               // The IP address of the author.
+	CreationDate      int64                                  `json:"creationDate,omitempty"`      // The creation date of the record, as epoch time in milliseconds.
+	Summary           string                                 `json:"summary,omitempty"`           // The summary of the record.
+	Description       string                                 `json:"description,omitempty"`       // The description of the record.
+	Category          string                                 `json:"category,omitempty"`          // The category of the record.
+	SysAdmin          bool                                   `json:"sysAdmin,omitempty"`          // Indicates if the change was made by a system administrator.
+	AffectedObject    *ConfluenceAuditAffectedObjectScheme   `json:"affectedObject,omitempty"`    // The object affected by the change.
+	ChangedValues     []*ConfluenceAuditChangedValueScheme   `json:"changedValues,omitempty"`     // The values changed by the record.
+	AssociatedObjects []*ConfluenceAuditAffectedObjectScheme `json:"associatedObjects,omitempty"` // The objects associated with the record.
+}
+
+// ConfluenceAuditRecordGetOptions represents the options for getting or exporting audit records in Confluence.
+type ConfluenceAuditRecordGetOptions struct {
+	SearchString string    // The text to search in the audit records.
+	StartDate    time.Time // Filters the records on or after the start date.
+	EndDate      time.Time // Filters the records on or before the end date.
+}
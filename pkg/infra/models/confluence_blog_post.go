@@ -0,0 +1,47 @@
+package models
+
+// BlogPostOptionsScheme represents the options for listing the blog posts in Confluence.
+type BlogPostOptionsScheme struct {
+	BlogPostIDs []int    // The IDs of the blog posts.
+	SpaceIDs    []int    // The IDs of the spaces of the blog posts.
+	Sort        string   // The sort order of the blog posts, e.g. -created-date.
+	Status      []string // The statuses of the blog posts, e.g. current, deleted or trashed.
+	Title       string   // The title of the blog posts.
+	BodyFormat  string   // The body format of the blog posts, e.g. storage or atlas_doc_format.
+}
+
+// BlogPostChunkScheme represents a chunk of blog posts in Confluence.
+type BlogPostChunkScheme struct {
+	Results []*BlogPostScheme     `json:"results,omitempty"` // The blog posts in the chunk.
+	Links   *PageChunkLinksScheme `json:"_links,omitempty"`  // The links of the chunk, the next link holds the cursor of the next chunk.
+}
+
+// BlogPostScheme represents a blog post in Confluence.
+type BlogPostScheme struct {
+	ID        string             `json:"id,omitempty"`        // The ID of the blog post.
+	Status    string             `json:"status,omitempty"`    // The status of the blog post.
+	Title     string             `json:"title,omitempty"`     // The title of the blog post.
+	SpaceID   string             `json:"spaceId,omitempty"`   // The ID of the space of the blog post.
+	AuthorID  string             `json:"authorId,omitempty"`  // The ID of the author of the blog post.
+	CreatedAt string             `json:"createdAt,omitempty"` // The timestamp of the creation of the blog post.
+	Version   *PageVersionScheme `json:"version,omitempty"`   // The version of the blog post.
+	Body      *PageBodyScheme    `json:"body,omitempty"`      // The body of the blog post.
+}
+
+// BlogPostCreatePayloadScheme represents the payload for creating a blog post in Confluence.
+type BlogPostCreatePayloadScheme struct {
+	SpaceID string                        `json:"spaceId,omitempty"` // The ID of the space of the blog post.
+	Status  string                        `json:"status,omitempty"`  // The status of the blog post, current or draft.
+	Title   string                        `json:"title,omitempty"`   // The title of the blog post.
+	Body    *PageBodyRepresentationScheme `json:"body,omitempty"`    // The body of the blog post.
+}
+
+// BlogPostUpdatePayloadScheme represents the payload for updating a blog post in Confluence.
+type BlogPostUpdatePayloadScheme struct {
+	ID      string                          `json:"id,omitempty"`      // The ID of the blog post.
+	Status  string                          `json:"status,omitempty"`  // The status of the blog post.
+	Title   string                          `json:"title,omitempty"`   // The title of the blog post.
+	SpaceID string                          `json:"spaceId,omitempty"` // The ID of the space of the blog post.
+	Body    *PageBodyRepresentationScheme   `json:"body,omitempty"`    // The body of the blog post.
+	Version *PageUpdatePayloadVersionScheme `json:"version,omitempty"` // The version of the blog post.
+}
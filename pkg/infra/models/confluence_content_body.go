@@ -0,0 +1,102 @@
+package models
+
+const (
+	// ContentBodyRepresentationStorage is the XHTML-based storage format used to persist the content.
+	ContentBodyRepresentationStorage = "storage"
+	// ContentBodyRepresentationView is the HTML rendered for the Confluence UI.
+	ContentBodyRepresentationView = "view"
+	// ContentBodyRepresentationExportView is the HTML rendered for exports, without the UI decorations.
+	ContentBodyRepresentationExportView = "export_view"
+	// ContentBodyRepresentationStyledView is the HTML rendered with the inline styles applied.
+	ContentBodyRepresentationStyledView = "styled_view"
+	// ContentBodyRepresentationEditor is the format used by the legacy editor.
+	ContentBodyRepresentationEditor = "editor"
+	// ContentBodyRepresentationAtlasDocFormat is the Atlassian Document Format (ADF) representation.
+	ContentBodyRepresentationAtlasDocFormat = "atlas_doc_format"
+	// ContentBodyRepresentationWiki is the wiki markup representation.
+	ContentBodyRepresentationWiki = "wiki"
+)
+
+const (
+	// ContentBodyConversionStatusWorking indicates the conversion task is running.
+	ContentBodyConversionStatusWorking = "WORKING"
+	// ContentBodyConversionStatusQueued indicates the conversion task is waiting to be executed.
+	ContentBodyConversionStatusQueued = "QUEUED"
+	// ContentBodyConversionStatusFailed indicates the conversion task finished with an error.
+	ContentBodyConversionStatusFailed = "FAILED"
+	// ContentBodyConversionStatusCompleted indicates the conversion task finished successfully.
+	ContentBodyConversionStatusCompleted = "COMPLETED"
+	// ContentBodyConversionStatusRerunning indicates the conversion task is being executed again.
+	ContentBodyConversionStatusRerunning = "RERUNNING"
+)
+
+// ContentBodyConvertPayloadScheme represents the body to convert in Confluence.
+type ContentBodyConvertPayloadScheme struct {
+	Value          string `json:"value"`          // The body of the content in the source representation.
+	Representation string `json:"representation"` // The source representation, e.g. storage or atlas_doc_format.
+}
+
+// ContentBodyConvertOptionsScheme represents the query parameters used to convert a content body in Confluence.
+type ContentBodyConvertOptionsScheme struct {
+	SpaceKeyContext       string   // The space key used to resolve the relative links and macros.
+	ContentIDContext      string   // The content ID used to resolve the relative links and macros.
+	EmbeddedContentRender string   // The mode used to render embedded content: current or version-at-save.
+	Expand                []string // The properties to expand, e.g. webresource or mediaToken.
+	AllowCache            bool     // Only used by the async conversion, reuses a cached conversion when available.
+}
+
+// ContentBodyConvertedScheme represents a converted content body in Confluence.
+type ContentBodyConvertedScheme struct {
+	Value           string                             `json:"value,omitempty"`           // The body of the content in the target representation.
+	Representation  string                             `json:"representation,omitempty"`  // The target representation.
+	EmbeddedContent []*ContentBodyEmbeddedScheme       `json:"embeddedContent,omitempty"` // The content embedded in the body.
+	MediaToken      *ContentBodyMediaTokenScheme       `json:"mediaToken,omitempty"`      // The token used to render the media files.
+	Expandable      *ContentBodyConvertedExpandsScheme `json:"_expandable,omitempty"`     // The properties that can be expanded.
+}
+
+// ContentBodyEmbeddedScheme represents a piece of content embedded in a converted body.
+type ContentBodyEmbeddedScheme struct {
+	EntityID   int    `json:"entityId,omitempty"`   // The ID of the embedded entity.
+	EntityType string `json:"entityType,omitempty"` // The type of the embedded entity.
+}
+
+// ContentBodyMediaTokenScheme represents the media token returned with a converted body.
+type ContentBodyMediaTokenScheme struct {
+	CollectionIDs  []string `json:"collectionIds,omitempty"`  // The IDs of the media collections.
+	ContentID      string   `json:"contentId,omitempty"`      // The ID of the content.
+	ExpiryDateTime string   `json:"expiryDateTime,omitempty"` // The expiry date of the token.
+	FileIDs        []string `json:"fileIds,omitempty"`        // The IDs of the media files.
+	Token          string   `json:"token,omitempty"`          // The token.
+}
+
+// ContentBodyConvertedExpandsScheme represents the expandable properties of a converted body.
+type ContentBodyConvertedExpandsScheme struct {
+	WebResource     string `json:"webresource,omitempty"`     // The link to expand the web resources.
+	EmbeddedContent string `json:"embeddedContent,omitempty"` // The link to expand the embedded content.
+	MediaToken      string `json:"mediaToken,omitempty"`      // The link to expand the media token.
+}
+
+// ContentBodyAsyncIDScheme represents the identifier of an async conversion task in Confluence.
+type ContentBodyAsyncIDScheme struct {
+	AsyncID string `json:"asyncId,omitempty"` // The ID of the conversion task.
+}
+
+// ContentBodyAsyncScheme represents the status or the result of an async conversion task in Confluence.
+type ContentBodyAsyncScheme struct {
+	Value           string                       `json:"value,omitempty"`           // The converted body, only set when the task is completed.
+	Representation  string                       `json:"representation,omitempty"`  // The target representation.
+	RenderTaskID    string                       `json:"renderTaskId,omitempty"`    // The ID of the conversion task.
+	Error           string                       `json:"error,omitempty"`           // The error message, only set when the task failed.
+	Status          string                       `json:"status,omitempty"`          // The status of the task, e.g. WORKING or COMPLETED.
+	EmbeddedContent []*ContentBodyEmbeddedScheme `json:"embeddedContent,omitempty"` // The content embedded in the body.
+	MediaToken      *ContentBodyMediaTokenScheme `json:"mediaToken,omitempty"`      // The token used to render the media files.
+}
+
+// Done reports whether the conversion task has finished, successfully or not.
+func (c *ContentBodyAsyncScheme) Done() bool {
+	if c == nil {
+		return false
+	}
+
+	return c.Status == ContentBodyConversionStatusCompleted || c.Status == ContentBodyConversionStatusFailed
+}
@@ -0,0 +1,58 @@
+package models
+
+// ContentCommentOptionsScheme represents the options for listing the footer or inline comments in Confluence.
+type ContentCommentOptionsScheme struct {
+	BodyFormat string // The body format of the comments, e.g. storage or atlas_doc_format.
+	Sort       string // The sort order of the comments, e.g. -created-date.
+}
+
+// ContentCommentChunkScheme represents a chunk of footer or inline comments in Confluence.
+type ContentCommentChunkScheme struct {
+	Results []*ContentCommentScheme `json:"results,omitempty"` // The comments in the chunk.
+	Links   *PageChunkLinksScheme   `json:"_links,omitempty"`  // The links of the chunk, the next link holds the cursor of the next chunk.
+}
+
+// ContentCommentScheme represents a footer or inline comment in Confluence.
+type ContentCommentScheme struct {
+	ID               string                         `json:"id,omitempty"`               // The ID of the comment.
+	Status           string                         `json:"status,omitempty"`           // The status of the comment.
+	Title            string                         `json:"title,omitempty"`            // The title of the comment.
+	PageID           string                         `json:"pageId,omitempty"`           // The ID of the page of the comment.
+	BlogPostID       string                         `json:"blogPostId,omitempty"`       // The ID of the blog post of the comment.
+	ParentCommentID  string                         `json:"parentCommentId,omitempty"`  // The ID of the parent comment, for the replies.
+	ResolutionStatus string                         `json:"resolutionStatus,omitempty"` // The resolution status of an inline comment, e.g. open or resolved.
+	Properties       *InlineCommentPropertiesScheme `json:"properties,omitempty"`       // The properties of an inline comment.
+	Version          *PageVersionScheme             `json:"version,omitempty"`          // The version of the comment.
+	Body             *PageBodyScheme                `json:"body,omitempty"`             // The body of the comment.
+}
+
+// InlineCommentPropertiesScheme represents the properties of an inline comment in Confluence.
+type InlineCommentPropertiesScheme struct {
+	InlineMarkerRef         string `json:"inlineMarkerRef,omitempty"`         // The reference of the marker highlighting the commented text.
+	InlineOriginalSelection string `json:"inlineOriginalSelection,omitempty"` // The text selected when the comment was created.
+}
+
+// ContentCommentCreatePayloadScheme represents the payload for creating a footer or inline comment in Confluence.
+//
+// One of the page, the blog post or the parent comment is required.
+type ContentCommentCreatePayloadScheme struct {
+	PageID                  string                                `json:"pageId,omitempty"`                  // The ID of the page to comment.
+	BlogPostID              string                                `json:"blogPostId,omitempty"`              // The ID of the blog post to comment.
+	ParentCommentID         string                                `json:"parentCommentId,omitempty"`         // The ID of the comment to reply to.
+	Body                    *PageBodyRepresentationScheme         `json:"body,omitempty"`                    // The body of the comment.
+	InlineCommentProperties *InlineCommentPropertiesPayloadScheme `json:"inlineCommentProperties,omitempty"` // The text highlighted by an inline comment, required for the top-level inline comments.
+}
+
+// InlineCommentPropertiesPayloadScheme represents the text highlighted by a new inline comment in Confluence.
+type InlineCommentPropertiesPayloadScheme struct {
+	TextSelection           string `json:"textSelection,omitempty"`           // The text to highlight.
+	TextSelectionMatchCount int    `json:"textSelectionMatchCount,omitempty"` // The number of occurrences of the text in the body.
+	TextSelectionMatchIndex int    `json:"textSelectionMatchIndex"`           // The index of the occurrence to highlight, starting at 0.
+}
+
+// ContentCommentUpdatePayloadScheme represents the payload for updating a footer or inline comment in Confluence.
+type ContentCommentUpdatePayloadScheme struct {
+	Version  *PageUpdatePayloadVersionScheme `json:"version,omitempty"`  // The version of the comment, the current version number plus one.
+	Body     *PageBodyRepresentationScheme   `json:"body,omitempty"`     // The body of the comment.
+	Resolved *bool                           `json:"resolved,omitempty"` // Resolves or reopens an inline comment.
+}
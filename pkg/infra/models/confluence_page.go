@@ -58,11 +58,43 @@ type PageScheme struct {
 
 // PageVersionScheme represents the version of a page in Confluence.
 type PageVersionScheme struct {
-	CreatedAt string `json:"createdAt,omitempty"` // The timestamp of the creation of the version.
-	Message   string `json:"message,omitempty"`   // The message of the version.
-	Number    int    `json:"number,omitempty"`    // The number of the version.
-	MinorEdit bool   `json:"minorEdit,omitempty"` // Indicates if the version is a minor edit.
-	AuthorID  string `json:"authorId,omitempty"`  // The ID of the author of the version.
+	CreatedAt string                 `json:"createdAt,omitempty"` // The timestamp of the creation of the version.
+	Message   string                 `json:"message,omitempty"`   // The message of the version.
+	Number    int                    `json:"number,omitempty"`    // The number of the version.
+	MinorEdit bool                   `json:"minorEdit,omitempty"` // Indicates if the version is a minor edit.
+	AuthorID  string                 `json:"authorId,omitempty"`  // The ID of the author of the version.
+	Page      *PageVersionPageScheme `json:"page,omitempty"`      // The page of the version, returned by the page versions endpoint.
+}
+
+// PageVersionPageScheme represents the page of a version in Confluence.
+type PageVersionPageScheme struct {
+	ID    string `json:"id,omitempty"`    // The ID of the page.
+	Title string `json:"title,omitempty"` // The title of the page at the version.
+}
+
+// PageVersionOptionsScheme represents the options for listing the versions of a page in Confluence.
+type PageVersionOptionsScheme struct {
+	BodyFormat string // The body format of the versions, e.g. storage or atlas_doc_format.
+	Sort       string // The sort order of the versions, e.g. -modified-date.
+}
+
+// PageVersionChunkScheme represents a chunk of page versions in Confluence.
+type PageVersionChunkScheme struct {
+	Results []*PageVersionScheme  `json:"results,omitempty"` // The versions in the chunk.
+	Links   *PageChunkLinksScheme `json:"_links,omitempty"`  // The links of the chunk.
+}
+
+// PageVersionDetailScheme represents the details of a page version in Confluence.
+type PageVersionDetailScheme struct {
+	Number              int      `json:"number,omitempty"`              // The number of the version.
+	AuthorID            string   `json:"authorId,omitempty"`            // The ID of the author of the version.
+	Message             string   `json:"message,omitempty"`             // The message of the version.
+	CreatedAt           string   `json:"createdAt,omitempty"`           // The timestamp of the creation of the version.
+	MinorEdit           bool     `json:"minorEdit,omitempty"`           // Indicates if the version is a minor edit.
+	ContentTypeModified bool     `json:"contentTypeModified,omitempty"` // Indicates if the content type was modified in the version.
+	Collaborators       []string `json:"collaborators,omitempty"`       // The account IDs of the collaborators of the version.
+	PrevVersion         int      `json:"prevVersion,omitempty"`         // The number of the previous version.
+	NextVersion         int      `json:"nextVersion,omitempty"`         // The number of the next version.
 }
 
 // PageBodyScheme represents the body of a page in Confluence.
@@ -103,3 +135,19 @@ type PageUpdatePayloadVersionScheme struct {
 	Number  int    `json:"number,omitempty"`  // The number of the version.
 	Message string `json:"message,omitempty"` // The message of the version.
 }
+
+// PagePublishPayloadScheme represents the payload for publishing Markdown as a page in Confluence.
+type PagePublishPayloadScheme struct {
+	SpaceID  int    // The ID of the space of the page.
+	Title    string // The title of the page, the page is identified by its title within the space.
+	ParentID string // The ID of the parent of the page, used when the page is created.
+	Markdown string // The Markdown converted to the storage format body of the page.
+	Message  string // The message of the new version when the page is updated.
+}
+
+// PagePublishScheme represents the result of publishing Markdown as a page in Confluence.
+type PagePublishScheme struct {
+	Page    *PageScheme // The published page.
+	Created bool        // Indicates if the page was created.
+	Updated bool        // Indicates if a new version of the page was published, false when the body is unchanged.
+}
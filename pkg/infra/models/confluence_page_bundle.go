@@ -0,0 +1,46 @@
+package models
+
+// PageBundleVersion is the version of the page bundle layout written by Page.Export.
+const PageBundleVersion = 1
+
+// PageBundleScheme represents the manifest of a page tree bundle in Confluence.
+//
+// The bundle stores the manifest in manifest.json, the storage format bodies in pages/ and the attachments in attachments/.
+type PageBundleScheme struct {
+	Version  int                     `json:"version"`            // The version of the bundle layout.
+	SpaceID  string                  `json:"spaceId,omitempty"`  // The ID of the source space.
+	SpaceKey string                  `json:"spaceKey,omitempty"` // The key of the source space, used to rewrite the links.
+	Pages    []*PageBundlePageScheme `json:"pages"`              // The pages of the tree, the parents are listed before their children.
+}
+
+// PageBundlePageScheme represents a page of a page tree bundle in Confluence.
+type PageBundlePageScheme struct {
+	ID          string                        `json:"id"`                    // The ID of the source page.
+	ParentID    string                        `json:"parentId,omitempty"`    // The ID of the source parent page, empty for the root page of the tree.
+	Title       string                        `json:"title"`                 // The title of the page.
+	Position    int                           `json:"position,omitempty"`    // The position of the page among its siblings.
+	Body        string                        `json:"body"`                  // The path of the storage format body in the bundle.
+	Labels      []*ContentLabelPayloadScheme  `json:"labels,omitempty"`      // The labels of the page.
+	Attachments []*PageBundleAttachmentScheme `json:"attachments,omitempty"` // The attachments of the page.
+}
+
+// PageBundleAttachmentScheme represents an attachment of a page tree bundle in Confluence.
+type PageBundleAttachmentScheme struct {
+	ID        string `json:"id"`                  // The ID of the source attachment.
+	Title     string `json:"title"`               // The file name of the attachment.
+	MediaType string `json:"mediaType,omitempty"` // The media type of the attachment.
+	File      string `json:"file"`                // The path of the attachment in the bundle.
+}
+
+// PageBundleImportOptionsScheme represents the options for importing a page tree bundle in Confluence.
+type PageBundleImportOptionsScheme struct {
+	SpaceID      int               // The ID of the target space.
+	SpaceKey     string            // The key of the target space, the links to the source space are rewritten to it.
+	ParentID     string            // The ID of the target parent page of the root page, the root page is created at the top level of the space when empty.
+	Replacements map[string]string // Additional replacements applied to the bodies, e.g. the source site URL to the target site URL.
+}
+
+// PageBundleImportScheme represents the result of importing a page tree bundle in Confluence.
+type PageBundleImportScheme struct {
+	Pages map[string]string // The IDs of the created pages, keyed by the IDs of the source pages.
+}
@@ -0,0 +1,114 @@
+package models
+
+const (
+	// LookAndFeelTypeGlobal applies the site look and feel to a space.
+	LookAndFeelTypeGlobal = "global"
+	// LookAndFeelTypeCustom applies the custom look and feel of the space or site.
+	LookAndFeelTypeCustom = "custom"
+	// LookAndFeelTypeTheme applies the look and feel of the selected theme.
+	LookAndFeelTypeTheme = "theme"
+)
+
+// LookAndFeelSettingsScheme represents the look and feel settings of the site or a space in Confluence.
+type LookAndFeelSettingsScheme struct {
+	Selected string             `json:"selected,omitempty"` // The look and feel type in use: global, custom or theme.
+	Global   *LookAndFeelScheme `json:"global,omitempty"`   // The global look and feel.
+	Theme    *LookAndFeelScheme `json:"theme,omitempty"`    // The look and feel of the selected theme.
+	Custom   *LookAndFeelScheme `json:"custom,omitempty"`   // The custom look and feel.
+}
+
+// LookAndFeelScheme represents a look and feel definition in Confluence.
+type LookAndFeelScheme struct {
+	Headings           *LookAndFeelColorScheme   `json:"headings,omitempty"`           // The color of the headings.
+	Links              *LookAndFeelColorScheme   `json:"links,omitempty"`              // The color of the links.
+	Menus              *LookAndFeelMenusScheme   `json:"menus,omitempty"`              // The colors of the menus.
+	Header             *LookAndFeelHeaderScheme  `json:"header,omitempty"`             // The colors of the header.
+	HorizontalHeader   *LookAndFeelHeaderScheme  `json:"horizontalHeader,omitempty"`   // The colors of the horizontal header.
+	Content            *LookAndFeelContentScheme `json:"content,omitempty"`            // The styles of the content.
+	BordersAndDividers *LookAndFeelColorScheme   `json:"bordersAndDividers,omitempty"` // The color of the borders and dividers.
+	SpaceReference     interface{}               `json:"spaceReference,omitempty"`     // The space the look and feel belongs to, if any.
+}
+
+// LookAndFeelColorScheme represents a color setting of a look and feel in Confluence.
+type LookAndFeelColorScheme struct {
+	Color string `json:"color,omitempty"` // The color as a hex code.
+}
+
+// LookAndFeelMenusScheme represents the menu colors of a look and feel in Confluence.
+type LookAndFeelMenusScheme struct {
+	HoverOrFocus *LookAndFeelBackgroundScheme `json:"hoverOrFocus,omitempty"` // The background color on hover or focus.
+	Color        string                       `json:"color,omitempty"`        // The color of the menu.
+}
+
+// LookAndFeelBackgroundScheme represents a background color setting of a look and feel in Confluence.
+type LookAndFeelBackgroundScheme struct {
+	BackgroundColor string `json:"backgroundColor,omitempty"` // The background color as a hex code.
+	Color           string `json:"color,omitempty"`           // The foreground color as a hex code.
+}
+
+// LookAndFeelHeaderScheme represents the header colors of a look and feel in Confluence.
+type LookAndFeelHeaderScheme struct {
+	BackgroundColor     string                       `json:"backgroundColor,omitempty"`     // The background color of the header.
+	Button              *LookAndFeelBackgroundScheme `json:"button,omitempty"`              // The colors of the header buttons.
+	PrimaryNavigation   *LookAndFeelNavigationScheme `json:"primaryNavigation,omitempty"`   // The colors of the primary navigation.
+	SecondaryNavigation *LookAndFeelNavigationScheme `json:"secondaryNavigation,omitempty"` // The colors of the secondary navigation.
+	Search              *LookAndFeelBackgroundScheme `json:"search,omitempty"`              // The colors of the search field.
+}
+
+// LookAndFeelNavigationScheme represents the navigation colors of a look and feel in Confluence.
+type LookAndFeelNavigationScheme struct {
+	Color        string                       `json:"color,omitempty"`        // The color of the navigation.
+	HoverOrFocus *LookAndFeelBackgroundScheme `json:"hoverOrFocus,omitempty"` // The colors on hover or focus.
+}
+
+// LookAndFeelContentScheme represents the content styles of a look and feel in Confluence.
+type LookAndFeelContentScheme struct {
+	Screen    *LookAndFeelContainerScheme `json:"screen,omitempty"`    // The styles of the screen.
+	Container *LookAndFeelContainerScheme `json:"container,omitempty"` // The styles of the container.
+	Header    *LookAndFeelContainerScheme `json:"header,omitempty"`    // The styles of the content header.
+	Body      *LookAndFeelContainerScheme `json:"body,omitempty"`      // The styles of the content body.
+}
+
+// LookAndFeelContainerScheme represents the styles of a container of a look and feel in Confluence.
+type LookAndFeelContainerScheme struct {
+	Background      string `json:"background,omitempty"`      // The background of the container.
+	BackgroundColor string `json:"backgroundColor,omitempty"` // The background color of the container.
+	BackgroundImage string `json:"backgroundImage,omitempty"` // The background image of the container.
+	BackgroundSize  string `json:"backgroundSize,omitempty"`  // The background size of the container.
+	Padding         string `json:"padding,omitempty"`         // The padding of the container.
+	BorderRadius    string `json:"borderRadius,omitempty"`    // The border radius of the container.
+}
+
+// LookAndFeelSelectionScheme represents the payload used to select the look and feel of a space in Confluence.
+type LookAndFeelSelectionScheme struct {
+	SpaceKey        string `json:"spaceKey"`        // The key of the space.
+	LookAndFeelType string `json:"lookAndFeelType"` // The look and feel type to apply: global, custom or theme.
+}
+
+// SystemInfoScheme represents the system information of a Confluence site.
+type SystemInfoScheme struct {
+	CloudID         string `json:"cloudId,omitempty"`         // The cloud ID of the site.
+	CommitHash      string `json:"commitHash,omitempty"`      // The commit hash of the running build.
+	BaseURL         string `json:"baseUrl,omitempty"`         // The base URL of the site.
+	Edition         string `json:"edition,omitempty"`         // The edition of the site.
+	SiteTitle       string `json:"siteTitle,omitempty"`       // The title of the site.
+	DefaultLocale   string `json:"defaultLocale,omitempty"`   // The default locale of the site.
+	DefaultTimeZone string `json:"defaultTimeZone,omitempty"` // The default time zone of the site.
+	MicrosPerimeter string `json:"microsPerimeter,omitempty"` // The perimeter of the site, e.g. commercial.
+}
+
+// ThemeScheme represents a theme in Confluence.
+type ThemeScheme struct {
+	ThemeKey    string           `json:"themeKey,omitempty"`    // The key of the theme.
+	Name        string           `json:"name,omitempty"`        // The name of the theme.
+	Description string           `json:"description,omitempty"` // The description of the theme.
+	Icon        *ThemeIconScheme `json:"icon,omitempty"`        // The icon of the theme.
+}
+
+// ThemeIconScheme represents the icon of a theme in Confluence.
+type ThemeIconScheme struct {
+	Path      string `json:"path,omitempty"`      // The path of the icon.
+	Width     int    `json:"width,omitempty"`     // The width of the icon.
+	Height    int    `json:"height,omitempty"`    // The height of the icon.
+	IsDefault bool   `json:"isDefault,omitempty"` // Indicates if the icon is the default one.
+}
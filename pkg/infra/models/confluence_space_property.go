@@ -0,0 +1,44 @@
+package models
+
+// SpacePropertyPayloadScheme represents the payload to create or update a space property in Confluence.
+type SpacePropertyPayloadScheme struct {
+	Key     string                             `json:"key,omitempty"`     // The key of the space property.
+	Value   interface{}                        `json:"value,omitempty"`   // The value of the space property, any JSON value.
+	Version *SpacePropertyVersionPayloadScheme `json:"version,omitempty"` // The new version of the space property, required by the updates.
+}
+
+// SpacePropertyVersionPayloadScheme represents the new version of an updated space property in Confluence.
+type SpacePropertyVersionPayloadScheme struct {
+	Number  int    `json:"number"`            // The number of the new version, the current version number plus one.
+	Message string `json:"message,omitempty"` // The message of the new version.
+}
+
+// SpacePropertyPageScheme represents a page of space properties in Confluence.
+type SpacePropertyPageScheme struct {
+	Results []*SpacePropertyScheme       `json:"results,omitempty"` // The space properties in the page.
+	Links   *SpacePropertyPageLinkScheme `json:"_links,omitempty"`  // The links of the page.
+}
+
+// SpacePropertyPageLinkScheme represents the links of a page of space properties in Confluence.
+type SpacePropertyPageLinkScheme struct {
+	Next string `json:"next,omitempty"` // The link to the next page of space properties.
+	Base string `json:"base,omitempty"` // The base URL of the Confluence site.
+}
+
+// SpacePropertyScheme represents a space property in Confluence.
+type SpacePropertyScheme struct {
+	ID        string                      `json:"id,omitempty"`        // The ID of the space property.
+	Key       string                      `json:"key,omitempty"`       // The key of the space property.
+	Value     interface{}                 `json:"value,omitempty"`     // The value of the space property.
+	CreatedAt string                      `json:"createdAt,omitempty"` // The creation timestamp of the space property.
+	CreatedBy string                      `json:"createdBy,omitempty"` // The account ID of the user who created the space property.
+	Version   *SpacePropertyVersionScheme `json:"version,omitempty"`   // The current version of the space property.
+}
+
+// SpacePropertyVersionScheme represents the version of a space property in Confluence.
+type SpacePropertyVersionScheme struct {
+	CreatedAt string `json:"createdAt,omitempty"` // The creation timestamp of the version.
+	CreatedBy string `json:"createdBy,omitempty"` // The account ID of the user who created the version.
+	Message   string `json:"message,omitempty"`   // The message of the version.
+	Number    int    `json:"number,omitempty"`    // The number of the version.
+}
@@ -0,0 +1,40 @@
+package models
+
+// WatchStatusScheme represents the watch status of a user for a content, space or label in Confluence.
+type WatchStatusScheme struct {
+	Watching bool `json:"watching"` // Indicates if the user is watching the entity.
+}
+
+// ContentWatcherPageScheme represents a page of content watchers in Confluence.
+type ContentWatcherPageScheme struct {
+	Results []*ContentWatcherScheme `json:"results,omitempty"` // The watchers in the page.
+	Start   int                     `json:"start,omitempty"`   // The start index of the watchers in the page.
+	Limit   int                     `json:"limit,omitempty"`   // The limit of the watchers in the page.
+	Size    int                     `json:"size,omitempty"`    // The size of the watchers in the page.
+	Links   *LinkScheme             `json:"_links,omitempty"`  // The links of the page.
+}
+
+// ContentWatcherScheme represents a watcher of a content in Confluence.
+type ContentWatcherScheme struct {
+	Type      string             `json:"type,omitempty"`      // The type of the watch.
+	Watcher   *ContentUserScheme `json:"watcher,omitempty"`   // The user watching the content.
+	ContentID int                `json:"contentId,omitempty"` // The ID of the watched content.
+}
+
+// SpaceWatcherPageScheme represents a page of space watchers in Confluence.
+type SpaceWatcherPageScheme struct {
+	Results []*SpaceWatcherScheme `json:"results,omitempty"` // The watchers in the page.
+	Start   int                   `json:"start,omitempty"`   // The start index of the watchers in the page.
+	Limit   int                   `json:"limit,omitempty"`   // The limit of the watchers in the page.
+	Size    int                   `json:"size,omitempty"`    // The size of the watchers in the page.
+	Links   *LinkScheme           `json:"_links,omitempty"`  // The links of the page.
+}
+
+// SpaceWatcherScheme represents a watcher of a space in Confluence.
+type SpaceWatcherScheme struct {
+	Type      string             `json:"type,omitempty"`      // The type of the watch.
+	Watcher   *ContentUserScheme `json:"watcher,omitempty"`   // The user watching the space.
+	SpaceKey  string             `json:"spaceKey,omitempty"`  // The key of the watched space.
+	LabelName string             `json:"labelName,omitempty"` // The name of the watched label, if any.
+	Prefix    string             `json:"prefix,omitempty"`    // The prefix of the watched label, if any.
+}
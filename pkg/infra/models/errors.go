@@ -29,6 +29,9 @@ var (
 	// ErrNoAdminPolicy indicates that a required organization policy ID was not provided
 	ErrNoAdminPolicy = errors.New("no organization policy id set")
 
+	// ErrNoAdminResourceID indicates that a required organization policy resource ID was not provided
+	ErrNoAdminResourceID = errors.New("no organization policy resource id set")
+
 	// ErrNoAdminDirectoryID indicates that a required directory ID was not provided
 	ErrNoAdminDirectoryID = errors.New("no directory id set")
 
@@ -50,15 +53,33 @@ var (
 	// ErrNoBoardID indicates that a required board ID was not provided
 	ErrNoBoardID = errors.New("no board id set")
 
+	// ErrNoBoardName indicates that a required board name was not provided
+	ErrNoBoardName = errors.New("no board name set")
+
+	// ErrNoBoardType indicates that a required board type was not provided
+	ErrNoBoardType = errors.New("no board type set")
+
+	// ErrNoQuickFilterID indicates that a required quick filter ID was not provided
+	ErrNoQuickFilterID = errors.New("no quick filter id set")
+
+	// ErrNoBoardFeature indicates that a required board feature was not provided
+	ErrNoBoardFeature = errors.New("no board feature set")
+
 	// ErrNoFilterID indicates that a required filter ID was not provided
 	ErrNoFilterID = errors.New("no filter id set")
 
 	// ErrNoFolderID indicates that a required folder ID was not provided
 	ErrNoFolderID = errors.New("no folder id set")
 
+	// ErrNoIssueRankTarget indicates that neither the rank before nor the rank after issue was provided
+	ErrNoIssueRankTarget = errors.New("no rank before or rank after issue set")
+
 	// ErrNoEpicID indicates that a required epic ID was not provided
 	ErrNoEpicID = errors.New("no epic id set")
 
+	// ErrNoEpicKey indicates that a required epic key was not provided
+	ErrNoEpicKey = errors.New("no epic key set")
+
 	// ErrNoSprintID indicates that a required sprint ID was not provided
 	ErrNoSprintID = errors.New("no sprint id set")
 
@@ -86,6 +107,18 @@ var (
 	// ErrNoTaskID indicates that a required task ID was not provided
 	ErrNoTaskID = errors.New("no task id set")
 
+	// ErrTaskFailed indicates that an asynchronous task finished without completing
+	ErrTaskFailed = errors.New("the task finished without completing")
+
+	// ErrNoBulkEditActions indicates that the fields to edit in bulk were not provided
+	ErrNoBulkEditActions = errors.New("no bulk edit actions set")
+
+	// ErrNoBulkTransitions indicates that the transitions to perform in bulk were not provided
+	ErrNoBulkTransitions = errors.New("no bulk transitions set")
+
+	// ErrNoBulkMoveTargets indicates that the targets of a bulk move were not provided
+	ErrNoBulkMoveTargets = errors.New("no bulk move targets set")
+
 	// ErrNoWorkspace indicates that a required workspace was not provided
 	ErrNoWorkspace = errors.New("no workspace set")
 
@@ -95,6 +128,27 @@ var (
 	// ErrNoWebhookID indicates that a required webhook ID was not provided
 	ErrNoWebhookID = errors.New("no webhook id set")
 
+	// ErrNoWebhookIDs indicates that the required webhook IDs were not provided
+	ErrNoWebhookIDs = errors.New("no webhook ids set")
+
+	// ErrNoWebhookURL indicates that a required webhook URL was not provided
+	ErrNoWebhookURL = errors.New("no webhook url set")
+
+	// ErrNoWebhookStore indicates that a required webhook store was not provided
+	ErrNoWebhookStore = errors.New("no webhook store set")
+
+	// ErrNoWebhookSignature indicates that a webhook request isn't signed while a secret is configured
+	ErrNoWebhookSignature = errors.New("no webhook signature set")
+
+	// ErrInvalidWebhookSignature indicates that the signature of a webhook request doesn't match its body
+	ErrInvalidWebhookSignature = errors.New("invalid webhook signature")
+
+	// ErrNoJQLValues indicates that a JQL clause has no operand
+	ErrNoJQLValues = errors.New("no jql clause values set")
+
+	// ErrInvalidJQLValues indicates that a JQL clause has several operands while its operator takes a single one
+	ErrInvalidJQLValues = errors.New("the jql operator takes a single value")
+
 	// ErrNoRepository indicates that a required repository was not provided
 	ErrNoRepository = errors.New("no repository set")
 
@@ -113,12 +167,33 @@ var (
 	// ErrInternal indicates an internal Atlassian error occurred
 	ErrInternal = errors.New("atlassian internal error")
 
+	// ErrInvalidADF indicates that a rich text document doesn't follow the Atlassian Document Format
+	ErrInvalidADF = errors.New("invalid atlassian document format")
+
+	// ErrMaintenance indicates that the Atlassian site is under maintenance, a 503 response announcing when to retry
+	ErrMaintenance = errors.New("atlassian site under maintenance")
+
 	// ErrBadRequest indicates that the request payload was invalid
 	ErrBadRequest = errors.New("atlassian invalid payload")
 
+	// ErrConflict indicates that the request conflicts with the current state of the Atlassian resource
+	ErrConflict = errors.New("atlassian resource conflict")
+
+	// ErrPreconditionFailed indicates that a precondition of the request, e.g. the resource version, was not met
+	ErrPreconditionFailed = errors.New("atlassian precondition failed")
+
+	// ErrDecode indicates that the response body could not be decoded into the target structure
+	ErrDecode = errors.New("unable to decode the response body")
+
+	// ErrUnknownFields indicates that the response body has fields the target structure doesn't capture
+	ErrUnknownFields = errors.New("the response body has unknown fields")
+
 	// ErrNoSite indicates that no Atlassian site URL was provided
 	ErrNoSite = errors.New("no atlassian site set")
 
+	// ErrNoCredentials indicates that no credentials were provided to build the product clients
+	ErrNoCredentials = errors.New("no atlassian credentials set")
+
 	// ErrNoContentAttachmentID indicates that a required attachment ID was not provided
 	ErrNoContentAttachmentID = errors.New("no attachment id set")
 
@@ -140,9 +215,24 @@ var (
 	// ErrNoPageID indicates that a required page ID was not provided
 	ErrNoPageID = errors.New("no page id set")
 
+	// ErrNoVersionNumber indicates that a required version number was not provided
+	ErrNoVersionNumber = errors.New("no version number set")
+
 	// ErrNoSpaceID indicates that a required space ID was not provided
 	ErrNoSpaceID = errors.New("no space id set")
 
+	// ErrNoSpacePropertyID indicates that a required space property ID was not provided
+	ErrNoSpacePropertyID = errors.New("no space property id set")
+
+	// ErrNoBlogPostID indicates that a required blog post ID was not provided
+	ErrNoBlogPostID = errors.New("no blog post id set")
+
+	// ErrNoCommentContainer indicates that the page, blog post or parent comment of a comment was not provided
+	ErrNoCommentContainer = errors.New("no comment page, blog post or parent comment id set")
+
+	// ErrNoPageTitle indicates that a required page title was not provided
+	ErrNoPageTitle = errors.New("no page title set")
+
 	// ErrNoTargetID indicates that a required target ID was not provided
 	ErrNoTargetID = errors.New("no target id set")
 
@@ -194,11 +284,32 @@ var (
 	// ErrNoLabelName indicates that a required label name was not provided
 	ErrNoLabelName = errors.New("no label name set")
 
+	// ErrNoConfluenceAuditRecord indicates that a required audit record payload was not provided
+	ErrNoConfluenceAuditRecord = errors.New("no audit record set")
+
+	// ErrNoLookAndFeel indicates that a required look and feel payload was not provided
+	ErrNoLookAndFeel = errors.New("no look and feel set")
+
+	// ErrLongTaskFailed indicates that a long-running task finished without success
+	ErrLongTaskFailed = errors.New("the long-running task finished without success")
+
+	// ErrNoContentBody indicates that a required content body was not provided
+	ErrNoContentBody = errors.New("no content body set")
+
+	// ErrNoContentBodyRepresentation indicates that a required content body representation was not provided
+	ErrNoContentBodyRepresentation = errors.New("no content body representation set")
+
+	// ErrNoContentBodyAsyncID indicates that a required content body conversion task ID was not provided
+	ErrNoContentBodyAsyncID = errors.New("no content body conversion task id set")
+
 	// Custom-field errors
 
 	// ErrNoFloatType indicates that a required float type was not provided
 	ErrNoFloatType = errors.New("no float type set")
 
+	// ErrSprintNotStarted indicates that the sprint has no start date, e.g. a future sprint
+	ErrSprintNotStarted = errors.New("the sprint has not been started")
+
 	// ErrNoSprintType indicates that a required sprint type was not found
 	ErrNoSprintType = errors.New("no sprint type found")
 
@@ -289,9 +400,39 @@ var (
 	// ErrNoTransitionID indicates that a required transition ID was not provided
 	ErrNoTransitionID = errors.New("no transition id set")
 
+	// ErrAttachmentChecksumMismatch indicates that the uploaded attachment doesn't match the checksum of the file
+	ErrAttachmentChecksumMismatch = errors.New("attachment checksum mismatch")
+
+	// ErrNoAttachmentUploaded indicates that the upload response didn't include the attachment
+	ErrNoAttachmentUploaded = errors.New("no attachment uploaded")
+
+	// ErrNoStatusName indicates that a required status name was not provided
+	ErrNoStatusName = errors.New("no status name set")
+
+	// ErrNoTargetStatus indicates that the status to transition the issue to was not provided
+	ErrNoTargetStatus = errors.New("no target status set")
+
+	// ErrNoTransitionToStatus indicates that the issue has no available transition to the next status
+	ErrNoTransitionToStatus = errors.New("no transition to the status available")
+
+	// ErrNoStatusRoute indicates that the workflow graph has no route to the target status
+	ErrNoStatusRoute = errors.New("no route to the target status in the workflow graph")
+
+	// ErrTransitionLimitReached indicates that the issue did not reach the target status within the maximum number of transitions
+	ErrTransitionLimitReached = errors.New("transitions limit reached before the target status")
+
 	// ErrNoAttachmentID indicates that a required attachment ID was not provided
 	ErrNoAttachmentID = errors.New("no attachment id set")
 
+	// ErrRollbackFailed indicates that the changes applied before a failure couldn't be reverted
+	ErrRollbackFailed = errors.New("the applied changes couldn't be rolled back")
+
+	// ErrUnresolvedEmails indicates that some email addresses don't match a user
+	ErrUnresolvedEmails = errors.New("the email addresses don't match a user")
+
+	// ErrNoWriter indicates that a required writer was not provided
+	ErrNoWriter = errors.New("no writer set")
+
 	// ErrNoAttachmentName indicates that a required attachment filename was not provided
 	ErrNoAttachmentName = errors.New("no attachment filename set")
 
@@ -322,18 +463,51 @@ var (
 	// ErrNoProjectFeatureState indicates that a required project state key was not provided
 	ErrNoProjectFeatureState = errors.New("no project state key set")
 
+	// ErrInvalidProjectAssigneeType indicates that the default assignee of a project is not one of the supported types
+	ErrInvalidProjectAssigneeType = errors.New("invalid project assignee type")
+
+	// ErrInvalidProjectFeatureState indicates that the project feature state cannot be set through the API
+	ErrInvalidProjectFeatureState = errors.New("invalid project feature state")
+
+	// ErrProjectFeatureNotFound indicates that the project feature is not available on the project
+	ErrProjectFeatureNotFound = errors.New("project feature not found")
+
+	// ErrProjectFeatureLocked indicates that the project feature cannot be toggled
+	ErrProjectFeatureLocked = errors.New("project feature is locked")
+
+	// ErrProjectFeaturePrerequisite indicates that a prerequisite of the project feature is not enabled
+	ErrProjectFeaturePrerequisite = errors.New("project feature prerequisite not enabled")
+
 	// ErrNoFieldID indicates that a required field ID was not provided
 	ErrNoFieldID = errors.New("no field id set")
 
 	// ErrInvalidCustomFieldUpdate represents an error indicating the custom field update payload contains an invalid type attribute.
 	ErrInvalidCustomFieldUpdate = errors.New("invalid custom field update payload, type is not a valid attribute for update")
 
+	// ErrNoCustomFieldName indicates that a required custom field name was not provided
+	ErrNoCustomFieldName = errors.New("no custom field name set")
+
+	// ErrNoCustomFieldType indicates that a required custom field type was not provided
+	ErrNoCustomFieldType = errors.New("no custom field type set")
+
+	// ErrInvalidCustomFieldSearcher indicates that the searcher of a custom field isn't accepted by its type
+	ErrInvalidCustomFieldSearcher = errors.New("the custom field type doesn't accept the searcher")
+
 	// ErrNoEditOperator indicates that a required update operation was not provided
 	ErrNoEditOperator = errors.New("no update operation set")
 
 	// ErrNoEditValue indicates that a required update operation value was not provided
 	ErrNoEditValue = errors.New("no update operation value set")
 
+	// ErrIssueFieldReadOnly indicates that a read-only issue field was requested in an update payload
+	ErrIssueFieldReadOnly = errors.New("the issue field is read-only")
+
+	// ErrNoSubtaskIssueType indicates that the project of the parent issue has no subtask issue type
+	ErrNoSubtaskIssueType = errors.New("the project has no subtask issue type")
+
+	// ErrIssueLinkNotOnIssue indicates that an issue link doesn't link the issue it's moved from
+	ErrIssueLinkNotOnIssue = errors.New("the issue link doesn't link the issue")
+
 	// ErrNoCustomFieldID indicates that a required custom field ID was not provided
 	ErrNoCustomFieldID = errors.New("no custom-field id set")
 
@@ -346,6 +520,9 @@ var (
 	// ErrNoWorkflowStatusNameOrID indicates that neither workflow status name nor ID was provided
 	ErrNoWorkflowStatusNameOrID = errors.New("no workflow status name or id set")
 
+	// ErrNoStatusCategoryIDOrKey indicates that neither status category ID nor key was provided
+	ErrNoStatusCategoryIDOrKey = errors.New("no status category id or key set")
+
 	// ErrNoFieldContextID indicates that a required field context ID was not provided
 	ErrNoFieldContextID = errors.New("no field context id set")
 
@@ -364,6 +541,21 @@ var (
 	// ErrNoLinkTypeID indicates that a required link type ID was not provided
 	ErrNoLinkTypeID = errors.New("no link type id set")
 
+	// ErrNoLinkTypeName indicates that a required link type name was not provided
+	ErrNoLinkTypeName = errors.New("no link type name set")
+
+	// ErrNoLinkTypeInward indicates that a required link type inward description was not provided
+	ErrNoLinkTypeInward = errors.New("no link type inward description set")
+
+	// ErrNoLinkTypeOutward indicates that a required link type outward description was not provided
+	ErrNoLinkTypeOutward = errors.New("no link type outward description set")
+
+	// ErrLinkTypeNameTaken indicates that the link type name is already used by another link type
+	ErrLinkTypeNameTaken = errors.New("link type name already used")
+
+	// ErrLinkTypeInUse indicates that the link type links more issues than allowed by the rename
+	ErrLinkTypeInUse = errors.New("link type used by more issues than allowed")
+
 	// ErrNoPriorityID indicates that a required priority ID was not provided
 	ErrNoPriorityID = errors.New("no priority id set")
 
@@ -373,6 +565,12 @@ var (
 	// ErrNoJQL indicates that a required JQL query was not provided
 	ErrNoJQL = errors.New("no sql set")
 
+	// ErrNoExpression indicates that a required Jira expression was not provided
+	ErrNoExpression = errors.New("no jira expression set")
+
+	// ErrNoSyncCheckpoint indicates that a required sync checkpoint was not provided
+	ErrNoSyncCheckpoint = errors.New("no sync checkpoint set")
+
 	// ErrNoIssueTypeID indicates that a required issue type ID was not provided
 	ErrNoIssueTypeID = errors.New("no issue type id set")
 
@@ -397,9 +595,15 @@ var (
 	// ErrNoPermissionGrantID indicates that a required permission grant ID was not provided
 	ErrNoPermissionGrantID = errors.New("no permission grant id set")
 
+	// ErrNoPermissionGrantHolder indicates that a required permission grant holder was not provided
+	ErrNoPermissionGrantHolder = errors.New("no permission grant holder set")
+
 	// ErrNoPermissionKeys indicates that required permission keys were not provided
 	ErrNoPermissionKeys = errors.New("no permission keys set")
 
+	// ErrNoPermissionKey indicates that a required permission key was not provided
+	ErrNoPermissionKey = errors.New("no permission key set")
+
 	// ErrNoComponentID indicates that a required component ID was not provided
 	ErrNoComponentID = errors.New("no component id set")
 
@@ -433,6 +637,12 @@ var (
 	// ErrNoWorkflowSchemeID indicates that a required workflow scheme ID was not provided
 	ErrNoWorkflowSchemeID = errors.New("no workflow scheme id set")
 
+	// ErrNoWorkflowName indicates that a required workflow name was not provided
+	ErrNoWorkflowName = errors.New("no workflow name set")
+
+	// ErrNoRedactionPatterns indicates that the patterns to redact were not provided
+	ErrNoRedactionPatterns = errors.New("no redaction patterns set")
+
 	// ErrNoScreenID indicates that a required screen ID was not provided
 	ErrNoScreenID = errors.New("no screen id set")
 
@@ -448,6 +658,12 @@ var (
 	// ErrNoFieldConfigurationSchemeName indicates that a required field configuration scheme name was not provided
 	ErrNoFieldConfigurationSchemeName = errors.New("no field configuration scheme name set")
 
+	// ErrNoIssueSecuritySchemeID indicates that a required issue security scheme ID was not provided
+	ErrNoIssueSecuritySchemeID = errors.New("no issue security scheme id set")
+
+	// ErrNoIssueSecurityLevelID indicates that a required issue security level ID was not provided
+	ErrNoIssueSecurityLevelID = errors.New("no issue security level id set")
+
 	// ErrNoFieldConfigurationSchemeID indicates that a required field configuration scheme ID was not provided
 	ErrNoFieldConfigurationSchemeID = errors.New("no field configuration scheme id set")
 
@@ -475,6 +691,9 @@ var (
 	// ErrNoIssuesSlice indicates that required issues object was not provided
 	ErrNoIssuesSlice = errors.New("no issues object set")
 
+	// ErrExperimentalAPI indicates that an experimental Service Management endpoint was called without the experimental APIs enabled
+	ErrExperimentalAPI = errors.New("experimental api disabled, enable it with the WithExperimentalAPIs client option")
+
 	// ErrNoKBQuery indicates that a required knowledge base query was not provided
 	ErrNoKBQuery = errors.New("no knowledge base query set")
 
@@ -487,6 +706,12 @@ var (
 	// ErrNoServiceDeskID indicates that a required service desk ID was not provided
 	ErrNoServiceDeskID = errors.New("no service desk id set")
 
+	// ErrNoCustomerEmail indicates that a required customer email address was not provided
+	ErrNoCustomerEmail = errors.New("no customer email set")
+
+	// ErrCustomerNotFound indicates that no customer of the service desk matches the email address
+	ErrCustomerNotFound = errors.New("customer not found")
+
 	// ErrNoQueueID indicates that a required service desk queue ID was not provided
 	ErrNoQueueID = errors.New("no service desk queue id set")
 
@@ -46,3 +46,83 @@ type IssueArchiveExportResultScheme struct {
 	SubmittedTime int64  `json:"submittedTime,omitempty"`
 	Status        string `json:"status,omitempty"`
 }
+
+// The plans of the applications of a Jira instance.
+const (
+	LicensePlanUnlicensed = "UNLICENSED"
+	LicensePlanFree       = "FREE"
+	LicensePlanPaid       = "PAID"
+)
+
+// IssueArchivalSettingsScheme represents the license of the Jira instance, deciding whether the issues can be archived.
+//
+// Archiving needs a paid plan, Premium or Enterprise. The license doesn't tell the paid plans apart, so the issues
+// of a Standard plan are reported as eligible and then rejected by Preserve as issues in unlicensed projects.
+type IssueArchivalSettingsScheme struct {
+	Applications []*LicensedApplicationScheme `json:"applications,omitempty"` // The applications of the instance.
+}
+
+// LicensedApplicationScheme represents the license of an application of the Jira instance.
+type LicensedApplicationScheme struct {
+	ID   string `json:"id,omitempty"`   // The ID of the application, e.g. jira-software.
+	Plan string `json:"plan,omitempty"` // The plan of the application, see the LicensePlan constants.
+}
+
+// licensedApplicationsByProjectType maps the project types to the applications licensing them. The business
+// projects aren't licensed by an application of their own, they come with any Jira application.
+var licensedApplicationsByProjectType = map[string]string{
+	"software":          "jira-software",
+	"service_desk":      "jira-servicedesk",
+	"product_discovery": "jira-product-discovery",
+}
+
+// Supported reports whether an application of the instance is on a paid plan, needed to archive the issues.
+func (s *IssueArchivalSettingsScheme) Supported() bool {
+
+	if s == nil {
+		return false
+	}
+
+	for _, application := range s.Applications {
+		if application != nil && application.Plan == LicensePlanPaid {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SupportsProjectType reports whether the issues of the projects of the type can be archived, the application
+// licensing the projects being on a paid plan. The business projects and the unknown project types are reported as
+// supported by any paid plan.
+func (s *IssueArchivalSettingsScheme) SupportsProjectType(projectTypeKey string) bool {
+
+	applicationID, ok := licensedApplicationsByProjectType[projectTypeKey]
+	if !ok {
+		return s.Supported()
+	}
+
+	if s == nil {
+		return false
+	}
+
+	for _, application := range s.Applications {
+		if application != nil && application.ID == applicationID {
+			return application.Plan == LicensePlanPaid
+		}
+	}
+
+	return false
+}
+
+// IssueArchivalEligibilityScheme represents the issues that can be archived, checked before calling Preserve.
+type IssueArchivalEligibilityScheme struct {
+	Settings *IssueArchivalSettingsScheme // The license of the instance.
+
+	// Eligible are the issues expected to be archived, in the order of the checked issues.
+	Eligible []string
+
+	// Ineligible are the issues that would be rejected by Preserve, classified as the errors it returns.
+	// The issues of archived projects can't be read and are reported as not found.
+	Ineligible *IssueArchivalSyncErrorScheme
+}
@@ -0,0 +1,29 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssueArchivalSettingsScheme_SupportsProjectType(t *testing.T) {
+
+	settings := &IssueArchivalSettingsScheme{Applications: []*LicensedApplicationScheme{
+		{ID: "jira-software", Plan: LicensePlanPaid},
+		{ID: "jira-servicedesk", Plan: LicensePlanFree},
+	}}
+
+	assert.True(t, settings.Supported())
+	assert.True(t, settings.SupportsProjectType("software"))
+	assert.False(t, settings.SupportsProjectType("service_desk"))
+	assert.False(t, settings.SupportsProjectType("product_discovery"))
+	assert.True(t, settings.SupportsProjectType("business"))
+	assert.True(t, settings.SupportsProjectType("custom"))
+
+	free := &IssueArchivalSettingsScheme{Applications: []*LicensedApplicationScheme{{ID: "jira-software", Plan: LicensePlanFree}}}
+	assert.False(t, free.Supported())
+	assert.False(t, free.SupportsProjectType("business"))
+	assert.False(t, free.SupportsProjectType("custom"))
+
+	assert.False(t, (*IssueArchivalSettingsScheme)(nil).SupportsProjectType("software"))
+}
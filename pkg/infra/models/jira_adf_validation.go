@@ -0,0 +1,305 @@
+package models
+
+import (
+	"fmt"
+	"slices"
+)
+
+// The ADF rules checked locally, a subset of the Atlassian Document Format schema covering the
+// mistakes Jira rejects with an opaque INVALID_INPUT: unknown nodes, nodes nested in the wrong parent,
+// missing attributes and invalid marks.
+// https://developer.atlassian.com/cloud/jira/platform/apis/document/structure/
+var (
+	adfInlineNodes = []string{"text", "hardBreak", "mention", "emoji", "date", "status", "inlineCard", "mediaInline", "placeholder", "inlineExtension"}
+
+	adfBlockNodes = []string{"paragraph", "heading", "bulletList", "orderedList", "blockquote", "codeBlock", "rule", "panel", "table",
+		"mediaSingle", "mediaGroup", "expand", "taskList", "decisionList", "blockCard", "embedCard", "extension", "bodiedExtension"}
+
+	adfListItemNodes = []string{"paragraph", "bulletList", "orderedList", "codeBlock", "mediaSingle", "taskList"}
+
+	// The tables and the expands can't be nested, the nestedExpand node is used inside them instead.
+	adfNestedNodes = []string{"paragraph", "heading", "bulletList", "orderedList", "blockquote", "codeBlock", "rule", "panel",
+		"mediaSingle", "mediaGroup", "nestedExpand", "taskList", "decisionList", "blockCard", "embedCard", "extension"}
+
+	// adfChildren are the node types allowed in the content of every known node type, nil for the leaf nodes.
+	adfChildren = map[string][]string{
+		"doc":             append([]string{"layoutSection"}, adfBlockNodes...),
+		"paragraph":       adfInlineNodes,
+		"heading":         adfInlineNodes,
+		"bulletList":      {"listItem"},
+		"orderedList":     {"listItem"},
+		"listItem":        adfListItemNodes,
+		"blockquote":      {"paragraph", "bulletList", "orderedList", "codeBlock", "mediaGroup", "mediaSingle"},
+		"codeBlock":       {"text"},
+		"panel":           {"paragraph", "heading", "bulletList", "orderedList", "blockCard", "codeBlock", "mediaGroup", "mediaSingle", "rule", "taskList", "decisionList"},
+		"table":           {"tableRow"},
+		"tableRow":        {"tableHeader", "tableCell"},
+		"tableHeader":     adfNestedNodes,
+		"tableCell":       adfNestedNodes,
+		"mediaSingle":     {"media", "caption"},
+		"mediaGroup":      {"media"},
+		"caption":         adfInlineNodes,
+		"expand":          append([]string{"table"}, adfNestedNodes...),
+		"nestedExpand":    {"paragraph", "heading", "mediaGroup", "mediaSingle", "codeBlock", "bulletList", "orderedList", "taskList", "decisionList", "rule", "panel", "blockquote"},
+		"taskList":        {"taskItem", "taskList"},
+		"taskItem":        adfInlineNodes,
+		"decisionList":    {"decisionItem"},
+		"decisionItem":    adfInlineNodes,
+		"layoutSection":   {"layoutColumn"},
+		"layoutColumn":    adfBlockNodes,
+		"bodiedExtension": adfBlockNodes,
+		"text":            nil,
+		"hardBreak":       nil,
+		"mention":         nil,
+		"emoji":           nil,
+		"date":            nil,
+		"status":          nil,
+		"inlineCard":      nil,
+		"mediaInline":     nil,
+		"placeholder":     nil,
+		"inlineExtension": nil,
+		"rule":            nil,
+		"media":           nil,
+		"blockCard":       nil,
+		"embedCard":       nil,
+		"extension":       nil,
+	}
+
+	// adfNonEmptyNodes are the node types that require at least one child.
+	adfNonEmptyNodes = map[string]bool{
+		"bulletList": true, "orderedList": true, "listItem": true, "table": true, "tableRow": true, "mediaSingle": true,
+		"mediaGroup": true, "taskList": true, "decisionList": true, "layoutSection": true, "layoutColumn": true,
+	}
+
+	// adfRequiredAttrs are the attributes required by the node types.
+	adfRequiredAttrs = map[string][]string{
+		"heading":      {"level"},
+		"mention":      {"id"},
+		"emoji":        {"shortName"},
+		"date":         {"timestamp"},
+		"status":       {"text", "color"},
+		"panel":        {"panelType"},
+		"media":        {"type"},
+		"taskItem":     {"localId", "state"},
+		"taskList":     {"localId"},
+		"decisionItem": {"localId", "state"},
+		"decisionList": {"localId"},
+	}
+
+	// adfMarkAttrs are the attributes required by every known mark type.
+	adfMarkAttrs = map[string][]string{
+		"strong":          nil,
+		"em":              nil,
+		"code":            nil,
+		"strike":          nil,
+		"underline":       nil,
+		"link":            {"href"},
+		"textColor":       {"color"},
+		"backgroundColor": {"color"},
+		"subsup":          {"type"},
+		"annotation":      {"id", "annotationType"},
+		"alignment":       {"align"},
+		"indentation":     {"level"},
+		"breakout":        {"mode"},
+		"border":          {"size", "color"},
+		"dataConsumer":    {"sources"},
+		"fragment":        {"localId"},
+	}
+
+	// adfPanelTypes are the values of the panelType attribute of the panels.
+	adfPanelTypes = []string{"info", "note", "tip", "warning", "error", "success", "custom"}
+)
+
+// ADFValidationError represents the violations of the Atlassian Document Format found in a rich text document.
+// It wraps ErrInvalidADF, so it can be checked with errors.Is.
+type ADFValidationError struct {
+	Violations []*ADFViolationScheme // The violations, in document order.
+}
+
+// ADFViolationScheme represents a violation of the Atlassian Document Format.
+type ADFViolationScheme struct {
+	Path    string // The position of the invalid node or mark, e.g. $.content[0].content[2].
+	Message string // The description of the violation.
+}
+
+// Error returns the first violation, and the number of the other ones.
+func (e *ADFValidationError) Error() string {
+
+	if len(e.Violations) == 0 {
+		return ErrInvalidADF.Error()
+	}
+
+	message := fmt.Sprintf("%v: %v: %v", ErrInvalidADF, e.Violations[0].Path, e.Violations[0].Message)
+	if len(e.Violations) > 1 {
+		message += fmt.Sprintf(" (and %v more)", len(e.Violations)-1)
+	}
+
+	return message
+}
+
+// Unwrap returns ErrInvalidADF.
+func (e *ADFValidationError) Unwrap() error {
+	return ErrInvalidADF
+}
+
+// Validate checks the document against the Atlassian Document Format before it's sent to Jira,
+// and returns an *ADFValidationError locating every violation, or nil if the document is valid.
+// A nil document is valid, as the field is omitted from the payload.
+//
+// The validation is opt-in, the services send the documents as they are: the rules are a subset of the schema,
+// so a document using a node type or a nesting they don't know is reported even if Jira accepts it.
+//
+// e.g. checking a description before creating the issue:
+//
+//	if err := payload.Fields.Description.Validate(); err != nil {
+//		return err
+//	}
+//
+//	issue, _, err := client.Issue.Create(ctx, payload, nil)
+func (n *CommentNodeScheme) Validate() error {
+
+	if n == nil {
+		return nil
+	}
+
+	validation := new(ADFValidationError)
+
+	if n.Type != "doc" {
+		validation.add("$", "the root node must be a doc, got %q", n.Type)
+	} else if n.Version != 1 {
+		validation.add("$", "the doc version must be 1, got %v", n.Version)
+	}
+
+	validation.node(n, "$")
+
+	if len(validation.Violations) != 0 {
+		return validation
+	}
+
+	return nil
+}
+
+// add adds a violation found at the path.
+func (e *ADFValidationError) add(path, format string, args ...interface{}) {
+	e.Violations = append(e.Violations, &ADFViolationScheme{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+// node validates the node and its content.
+func (e *ADFValidationError) node(node *CommentNodeScheme, path string) {
+
+	children, known := adfChildren[node.Type]
+	if !known {
+		e.add(path, "unknown node type %q", node.Type)
+		return
+	}
+
+	switch {
+	case node.Type == "text" && node.Text == "":
+		e.add(path, "the text node must have a non-empty text")
+	case node.Type != "text" && node.Text != "":
+		e.add(path, "the %v node cannot have a text", node.Type)
+	}
+
+	if children == nil && len(node.Content) != 0 {
+		e.add(path, "the %v node cannot have content", node.Type)
+	}
+
+	if adfNonEmptyNodes[node.Type] && len(node.Content) == 0 {
+		e.add(path, "the %v node must have content", node.Type)
+	}
+
+	for _, attr := range adfRequiredAttrs[node.Type] {
+		if value, ok := node.Attrs[attr]; !ok || value == nil || value == "" {
+			e.add(path, "the %v node requires the %v attribute", node.Type, attr)
+		}
+	}
+
+	switch node.Type {
+	case "heading":
+
+		if level, ok := node.Attrs["level"]; ok && !adfLevel(level) {
+			e.add(path, "the heading level must be between 1 and 6, got %v", level)
+		}
+
+	case "panel":
+
+		if panelType, ok := node.Attrs["panelType"].(string); ok && !slices.Contains(adfPanelTypes, panelType) {
+			e.add(path, "unknown panel type %q", panelType)
+		}
+	}
+
+	e.marks(node, path)
+
+	for index, child := range node.Content {
+
+		childPath := fmt.Sprintf("%v.content[%v]", path, index)
+
+		if child == nil {
+			e.add(childPath, "the node cannot be null")
+			continue
+		}
+
+		if _, known := adfChildren[child.Type]; known && !slices.Contains(children, child.Type) {
+			e.add(childPath, "the %v node is not allowed in a %v node", child.Type, node.Type)
+			continue
+		}
+
+		if node.Type == "codeBlock" && len(child.Marks) != 0 {
+			e.add(childPath, "the text of a codeBlock node cannot have marks")
+		}
+
+		e.node(child, childPath)
+	}
+}
+
+// marks validates the marks of the node.
+func (e *ADFValidationError) marks(node *CommentNodeScheme, path string) {
+
+	var code, formatted bool
+	for index, mark := range node.Marks {
+
+		markPath := fmt.Sprintf("%v.marks[%v]", path, index)
+
+		if mark == nil {
+			e.add(markPath, "the mark cannot be null")
+			continue
+		}
+
+		attrs, known := adfMarkAttrs[mark.Type]
+		if !known {
+			e.add(markPath, "unknown mark type %q", mark.Type)
+			continue
+		}
+
+		for _, attr := range attrs {
+			if value, ok := mark.Attrs[attr]; !ok || value == nil || value == "" {
+				e.add(markPath, "the %v mark requires the %v attribute", mark.Type, attr)
+			}
+		}
+
+		switch mark.Type {
+		case "code":
+			code = true
+		case "link", "annotation":
+		default:
+			formatted = true
+		}
+	}
+
+	if code && formatted {
+		e.add(path, "the code mark can only be combined with the link and annotation marks")
+	}
+}
+
+// adfLevel reports whether the heading level, decoded from JSON or set in Go, is between 1 and 6.
+func adfLevel(level interface{}) bool {
+
+	switch level := level.(type) {
+	case int:
+		return level >= 1 && level <= 6
+	case float64:
+		return level >= 1 && level <= 6 && level == float64(int(level))
+	}
+
+	return false
+}
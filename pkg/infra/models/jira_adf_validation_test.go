@@ -0,0 +1,108 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommentNodeScheme_Validate(t *testing.T) {
+
+	testCases := []struct {
+		name           string
+		document       string
+		wantViolations []*ADFViolationScheme
+	}{
+		{
+			name: "when the document is valid",
+			document: `{"version":1,"type":"doc","content":[
+				{"type":"heading","attrs":{"level":2},"content":[{"type":"text","text":"Title"}]},
+				{"type":"paragraph","content":[
+					{"type":"text","text":"Hello ","marks":[{"type":"strong"}]},
+					{"type":"mention","attrs":{"id":"5b10ac8d82e05b22cc7d4ef5","text":"@Carlos"}},
+					{"type":"text","text":"docs","marks":[{"type":"code"},{"type":"link","attrs":{"href":"https://example.com"}}]}
+				]},
+				{"type":"bulletList","content":[{"type":"listItem","content":[{"type":"paragraph"}]}]},
+				{"type":"codeBlock","attrs":{"language":"go"},"content":[{"type":"text","text":"fmt.Println()"}]}
+			]}`,
+		},
+
+		{
+			name:     "when the root node is not a document",
+			document: `{"type":"paragraph","content":[{"type":"text","text":"Hello"}]}`,
+			wantViolations: []*ADFViolationScheme{
+				{Path: "$", Message: `the root node must be a doc, got "paragraph"`},
+			},
+		},
+
+		{
+			name: "when the nodes are invalid",
+			document: `{"version":1,"type":"doc","content":[
+				{"type":"text","text":"Hello"},
+				{"type":"paragraph","content":[{"type":"paragraph"},{"type":"text","text":""},{"type":"mention","attrs":{}}]},
+				{"type":"heading","attrs":{"level":7}},
+				{"type":"bulletList"},
+				{"type":"unknown"}
+			]}`,
+			wantViolations: []*ADFViolationScheme{
+				{Path: "$.content[0]", Message: "the text node is not allowed in a doc node"},
+				{Path: "$.content[1].content[0]", Message: "the paragraph node is not allowed in a paragraph node"},
+				{Path: "$.content[1].content[1]", Message: "the text node must have a non-empty text"},
+				{Path: "$.content[1].content[2]", Message: "the mention node requires the id attribute"},
+				{Path: "$.content[2]", Message: "the heading level must be between 1 and 6, got 7"},
+				{Path: "$.content[3]", Message: "the bulletList node must have content"},
+				{Path: "$.content[4]", Message: `unknown node type "unknown"`},
+			},
+		},
+
+		{
+			name: "when the marks are invalid",
+			document: `{"version":1,"type":"doc","content":[
+				{"type":"paragraph","content":[
+					{"type":"text","text":"link","marks":[{"type":"link"},{"type":"bold"}]},
+					{"type":"text","text":"code","marks":[{"type":"code"},{"type":"strong"}]}
+				]},
+				{"type":"codeBlock","content":[{"type":"text","text":"x","marks":[{"type":"em"}]}]}
+			]}`,
+			wantViolations: []*ADFViolationScheme{
+				{Path: "$.content[0].content[0].marks[0]", Message: "the link mark requires the href attribute"},
+				{Path: "$.content[0].content[0].marks[1]", Message: `unknown mark type "bold"`},
+				{Path: "$.content[0].content[1]", Message: "the code mark can only be combined with the link and annotation marks"},
+				{Path: "$.content[1].content[0]", Message: "the text of a codeBlock node cannot have marks"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			document := new(CommentNodeScheme)
+			assert.NoError(t, json.Unmarshal([]byte(testCase.document), document))
+
+			err := document.Validate()
+			if len(testCase.wantViolations) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.True(t, errors.Is(err, ErrInvalidADF))
+
+			var validationErr *ADFValidationError
+			if assert.True(t, errors.As(err, &validationErr)) {
+				assert.Equal(t, testCase.wantViolations, validationErr.Violations)
+			}
+		})
+	}
+}
+
+func TestADFValidationError_Error(t *testing.T) {
+
+	err := &ADFValidationError{Violations: []*ADFViolationScheme{
+		{Path: "$.content[0]", Message: "the text node is not allowed in a doc node"},
+		{Path: "$.content[1]", Message: `unknown node type "unknown"`},
+	}}
+
+	assert.Equal(t, `invalid atlassian document format: $.content[0]: the text node is not allowed in a doc node (and 1 more)`, err.Error())
+}
@@ -8,6 +8,15 @@ type IssueChangelogScheme struct {
 	Histories  []*IssueChangelogHistoryScheme `json:"histories,omitempty"`  // The history of changes in the changelog.
 }
 
+// IssueChangelogPageScheme represents a page of the changelog of an issue in Jira.
+type IssueChangelogPageScheme struct {
+	StartAt    int                            `json:"startAt,omitempty"`    // The starting index of the page.
+	MaxResults int                            `json:"maxResults,omitempty"` // The maximum number of histories in the page.
+	Total      int                            `json:"total,omitempty"`      // The total number of histories in the changelog.
+	IsLast     bool                           `json:"isLast,omitempty"`     // Indicates if the page is the last one.
+	Values     []*IssueChangelogHistoryScheme `json:"values,omitempty"`     // The histories of the page.
+}
+
 // IssueChangelogHistoryScheme represents a history of changes in an issue's changelog in Jira.
 type IssueChangelogHistoryScheme struct {
 	ID      string                             `json:"id,omitempty"`      // The ID of the history.
@@ -0,0 +1,140 @@
+package models
+
+import (
+	"encoding/json"
+	"slices"
+)
+
+// CommentFilterScheme represents the criteria to filter the comments of an issue, e.g. to tell apart the comments of
+// the agents and the customers of a Jira Service Management request. The nil criteria are ignored.
+//
+// Example usage:
+//
+//	page, _, _ := client.Issue.Comment.Gets(ctx, "DESK-1", "", nil, 0, 50)
+//
+//	customerComments := page.Filter(&models.CommentFilterScheme{
+//		AuthorAccountTypes: []string{models.UserAccountTypeCustomer},
+//	})
+//
+// The comments decoded without the jsdPublic field, e.g. the comments of the issues outside Jira Service Management,
+// are public as in Jira.
+type CommentFilterScheme struct {
+	Public              *bool    // Keeps the public comments when true, the internal comments when false.
+	AuthorCanSeeRequest *bool    // Keeps the comments whose author can, or can't, see the request.
+	AuthorAccountTypes  []string // Keeps the comments whose author has one of the account types, see the UserAccountType constants.
+}
+
+// match reports whether a comment with the Jira Service Management metadata matches the filter.
+func (f *CommentFilterScheme) match(public, authorCanSeeRequest bool, author *UserScheme) bool {
+
+	if f == nil {
+		return true
+	}
+
+	if f.Public != nil && *f.Public != public {
+		return false
+	}
+
+	if f.AuthorCanSeeRequest != nil && *f.AuthorCanSeeRequest != authorCanSeeRequest {
+		return false
+	}
+
+	if len(f.AuthorAccountTypes) != 0 && !slices.Contains(f.AuthorAccountTypes, commentAuthorAccountType(author)) {
+		return false
+	}
+
+	return true
+}
+
+// commentAuthorAccountType returns the account type of the author of a comment, UserAccountTypeUnknown when it's not returned.
+func commentAuthorAccountType(author *UserScheme) string {
+
+	if author == nil || author.AccountType == "" {
+		return UserAccountTypeUnknown
+	}
+
+	return author.AccountType
+}
+
+// commentJSDPublic is the jsdPublic field of a comment, decoded to tell a missing field apart from false.
+type commentJSDPublic struct {
+	JSDPublic *bool `json:"jsdPublic"`
+}
+
+// UnmarshalJSON decodes the comment and records whether the jsdPublic field was returned.
+func (c *IssueCommentScheme) UnmarshalJSON(data []byte) error {
+
+	type alias IssueCommentScheme
+
+	decoded := new(alias)
+	if err := json.Unmarshal(data, decoded); err != nil {
+		return err
+	}
+
+	public := new(commentJSDPublic)
+	if err := json.Unmarshal(data, public); err != nil {
+		return err
+	}
+
+	*c = IssueCommentScheme(*decoded)
+	c.jsdPublicMissing = public.JSDPublic == nil
+	return nil
+}
+
+// UnmarshalJSON decodes the comment and records whether the jsdPublic field was returned.
+func (c *IssueCommentSchemeV2) UnmarshalJSON(data []byte) error {
+
+	type alias IssueCommentSchemeV2
+
+	decoded := new(alias)
+	if err := json.Unmarshal(data, decoded); err != nil {
+		return err
+	}
+
+	public := new(commentJSDPublic)
+	if err := json.Unmarshal(data, public); err != nil {
+		return err
+	}
+
+	*c = IssueCommentSchemeV2(*decoded)
+	c.jsdPublicMissing = public.JSDPublic == nil
+	return nil
+}
+
+// AuthorAccountType returns the account type of the author of the comment, see the UserAccountType constants.
+// It returns UserAccountTypeUnknown when the author or its account type isn't returned.
+func (c *IssueCommentScheme) AuthorAccountType() string {
+	return commentAuthorAccountType(c.Author)
+}
+
+// AuthorAccountType returns the account type of the author of the comment, see the UserAccountType constants.
+// It returns UserAccountTypeUnknown when the author or its account type isn't returned.
+func (c *IssueCommentSchemeV2) AuthorAccountType() string {
+	return commentAuthorAccountType(c.Author)
+}
+
+// Filter returns the comments of the page matching the filter.
+func (p *IssueCommentPageScheme) Filter(filter *CommentFilterScheme) []*IssueCommentScheme {
+
+	var comments []*IssueCommentScheme
+	for _, comment := range p.Comments {
+		if comment != nil && filter.match(comment.JSDPublic || comment.jsdPublicMissing, comment.JSDAuthorCanSeeRequest, comment.Author) {
+			comments = append(comments, comment)
+		}
+	}
+
+	return comments
+}
+
+// Filter returns the comments of the page matching the filter.
+func (p *IssueCommentPageSchemeV2) Filter(filter *CommentFilterScheme) []*IssueCommentSchemeV2 {
+
+	var comments []*IssueCommentSchemeV2
+	for _, comment := range p.Comments {
+		if comment != nil && filter.match(comment.JSDPublic || comment.jsdPublicMissing, comment.JSDAuthorCanSeeRequest, comment.Author) {
+			comments = append(comments, comment)
+		}
+	}
+
+	return comments
+}
@@ -0,0 +1,105 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssueCommentPageScheme_Filter(t *testing.T) {
+
+	var page IssueCommentPageScheme
+	err := json.Unmarshal([]byte(`{
+		"comments": [
+			{"id": "10001", "jsdPublic": true, "jsdAuthorCanSeeRequest": true, "author": {"accountType": "customer"}},
+			{"id": "10002", "jsdPublic": true, "jsdAuthorCanSeeRequest": true, "author": {"accountType": "atlassian"}},
+			{"id": "10003", "jsdPublic": false, "author": {"accountType": "atlassian"}},
+			{"id": "10004", "jsdPublic": true, "jsdAuthorCanSeeRequest": true},
+			{"id": "10005", "author": {"accountType": "atlassian"}}
+		]
+	}`), &page)
+	assert.NoError(t, err)
+
+	public, internal := true, false
+
+	testCases := []struct {
+		name   string
+		filter *CommentFilterScheme
+		want   []string
+	}{
+		{
+			name: "when the filter is not provided",
+			want: []string{"10001", "10002", "10003", "10004", "10005"},
+		},
+
+		{
+			name:   "when the customer comments are requested",
+			filter: &CommentFilterScheme{AuthorAccountTypes: []string{UserAccountTypeCustomer}},
+			want:   []string{"10001"},
+		},
+
+		{
+			name:   "when the public agent comments are requested",
+			filter: &CommentFilterScheme{Public: &public, AuthorAccountTypes: []string{UserAccountTypeAtlassian}},
+			want:   []string{"10002", "10005"},
+		},
+
+		{
+			name:   "when the internal comments are requested",
+			filter: &CommentFilterScheme{Public: &internal},
+			want:   []string{"10003"},
+		},
+
+		{
+			name:   "when the comments of authors that can't see the request are requested",
+			filter: &CommentFilterScheme{AuthorCanSeeRequest: &internal},
+			want:   []string{"10003", "10005"},
+		},
+
+		{
+			name:   "when the comments without author account type are requested",
+			filter: &CommentFilterScheme{AuthorAccountTypes: []string{UserAccountTypeUnknown}},
+			want:   []string{"10004"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			var got []string
+			for _, comment := range page.Filter(testCase.filter) {
+				got = append(got, comment.ID)
+			}
+
+			assert.Equal(t, testCase.want, got)
+		})
+	}
+}
+
+func TestIssueCommentPageSchemeV2_Filter(t *testing.T) {
+
+	page := &IssueCommentPageSchemeV2{
+		Comments: []*IssueCommentSchemeV2{
+			{ID: "10001", JSDPublic: true, JSDAuthorCanSeeRequest: true, Author: &UserScheme{AccountType: UserAccountTypeCustomer}},
+			{ID: "10002", JSDPublic: true, JSDAuthorCanSeeRequest: true, Author: &UserScheme{AccountType: UserAccountTypeAtlassian}},
+		},
+	}
+
+	got := page.Filter(&CommentFilterScheme{AuthorAccountTypes: []string{UserAccountTypeAtlassian}})
+
+	assert.Len(t, got, 1)
+	assert.Equal(t, "10002", got[0].ID)
+
+	var decoded IssueCommentPageSchemeV2
+	assert.NoError(t, json.Unmarshal([]byte(`{"comments":[{"id":"10003","body":"shipped"},{"id":"10004","jsdPublic":false}]}`), &decoded))
+
+	public := true
+	got = decoded.Filter(&CommentFilterScheme{Public: &public})
+
+	assert.Len(t, got, 1)
+	assert.Equal(t, "10003", got[0].ID)
+	assert.Equal(t, "shipped", got[0].Body)
+	assert.Equal(t, UserAccountTypeCustomer, page.Comments[0].AuthorAccountType())
+	assert.Equal(t, UserAccountTypeUnknown, (&IssueCommentSchemeV2{}).AuthorAccountType())
+}
@@ -0,0 +1,27 @@
+package models
+
+import "time"
+
+// IssueCommentSyncPageSize is the number of comments fetched per page by the comment sync by default.
+const IssueCommentSyncPageSize = 100
+
+// IssueCommentSyncOptionsScheme represents the options of an incremental sync of the comments of an issue.
+type IssueCommentSyncOptionsScheme struct {
+	Since      time.Time // The checkpoint of the previous sync, the comments updated at or after it are returned. Every comment when zero.
+	Expand     []string  // The expansions of the comments, e.g. renderedBody or properties.
+	MaxResults int       // The number of comments fetched per page, IssueCommentSyncPageSize when zero.
+}
+
+// IssueCommentSyncScheme represents the comments of an issue changed since the checkpoint of an incremental sync.
+type IssueCommentSyncScheme struct {
+	Created    []*IssueCommentScheme // The comments created at or after the checkpoint, in the order of creation.
+	Updated    []*IssueCommentScheme // The comments created before the checkpoint and updated at or after it, in the order of creation.
+	Checkpoint time.Time             // The checkpoint to use as Since on the next sync.
+}
+
+// IssueCommentSyncSchemeV2 represents the comments of an issue changed since the checkpoint of an incremental sync.
+type IssueCommentSyncSchemeV2 struct {
+	Created    []*IssueCommentSchemeV2 // The comments created at or after the checkpoint, in the order of creation.
+	Updated    []*IssueCommentSchemeV2 // The comments created before the checkpoint and updated at or after it, in the order of creation.
+	Checkpoint time.Time               // The checkpoint to use as Since on the next sync.
+}
@@ -10,16 +10,19 @@ type IssueCommentPageSchemeV2 struct {
 
 // IssueCommentSchemeV2 represents an issue comment in Jira.
 type IssueCommentSchemeV2 struct {
-	Self         string                   `json:"self,omitempty"`         // The URL of the comment.
-	ID           string                   `json:"id,omitempty"`           // The ID of the comment.
-	Body         string                   `json:"body,omitempty"`         // The body of the comment.
-	RenderedBody string                   `json:"renderedBody,omitempty"` // The rendered body of the comment.
-	Author       *UserScheme              `json:"author,omitempty"`       // The author of the comment.
-	JSDPublic    bool                     `json:"jsdPublic,omitempty"`    // Indicates if the comment is public in Jira Service Desk.
-	UpdateAuthor *UserScheme              `json:"updateAuthor,omitempty"` // The user who last updated the comment.
-	Created      string                   `json:"created,omitempty"`      // The creation time of the comment. TODO: Should use *DateTimeScheme for proper RFC3339 formatting. Cannot change without breaking API compatibility.
-	Updated      string                   `json:"updated,omitempty"`      // The last update time of the comment. TODO: Should use *DateTimeScheme for proper RFC3339 formatting. Cannot change without breaking API compatibility.
-	Visibility   *CommentVisibilityScheme `json:"visibility,omitempty"`   // The visibility of the comment.
+	Self                   string                   `json:"self,omitempty"`                   // The URL of the comment.
+	ID                     string                   `json:"id,omitempty"`                     // The ID of the comment.
+	Body                   string                   `json:"body,omitempty"`                   // The body of the comment.
+	RenderedBody           string                   `json:"renderedBody,omitempty"`           // The rendered body of the comment.
+	Author                 *UserScheme              `json:"author,omitempty"`                 // The author of the comment.
+	JSDPublic              bool                     `json:"jsdPublic,omitempty"`              // Indicates if the comment is public in Jira Service Desk.
+	JSDAuthorCanSeeRequest bool                     `json:"jsdAuthorCanSeeRequest,omitempty"` // Indicates if the author of the comment can see the Jira Service Management request.
+	UpdateAuthor           *UserScheme              `json:"updateAuthor,omitempty"`           // The user who last updated the comment.
+	Created                string                   `json:"created,omitempty"`                // The creation time of the comment. TODO: Should use *DateTimeScheme for proper RFC3339 formatting. Cannot change without breaking API compatibility.
+	Updated                string                   `json:"updated,omitempty"`                // The last update time of the comment. TODO: Should use *DateTimeScheme for proper RFC3339 formatting. Cannot change without breaking API compatibility.
+	Visibility             *CommentVisibilityScheme `json:"visibility,omitempty"`             // The visibility of the comment.
+
+	jsdPublicMissing bool // Indicates if the response didn't include jsdPublic, see CommentFilterScheme.
 }
 
 // CommentPayloadSchemeV2 represents the payload for an issue comment in Jira.
@@ -1,6 +1,8 @@
 // Package models provides the data structures used in the admin package.
 package models
 
+import "strings"
+
 // CommentNodeScheme represents a node in a comment.
 type CommentNodeScheme struct {
 	Version int                    `json:"version,omitempty"` // The version of the node.
@@ -16,6 +18,37 @@ func (n *CommentNodeScheme) AppendNode(node *CommentNodeScheme) {
 	n.Content = append(n.Content, node)
 }
 
+// NewCommentDocument returns an ADF document with the nodes as content, used as the body of a comment.
+func NewCommentDocument(nodes ...*CommentNodeScheme) *CommentNodeScheme {
+	return &CommentNodeScheme{Version: 1, Type: "doc", Content: nodes}
+}
+
+// NewCommentParagraph returns an ADF paragraph with the nodes as content.
+func NewCommentParagraph(nodes ...*CommentNodeScheme) *CommentNodeScheme {
+	return &CommentNodeScheme{Type: "paragraph", Content: nodes}
+}
+
+// NewCommentText returns an ADF text node.
+func NewCommentText(text string) *CommentNodeScheme {
+	return &CommentNodeScheme{Type: "text", Text: text}
+}
+
+// NewCommentMention returns an ADF mention of the user with the account ID.
+//
+// The text is displayed when the user can't be rendered, e.g. the display name, the @ prefix is added when missing.
+func NewCommentMention(accountID, text string) *CommentNodeScheme {
+
+	attrs := map[string]interface{}{"id": accountID, "accessLevel": ""}
+	if text != "" {
+		if !strings.HasPrefix(text, "@") {
+			text = "@" + text
+		}
+		attrs["text"] = text
+	}
+
+	return &CommentNodeScheme{Type: "mention", Attrs: attrs}
+}
+
 // MarkScheme represents a mark in a comment.
 type MarkScheme struct {
 	Type  string                 `json:"type,omitempty"`  // The type of the mark.
@@ -38,16 +71,19 @@ type IssueCommentPageScheme struct {
 
 // IssueCommentScheme represents a comment on an issue.
 type IssueCommentScheme struct {
-	Self         string                   `json:"self,omitempty"`         // The self link of the comment.
-	ID           string                   `json:"id,omitempty"`           // The ID of the comment.
-	Author       *UserScheme              `json:"author,omitempty"`       // The author of the comment.
-	RenderedBody string                   `json:"renderedBody,omitempty"` // The rendered body of the comment.
-	Body         *CommentNodeScheme       `json:"body,omitempty"`         // The body of the comment.
-	JSDPublic    bool                     `json:"jsdPublic,omitempty"`    // Whether the comment is public.
-	UpdateAuthor *UserScheme              `json:"updateAuthor,omitempty"` // The author of the last update.
-	Created      string                   `json:"created,omitempty"`      // The creation time of the comment. TODO: Should use *DateTimeScheme for proper RFC3339 formatting. Cannot change without breaking API compatibility.
-	Updated      string                   `json:"updated,omitempty"`      // The last update time of the comment. TODO: Should use *DateTimeScheme for proper RFC3339 formatting. Cannot change without breaking API compatibility.
-	Visibility   *CommentVisibilityScheme `json:"visibility,omitempty"`   // The visibility of the comment.
+	Self                   string                   `json:"self,omitempty"`                   // The self link of the comment.
+	ID                     string                   `json:"id,omitempty"`                     // The ID of the comment.
+	Author                 *UserScheme              `json:"author,omitempty"`                 // The author of the comment.
+	RenderedBody           string                   `json:"renderedBody,omitempty"`           // The rendered body of the comment.
+	Body                   *CommentNodeScheme       `json:"body,omitempty"`                   // The body of the comment.
+	JSDPublic              bool                     `json:"jsdPublic,omitempty"`              // Whether the comment is public.
+	JSDAuthorCanSeeRequest bool                     `json:"jsdAuthorCanSeeRequest,omitempty"` // Indicates if the author of the comment can see the Jira Service Management request.
+	UpdateAuthor           *UserScheme              `json:"updateAuthor,omitempty"`           // The author of the last update.
+	Created                string                   `json:"created,omitempty"`                // The creation time of the comment. TODO: Should use *DateTimeScheme for proper RFC3339 formatting. Cannot change without breaking API compatibility.
+	Updated                string                   `json:"updated,omitempty"`                // The last update time of the comment. TODO: Should use *DateTimeScheme for proper RFC3339 formatting. Cannot change without breaking API compatibility.
+	Visibility             *CommentVisibilityScheme `json:"visibility,omitempty"`             // The visibility of the comment.
+
+	jsdPublicMissing bool // Indicates if the response didn't include jsdPublic, see CommentFilterScheme.
 }
 
 // CommentVisibilityScheme represents the visibility of a comment.
@@ -1,6 +1,11 @@
 package models
 
-import "testing"
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
 
 func TestCommentNodeScheme_AppendNode(t *testing.T) {
 	type fields struct {
@@ -43,3 +48,24 @@ func TestCommentNodeScheme_AppendNode(t *testing.T) {
 		})
 	}
 }
+
+func TestNewCommentMention(t *testing.T) {
+
+	document := NewCommentDocument(NewCommentParagraph(NewCommentText("Hi "), NewCommentMention("account-id-a", "Jane Doe")))
+
+	payload, err := json.Marshal(document)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"version": 1,
+		"type": "doc",
+		"content": [{
+			"type": "paragraph",
+			"content": [
+				{"type": "text", "text": "Hi "},
+				{"type": "mention", "attrs": {"id": "account-id-a", "text": "@Jane Doe", "accessLevel": ""}}
+			]
+		}]
+	}`, string(payload))
+
+	assert.Equal(t, map[string]interface{}{"id": "account-id-b", "accessLevel": ""}, NewCommentMention("account-id-b", "").Attrs)
+}
@@ -17,6 +17,7 @@ type ComponentScheme struct {
 	Name                string      `json:"name,omitempty"`                // The name of the component.
 	Description         string      `json:"description,omitempty"`         // The description of the component.
 	Lead                *UserScheme `json:"lead,omitempty"`                // The lead of the component.
+	LeadAccountID       string      `json:"leadAccountId,omitempty"`       // The account ID of the lead.
 	LeadUserName        string      `json:"leadUserName,omitempty"`        // The username of the lead.
 	AssigneeType        string      `json:"assigneeType,omitempty"`        // The type of the assignee.
 	Assignee            *UserScheme `json:"assignee,omitempty"`            // The assignee of the component.
@@ -32,3 +33,21 @@ type ComponentCountScheme struct {
 	Self       string `json:"self,omitempty"`       // The URL of the component count.
 	IssueCount int    `json:"issueCount,omitempty"` // The count of issues in the component.
 }
+
+// ComponentSearchOptionsScheme represents the options to search the components of the projects in Jira.
+type ComponentSearchOptionsScheme struct {
+	ProjectIDsOrKeys []string // The projects of the components, every project when empty.
+	Query            string   // The text contained in the name or the description of the components.
+	OrderBy          string   // The order of the components, e.g. name, -name, description or -description.
+}
+
+// ComponentPageScheme represents a page of components in Jira.
+type ComponentPageScheme struct {
+	Self       string             `json:"self,omitempty"`       // The URL of the page.
+	NextPage   string             `json:"nextPage,omitempty"`   // The URL of the next page.
+	MaxResults int                `json:"maxResults,omitempty"` // The maximum number of components per page.
+	StartAt    int                `json:"startAt,omitempty"`    // The index of the first component of the page.
+	Total      int                `json:"total,omitempty"`      // The total number of components.
+	IsLast     bool               `json:"isLast,omitempty"`     // Indicates if this is the last page.
+	Values     []*ComponentScheme `json:"values,omitempty"`     // The components of the page.
+}
@@ -55,6 +55,21 @@ type DashboardSearchOptionsScheme struct {
 	DashboardName       string   // The name of the dashboard.
 	OwnerAccountID      string   // The account ID of the owner of the dashboard.
 	GroupPermissionName string   // The name of the group permission of the dashboard.
+	GroupID             string   // The ID of the group permission of the dashboard, takes precedence over the group name.
+	ProjectID           int      // The ID of the project the dashboard is shared with.
+	Status              string   // The status of the dashboard: active, archived or deleted.
 	OrderBy             string   // The order by criteria of the dashboard.
 	Expand              []string // The fields to be expanded in the dashboard.
 }
+
+// DashboardBulkEditScheme represents the result of a bulk edit of dashboards in Jira.
+type DashboardBulkEditScheme struct {
+	Action       string                                   `json:"action,omitempty"`       // The action performed on the dashboards.
+	EntityErrors map[string]*DashboardBulkEditErrorScheme `json:"entityErrors,omitempty"` // The errors of the dashboards that couldn't be edited, keyed by dashboard ID.
+}
+
+// DashboardBulkEditErrorScheme represents the errors of a dashboard that couldn't be edited in bulk in Jira.
+type DashboardBulkEditErrorScheme struct {
+	ErrorMessages []string          `json:"errorMessages,omitempty"` // The error messages.
+	Errors        map[string]string `json:"errors,omitempty"`        // The errors, keyed by field.
+}
@@ -0,0 +1,126 @@
+package models
+
+import "encoding/json"
+
+// Jira expression analysis check constants
+const (
+	ExpressionCheckSyntax     = "syntax"     // Checks the syntax of the expressions.
+	ExpressionCheckType       = "type"       // Checks the syntax and the types of the expressions.
+	ExpressionCheckComplexity = "complexity" // Checks the syntax, the types and the complexity of the expressions.
+)
+
+// ExpressionContextScheme represents the context in which a Jira expression is evaluated.
+//
+// The context variables are available in the expression, e.g. issue, project or sprint.
+type ExpressionContextScheme struct {
+	Issue           *ExpressionEntityContextScheme           `json:"issue,omitempty"`           // The issue available as issue.
+	Issues          *ExpressionIssuesContextScheme           `json:"issues,omitempty"`          // The issues available as issues, found with JQL.
+	Project         *ExpressionEntityContextScheme           `json:"project,omitempty"`         // The project available as project.
+	Sprint          int                                      `json:"sprint,omitempty"`          // The ID of the sprint available as sprint.
+	Board           int                                      `json:"board,omitempty"`           // The ID of the board available as board.
+	ServiceDesk     int                                      `json:"serviceDesk,omitempty"`     // The ID of the service desk available as serviceDesk.
+	CustomerRequest int                                      `json:"customerRequest,omitempty"` // The ID of the customer request available as customerRequest.
+	Custom          []*ExpressionCustomContextVariableScheme `json:"custom,omitempty"`          // The custom context variables.
+}
+
+// ExpressionEntityContextScheme represents an issue or a project of the context of a Jira expression, by ID or key.
+type ExpressionEntityContextScheme struct {
+	ID  int    `json:"id,omitempty"`  // The ID of the issue or project.
+	Key string `json:"key,omitempty"` // The key of the issue or project.
+}
+
+// ExpressionIssuesContextScheme represents the issues of the context of a Jira expression.
+type ExpressionIssuesContextScheme struct {
+	JQL *ExpressionJQLContextScheme `json:"jql,omitempty"` // The JQL query finding the issues.
+}
+
+// ExpressionJQLContextScheme represents the JQL query finding the issues of the context of a Jira expression.
+type ExpressionJQLContextScheme struct {
+	Query      string `json:"query,omitempty"`      // The JQL query.
+	StartAt    int    `json:"startAt,omitempty"`    // The index of the first issue to return.
+	MaxResults int    `json:"maxResults,omitempty"` // The maximum number of issues to return.
+	Validation string `json:"validation,omitempty"` // The validation of the query: strict, warn or none.
+}
+
+// ExpressionCustomContextVariableScheme represents a custom variable of the context of a Jira expression.
+type ExpressionCustomContextVariableScheme struct {
+	Type      string      `json:"type,omitempty"`      // The type of the variable: issue, json or user.
+	Key       string      `json:"key,omitempty"`       // The name of the variable, or the key of the issue for the issue variables.
+	ID        int         `json:"id,omitempty"`        // The ID of the issue, for the issue variables.
+	AccountID string      `json:"accountId,omitempty"` // The account ID of the user, for the user variables.
+	Value     interface{} `json:"value,omitempty"`     // The value of the variable, for the json variables.
+}
+
+// ExpressionEvaluationScheme represents the result of the evaluation of a Jira expression.
+type ExpressionEvaluationScheme struct {
+	Value json.RawMessage                 `json:"value,omitempty"` // The value of the expression, see Decode.
+	Meta  *ExpressionEvaluationMetaScheme `json:"meta,omitempty"`  // The metadata of the evaluation.
+}
+
+// Decode decodes the value of the expression into target, e.g. a bool for a permission check.
+func (e *ExpressionEvaluationScheme) Decode(target interface{}) error {
+	return json.Unmarshal(e.Value, target)
+}
+
+// ExpressionEvaluationMetaScheme represents the metadata of the evaluation of a Jira expression.
+type ExpressionEvaluationMetaScheme struct {
+	Complexity *ExpressionComplexityScheme `json:"complexity,omitempty"` // The complexity of the evaluation.
+	Issues     *ExpressionIssuesMetaScheme `json:"issues,omitempty"`     // The issues of the context found with JQL.
+}
+
+// ExpressionComplexityScheme represents the complexity of the evaluation of a Jira expression, against its limits.
+type ExpressionComplexityScheme struct {
+	Steps               *ExpressionComplexityValueScheme `json:"steps,omitempty"`               // The number of steps of the evaluation.
+	ExpensiveOperations *ExpressionComplexityValueScheme `json:"expensiveOperations,omitempty"` // The number of expensive operations.
+	Beans               *ExpressionComplexityValueScheme `json:"beans,omitempty"`               // The number of Jira REST API beans returned.
+	PrimitiveValues     *ExpressionComplexityValueScheme `json:"primitiveValues,omitempty"`     // The number of primitive values returned.
+}
+
+// ExpressionComplexityValueScheme represents a complexity metric of a Jira expression and its limit.
+type ExpressionComplexityValueScheme struct {
+	Value int `json:"value,omitempty"` // The value of the metric.
+	Limit int `json:"limit,omitempty"` // The maximum value of the metric.
+}
+
+// ExpressionIssuesMetaScheme represents the issues of the context of an evaluated Jira expression.
+type ExpressionIssuesMetaScheme struct {
+	JQL *ExpressionJQLMetaScheme `json:"jql,omitempty"` // The page of issues found with the JQL query.
+}
+
+// ExpressionJQLMetaScheme represents the page of issues found with the JQL query of the context of a Jira expression.
+type ExpressionJQLMetaScheme struct {
+	StartAt            int      `json:"startAt,omitempty"`            // The index of the first issue.
+	MaxResults         int      `json:"maxResults,omitempty"`         // The maximum number of issues.
+	Count              int      `json:"count,omitempty"`              // The number of issues returned.
+	TotalCount         int      `json:"totalCount,omitempty"`         // The total number of issues found.
+	ValidationWarnings []string `json:"validationWarnings,omitempty"` // The warnings of the validation of the query.
+}
+
+// ExpressionAnalysisScheme represents the analysis of Jira expressions.
+type ExpressionAnalysisScheme struct {
+	Results []*ExpressionAnalysisResultScheme `json:"results,omitempty"` // The analysis of each expression, in the requested order.
+}
+
+// ExpressionAnalysisResultScheme represents the analysis of a Jira expression.
+type ExpressionAnalysisResultScheme struct {
+	Expression string                              `json:"expression,omitempty"` // The analysed expression.
+	Valid      bool                                `json:"valid,omitempty"`      // Indicates if the expression is valid.
+	Errors     []*ExpressionValidationErrorScheme  `json:"errors,omitempty"`     // The errors of the expression.
+	Type       string                              `json:"type,omitempty"`       // The type of the value of the expression.
+	Complexity *ExpressionAnalysisComplexityScheme `json:"complexity,omitempty"` // The estimated complexity of the expression.
+}
+
+// ExpressionValidationErrorScheme represents an error of a Jira expression.
+type ExpressionValidationErrorScheme struct {
+	Line       int    `json:"line,omitempty"`       // The line of the error.
+	Column     int    `json:"column,omitempty"`     // The column of the error.
+	Expression string `json:"expression,omitempty"` // The part of the expression in error.
+	Message    string `json:"message,omitempty"`    // The description of the error.
+	Type       string `json:"type,omitempty"`       // The type of the error: syntax, type or other.
+}
+
+// ExpressionAnalysisComplexityScheme represents the estimated complexity of a Jira expression.
+type ExpressionAnalysisComplexityScheme struct {
+	ExpensiveOperations string            `json:"expensiveOperations,omitempty"` // The formula of the number of expensive operations.
+	Variables           map[string]string `json:"variables,omitempty"`           // The variables of the formula.
+}
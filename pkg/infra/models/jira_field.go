@@ -1,5 +1,7 @@
 package models
 
+import "sort"
+
 // IssueFieldScheme represents an issue field in Jira.
 type IssueFieldScheme struct {
 	ID            string                         `json:"id,omitempty"`
@@ -29,6 +31,53 @@ type IssueFieldSchemaScheme struct {
 	CustomID int    `json:"customId,omitempty"`
 }
 
+// IssueFieldNamesScheme represents the field names returned with the names expansion, keyed by field ID.
+type IssueFieldNamesScheme map[string]string
+
+// Name returns the name of the field, or the field ID when the name is unknown.
+func (n IssueFieldNamesScheme) Name(fieldID string) string {
+
+	if name, ok := n[fieldID]; ok {
+		return name
+	}
+
+	return fieldID
+}
+
+// ID returns the ID of the first field with the name, e.g. the custom field ID of "Story Points".
+func (n IssueFieldNamesScheme) ID(name string) (string, bool) {
+
+	ids := make([]string, 0, len(n))
+	for id, fieldName := range n {
+		if fieldName == name {
+			ids = append(ids, id)
+		}
+	}
+
+	if len(ids) == 0 {
+		return "", false
+	}
+
+	sort.Strings(ids)
+	return ids[0], true
+}
+
+// CustomFieldUsageScheme represents the usage of a custom field in Jira.
+type CustomFieldUsageScheme struct {
+	Field      *IssueFieldScheme      // The custom field.
+	Contexts   int                    // The number of contexts of the custom field.
+	Global     bool                   // Indicates if a context of the custom field applies to every project.
+	ProjectIDs []string               // The IDs of the projects the contexts of the custom field are mapped to.
+	Screens    []*ScreenWithTabScheme // The screens the custom field is used in.
+	IssueCount int                    // The approximate number of issues with a value on the custom field.
+	Err        error                  // The error that prevented the usage from being completed, if any.
+}
+
+// Unused reports whether the custom field isn't used in any screen and no issue has a value on it.
+func (u *CustomFieldUsageScheme) Unused() bool {
+	return u.Err == nil && len(u.Screens) == 0 && u.IssueCount == 0
+}
+
 // IssueFieldLastUsedScheme represents the last used information of an issue field in Jira.
 type IssueFieldLastUsedScheme struct {
 	Type  string `json:"type,omitempty"`
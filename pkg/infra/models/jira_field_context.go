@@ -37,12 +37,37 @@ type CustomFieldDefaultValuePageScheme struct {
 }
 
 // CustomFieldDefaultValueScheme represents a default value for a custom field in Jira.
+//
+// The fields set depend on the type of the default value, see the Default constructors, e.g. DefaultSingleOption.
 type CustomFieldDefaultValueScheme struct {
-	ContextID         string   `json:"contextId,omitempty"`         // The ID of the context.
-	OptionID          string   `json:"optionId,omitempty"`          // The ID of the option.
-	CascadingOptionID string   `json:"cascadingOptionId,omitempty"` // The ID of the cascading option.
-	OptionIDs         []string `json:"optionIds,omitempty"`         // The IDs of the options.
-	Type              string   `json:"type,omitempty"`              // The type of the default value.
+	ContextID         string                       `json:"contextId,omitempty"`         // The ID of the context.
+	OptionID          string                       `json:"optionId,omitempty"`          // The ID of the option.
+	CascadingOptionID string                       `json:"cascadingOptionId,omitempty"` // The ID of the cascading option.
+	OptionIDs         []string                     `json:"optionIds,omitempty"`         // The IDs of the options.
+	AccountID         string                       `json:"accountId,omitempty"`         // The account ID of the user.
+	AccountIDs        []string                     `json:"accountIds,omitempty"`        // The account IDs of the users.
+	GroupID           string                       `json:"groupId,omitempty"`           // The ID of the group.
+	GroupIDs          []string                     `json:"groupIds,omitempty"`          // The IDs of the groups.
+	Date              string                       `json:"date,omitempty"`              // The date, in the ISO format, e.g. 2023-06-30.
+	DateTime          string                       `json:"dateTime,omitempty"`          // The date time, in the ISO format, e.g. 2023-06-30T10:00:00.000+0000.
+	UseCurrent        bool                         `json:"useCurrent,omitempty"`        // Indicates if the current date or date time is used.
+	Number            *float64                     `json:"number,omitempty"`            // The number.
+	Text              string                       `json:"text,omitempty"`              // The text.
+	URL               string                       `json:"url,omitempty"`               // The URL.
+	ProjectID         string                       `json:"projectId,omitempty"`         // The ID of the project.
+	VersionID         string                       `json:"versionId,omitempty"`         // The ID of the version.
+	VersionIDs        []string                     `json:"versionIds,omitempty"`        // The IDs of the versions.
+	VersionOrder      string                       `json:"versionOrder,omitempty"`      // The order of the versions, e.g. releasedFirst or unreleasedFirst.
+	Labels            []string                     `json:"labels,omitempty"`            // The labels.
+	UserFilter        *CustomFieldUserFilterScheme `json:"userFilter,omitempty"`        // The filter of the users of a user picker.
+	Type              string                       `json:"type,omitempty"`              // The type of the default value, see the FieldContextDefaultType constants.
+}
+
+// CustomFieldUserFilterScheme represents the filter of the users that can be selected in a user picker.
+type CustomFieldUserFilterScheme struct {
+	Enabled bool     `json:"enabled"`           // Indicates if the filter is enabled.
+	Groups  []string `json:"groups,omitempty"`  // The names of the groups the users must belong to.
+	RoleIDs []int    `json:"roleIds,omitempty"` // The IDs of the project roles the users must have.
 }
 
 // FieldContextDefaultPayloadScheme represents the payload for a default field context in Jira.
@@ -0,0 +1,131 @@
+package models
+
+// The types of the default values of the custom field contexts, the discriminator of CustomFieldDefaultValueScheme.
+const (
+	FieldContextDefaultTypeSingleOption    = "option.single"        // A single select list.
+	FieldContextDefaultTypeMultipleOption  = "option.multiple"      // A multiple select list or checkboxes.
+	FieldContextDefaultTypeCascadingOption = "option.cascading"     // A cascading select list.
+	FieldContextDefaultTypeSingleUser      = "single.user.select"   // A user picker.
+	FieldContextDefaultTypeMultipleUser    = "multi.user.select"    // A multiple users picker.
+	FieldContextDefaultTypeSingleGroup     = "grouppicker.single"   // A group picker.
+	FieldContextDefaultTypeMultipleGroup   = "grouppicker.multiple" // A multiple groups picker.
+	FieldContextDefaultTypeDate            = "datepicker"           // A date picker.
+	FieldContextDefaultTypeDateTime        = "datetimepicker"       // A date time picker.
+	FieldContextDefaultTypeFloat           = "float"                // A number field.
+	FieldContextDefaultTypeTextField       = "textfield"            // A single line text field.
+	FieldContextDefaultTypeTextArea        = "textarea"             // A multi-line text field.
+	FieldContextDefaultTypeURL             = "url"                  // A URL field.
+	FieldContextDefaultTypeProject         = "project"              // A project picker.
+	FieldContextDefaultTypeSingleVersion   = "version.single"       // A version picker.
+	FieldContextDefaultTypeMultipleVersion = "version.multiple"     // A multiple versions picker.
+	FieldContextDefaultTypeLabels          = "labels"               // A labels field.
+)
+
+// DefaultSingleOption returns the default value of a single select list context, the option being selected by default.
+//
+// Example usage:
+//
+//	payload := &models.FieldContextDefaultPayloadScheme{
+//		DefaultValues: []*models.CustomFieldDefaultValueScheme{
+//			models.DefaultSingleOption("10138", "10022"),
+//		},
+//	}
+//
+//	_, err := client.Issue.Field.Context.SetDefaultValue(ctx, "customfield_10038", payload)
+func DefaultSingleOption(contextID, optionID string) *CustomFieldDefaultValueScheme {
+	return &CustomFieldDefaultValueScheme{ContextID: contextID, OptionID: optionID, Type: FieldContextDefaultTypeSingleOption}
+}
+
+// DefaultMultipleOption returns the default value of a multiple select list or checkboxes context.
+func DefaultMultipleOption(contextID string, optionIDs ...string) *CustomFieldDefaultValueScheme {
+	return &CustomFieldDefaultValueScheme{ContextID: contextID, OptionIDs: optionIDs, Type: FieldContextDefaultTypeMultipleOption}
+}
+
+// DefaultCascading returns the default value of a cascading select list context, the child option being optional.
+func DefaultCascading(contextID, parentOptionID, childOptionID string) *CustomFieldDefaultValueScheme {
+	return &CustomFieldDefaultValueScheme{
+		ContextID:         contextID,
+		OptionID:          parentOptionID,
+		CascadingOptionID: childOptionID,
+		Type:              FieldContextDefaultTypeCascadingOption,
+	}
+}
+
+// DefaultUser returns the default value of a user picker context, the users being unfiltered.
+//
+// The user filter is required by Jira for the user pickers; set UserFilter to restrict the users to groups or roles.
+func DefaultUser(contextID, accountID string) *CustomFieldDefaultValueScheme {
+	return &CustomFieldDefaultValueScheme{
+		ContextID:  contextID,
+		AccountID:  accountID,
+		UserFilter: &CustomFieldUserFilterScheme{},
+		Type:       FieldContextDefaultTypeSingleUser,
+	}
+}
+
+// DefaultUsers returns the default value of a multiple users picker context.
+func DefaultUsers(contextID string, accountIDs ...string) *CustomFieldDefaultValueScheme {
+	return &CustomFieldDefaultValueScheme{ContextID: contextID, AccountIDs: accountIDs, Type: FieldContextDefaultTypeMultipleUser}
+}
+
+// DefaultGroup returns the default value of a group picker context.
+func DefaultGroup(contextID, groupID string) *CustomFieldDefaultValueScheme {
+	return &CustomFieldDefaultValueScheme{ContextID: contextID, GroupID: groupID, Type: FieldContextDefaultTypeSingleGroup}
+}
+
+// DefaultGroups returns the default value of a multiple groups picker context.
+func DefaultGroups(contextID string, groupIDs ...string) *CustomFieldDefaultValueScheme {
+	return &CustomFieldDefaultValueScheme{ContextID: contextID, GroupIDs: groupIDs, Type: FieldContextDefaultTypeMultipleGroup}
+}
+
+// DefaultDate returns the default value of a date picker context, the date being in the ISO format, e.g. 2023-06-30.
+// The current date is used by default when the date is empty.
+func DefaultDate(contextID, date string) *CustomFieldDefaultValueScheme {
+	return &CustomFieldDefaultValueScheme{ContextID: contextID, Date: date, UseCurrent: date == "", Type: FieldContextDefaultTypeDate}
+}
+
+// DefaultDateTime returns the default value of a date time picker context, the date time being in the ISO format,
+// e.g. 2023-06-30T10:00:00.000+0000. The current date time is used by default when the date time is empty.
+func DefaultDateTime(contextID, dateTime string) *CustomFieldDefaultValueScheme {
+	return &CustomFieldDefaultValueScheme{ContextID: contextID, DateTime: dateTime, UseCurrent: dateTime == "", Type: FieldContextDefaultTypeDateTime}
+}
+
+// DefaultFloat returns the default value of a number field context.
+func DefaultFloat(contextID string, number float64) *CustomFieldDefaultValueScheme {
+	return &CustomFieldDefaultValueScheme{ContextID: contextID, Number: &number, Type: FieldContextDefaultTypeFloat}
+}
+
+// DefaultText returns the default value of a single line text field context.
+func DefaultText(contextID, text string) *CustomFieldDefaultValueScheme {
+	return &CustomFieldDefaultValueScheme{ContextID: contextID, Text: text, Type: FieldContextDefaultTypeTextField}
+}
+
+// DefaultTextArea returns the default value of a multi-line text field context.
+func DefaultTextArea(contextID, text string) *CustomFieldDefaultValueScheme {
+	return &CustomFieldDefaultValueScheme{ContextID: contextID, Text: text, Type: FieldContextDefaultTypeTextArea}
+}
+
+// DefaultURL returns the default value of a URL field context.
+func DefaultURL(contextID, url string) *CustomFieldDefaultValueScheme {
+	return &CustomFieldDefaultValueScheme{ContextID: contextID, URL: url, Type: FieldContextDefaultTypeURL}
+}
+
+// DefaultProject returns the default value of a project picker context.
+func DefaultProject(contextID, projectID string) *CustomFieldDefaultValueScheme {
+	return &CustomFieldDefaultValueScheme{ContextID: contextID, ProjectID: projectID, Type: FieldContextDefaultTypeProject}
+}
+
+// DefaultVersion returns the default value of a version picker context.
+func DefaultVersion(contextID, versionID string) *CustomFieldDefaultValueScheme {
+	return &CustomFieldDefaultValueScheme{ContextID: contextID, VersionID: versionID, Type: FieldContextDefaultTypeSingleVersion}
+}
+
+// DefaultVersions returns the default value of a multiple versions picker context.
+func DefaultVersions(contextID string, versionIDs ...string) *CustomFieldDefaultValueScheme {
+	return &CustomFieldDefaultValueScheme{ContextID: contextID, VersionIDs: versionIDs, Type: FieldContextDefaultTypeMultipleVersion}
+}
+
+// DefaultLabels returns the default value of a labels field context.
+func DefaultLabels(contextID string, labels ...string) *CustomFieldDefaultValueScheme {
+	return &CustomFieldDefaultValueScheme{ContextID: contextID, Labels: labels, Type: FieldContextDefaultTypeLabels}
+}
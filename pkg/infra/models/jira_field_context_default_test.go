@@ -0,0 +1,62 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCustomFieldDefaultValueScheme_Constructors(t *testing.T) {
+
+	testCases := []struct {
+		name  string
+		value *CustomFieldDefaultValueScheme
+		want  string
+	}{
+		{
+			name:  "when the default value is a single option",
+			value: DefaultSingleOption("10138", "10022"),
+			want:  `{"contextId":"10138","optionId":"10022","type":"option.single"}`,
+		},
+		{
+			name:  "when the default value is a cascading option",
+			value: DefaultCascading("10138", "10022", "10023"),
+			want:  `{"contextId":"10138","optionId":"10022","cascadingOptionId":"10023","type":"option.cascading"}`,
+		},
+		{
+			name:  "when the default value is a user",
+			value: DefaultUser("10138", "5b10ac8d82e05b22cc7d4ef5"),
+			want:  `{"contextId":"10138","accountId":"5b10ac8d82e05b22cc7d4ef5","userFilter":{"enabled":false},"type":"single.user.select"}`,
+		},
+		{
+			name:  "when the default value is a list of groups",
+			value: DefaultGroups("10138", "276f955c-63d7-42c8-9520-92d01dca0625"),
+			want:  `{"contextId":"10138","groupIds":["276f955c-63d7-42c8-9520-92d01dca0625"],"type":"grouppicker.multiple"}`,
+		},
+		{
+			name:  "when the default value is a zero number",
+			value: DefaultFloat("10138", 0),
+			want:  `{"contextId":"10138","number":0,"type":"float"}`,
+		},
+		{
+			name:  "when the default value is the current date",
+			value: DefaultDate("10138", ""),
+			want:  `{"contextId":"10138","useCurrent":true,"type":"datepicker"}`,
+		},
+		{
+			name:  "when the default value is a list of labels",
+			value: DefaultLabels("10138", "backend", "api"),
+			want:  `{"contextId":"10138","labels":["backend","api"],"type":"labels"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			got, err := json.Marshal(testCase.value)
+			assert.NoError(t, err)
+			assert.JSONEq(t, testCase.want, string(got))
+		})
+	}
+}
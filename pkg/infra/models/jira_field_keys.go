@@ -0,0 +1,51 @@
+package models
+
+// Jira system field keys, used in the issue payloads, the fields parameters and the field filters.
+const (
+	IssueFieldSummary              = "summary"
+	IssueFieldDescription          = "description"
+	IssueFieldEnvironment          = "environment"
+	IssueFieldProject              = "project"
+	IssueFieldIssueType            = "issuetype"
+	IssueFieldParent               = "parent"
+	IssueFieldStatus               = "status"
+	IssueFieldStatusCategory       = "statusCategory"
+	IssueFieldResolution           = "resolution"
+	IssueFieldResolutionDate       = "resolutiondate"
+	IssueFieldPriority             = "priority"
+	IssueFieldAssignee             = "assignee"
+	IssueFieldReporter             = "reporter"
+	IssueFieldCreator              = "creator"
+	IssueFieldLabels               = "labels"
+	IssueFieldComponents           = "components"
+	IssueFieldVersions             = "versions"
+	IssueFieldFixVersions          = "fixVersions"
+	IssueFieldDueDate              = "duedate"
+	IssueFieldCreated              = "created"
+	IssueFieldUpdated              = "updated"
+	IssueFieldLastViewed           = "lastViewed"
+	IssueFieldSecurity             = "security"
+	IssueFieldIssueLinks           = "issuelinks"
+	IssueFieldSubtasks             = "subtasks"
+	IssueFieldAttachment           = "attachment"
+	IssueFieldComment              = "comment"
+	IssueFieldWorklog              = "worklog"
+	IssueFieldWatches              = "watches"
+	IssueFieldVotes                = "votes"
+	IssueFieldTimeTracking         = "timetracking"
+	IssueFieldTimeEstimate         = "timeestimate"
+	IssueFieldTimeOriginalEstimate = "timeoriginalestimate"
+	IssueFieldTimeSpent            = "timespent"
+	IssueFieldAggregateProgress    = "aggregateprogress"
+	IssueFieldProgress             = "progress"
+	IssueFieldWorkRatio            = "workratio"
+	IssueFieldThumbnail            = "thumbnail"
+)
+
+// Field selectors accepted by the fields parameters, in addition to the field keys.
+const (
+	IssueFieldsAll        = "*all"        // Returns all the fields.
+	IssueFieldsNavigable  = "*navigable"  // Returns the navigable fields.
+	IssueFieldsExcludeAll = "-*all"       // Excludes all the fields, combined with explicit field keys.
+	IssueFieldsExcludeNav = "-*navigable" // Excludes the navigable fields, combined with explicit field keys.
+)
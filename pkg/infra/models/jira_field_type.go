@@ -0,0 +1,113 @@
+package models
+
+import (
+	"fmt"
+	"slices"
+)
+
+// customFieldTypePrefix is the prefix of the keys of the custom field types and searchers provided by Jira.
+const customFieldTypePrefix = "com.atlassian.jira.plugin.system.customfieldtypes:"
+
+// CustomFieldType constants represent the types of the custom fields provided by Jira, see CustomFieldScheme.FieldType.
+const (
+	CustomFieldTypeCascadingSelect  = customFieldTypePrefix + "cascadingselect"  // Select list (cascading).
+	CustomFieldTypeDatePicker       = customFieldTypePrefix + "datepicker"       // Date picker.
+	CustomFieldTypeDateTime         = customFieldTypePrefix + "datetime"         // Date time picker.
+	CustomFieldTypeFloat            = customFieldTypePrefix + "float"            // Number field.
+	CustomFieldTypeGroupPicker      = customFieldTypePrefix + "grouppicker"      // Group picker (single group).
+	CustomFieldTypeImportID         = customFieldTypePrefix + "importid"         // Import ID.
+	CustomFieldTypeLabels           = customFieldTypePrefix + "labels"           // Labels.
+	CustomFieldTypeMultiCheckboxes  = customFieldTypePrefix + "multicheckboxes"  // Checkboxes.
+	CustomFieldTypeMultiGroupPicker = customFieldTypePrefix + "multigrouppicker" // Group picker (multiple groups).
+	CustomFieldTypeMultiSelect      = customFieldTypePrefix + "multiselect"      // Select list (multiple choices).
+	CustomFieldTypeMultiUserPicker  = customFieldTypePrefix + "multiuserpicker"  // User picker (multiple users).
+	CustomFieldTypeMultiVersion     = customFieldTypePrefix + "multiversion"     // Version picker (multiple versions).
+	CustomFieldTypeProject          = customFieldTypePrefix + "project"          // Project picker (single project).
+	CustomFieldTypeRadioButtons     = customFieldTypePrefix + "radiobuttons"     // Radio buttons.
+	CustomFieldTypeReadOnlyField    = customFieldTypePrefix + "readonlyfield"    // Text field (read only).
+	CustomFieldTypeSelect           = customFieldTypePrefix + "select"           // Select list (single choice).
+	CustomFieldTypeTextArea         = customFieldTypePrefix + "textarea"         // Paragraph (supports rich text).
+	CustomFieldTypeTextField        = customFieldTypePrefix + "textfield"        // Short text (plain text only).
+	CustomFieldTypeURL              = customFieldTypePrefix + "url"              // URL field.
+	CustomFieldTypeUserPicker       = customFieldTypePrefix + "userpicker"       // User picker (single user).
+	CustomFieldTypeVersion          = customFieldTypePrefix + "version"          // Version picker (single version).
+)
+
+// CustomFieldSearcher constants represent the searchers of the custom fields provided by Jira, see CustomFieldScheme.SearcherKey.
+const (
+	CustomFieldSearcherCascadingSelect = customFieldTypePrefix + "cascadingselectsearcher"
+	CustomFieldSearcherDateRange       = customFieldTypePrefix + "daterange"
+	CustomFieldSearcherDateTimeRange   = customFieldTypePrefix + "datetimerange"
+	CustomFieldSearcherExactNumber     = customFieldTypePrefix + "exactnumber"
+	CustomFieldSearcherExactText       = customFieldTypePrefix + "exacttextsearcher"
+	CustomFieldSearcherGroupPicker     = customFieldTypePrefix + "grouppickersearcher"
+	CustomFieldSearcherLabel           = customFieldTypePrefix + "labelsearcher"
+	CustomFieldSearcherMultiSelect     = customFieldTypePrefix + "multiselectsearcher"
+	CustomFieldSearcherNumberRange     = customFieldTypePrefix + "numberrange"
+	CustomFieldSearcherProject         = customFieldTypePrefix + "projectsearcher"
+	CustomFieldSearcherText            = customFieldTypePrefix + "textsearcher"
+	CustomFieldSearcherUserPickerGroup = customFieldTypePrefix + "userpickergroupsearcher"
+	CustomFieldSearcherVersion         = customFieldTypePrefix + "versionsearcher"
+)
+
+// customFieldSearchers maps the custom field types provided by Jira to the searchers they accept, the default one first.
+var customFieldSearchers = map[string][]string{
+	CustomFieldTypeCascadingSelect:  {CustomFieldSearcherCascadingSelect},
+	CustomFieldTypeDatePicker:       {CustomFieldSearcherDateRange},
+	CustomFieldTypeDateTime:         {CustomFieldSearcherDateTimeRange},
+	CustomFieldTypeFloat:            {CustomFieldSearcherExactNumber, CustomFieldSearcherNumberRange},
+	CustomFieldTypeGroupPicker:      {CustomFieldSearcherGroupPicker},
+	CustomFieldTypeImportID:         {CustomFieldSearcherExactNumber, CustomFieldSearcherNumberRange},
+	CustomFieldTypeLabels:           {CustomFieldSearcherLabel},
+	CustomFieldTypeMultiCheckboxes:  {CustomFieldSearcherMultiSelect},
+	CustomFieldTypeMultiGroupPicker: {CustomFieldSearcherMultiSelect},
+	CustomFieldTypeMultiSelect:      {CustomFieldSearcherMultiSelect},
+	CustomFieldTypeMultiUserPicker:  {CustomFieldSearcherUserPickerGroup},
+	CustomFieldTypeMultiVersion:     {CustomFieldSearcherVersion},
+	CustomFieldTypeProject:          {CustomFieldSearcherProject},
+	CustomFieldTypeRadioButtons:     {CustomFieldSearcherMultiSelect},
+	CustomFieldTypeReadOnlyField:    {CustomFieldSearcherText},
+	CustomFieldTypeSelect:           {CustomFieldSearcherMultiSelect},
+	CustomFieldTypeTextArea:         {CustomFieldSearcherText},
+	CustomFieldTypeTextField:        {CustomFieldSearcherText},
+	CustomFieldTypeURL:              {CustomFieldSearcherExactText},
+	CustomFieldTypeUserPicker:       {CustomFieldSearcherUserPickerGroup},
+	CustomFieldTypeVersion:          {CustomFieldSearcherVersion},
+}
+
+// CustomFieldSearcherKeys returns the searchers accepted by a custom field type provided by Jira, the default one first.
+// It returns nil for the unknown types, e.g. the types provided by the apps.
+func CustomFieldSearcherKeys(fieldType string) []string {
+	return slices.Clone(customFieldSearchers[fieldType])
+}
+
+// DefaultCustomFieldSearcherKey returns the default searcher of a custom field type provided by Jira,
+// an empty string for the unknown types.
+func DefaultCustomFieldSearcherKey(fieldType string) string {
+
+	if searchers := customFieldSearchers[fieldType]; len(searchers) != 0 {
+		return searchers[0]
+	}
+
+	return ""
+}
+
+// Validate checks the custom field has a name and a type, and that its searcher, when set, is accepted by its type.
+// The searchers of the unknown types, e.g. the types provided by the apps, aren't checked.
+func (c *CustomFieldScheme) Validate() error {
+
+	if c == nil || c.Name == "" {
+		return ErrNoCustomFieldName
+	}
+
+	if c.FieldType == "" {
+		return ErrNoCustomFieldType
+	}
+
+	searchers, ok := customFieldSearchers[c.FieldType]
+	if !ok || c.SearcherKey == "" || slices.Contains(searchers, c.SearcherKey) {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %v doesn't accept %v, use one of %v", ErrInvalidCustomFieldSearcher, c.FieldType, c.SearcherKey, searchers)
+}
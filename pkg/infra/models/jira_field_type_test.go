@@ -0,0 +1,74 @@
+package models
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCustomFieldScheme_Validate(t *testing.T) {
+
+	testCases := []struct {
+		name    string
+		field   *CustomFieldScheme
+		wantErr error
+	}{
+		{
+			name:  "when the searcher is accepted by the type",
+			field: &CustomFieldScheme{Name: "Alliance", FieldType: CustomFieldTypeCascadingSelect, SearcherKey: CustomFieldSearcherCascadingSelect},
+		},
+		{
+			name:  "when the type accepts several searchers",
+			field: &CustomFieldScheme{Name: "Story points", FieldType: CustomFieldTypeFloat, SearcherKey: CustomFieldSearcherNumberRange},
+		},
+		{
+			name:  "when the searcher is not set",
+			field: &CustomFieldScheme{Name: "Due", FieldType: CustomFieldTypeDatePicker},
+		},
+		{
+			name:  "when the type is provided by an app",
+			field: &CustomFieldScheme{Name: "Asset", FieldType: "com.atlassian.jira.plugins.cmdb:cmdb-object-cftype", SearcherKey: "com.atlassian.jira.plugins.cmdb:cmdb-object-searcher"},
+		},
+		{
+			name:    "when the searcher is not accepted by the type",
+			field:   &CustomFieldScheme{Name: "Due", FieldType: CustomFieldTypeDatePicker, SearcherKey: CustomFieldSearcherText},
+			wantErr: ErrInvalidCustomFieldSearcher,
+		},
+		{
+			name:    "when the type is not set",
+			field:   &CustomFieldScheme{Name: "Due"},
+			wantErr: ErrNoCustomFieldType,
+		},
+		{
+			name:    "when the name is not set",
+			field:   &CustomFieldScheme{FieldType: CustomFieldTypeTextField},
+			wantErr: ErrNoCustomFieldName,
+		},
+		{
+			name:    "when the field is nil",
+			wantErr: ErrNoCustomFieldName,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			err := testCase.field.Validate()
+
+			if testCase.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.True(t, errors.Is(err, testCase.wantErr), "expected error: %v, got: %v", testCase.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestCustomFieldSearcherKeys(t *testing.T) {
+	assert.Equal(t, []string{CustomFieldSearcherExactNumber, CustomFieldSearcherNumberRange}, CustomFieldSearcherKeys(CustomFieldTypeFloat))
+	assert.Nil(t, CustomFieldSearcherKeys("unknown"))
+
+	assert.Equal(t, CustomFieldSearcherMultiSelect, DefaultCustomFieldSearcherKey(CustomFieldTypeSelect))
+	assert.Equal(t, "", DefaultCustomFieldSearcherKey("unknown"))
+}
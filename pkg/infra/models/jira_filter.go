@@ -88,13 +88,16 @@ type FilterPayloadScheme struct {
 
 // FilterSearchOptionScheme represents the search options for a filter in Jira.
 type FilterSearchOptionScheme struct {
-	Name      string
-	AccountID string
-	Group     string
-	OrderBy   string
-	ProjectID int
-	IDs       []int
-	Expand    []string
+	Name                     string
+	AccountID                string
+	Group                    string
+	GroupID                  string // The ID of the group the filter is shared with, takes precedence over the group name.
+	OrderBy                  string
+	ProjectID                int
+	IDs                      []int
+	Expand                   []string
+	OverrideSharePermissions bool // Returns the filters regardless of their share permissions, requires the Administer Jira permission.
+	IsSubstringMatch         bool // Matches the name as a substring instead of a prefix.
 }
 
 // ShareFilterScopeScheme represents the scope of a shared filter in Jira.
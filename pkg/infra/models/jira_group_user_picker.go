@@ -1,5 +1,10 @@
 package models
 
+import (
+	"encoding/json"
+	"strings"
+)
+
 // GroupUserPickerFindOptionScheme represents the options for finding users and groups in Jira.
 type GroupUserPickerFindOptionScheme struct {
 	// Query is the search string.
@@ -49,6 +54,9 @@ type GroupUserPickerFoundGroupsScheme struct {
 }
 
 // GroupUserPickerFoundGroupScheme represents a group found in a search.
+//
+// Jira highlights the matched query string using HTML tags on the HTML field, Text and Highlight always
+// contain the plain text and the highlighted parts, and Name is free of HTML.
 type GroupUserPickerFoundGroupScheme struct {
 	// GroupID is the ID of the group, which uniquely identifies the group across all Atlassian products.
 	// For example, 952d12c3-5b5b-4d04-bb32-44d383afc4b2.
@@ -60,6 +68,28 @@ type GroupUserPickerFoundGroupScheme struct {
 	// Name is the name of the group.
 	// The name of a group is mutable, to reliably identify a group use GroupID.
 	Name string `json:"name"`
+	// Text is HTML with the highlighting stripped, as plain text.
+	Text string `json:"-"`
+	// Highlight is the list of the parts of HTML matching the query string, as plain text.
+	Highlight []string `json:"-"`
+}
+
+// UnmarshalJSON decodes the group and extracts the plain text and the highlighted parts of its HTML.
+func (g *GroupUserPickerFoundGroupScheme) UnmarshalJSON(data []byte) error {
+
+	type alias GroupUserPickerFoundGroupScheme
+
+	decoded := new(alias)
+	if err := json.Unmarshal(data, decoded); err != nil {
+		return err
+	}
+
+	*g = GroupUserPickerFoundGroupScheme(*decoded)
+
+	g.Name = stripHTMLTags(g.Name)
+	g.Text = stripHTMLTags(g.HTML)
+	g.Highlight = htmlHighlights(g.HTML)
+	return nil
 }
 
 // GroupUserPickerFoundGroupLabelScheme represents a label associated with a group.
@@ -84,6 +114,9 @@ type GroupUserPickerFoundUsersScheme struct {
 }
 
 // GroupUserPickerFoundUserScheme represents a user found in a search.
+//
+// Jira highlights the matched query string using HTML tags on the HTML field, Text and Highlight always
+// contain the plain text and the highlighted parts, and DisplayName is free of HTML.
 type GroupUserPickerFoundUserScheme struct {
 	// AccountID is the account ID of the user, which uniquely identifies the user across all Atlassian products.
 	// For example, 5b10ac8d82e05b22cc7d4ef5.
@@ -94,4 +127,64 @@ type GroupUserPickerFoundUserScheme struct {
 	DisplayName string `json:"displayName"`
 	// HTML is the display name, email address, and key of the user with the matched query string highlighted with the HTML bold tag.
 	HTML string `json:"html"`
+	// AvatarURLs is the avatar URL of the user keyed by its size, set when the requested avatar size is up to 48x48 pixels.
+	AvatarURLs *AvatarURLScheme `json:"-"`
+	// Text is HTML with the highlighting stripped, as plain text.
+	Text string `json:"-"`
+	// Highlight is the list of the parts of HTML matching the query string, as plain text.
+	Highlight []string `json:"-"`
+}
+
+// UnmarshalJSON decodes the user and extracts the plain text and the highlighted parts of its HTML.
+func (u *GroupUserPickerFoundUserScheme) UnmarshalJSON(data []byte) error {
+
+	type alias GroupUserPickerFoundUserScheme
+
+	decoded := new(alias)
+	if err := json.Unmarshal(data, decoded); err != nil {
+		return err
+	}
+
+	*u = GroupUserPickerFoundUserScheme(*decoded)
+
+	u.DisplayName = stripHTMLTags(u.DisplayName)
+	u.Text = stripHTMLTags(u.HTML)
+	u.Highlight = htmlHighlights(u.HTML)
+	return nil
+}
+
+// groupUserPickerAvatarSizes maps the avatar sizes of the search to the sizes of an AvatarURLScheme, the larger sizes having no equivalent.
+var groupUserPickerAvatarSizes = map[string]func(avatars *AvatarURLScheme, avatarURL string){
+	"xsmall": func(avatars *AvatarURLScheme, avatarURL string) { avatars.One6X16 = avatarURL },
+	"small":  func(avatars *AvatarURLScheme, avatarURL string) { avatars.Two4X24 = avatarURL },
+	"medium": func(avatars *AvatarURLScheme, avatarURL string) { avatars.Three2X32 = avatarURL },
+	"large":  func(avatars *AvatarURLScheme, avatarURL string) { avatars.Four8X48 = avatarURL },
+}
+
+// SetAvatarSize sets the AvatarURLs of the users found with the avatar size of the search, see
+// GroupUserPickerFindOptionScheme.AvatarSize, xsmall when empty. The high resolution variants, e.g. small@2x,
+// are keyed by their display size.
+func (g *GroupUserPickerFindScheme) SetAvatarSize(avatarSize string) {
+
+	if g == nil || g.Users == nil {
+		return
+	}
+
+	if avatarSize == "" {
+		avatarSize = "xsmall"
+	}
+
+	size, _, _ := strings.Cut(avatarSize, "@")
+
+	set, ok := groupUserPickerAvatarSizes[size]
+	if !ok {
+		return
+	}
+
+	for _, user := range g.Users.Users {
+		if user != nil && user.AvatarURL != "" {
+			user.AvatarURLs = new(AvatarURLScheme)
+			set(user.AvatarURLs, user.AvatarURL)
+		}
+	}
 }
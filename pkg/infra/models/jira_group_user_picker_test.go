@@ -0,0 +1,119 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupUserPickerFoundUserScheme_UnmarshalJSON(t *testing.T) {
+
+	testCases := []struct {
+		name    string
+		data    string
+		want    *GroupUserPickerFoundUserScheme
+		wantErr bool
+	}{
+		{
+			name: "when the display name is highlighted",
+			data: `{"accountId":"uuid","displayName":"<b>Tom</b> Jerry","html":"<b>Tom</b> Jerry - tom&amp;jerry@example.com","avatarUrl":"https://avatar/16"}`,
+			want: &GroupUserPickerFoundUserScheme{
+				AccountID:   "uuid",
+				AvatarURL:   "https://avatar/16",
+				DisplayName: "Tom Jerry",
+				HTML:        "<b>Tom</b> Jerry - tom&amp;jerry@example.com",
+				Text:        "Tom Jerry - tom&jerry@example.com",
+				Highlight:   []string{"Tom"},
+			},
+		},
+		{
+			name: "when the query is not highlighted",
+			data: `{"accountId":"uuid","displayName":"Tom Jerry","html":"Tom Jerry"}`,
+			want: &GroupUserPickerFoundUserScheme{
+				AccountID:   "uuid",
+				DisplayName: "Tom Jerry",
+				HTML:        "Tom Jerry",
+				Text:        "Tom Jerry",
+			},
+		},
+		{
+			name:    "when the payload is invalid",
+			data:    `{"accountId":1}`,
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			got := new(GroupUserPickerFoundUserScheme)
+			err := json.Unmarshal([]byte(testCase.data), got)
+
+			if testCase.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.want, got)
+		})
+	}
+}
+
+func TestGroupUserPickerFoundGroupScheme_UnmarshalJSON(t *testing.T) {
+
+	got := new(GroupUserPickerFoundGroupScheme)
+	err := json.Unmarshal([]byte(`{"groupId":"uuid","name":"jira-admins","html":"<b>jira</b>-<strong>admins</strong>"}`), got)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &GroupUserPickerFoundGroupScheme{
+		GroupID:   "uuid",
+		Name:      "jira-admins",
+		HTML:      "<b>jira</b>-<strong>admins</strong>",
+		Text:      "jira-admins",
+		Highlight: []string{"jira", "admins"},
+	}, got)
+}
+
+func TestGroupUserPickerFindScheme_SetAvatarSize(t *testing.T) {
+
+	testCases := []struct {
+		name       string
+		avatarSize string
+		want       *AvatarURLScheme
+	}{
+		{
+			name: "when the avatar size is not provided",
+			want: &AvatarURLScheme{One6X16: "https://avatar"},
+		},
+		{
+			name:       "when the avatar size is a high resolution variant",
+			avatarSize: "medium@2x",
+			want:       &AvatarURLScheme{Three2X32: "https://avatar"},
+		},
+		{
+			name:       "when the avatar size is large",
+			avatarSize: "large",
+			want:       &AvatarURLScheme{Four8X48: "https://avatar"},
+		},
+		{
+			name:       "when the avatar size has no equivalent",
+			avatarSize: "xxlarge",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			find := &GroupUserPickerFindScheme{
+				Users: &GroupUserPickerFoundUsersScheme{
+					Users: []*GroupUserPickerFoundUserScheme{{AccountID: "uuid", AvatarURL: "https://avatar"}},
+				},
+			}
+
+			find.SetAvatarSize(testCase.avatarSize)
+			assert.Equal(t, testCase.want, find.Users.Users[0].AvatarURLs)
+		})
+	}
+}
@@ -1,5 +1,7 @@
 package models
 
+import "errors"
+
 // AttachmentSettingScheme represents the attachment settings in Jira.
 type AttachmentSettingScheme struct {
 	Enabled     bool `json:"enabled,omitempty"`     // Indicates if attachments are enabled.
@@ -19,6 +21,28 @@ type IssueAttachmentScheme struct {
 	Thumbnail string      `json:"thumbnail,omitempty"` // The thumbnail of the attachment.
 }
 
+// IssueAttachmentUploadOptionsScheme represents the options to upload an attachment of an issue in Jira.
+type IssueAttachmentUploadOptionsScheme struct {
+	// Verify downloads the attachment after the upload and compares its SHA-256 checksum with the checksum of the file.
+	// Otherwise, only the size of the attachment is compared with the size of the file.
+	Verify bool
+
+	// Attempts is the maximum number of uploads of the file, 1 when zero.
+	// The attachments that don't match the file are deleted before the file is uploaded again, and the rate-limited
+	// uploads are retried once the delay returned by RetryDelay is waited out. The uploads failing with a server or
+	// a network error aren't retried, the file may have been attached before the failure.
+	Attempts int
+}
+
+// IssueAttachmentUploadScheme represents the result of the upload of an attachment of an issue in Jira.
+type IssueAttachmentUploadScheme struct {
+	Attachment *IssueAttachmentScheme // The uploaded attachment.
+	Checksum   string                 // The hex-encoded SHA-256 checksum of the file.
+	Size       int64                  // The size of the file, in bytes.
+	Attempts   int                    // The number of uploads of the file.
+	Verified   bool                   // Indicates if the checksum of the downloaded attachment was compared with the checksum of the file.
+}
+
 // IssueAttachmentMetadataScheme represents the metadata of an attachment of an issue in Jira.
 type IssueAttachmentMetadataScheme struct {
 	ID        int         `json:"id,omitempty"`        // The ID of the attachment.
@@ -49,3 +73,66 @@ type IssueAttachmentHumanMetadataEntryScheme struct {
 	MediaType string `json:"mediaType,omitempty"` // The media type of the entry.
 	Label     string `json:"label,omitempty"`     // The label of the entry.
 }
+
+// IssueAttachmentRawMetadataScheme represents the raw metadata of the contents of an archive attachment of an issue in Jira.
+type IssueAttachmentRawMetadataScheme struct {
+	Entries         []*IssueAttachmentRawMetadataEntryScheme `json:"entries,omitempty"`         // The entries of the archive.
+	TotalEntryCount int                                      `json:"totalEntryCount,omitempty"` // The total count of entries of the archive.
+}
+
+// IssueAttachmentRawMetadataEntryScheme represents an entry of the raw metadata of an archive attachment of an issue in Jira.
+type IssueAttachmentRawMetadataEntryScheme struct {
+	EntryIndex int    `json:"entryIndex,omitempty"` // The index of the entry in the archive.
+	Name       string `json:"name,omitempty"`       // The path of the entry in the archive.
+	Size       int64  `json:"size,omitempty"`       // The size of the entry, in bytes.
+	MediaType  string `json:"mediaType,omitempty"`  // The media type of the entry.
+}
+
+// IssueAttachmentThumbnailOptionsScheme represents the options to get the thumbnail of an attachment of an issue in Jira.
+type IssueAttachmentThumbnailOptionsScheme struct {
+	Redirect          bool // Redirects to the thumbnail instead of returning it.
+	FallbackToDefault bool // Returns a default thumbnail when the attachment has none.
+	Width             int  // The maximum width of the thumbnail, in pixels.
+	Height            int  // The maximum height of the thumbnail, in pixels.
+}
+
+// IssueAttachmentsScheme represents an issue requested with the attachment field only.
+type IssueAttachmentsScheme struct {
+	ID     string                        `json:"id,omitempty"`     // The ID of the issue.
+	Key    string                        `json:"key,omitempty"`    // The key of the issue.
+	Fields *IssueAttachmentsFieldsScheme `json:"fields,omitempty"` // The attachment field of the issue.
+}
+
+// IssueAttachmentsFieldsScheme represents the attachment field of an issue in Jira.
+type IssueAttachmentsFieldsScheme struct {
+	Attachment []*IssueAttachmentScheme `json:"attachment,omitempty"` // The attachments of the issue.
+}
+
+// IssueAttachmentArchiveScheme represents the result of archiving the attachments of an issue into a ZIP file.
+type IssueAttachmentArchiveScheme struct {
+	Files []*IssueAttachmentArchiveFileScheme // The attachments of the issue, in the order returned by Jira.
+}
+
+// IssueAttachmentArchiveFileScheme represents an attachment archived into a ZIP file.
+type IssueAttachmentArchiveFileScheme struct {
+	Attachment *IssueAttachmentScheme // The attachment metadata.
+	Name       string                 // The name of the file in the archive.
+	Err        error                  // The error that prevented the attachment from being archived, if any.
+}
+
+// Err returns the errors of the attachments that couldn't be archived, or nil if every attachment was archived.
+func (a *IssueAttachmentArchiveScheme) Err() error {
+
+	if a == nil {
+		return nil
+	}
+
+	var errs []error
+	for _, file := range a.Files {
+		if file.Err != nil {
+			errs = append(errs, file.Err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
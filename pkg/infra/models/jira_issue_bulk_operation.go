@@ -0,0 +1,175 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// Bulk operation status constants
+const (
+	BulkOperationStatusEnqueued        = "ENQUEUED"
+	BulkOperationStatusRunning         = "RUNNING"
+	BulkOperationStatusComplete        = "COMPLETE"
+	BulkOperationStatusFailed          = "FAILED"
+	BulkOperationStatusCancelRequested = "CANCEL_REQUESTED"
+	BulkOperationStatusCancelled       = "CANCELLED"
+	BulkOperationStatusDead            = "DEAD"
+)
+
+// Bulk edit multi-select field option constants, the change made to the multi-select fields, e.g. the labels.
+const (
+	BulkEditMultiSelectAdd       = "ADD"
+	BulkEditMultiSelectRemove    = "REMOVE"
+	BulkEditMultiSelectReplace   = "REPLACE"
+	BulkEditMultiSelectRemoveAll = "REMOVE_ALL"
+)
+
+// BulkEditableFieldPageScheme represents a page of the fields that can be edited in bulk on issues.
+type BulkEditableFieldPageScheme struct {
+	Fields        []*BulkEditableFieldScheme `json:"fields,omitempty"`        // The editable fields.
+	StartingAfter string                     `json:"startingAfter,omitempty"` // The cursor of the next page.
+	EndingBefore  string                     `json:"endingBefore,omitempty"`  // The cursor of the previous page.
+}
+
+// BulkEditableFieldScheme represents a field that can be edited in bulk on issues.
+type BulkEditableFieldScheme struct {
+	ID                      string   `json:"id,omitempty"`                      // The ID of the field.
+	Name                    string   `json:"name,omitempty"`                    // The name of the field.
+	Type                    string   `json:"type,omitempty"`                    // The type of the field.
+	IsRequired              bool     `json:"isRequired,omitempty"`              // Indicates if the field is required.
+	Unavailable             bool     `json:"unavailable,omitempty"`             // Indicates if the field can't be edited on the selected issues.
+	UnavailableMessage      string   `json:"unavailableMessage,omitempty"`      // The reason the field can't be edited.
+	SearchURL               string   `json:"searchUrl,omitempty"`               // The URL to search the values of the field.
+	MultiSelectFieldOptions []string `json:"multiSelectFieldOptions,omitempty"` // The changes allowed on a multi-select field, see the BulkEditMultiSelect constants.
+}
+
+// BulkEditPayloadScheme represents the payload to edit the fields of issues in bulk.
+//
+// The edited fields input holds the values of the selected actions, keyed by kind of field as documented by
+// the bulk edit endpoint, e.g. {"labelsFields": [{"fieldId": "labels", "bulkEditMultiSelectFieldOption": "ADD",
+// "labels": [{"name": "backend"}]}]}.
+type BulkEditPayloadScheme struct {
+	SelectedIssueIDsOrKeys []string               `json:"selectedIssueIdsOrKeys,omitempty"` // The IDs or keys of the issues to edit, up to 1000.
+	SelectedActions        []string               `json:"selectedActions,omitempty"`        // The IDs of the fields to edit, up to 200.
+	EditedFieldsInput      map[string]interface{} `json:"editedFieldsInput,omitempty"`      // The new values of the fields.
+	SendBulkNotification   *bool                  `json:"sendBulkNotification,omitempty"`   // Sends the bulk change notification, true when nil.
+}
+
+// BulkTransitionPageScheme represents a page of the transitions available on issues, grouped by workflow.
+type BulkTransitionPageScheme struct {
+	AvailableTransitions []*BulkIssueTransitionsScheme `json:"availableTransitions,omitempty"` // The transitions available per group of issues.
+	StartingAfter        string                        `json:"startingAfter,omitempty"`        // The cursor of the next page.
+	EndingBefore         string                        `json:"endingBefore,omitempty"`         // The cursor of the previous page.
+}
+
+// BulkIssueTransitionsScheme represents the transitions available on a group of issues sharing a workflow.
+type BulkIssueTransitionsScheme struct {
+	Issues                []string                `json:"issues,omitempty"`                // The keys of the issues.
+	IsTransitionsFiltered bool                    `json:"isTransitionsFiltered,omitempty"` // Indicates if transitions were filtered out, e.g. the ones with screens.
+	Transitions           []*BulkTransitionScheme `json:"transitions,omitempty"`           // The transitions available on the issues.
+}
+
+// BulkTransitionScheme represents a transition available on issues in bulk.
+type BulkTransitionScheme struct {
+	TransitionID   int                         `json:"transitionId,omitempty"`   // The ID of the transition.
+	TransitionName string                      `json:"transitionName,omitempty"` // The name of the transition.
+	To             *BulkTransitionStatusScheme `json:"to,omitempty"`             // The status the transition leads to.
+}
+
+// BulkTransitionStatusScheme represents the status a bulk transition leads to.
+type BulkTransitionStatusScheme struct {
+	StatusID   string `json:"statusId,omitempty"`   // The ID of the status.
+	StatusName string `json:"statusName,omitempty"` // The name of the status.
+}
+
+// BulkTransitionPayloadScheme represents the payload to transition issues in bulk.
+type BulkTransitionPayloadScheme struct {
+	BulkTransitionInputs []*BulkTransitionInputScheme `json:"bulkTransitionInputs,omitempty"` // The transitions to perform, per group of issues.
+	SendBulkNotification *bool                        `json:"sendBulkNotification,omitempty"` // Sends the bulk change notification, true when nil.
+}
+
+// BulkTransitionInputScheme represents a transition to perform on a group of issues.
+type BulkTransitionInputScheme struct {
+	SelectedIssueIDsOrKeys []string `json:"selectedIssueIdsOrKeys,omitempty"` // The IDs or keys of the issues to transition.
+	TransitionID           string   `json:"transitionId,omitempty"`           // The ID of the transition.
+}
+
+// BulkMovePayloadScheme represents the payload to move issues in bulk to other projects or issue types.
+type BulkMovePayloadScheme struct {
+	SendBulkNotification   *bool                            `json:"sendBulkNotification,omitempty"`   // Sends the bulk change notification, true when nil.
+	TargetToSourcesMapping map[string]*BulkMoveTargetScheme `json:"targetToSourcesMapping,omitempty"` // The issues to move, keyed by target, see BulkMoveTargetKey.
+}
+
+// BulkMoveTargetScheme represents the issues moved to a target, and how their fields and statuses are mapped.
+//
+// The target mandatory fields, statuses and classifications are passed as documented by the bulk move endpoint.
+type BulkMoveTargetScheme struct {
+	IssueIDsOrKeys              []string                 `json:"issueIdsOrKeys,omitempty"`        // The IDs or keys of the issues to move.
+	InferFieldDefaults          bool                     `json:"inferFieldDefaults"`              // Uses the default values of the target for the missing mandatory fields.
+	InferStatusDefaults         bool                     `json:"inferStatusDefaults"`             // Maps the statuses to the default status of the target workflow.
+	InferSubtaskTypeDefault     bool                     `json:"inferSubtaskTypeDefault"`         // Uses the default subtask type of the target project.
+	InferClassificationDefaults bool                     `json:"inferClassificationDefaults"`     // Uses the default classification of the target.
+	TargetMandatoryFields       []map[string]interface{} `json:"targetMandatoryFields,omitempty"` // The values of the mandatory fields of the target.
+	TargetStatus                []map[string]interface{} `json:"targetStatus,omitempty"`          // The mapping of the statuses to the target workflow.
+	TargetClassification        []map[string]interface{} `json:"targetClassification,omitempty"`  // The mapping of the classifications.
+}
+
+// BulkMoveTargetKey returns the key of a target of BulkMovePayloadScheme.TargetToSourcesMapping, the target project
+// and issue type, and the target parent for the subtasks.
+func BulkMoveTargetKey(projectIDOrKey, issueTypeID, parentIDOrKey string) string {
+
+	if parentIDOrKey == "" {
+		return projectIDOrKey + "," + issueTypeID
+	}
+
+	return projectIDOrKey + "," + issueTypeID + "," + parentIDOrKey
+}
+
+// BulkOperationTaskScheme represents the task of a bulk operation submitted to Jira.
+type BulkOperationTaskScheme struct {
+	TaskID string `json:"taskId,omitempty"` // The ID of the task.
+}
+
+// BulkOperationProgressScheme represents the progress of a bulk operation.
+type BulkOperationProgressScheme struct {
+	TaskID                          string              `json:"taskId,omitempty"`                          // The ID of the task.
+	Status                          string              `json:"status,omitempty"`                          // The status of the task, see the BulkOperationStatus constants.
+	ProgressPercent                 int                 `json:"progressPercent,omitempty"`                 // The progress of the task, in percent.
+	SubmittedBy                     *UserScheme         `json:"submittedBy,omitempty"`                     // The user who submitted the operation.
+	Created                         string              `json:"created,omitempty"`                         // The date the task was created.
+	Started                         string              `json:"started,omitempty"`                         // The date the task started.
+	Updated                         string              `json:"updated,omitempty"`                         // The date the task was last updated.
+	TotalIssueCount                 int                 `json:"totalIssueCount,omitempty"`                 // The number of issues of the operation.
+	InvalidOrInaccessibleIssueCount int                 `json:"invalidOrInaccessibleIssueCount,omitempty"` // The number of issues not found or not accessible.
+	ProcessedAccessibleIssues       []int               `json:"processedAccessibleIssues,omitempty"`       // The IDs of the issues processed successfully.
+	FailedAccessibleIssues          map[string][]string `json:"failedAccessibleIssues,omitempty"`          // The errors of the issues that failed, keyed by issue ID.
+}
+
+// Done reports whether the bulk operation is finished, successfully or not.
+func (p *BulkOperationProgressScheme) Done() bool {
+
+	switch p.Status {
+	case BulkOperationStatusComplete, BulkOperationStatusFailed, BulkOperationStatusCancelled, BulkOperationStatusDead:
+		return true
+	}
+
+	return false
+}
+
+// Err returns the errors of the issues the bulk operation failed on, or nil if none failed.
+func (p *BulkOperationProgressScheme) Err() error {
+
+	if p == nil {
+		return nil
+	}
+
+	var errs []error
+	for _, issueID := range slices.Sorted(maps.Keys(p.FailedAccessibleIssues)) {
+		errs = append(errs, fmt.Errorf("issue %v: %v", issueID, strings.Join(p.FailedAccessibleIssues[issueID], ", ")))
+	}
+
+	return errors.Join(errs...)
+}
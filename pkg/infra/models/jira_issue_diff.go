@@ -0,0 +1,243 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/tidwall/gjson"
+)
+
+// IssueFieldChangeScheme represents a field changed between two snapshots of an issue in Jira.
+type IssueFieldChangeScheme struct {
+	FieldID    string      // The ID of the field, e.g. "summary" or "customfield_10010".
+	Field      string      // The name of the field, only known when the change comes from the changelog.
+	Custom     bool        // Indicates if the field is a custom field.
+	From       interface{} // The previous value of the field, nil if the field was added.
+	To         interface{} // The new value of the field, nil if the field was removed.
+	FromString string      // The previous value of the field as a string.
+	ToString   string      // The new value of the field as a string.
+}
+
+// DiffIssues returns the fields changed between two snapshots of an issue, sorted by field ID.
+// The custom fields are not part of the IssueScheme struct, use DiffIssueFields with the
+// response bodies to include them.
+func DiffIssues(before, after *IssueScheme) ([]*IssueFieldChangeScheme, error) {
+
+	var beforeFields, afterFields *IssueFieldsScheme
+	if before != nil {
+		beforeFields = before.Fields
+	}
+
+	if after != nil {
+		afterFields = after.Fields
+	}
+
+	return diffIssueStructs(beforeFields, afterFields)
+}
+
+// DiffIssuesV2 returns the fields changed between two snapshots of an issue, sorted by field ID.
+// The custom fields are not part of the IssueSchemeV2 struct, use DiffIssueFields with the
+// response bodies to include them.
+func DiffIssuesV2(before, after *IssueSchemeV2) ([]*IssueFieldChangeScheme, error) {
+
+	var beforeFields, afterFields *IssueFieldsSchemeV2
+	if before != nil {
+		beforeFields = before.Fields
+	}
+
+	if after != nil {
+		afterFields = after.Fields
+	}
+
+	return diffIssueStructs(beforeFields, afterFields)
+}
+
+// DiffIssueFields returns the fields changed between two issue response bodies, sorted by field ID,
+// including the custom fields.
+//
+// Example usage:
+//
+//	_, before, _ := client.Issue.Get(ctx, "KP-1", nil, nil)
+//	// ...
+//	_, after, _ := client.Issue.Get(ctx, "KP-1", nil, nil)
+//
+//	changes, err := models.DiffIssueFields(before.Bytes, after.Bytes)
+func DiffIssueFields(before, after bytes.Buffer) ([]*IssueFieldChangeScheme, error) {
+
+	beforeRaw, afterRaw := gjson.ParseBytes(before.Bytes()), gjson.ParseBytes(after.Bytes())
+
+	if !beforeRaw.Get("fields").Exists() || !afterRaw.Get("fields").Exists() {
+		return nil, ErrNoFieldInformation
+	}
+
+	beforeFields := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(beforeRaw.Get("fields").Raw), &beforeFields); err != nil {
+		return nil, err
+	}
+
+	afterFields := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(afterRaw.Get("fields").Raw), &afterFields); err != nil {
+		return nil, err
+	}
+
+	return diffIssueFieldMaps(beforeFields, afterFields), nil
+}
+
+// Changes returns the fields changed by the changelog history, in the order of its items.
+// The values of the changes are the raw values stored on the changelog, e.g. the IDs of the options.
+func (h *IssueChangelogHistoryScheme) Changes() []*IssueFieldChangeScheme {
+
+	if h == nil {
+		return nil
+	}
+
+	changes := make([]*IssueFieldChangeScheme, 0, len(h.Items))
+	for _, item := range h.Items {
+
+		if item == nil {
+			continue
+		}
+
+		fieldID := item.FieldID
+		if fieldID == "" {
+			fieldID = item.Field
+		}
+
+		change := &IssueFieldChangeScheme{
+			FieldID:    fieldID,
+			Field:      item.Field,
+			Custom:     item.Fieldtype == "custom" || strings.HasPrefix(fieldID, "customfield_"),
+			FromString: item.FromString,
+			ToString:   item.ToString,
+		}
+
+		if item.From != "" {
+			change.From = item.From
+		}
+
+		if item.To != "" {
+			change.To = item.To
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes
+}
+
+// diffIssueStructs returns the changes between the fields structs, compared by their JSON representation.
+func diffIssueStructs(before, after interface{}) ([]*IssueFieldChangeScheme, error) {
+
+	beforeFields, err := issueFieldsAsMap(before)
+	if err != nil {
+		return nil, err
+	}
+
+	afterFields, err := issueFieldsAsMap(after)
+	if err != nil {
+		return nil, err
+	}
+
+	return diffIssueFieldMaps(beforeFields, afterFields), nil
+}
+
+// issueFieldsAsMap converts the fields struct to a map keyed by field ID.
+func issueFieldsAsMap(fields interface{}) (map[string]interface{}, error) {
+
+	fieldsAsMap := make(map[string]interface{})
+	if fields == nil || reflect.ValueOf(fields).IsNil() {
+		return fieldsAsMap, nil
+	}
+
+	fieldsAsBytes, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(fieldsAsBytes, &fieldsAsMap); err != nil {
+		return nil, err
+	}
+
+	return fieldsAsMap, nil
+}
+
+// diffIssueFieldMaps returns the changes between the fields maps, sorted by field ID.
+// A null field is treated as a missing field.
+func diffIssueFieldMaps(before, after map[string]interface{}) []*IssueFieldChangeScheme {
+
+	fieldIDs := make(map[string]struct{}, len(before)+len(after))
+	for fieldID := range before {
+		fieldIDs[fieldID] = struct{}{}
+	}
+
+	for fieldID := range after {
+		fieldIDs[fieldID] = struct{}{}
+	}
+
+	sortedIDs := make([]string, 0, len(fieldIDs))
+	for fieldID := range fieldIDs {
+		sortedIDs = append(sortedIDs, fieldID)
+	}
+
+	sort.Strings(sortedIDs)
+
+	var changes []*IssueFieldChangeScheme
+	for _, fieldID := range sortedIDs {
+
+		from, to := before[fieldID], after[fieldID]
+		if reflect.DeepEqual(from, to) {
+			continue
+		}
+
+		changes = append(changes, &IssueFieldChangeScheme{
+			FieldID:    fieldID,
+			Custom:     strings.HasPrefix(fieldID, "customfield_"),
+			From:       from,
+			To:         to,
+			FromString: issueFieldValueString(from),
+			ToString:   issueFieldValueString(to),
+		})
+	}
+
+	return changes
+}
+
+// issueFieldValueString returns a readable representation of the field value.
+// The objects are represented by their display name, name, value or key, whichever is found first.
+func issueFieldValueString(value interface{}) string {
+
+	switch value := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case float64, bool:
+		return fmt.Sprint(value)
+	case []interface{}:
+
+		values := make([]string, 0, len(value))
+		for _, element := range value {
+			values = append(values, issueFieldValueString(element))
+		}
+
+		return strings.Join(values, ", ")
+	case map[string]interface{}:
+
+		for _, key := range []string{"displayName", "name", "value", "key"} {
+			if label, ok := value[key].(string); ok {
+				return label
+			}
+		}
+	}
+
+	valueAsBytes, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+
+	return string(valueAsBytes)
+}
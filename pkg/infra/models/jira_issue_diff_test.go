@@ -0,0 +1,80 @@
+package models
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffIssues(t *testing.T) {
+
+	before := &IssueScheme{Key: "KP-1", Fields: &IssueFieldsScheme{
+		Summary: "Old summary",
+		Labels:  []string{"backend"},
+		Status:  &StatusScheme{ID: "1", Name: "To Do"},
+	}}
+
+	after := &IssueScheme{Key: "KP-1", Fields: &IssueFieldsScheme{
+		Summary: "New summary",
+		Labels:  []string{"backend"},
+		Status:  &StatusScheme{ID: "3", Name: "In Progress"},
+	}}
+
+	changes, err := DiffIssues(before, after)
+	assert.NoError(t, err)
+	assert.Len(t, changes, 2)
+
+	assert.Equal(t, "status", changes[0].FieldID)
+	assert.Equal(t, "To Do", changes[0].FromString)
+	assert.Equal(t, "In Progress", changes[0].ToString)
+
+	assert.Equal(t, "summary", changes[1].FieldID)
+	assert.Equal(t, "Old summary", changes[1].From)
+	assert.Equal(t, "New summary", changes[1].To)
+
+	changes, err = DiffIssues(nil, &IssueScheme{Fields: &IssueFieldsScheme{Summary: "Created"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []*IssueFieldChangeScheme{{FieldID: "summary", To: "Created", ToString: "Created"}}, changes)
+}
+
+func TestDiffIssueFields(t *testing.T) {
+
+	before := *bytes.NewBufferString(`{"key":"KP-1","fields":{"summary":"Summary","customfield_10010":{"value":"Blue"},"customfield_10020":[{"name":"Sprint 1"}],"customfield_10030":null}}`)
+	after := *bytes.NewBufferString(`{"key":"KP-1","fields":{"summary":"Summary","customfield_10010":{"value":"Green"},"customfield_10020":[{"name":"Sprint 1"},{"name":"Sprint 2"}],"customfield_10030":5}}`)
+
+	changes, err := DiffIssueFields(before, after)
+	assert.NoError(t, err)
+	assert.Len(t, changes, 3)
+
+	assert.Equal(t, &IssueFieldChangeScheme{
+		FieldID:    "customfield_10010",
+		Custom:     true,
+		From:       map[string]interface{}{"value": "Blue"},
+		To:         map[string]interface{}{"value": "Green"},
+		FromString: "Blue",
+		ToString:   "Green",
+	}, changes[0])
+
+	assert.Equal(t, "Sprint 1, Sprint 2", changes[1].ToString)
+	assert.Nil(t, changes[2].From)
+	assert.Equal(t, "5", changes[2].ToString)
+
+	_, err = DiffIssueFields(before, *bytes.NewBufferString(`{"key":"KP-1"}`))
+	assert.ErrorIs(t, err, ErrNoFieldInformation)
+}
+
+func TestIssueChangelogHistoryScheme_Changes(t *testing.T) {
+
+	history := &IssueChangelogHistoryScheme{
+		Items: []*IssueChangelogHistoryItemScheme{
+			{Field: "status", Fieldtype: "jira", FieldID: "status", From: "1", FromString: "To Do", To: "3", ToString: "In Progress"},
+			{Field: "Story Points", Fieldtype: "custom", FieldID: "customfield_10016", ToString: "5"},
+		},
+	}
+
+	assert.Equal(t, []*IssueFieldChangeScheme{
+		{FieldID: "status", Field: "status", From: "1", To: "3", FromString: "To Do", ToString: "In Progress"},
+		{FieldID: "customfield_10016", Field: "Story Points", Custom: true, ToString: "5"},
+	}, history.Changes())
+}
@@ -0,0 +1,35 @@
+package models
+
+// Jira built-in issue event IDs, used by the notification schemes and the workflow transitions.
+// See https://support.atlassian.com/jira-cloud-administration/docs/configure-system-events/
+const (
+	IssueEventCreated        = 1
+	IssueEventUpdated        = 2
+	IssueEventAssigned       = 3
+	IssueEventResolved       = 4
+	IssueEventClosed         = 5
+	IssueEventCommented      = 6
+	IssueEventReopened       = 7
+	IssueEventDeleted        = 8
+	IssueEventMoved          = 9
+	IssueEventWorkLogged     = 10
+	IssueEventWorkStarted    = 11
+	IssueEventWorkStopped    = 12
+	IssueEventGeneric        = 13
+	IssueEventCommentEdited  = 14
+	IssueEventWorklogUpdated = 15
+	IssueEventWorklogDeleted = 16
+	IssueEventCommentDeleted = 17
+)
+
+// Jira webhook event names, used by the dynamic webhooks registration.
+const (
+	WebhookEventIssueCreated         = "jira:issue_created"
+	WebhookEventIssueUpdated         = "jira:issue_updated"
+	WebhookEventIssueDeleted         = "jira:issue_deleted"
+	WebhookEventCommentCreated       = "comment_created"
+	WebhookEventCommentUpdated       = "comment_updated"
+	WebhookEventCommentDeleted       = "comment_deleted"
+	WebhookEventIssuePropertySet     = "issue_property_set"
+	WebhookEventIssuePropertyDeleted = "issue_property_deleted"
+)
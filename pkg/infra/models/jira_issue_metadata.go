@@ -1,5 +1,8 @@
 package models
 
+// IssueMetadataExpandFields is the expand of the create metadata that includes the fields of the issue types.
+const IssueMetadataExpandFields = "projects.issuetypes.fields"
+
 // IssueMetadataCreateOptions represents the options for creating issue metadata in Jira.
 type IssueMetadataCreateOptions struct {
 	ProjectIDs     []string // The IDs of the projects.
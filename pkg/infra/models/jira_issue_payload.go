@@ -0,0 +1,194 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// issueReadOnlyFields are the issue fields computed by Jira, rejected when sent back in a create or edit payload.
+var issueReadOnlyFields = map[string]bool{
+	IssueFieldStatus:               true,
+	IssueFieldStatusCategory:       true,
+	IssueFieldResolutionDate:       true,
+	IssueFieldCreator:              true,
+	IssueFieldCreated:              true,
+	IssueFieldUpdated:              true,
+	IssueFieldLastViewed:           true,
+	IssueFieldIssueLinks:           true,
+	IssueFieldSubtasks:             true,
+	IssueFieldAttachment:           true,
+	IssueFieldComment:              true,
+	IssueFieldWorklog:              true,
+	IssueFieldWatches:              true,
+	IssueFieldVotes:                true,
+	IssueFieldTimeSpent:            true,
+	IssueFieldAggregateProgress:    true,
+	IssueFieldProgress:             true,
+	IssueFieldWorkRatio:            true,
+	IssueFieldThumbnail:            true,
+	"statuscategorychangedate":     true,
+	"sprint":                       true,
+	"closedSprints":                true,
+	IssueFieldTimeEstimate:         true,
+	IssueFieldTimeOriginalEstimate: true,
+}
+
+// issueCreateOnlyFields are the issue fields only accepted when the issue is created.
+// The project of an existing issue is changed by moving it.
+var issueCreateOnlyFields = map[string]bool{
+	IssueFieldProject: true,
+}
+
+// IsIssueFieldReadOnly reports whether the issue field is computed by Jira and cannot be set in a payload.
+func IsIssueFieldReadOnly(fieldID string) bool {
+	return issueReadOnlyFields[fieldID]
+}
+
+// ToCreatePayload returns a copy of the issue holding only the fields accepted by the create issue endpoint.
+// The identifiers, the transitions, the changelog and the read-only fields are removed, and
+// the referenced entities (project, issue type, users, versions...) are reduced to their identifiers,
+// so an issue fetched from Jira can be used as the template of a new one.
+func (i *IssueScheme) ToCreatePayload() (*IssueScheme, error) {
+
+	payload := &IssueScheme{}
+	if err := buildIssuePayload(i, payload, false, nil); err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
+// ToEditPayload returns a copy of the issue holding only the fields accepted by the edit issue endpoint.
+// When changedFields are provided, only those fields are kept; a read-only field returns ErrIssueFieldReadOnly.
+// The referenced entities are reduced to their identifiers, so a fetched and modified issue can be sent back.
+//
+// Example usage:
+//
+//	issue, _, _ := client.Issue.Get(ctx, "KP-1", nil, nil)
+//	issue.Fields.Summary = "New summary"
+//
+//	payload, err := issue.ToEditPayload(models.IssueFieldSummary)
+//	if err != nil {
+//		return err
+//	}
+//
+//	_, err = client.Issue.Update(ctx, "KP-1", true, payload, nil, nil)
+func (i *IssueScheme) ToEditPayload(changedFields ...string) (*IssueScheme, error) {
+
+	payload := &IssueScheme{}
+	if err := buildIssuePayload(i, payload, true, changedFields); err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
+// ToCreatePayload returns a copy of the issue holding only the fields accepted by the create issue endpoint.
+// See IssueScheme.ToCreatePayload.
+func (i *IssueSchemeV2) ToCreatePayload() (*IssueSchemeV2, error) {
+
+	payload := &IssueSchemeV2{}
+	if err := buildIssuePayload(i, payload, false, nil); err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
+// ToEditPayload returns a copy of the issue holding only the fields accepted by the edit issue endpoint.
+// See IssueScheme.ToEditPayload.
+func (i *IssueSchemeV2) ToEditPayload(changedFields ...string) (*IssueSchemeV2, error) {
+
+	payload := &IssueSchemeV2{}
+	if err := buildIssuePayload(i, payload, true, changedFields); err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
+// IssueFieldReference reduces the value of an issue field, e.g. a user, a priority or a list of components,
+// to the identifiers Jira resolves it by, so the value read from an issue can be sent in a payload.
+func IssueFieldReference(value interface{}) interface{} {
+	return issueReference(value)
+}
+
+// buildIssuePayload copies the writable fields of the issue into the payload through their JSON representation.
+func buildIssuePayload(issue, payload interface{}, edit bool, changedFields []string) error {
+
+	for _, field := range changedFields {
+		if issueReadOnlyFields[field] || (edit && issueCreateOnlyFields[field]) {
+			return fmt.Errorf("%w: %v", ErrIssueFieldReadOnly, field)
+		}
+	}
+
+	issueAsBytes, err := json.Marshal(issue)
+	if err != nil {
+		return err
+	}
+
+	var issueAsMap struct {
+		Fields map[string]interface{} `json:"fields"`
+	}
+
+	if err := json.Unmarshal(issueAsBytes, &issueAsMap); err != nil {
+		return err
+	}
+
+	changed := make(map[string]bool, len(changedFields))
+	for _, field := range changedFields {
+		changed[field] = true
+	}
+
+	fields := make(map[string]interface{}, len(issueAsMap.Fields))
+	for field, value := range issueAsMap.Fields {
+
+		if issueReadOnlyFields[field] || (edit && issueCreateOnlyFields[field]) {
+			continue
+		}
+
+		if len(changed) != 0 && !changed[field] {
+			continue
+		}
+
+		fields[field] = issueReference(value)
+	}
+
+	payloadAsBytes, err := json.Marshal(map[string]interface{}{"fields": fields})
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(payloadAsBytes, payload)
+}
+
+// issueReference reduces a referenced entity, or a list of them, to the identifier Jira resolves it by.
+// The values that are not entities are returned unchanged.
+func issueReference(value interface{}) interface{} {
+
+	switch value := value.(type) {
+	case []interface{}:
+
+		references := make([]interface{}, 0, len(value))
+		for _, item := range value {
+			references = append(references, issueReference(item))
+		}
+
+		return references
+
+	case map[string]interface{}:
+
+		// The rich text documents, e.g. the ADF descriptions, are sent as they are.
+		if _, ok := value["type"]; ok {
+			return value
+		}
+
+		for _, identifier := range []string{"accountId", "id", "key", "name"} {
+			if reference, ok := value[identifier]; ok {
+				return map[string]interface{}{identifier: reference}
+			}
+		}
+	}
+
+	return value
+}
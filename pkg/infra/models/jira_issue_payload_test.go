@@ -0,0 +1,138 @@
+package models
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssueScheme_ToCreatePayload(t *testing.T) {
+
+	issue := &IssueScheme{
+		ID:   "10001",
+		Key:  "KP-1",
+		Self: "https://ctreminiom.atlassian.net/rest/api/3/issue/10001",
+		Fields: &IssueFieldsScheme{
+			Summary:   "Issue summary",
+			Project:   &ProjectScheme{ID: "10000", Key: "KP", Name: "Kanban Project"},
+			IssueType: &IssueTypeScheme{ID: "10002", Name: "Bug"},
+			Assignee:  &UserScheme{AccountID: "5b10ac8d82e05b22cc7d4ef5", DisplayName: "Carlos"},
+			Labels:    []string{"backend"},
+			Components: []*ComponentScheme{
+				{ID: "10010", Name: "API"},
+			},
+			Description: &CommentNodeScheme{Version: 1, Type: "doc"},
+			Status:      &StatusScheme{ID: "1", Name: "Open"},
+			Creator:     &UserScheme{AccountID: "5b10ac8d82e05b22cc7d4ef5"},
+			Votes:       &IssueVoteScheme{Votes: 2},
+		},
+	}
+
+	got, err := issue.ToCreatePayload()
+	assert.NoError(t, err)
+
+	assert.Empty(t, got.ID)
+	assert.Empty(t, got.Key)
+	assert.Empty(t, got.Self)
+
+	assert.Equal(t, "Issue summary", got.Fields.Summary)
+	assert.Equal(t, &ProjectScheme{ID: "10000"}, got.Fields.Project)
+	assert.Equal(t, &IssueTypeScheme{ID: "10002"}, got.Fields.IssueType)
+	assert.Equal(t, &UserScheme{AccountID: "5b10ac8d82e05b22cc7d4ef5"}, got.Fields.Assignee)
+	assert.Equal(t, []*ComponentScheme{{ID: "10010"}}, got.Fields.Components)
+	assert.Equal(t, []string{"backend"}, got.Fields.Labels)
+	assert.Equal(t, &CommentNodeScheme{Version: 1, Type: "doc"}, got.Fields.Description)
+
+	assert.Nil(t, got.Fields.Status)
+	assert.Nil(t, got.Fields.Creator)
+	assert.Nil(t, got.Fields.Votes)
+}
+
+func TestIssueScheme_ToEditPayload(t *testing.T) {
+
+	issue := &IssueScheme{
+		Key: "KP-1",
+		Fields: &IssueFieldsScheme{
+			Summary:  "Issue summary",
+			Project:  &ProjectScheme{ID: "10000"},
+			Priority: &PriorityScheme{ID: "3", Name: "Medium"},
+			Status:   &StatusScheme{ID: "1"},
+			Labels:   []string{"backend"},
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		changedFields []string
+		want          *IssueFieldsScheme
+		wantErr       bool
+		Err           error
+	}{
+		{
+			name: "when the changed fields are not provided",
+			want: &IssueFieldsScheme{
+				Summary:  "Issue summary",
+				Priority: &PriorityScheme{ID: "3"},
+				Labels:   []string{"backend"},
+			},
+		},
+
+		{
+			name:          "when the changed fields are provided",
+			changedFields: []string{IssueFieldSummary, IssueFieldLabels},
+			want: &IssueFieldsScheme{
+				Summary: "Issue summary",
+				Labels:  []string{"backend"},
+			},
+		},
+
+		{
+			name:          "when a changed field is read-only",
+			changedFields: []string{IssueFieldSummary, IssueFieldStatus},
+			wantErr:       true,
+			Err:           ErrIssueFieldReadOnly,
+		},
+
+		{
+			name:          "when a changed field is the project",
+			changedFields: []string{IssueFieldProject},
+			wantErr:       true,
+			Err:           ErrIssueFieldReadOnly,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			got, err := issue.ToEditPayload(testCase.changedFields...)
+
+			if testCase.wantErr {
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Empty(t, got.Key)
+			assert.Equal(t, testCase.want, got.Fields)
+		})
+	}
+}
+
+func TestIssueSchemeV2_ToEditPayload(t *testing.T) {
+
+	issue := &IssueSchemeV2{
+		Fields: &IssueFieldsSchemeV2{
+			Description: "Issue description",
+			Reporter:    &UserScheme{AccountID: "5b10ac8d82e05b22cc7d4ef5", Active: true},
+			Created:     &DateTimeScheme{},
+		},
+	}
+
+	got, err := issue.ToEditPayload()
+	assert.NoError(t, err)
+	assert.Equal(t, &IssueFieldsSchemeV2{
+		Description: "Issue description",
+		Reporter:    &UserScheme{AccountID: "5b10ac8d82e05b22cc7d4ef5"},
+	}, got.Fields)
+}
@@ -0,0 +1,66 @@
+package models
+
+import "encoding/json"
+
+// IssuePickerOptionsScheme represents the query parameters used to get the issue picker suggestions.
+type IssuePickerOptionsScheme struct {
+	// Query is the text to search for in the issue key, summary and description.
+	Query string `url:"query,omitempty"`
+	// CurrentJQL is a JQL query defining a list of issues to search for the query term.
+	CurrentJQL string `url:"currentJQL,omitempty"`
+	// CurrentIssueKey is the key of an issue to exclude from search results.
+	CurrentIssueKey string `url:"currentIssueKey,omitempty"`
+	// CurrentProjectID is the ID of a project that suggested issues must belong to.
+	CurrentProjectID string `url:"currentProjectId,omitempty"`
+	// ShowSubTasks indicates whether subtasks should be included in the suggestions list.
+	ShowSubTasks bool `url:"showSubTasks,omitempty"`
+	// ShowSubTaskParent indicates whether to include the parent of the CurrentIssueKey issue, if it's a subtask.
+	ShowSubTaskParent bool `url:"showSubTaskParent,omitempty"`
+}
+
+// IssuePickerScheme represents the issue picker suggestions grouped by section.
+type IssuePickerScheme struct {
+	Sections []*IssuePickerSectionScheme `json:"sections,omitempty"` // The list of suggested issue sections.
+}
+
+// IssuePickerSectionScheme represents a section of the issue picker suggestions, e.g. "History Search" or "Current Search".
+type IssuePickerSectionScheme struct {
+	ID     string                         `json:"id,omitempty"`     // The ID of the section.
+	Label  string                         `json:"label,omitempty"`  // The label of the section.
+	Msg    string                         `json:"msg,omitempty"`    // A message about the section.
+	Sub    string                         `json:"sub,omitempty"`    // The sub-title of the section.
+	Issues []*IssuePickerSuggestionScheme `json:"issues,omitempty"` // The list of issues suggested in the section.
+}
+
+// IssuePickerSuggestionScheme represents an issue suggested by the issue picker.
+//
+// Jira highlights the matched query string using HTML tags on the Summary and KeyHTML fields,
+// SummaryText always contains the summary with the HTML highlighting stripped.
+type IssuePickerSuggestionScheme struct {
+	ID          int    `json:"id,omitempty"`          // The ID of the issue.
+	Img         string `json:"img,omitempty"`         // The URL of the issue type's avatar.
+	Key         string `json:"key,omitempty"`         // The key of the issue.
+	KeyHTML     string `json:"keyHtml,omitempty"`     // The key of the issue in HTML format.
+	Summary     string `json:"summary,omitempty"`     // The phrase containing the query string in HTML format, with the string highlighted with HTML bold tags.
+	SummaryText string `json:"summaryText,omitempty"` // The phrase containing the query string, as plain text.
+}
+
+// UnmarshalJSON decodes the suggestion and makes sure the SummaryText field is free of HTML highlighting.
+func (i *IssuePickerSuggestionScheme) UnmarshalJSON(data []byte) error {
+
+	type alias IssuePickerSuggestionScheme
+
+	decoded := new(alias)
+	if err := json.Unmarshal(data, decoded); err != nil {
+		return err
+	}
+
+	*i = IssuePickerSuggestionScheme(*decoded)
+
+	if i.SummaryText == "" {
+		i.SummaryText = i.Summary
+	}
+
+	i.SummaryText = stripHTMLTags(i.SummaryText)
+	return nil
+}
@@ -0,0 +1,61 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssuePickerSuggestionScheme_UnmarshalJSON(t *testing.T) {
+
+	testCases := []struct {
+		name    string
+		data    string
+		want    *IssuePickerSuggestionScheme
+		wantErr bool
+	}{
+		{
+			name: "when the summary text is highlighted",
+			data: `{"id":10001,"key":"KP-1","keyHtml":"KP-1","summary":"Fix the <b>login</b> page","summaryText":"Fix the <b>login</b> page"}`,
+			want: &IssuePickerSuggestionScheme{
+				ID:          10001,
+				Key:         "KP-1",
+				KeyHTML:     "KP-1",
+				Summary:     "Fix the <b>login</b> page",
+				SummaryText: "Fix the login page",
+			},
+		},
+		{
+			name: "when the summary text is not provided",
+			data: `{"id":10002,"key":"KP-2","summary":"Tom &amp; Jerry <b>login</b>"}`,
+			want: &IssuePickerSuggestionScheme{
+				ID:          10002,
+				Key:         "KP-2",
+				Summary:     "Tom &amp; Jerry <b>login</b>",
+				SummaryText: "Tom & Jerry login",
+			},
+		},
+		{
+			name:    "when the payload is invalid",
+			data:    `{"id":"invalid"}`,
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			got := new(IssuePickerSuggestionScheme)
+			err := json.Unmarshal([]byte(testCase.data), got)
+
+			if testCase.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.want, got)
+		})
+	}
+}
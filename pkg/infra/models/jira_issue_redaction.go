@@ -0,0 +1,134 @@
+package models
+
+import "regexp"
+
+// IssueRedactionReplacement is the text used by default to replace the redacted matches.
+const IssueRedactionReplacement = "[REDACTED]"
+
+// The fields rewritten by the issue redaction.
+const (
+	IssueRedactionFieldDescription = IssueFieldDescription // The description of the issue.
+	IssueRedactionFieldComment     = IssueFieldComment     // A comment of the issue.
+)
+
+// IssueRedactionOptionsScheme represents the options to redact the content of an issue in Jira.
+type IssueRedactionOptionsScheme struct {
+	Patterns    []*regexp.Regexp // The patterns to redact, e.g. tokens or passwords.
+	Replacement string           // The text replacing the matches, IssueRedactionReplacement when empty.
+	DryRun      bool             // Reports the redactions without updating the issue.
+	NotifyUsers bool             // Indicates if the watchers are notified of the description and comment updates.
+}
+
+// RedactText replaces the matches of the patterns in the text, and returns the redacted text and the number of matches.
+func (o *IssueRedactionOptionsScheme) RedactText(text string) (string, int) {
+
+	replacement := o.Replacement
+	if replacement == "" {
+		replacement = IssueRedactionReplacement
+	}
+
+	var matches int
+	for _, pattern := range o.Patterns {
+
+		if pattern == nil {
+			continue
+		}
+
+		found := pattern.FindAllStringIndex(text, -1)
+		if len(found) == 0 {
+			continue
+		}
+
+		matches += len(found)
+		text = pattern.ReplaceAllLiteralString(text, replacement)
+	}
+
+	return text, matches
+}
+
+// RedactNode replaces the matches of the patterns in the ADF document, and returns the number of matches.
+//
+// The text and the string attributes of the nodes and their marks are redacted, e.g. the href of a link or the url of
+// a card. The node is rewritten in place, a match split across several text nodes, e.g. because of a mark, isn't
+// redacted.
+func (o *IssueRedactionOptionsScheme) RedactNode(node *CommentNodeScheme) int {
+
+	if node == nil {
+		return 0
+	}
+
+	var matches, found int
+	if node.Text != "" {
+		node.Text, found = o.RedactText(node.Text)
+		matches += found
+	}
+
+	matches += o.redactAttrs(node.Attrs)
+	for _, mark := range node.Marks {
+		if mark != nil {
+			matches += o.redactAttrs(mark.Attrs)
+		}
+	}
+
+	for _, child := range node.Content {
+		matches += o.RedactNode(child)
+	}
+
+	return matches
+}
+
+// redactAttrs replaces the matches of the patterns in the string values of the ADF attributes, including the nested
+// ones, and returns the number of matches.
+func (o *IssueRedactionOptionsScheme) redactAttrs(attrs map[string]interface{}) int {
+
+	var matches int
+	for name, value := range attrs {
+
+		var found int
+		attrs[name], found = o.redactAttr(value)
+		matches += found
+	}
+
+	return matches
+}
+
+// redactAttr returns the ADF attribute value with the matches of the patterns replaced, and the number of matches.
+func (o *IssueRedactionOptionsScheme) redactAttr(value interface{}) (interface{}, int) {
+
+	switch value := value.(type) {
+	case string:
+		return o.RedactText(value)
+
+	case map[string]interface{}:
+		return value, o.redactAttrs(value)
+
+	case []interface{}:
+
+		var matches int
+		for index, item := range value {
+
+			var found int
+			value[index], found = o.redactAttr(item)
+			matches += found
+		}
+
+		return value, matches
+	}
+
+	return value, 0
+}
+
+// IssueRedactionScheme represents the result of the redaction of an issue in Jira.
+type IssueRedactionScheme struct {
+	IssueKeyOrID string                        // The key or ID of the issue.
+	DryRun       bool                          // Indicates if the issue was left untouched.
+	Redactions   []*IssueRedactionChangeScheme // The description and comments with matches.
+}
+
+// IssueRedactionChangeScheme represents the redaction of the description or a comment of an issue in Jira.
+type IssueRedactionChangeScheme struct {
+	Field     string // The rewritten field, see the IssueRedactionField constants.
+	CommentID string // The ID of the comment, only set for comments.
+	Matches   int    // The number of redacted matches, the matches themselves aren't reported.
+	Applied   bool   // Indicates if the field was updated on Jira.
+}
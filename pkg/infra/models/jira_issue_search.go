@@ -16,3 +16,18 @@ type IssueMatchesScheme struct {
 	MatchedIssues []int    `json:"matchedIssues,omitempty"` // The matched issues.
 	Errors        []string `json:"errors,omitempty"`        // The errors occurred during the matching process.
 }
+
+// IssueSearchJQLPayloadScheme represents the payload of the JQL search.
+//
+// The query is sent in the request body, so long JQL queries and field lists don't hit the URL length limits.
+type IssueSearchJQLPayloadScheme struct {
+	JQL             string   `json:"jql,omitempty"`             // The JQL query that selects the issues.
+	NextPageToken   string   `json:"nextPageToken,omitempty"`   // The token of the page to fetch, returned by the previous page.
+	MaxResults      int      `json:"maxResults,omitempty"`      // The maximum number of issues to return per page.
+	Fields          []string `json:"fields,omitempty"`          // The fields to return for each issue, e.g. *all or *navigable.
+	Expand          string   `json:"expand,omitempty"`          // The comma-separated expansions, e.g. names,schema,changelog.
+	Properties      []string `json:"properties,omitempty"`      // The issue properties to return for each issue.
+	FieldsByKeys    bool     `json:"fieldsByKeys,omitempty"`    // Indicates if the fields are referenced by keys rather than IDs.
+	FailFast        bool     `json:"failFast,omitempty"`        // Indicates if the search fails when a field value can't be retrieved.
+	ReconcileIssues []int    `json:"reconcileIssues,omitempty"` // The IDs of the issues to reconcile with the search results, for read-after-write consistency.
+}
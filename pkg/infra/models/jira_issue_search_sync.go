@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// IssueSearchSyncOptionsScheme represents the options of an incremental issue sync.
+type IssueSearchSyncOptionsScheme struct {
+	JQL        string         // The JQL scope of the sync, e.g. project = KP. It must not have an ORDER BY clause.
+	Since      time.Time      // The checkpoint of the previous sync, the issues updated at or after it are returned.
+	Location   *time.Location // The time zone of the user, used to write the JQL dates. Defaults to the time zone of the current user.
+	KnownKeys  []string       // The issue keys already synced, e.g. stored by the data warehouse, used to detect the deleted issues.
+	MaxResults int            // The number of issues fetched per page.
+}
+
+// IssueSearchSyncScheme represents the issue keys changed since the checkpoint of an incremental sync.
+type IssueSearchSyncScheme struct {
+	Created    []string  // The keys of the issues created at or after the checkpoint.
+	Updated    []string  // The keys of the issues created before the checkpoint and updated at or after it.
+	Deleted    []string  // The known keys no longer part of the scope, because the issue was deleted, moved or doesn't match the JQL anymore.
+	Checkpoint time.Time // The checkpoint to use as Since on the next sync.
+}
@@ -1,5 +1,7 @@
 package models
 
+import "errors"
+
 // IssueSecurityLevelsScheme represents the security levels of an issue in Jira.
 type IssueSecurityLevelsScheme struct {
 	Levels []*IssueSecurityLevelScheme `json:"levels,omitempty"` // The security levels of the issue.
@@ -12,3 +14,62 @@ type IssueSecurityLevelScheme struct {
 	Description string `json:"description,omitempty"` // The description of the security level.
 	Name        string `json:"name,omitempty"`        // The name of the security level.
 }
+
+// IssueSecurityLevelUpdateConcurrency is the number of issues updated at the same time by default.
+const IssueSecurityLevelUpdateConcurrency = 5
+
+// IssueSecuritySchemeAssignPayloadScheme represents the payload to assign an issue security scheme to a project in Jira.
+type IssueSecuritySchemeAssignPayloadScheme struct {
+	ProjectID string `json:"projectId"` // The ID of the project.
+	SchemeID  string `json:"schemeId"`  // The ID of the issue security scheme, "-1" removes the scheme from the project.
+
+	// The security levels of the issues mapped to the levels of the new scheme, required when the project already has a scheme.
+	OldToNewSecurityLevelMappings []*IssueSecurityLevelMappingScheme `json:"oldToNewSecurityLevelMappings,omitempty"`
+}
+
+// IssueSecurityLevelMappingScheme represents the mapping of a security level of the old scheme to a level of the new scheme in Jira.
+type IssueSecurityLevelMappingScheme struct {
+	OldLevelID string `json:"oldLevelId"` // The ID of the security level of the old scheme, "" for the issues without security level.
+	NewLevelID string `json:"newLevelId"` // The ID of the security level of the new scheme, "" to remove the security level.
+}
+
+// IssueSecurityLevelUpdateOptionsScheme represents the options to set the security level of the issues matching a JQL query in Jira.
+type IssueSecurityLevelUpdateOptionsScheme struct {
+	Concurrency int  // The number of issues updated at the same time, IssueSecurityLevelUpdateConcurrency when zero.
+	Rate        int  // The maximum number of issues updated per second, unlimited when zero.
+	Notify      bool // Sends the issue updated notifications to the watchers.
+	DryRun      bool // Reports the issues matching the query without updating them.
+}
+
+// IssueSecurityLevelUpdateScheme represents the result of setting the security level of the issues matching a JQL query in Jira.
+type IssueSecurityLevelUpdateScheme struct {
+	JQL     string                                 // The JQL query of the updated issues.
+	LevelID string                                 // The ID of the security level set on the issues.
+	DryRun  bool                                   // Indicates if the issues were only reported.
+	Issues  []*IssueSecurityLevelIssueUpdateScheme // The issues matching the query, in the order returned by the search.
+}
+
+// IssueSecurityLevelIssueUpdateScheme represents the update of the security level of an issue in Jira.
+type IssueSecurityLevelIssueUpdateScheme struct {
+	IssueKey        string // The key of the issue.
+	PreviousLevelID string // The ID of the security level of the issue before the update, "" when it had none.
+	Skipped         bool   // Indicates if the issue already had the security level and wasn't updated.
+	Err             error  // The error that prevented the issue from being updated, if any.
+}
+
+// Err returns the errors of the issues that couldn't be updated, or nil if every issue was updated.
+func (u *IssueSecurityLevelUpdateScheme) Err() error {
+
+	if u == nil {
+		return nil
+	}
+
+	var errs []error
+	for _, issue := range u.Issues {
+		if issue.Err != nil {
+			errs = append(errs, issue.Err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
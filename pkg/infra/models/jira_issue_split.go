@@ -0,0 +1,44 @@
+package models
+
+// The items moved by the issue split.
+const (
+	IssueSplitItemComment    = "comment"    // A comment, copied to the new issue.
+	IssueSplitItemAttachment = "attachment" // An attachment, re-uploaded to the new issue.
+	IssueSplitItemLink       = "link"       // An issue link, recreated on the new issue.
+	IssueSplitItemSubtask    = "subtask"    // A subtask, moved under the new issue.
+)
+
+// IssueSplitOptionsScheme represents the items of an issue moved to the new issue created by a split in Jira.
+type IssueSplitOptionsScheme struct {
+	CommentIDs    []string // The IDs of the comments copied to the new issue, the original comments are kept.
+	AttachmentIDs []string // The IDs of the attachments re-uploaded to the new issue.
+	LinkIDs       []string // The IDs of the issue links recreated on the new issue.
+	SubtaskKeys   []string // The keys or IDs of the subtasks moved under the new issue.
+
+	// RemoveOriginals deletes the attachments and issue links from the original issue once they're moved.
+	RemoveOriginals bool
+
+	// LinkType is the name of the issue link type linking the new issue to the original issue, e.g. Relates or Cloners,
+	// the new issue being the outward issue. The issues aren't linked when it's empty.
+	LinkType string
+}
+
+// IssueSplitScheme represents the result of the split of an issue in Jira.
+//
+// The items that can't be moved don't stop the split, they're reported in Failures.
+type IssueSplitScheme struct {
+	IssueKeyOrID string                     // The key or ID of the original issue.
+	Issue        *IssueResponseScheme       // The new issue.
+	Comments     map[string]string          // The IDs of the copied comments, mapped to the IDs of the copies.
+	Attachments  map[string]string          // The IDs of the re-uploaded attachments, mapped to the IDs of the uploads.
+	Links        map[string]string          // The IDs of the recreated links, mapped to the IDs of the new links when returned.
+	Subtasks     []string                   // The keys or IDs of the moved subtasks.
+	Failures     []*IssueSplitFailureScheme // The items that couldn't be moved.
+}
+
+// IssueSplitFailureScheme represents an item that couldn't be moved by the split of an issue in Jira.
+type IssueSplitFailureScheme struct {
+	Item string // The kind of item, see the IssueSplitItem constants.
+	ID   string // The ID of the item, or the key of the subtask.
+	Err  error  // The error returned by Jira.
+}
@@ -0,0 +1,64 @@
+package models
+
+import (
+	"bytes"
+	"strconv"
+)
+
+// The styles of a Jira project.
+const (
+	ProjectStyleCompanyManaged = "classic"  // Company-managed project, formerly known as classic.
+	ProjectStyleTeamManaged    = "next-gen" // Team-managed project, formerly known as next-gen.
+)
+
+// IsTeamManaged reports whether the project is a team-managed (next-gen) project.
+func (p *ProjectScheme) IsTeamManaged() bool {
+
+	if p == nil {
+		return false
+	}
+
+	return p.Style == ProjectStyleTeamManaged || p.Simplified
+}
+
+// NewParentScheme returns the parent used on the create and edit payloads.
+//
+// The parent is identified by the ID when issueKeyOrID is numeric, otherwise by the key.
+//
+// On team-managed projects, the parent field links any issue to an issue of a higher hierarchy level, e.g. a story to an epic.
+//
+// On company-managed projects, the parent field links sub-tasks to their parent and, on sites where the Epic Link
+// field was replaced, issues to their epic; otherwise use CustomFields.EpicLink.
+func NewParentScheme(issueKeyOrID string) *ParentScheme {
+
+	if _, err := strconv.Atoi(issueKeyOrID); err == nil {
+		return &ParentScheme{ID: issueKeyOrID}
+	}
+
+	return &ParentScheme{Key: issueKeyOrID}
+}
+
+// EpicLink adds the Epic Link custom field used by company-managed projects to the collection.
+//
+// Team-managed projects don't have the Epic Link field, the epic is set with the parent field, see NewParentScheme.
+func (c *CustomFields) EpicLink(customFieldID, epicKey string) error {
+
+	if len(customFieldID) == 0 {
+		return ErrNoFieldID
+	}
+
+	if len(epicKey) == 0 {
+		return ErrNoEpicKey
+	}
+
+	return c.Raw(customFieldID, epicKey)
+}
+
+// ParseStoryPointEstimateCustomField parses the Story point estimate field used by team-managed projects.
+//
+// The field ID is site-specific, e.g. customfield_10016, and can be found with the Issue.Field.Gets method.
+//
+// Docs: https://docs.go-atlassian.io/cookbooks/extract-customfields-from-issue-s#parse-float-customfield
+func ParseStoryPointEstimateCustomField(buffer bytes.Buffer, customField string) (float64, error) {
+	return ParseFloatCustomField(buffer, customField)
+}
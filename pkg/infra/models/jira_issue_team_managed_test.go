@@ -0,0 +1,126 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProjectScheme_IsTeamManaged(t *testing.T) {
+
+	testCases := []struct {
+		name    string
+		project *ProjectScheme
+		want    bool
+	}{
+		{
+			name:    "when the project style is next-gen",
+			project: &ProjectScheme{Style: ProjectStyleTeamManaged},
+			want:    true,
+		},
+		{
+			name:    "when the project is simplified",
+			project: &ProjectScheme{Simplified: true},
+			want:    true,
+		},
+		{
+			name:    "when the project style is classic",
+			project: &ProjectScheme{Style: ProjectStyleCompanyManaged},
+			want:    false,
+		},
+		{
+			name:    "when the project is nil",
+			project: nil,
+			want:    false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.want, testCase.project.IsTeamManaged())
+		})
+	}
+}
+
+func TestNewParentScheme(t *testing.T) {
+
+	assert.Equal(t, &ParentScheme{Key: "KP-1"}, NewParentScheme("KP-1"))
+	assert.Equal(t, &ParentScheme{ID: "10001"}, NewParentScheme("10001"))
+
+	payload, err := json.Marshal(&IssueScheme{Fields: &IssueFieldsScheme{Parent: NewParentScheme("KP-1")}})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"fields":{"parent":{"key":"KP-1"}}}`, string(payload))
+}
+
+func TestCustomFields_EpicLink(t *testing.T) {
+
+	testCases := []struct {
+		name          string
+		customFieldID string
+		epicKey       string
+		wantErr       bool
+		Err           error
+	}{
+		{
+			name:          "when the parameters are correct",
+			customFieldID: "customfield_10014",
+			epicKey:       "KP-1",
+		},
+		{
+			name:          "when the custom-field is not provided",
+			customFieldID: "",
+			epicKey:       "KP-1",
+			wantErr:       true,
+			Err:           ErrNoFieldID,
+		},
+		{
+			name:          "when the epic key is not provided",
+			customFieldID: "customfield_10014",
+			epicKey:       "",
+			wantErr:       true,
+			Err:           ErrNoEpicKey,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			c := &CustomFields{}
+			err := c.EpicLink(testCase.customFieldID, testCase.epicKey)
+
+			if testCase.wantErr {
+				assert.ErrorIs(t, err, testCase.Err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, []map[string]interface{}{{"fields": map[string]interface{}{"customfield_10014": "KP-1"}}}, c.Fields)
+		})
+	}
+}
+
+func TestIssueFieldsScheme_TeamManaged(t *testing.T) {
+
+	data := `{
+		"key": "KP-2",
+		"fields": {
+			"parent": {"id": "10001", "key": "KP-1", "fields": {"summary": "Epic", "issuetype": {"name": "Epic", "hierarchyLevel": 1}}},
+			"sprint": {"id": 4, "state": "active", "name": "KP Sprint 4", "originBoardId": 2},
+			"closedSprints": [{"id": 3, "state": "closed", "name": "KP Sprint 3"}],
+			"customfield_10016": 5
+		}
+	}`
+
+	issue := new(IssueScheme)
+	assert.NoError(t, json.Unmarshal([]byte(data), issue))
+	assert.Equal(t, "KP-1", issue.Fields.Parent.Key)
+	assert.Equal(t, 1, issue.Fields.Parent.Fields.IssueType.HierarchyLevel)
+	assert.Equal(t, &SprintDetailScheme{ID: 4, State: "active", Name: "KP Sprint 4", OriginBoardID: 2}, issue.Fields.Sprint)
+	assert.Len(t, issue.Fields.ClosedSprints, 1)
+
+	estimate, err := ParseStoryPointEstimateCustomField(*bytes.NewBufferString(data), "customfield_10016")
+	assert.NoError(t, err)
+	assert.Equal(t, float64(5), estimate)
+}
@@ -0,0 +1,27 @@
+package models
+
+// IssueTransitionToMaxSteps is the maximum number of transitions performed by default to reach the target status.
+const IssueTransitionToMaxSteps = 10
+
+// IssueTransitionToOptionsScheme represents the options to transition an issue to a target status in Jira.
+//
+// Without a path or a workflow graph, the target status must be reachable with one of the available transitions.
+type IssueTransitionToOptionsScheme struct {
+	// Path is the names of the intermediate statuses, visited in order before the target status.
+	Path []string
+
+	// Workflow is the workflow graph, the names of the statuses reachable from each status name.
+	// The shortest route to the target status is planned again after each transition.
+	Workflow map[string][]string
+
+	// MaxSteps is the maximum number of transitions performed, IssueTransitionToMaxSteps when zero.
+	MaxSteps int
+}
+
+// IssueTransitionToScheme represents the result of the transition of an issue to a target status in Jira.
+type IssueTransitionToScheme struct {
+	IssueKeyOrID string                   // The key or ID of the issue.
+	From         string                   // The name of the status before the transitions.
+	To           string                   // The name of the status after the transitions.
+	Transitions  []*IssueTransitionScheme // The transitions performed, in order.
+}
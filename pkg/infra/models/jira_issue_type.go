@@ -14,9 +14,62 @@ type IssueTypeScheme struct {
 	Scope          *IssueTypeScopeScheme `json:"scope,omitempty"`          // The scope of the issue type.
 }
 
+// IssueTypeScopeProject is the type of the scope of the issue types scoped to a team-managed project.
+const IssueTypeScopeProject = "PROJECT"
+
+// IsProjectScoped reports whether the issue type is scoped to a team-managed project.
+// The issue types without a scope are global.
+func (i *IssueTypeScheme) IsProjectScoped() bool {
+	return i != nil && i.Scope != nil && i.Scope.Type == IssueTypeScopeProject
+}
+
+// ScopeProjectID returns the ID of the team-managed project the issue type is scoped to,
+// or an empty string if the issue type is global.
+func (i *IssueTypeScheme) ScopeProjectID() string {
+
+	if !i.IsProjectScoped() || i.Scope.Project == nil {
+		return ""
+	}
+
+	return i.Scope.Project.ID
+}
+
+// GlobalIssueTypes returns the issue types that are not scoped to a project.
+func GlobalIssueTypes(issueTypes []*IssueTypeScheme) []*IssueTypeScheme {
+
+	var global []*IssueTypeScheme
+	for _, issueType := range issueTypes {
+
+		if issueType != nil && !issueType.IsProjectScoped() {
+			global = append(global, issueType)
+		}
+	}
+
+	return global
+}
+
+// ProjectScopedIssueTypes returns the issue types scoped to the team-managed project,
+// or the issue types scoped to any project if the project ID is empty.
+func ProjectScopedIssueTypes(issueTypes []*IssueTypeScheme, projectID string) []*IssueTypeScheme {
+
+	var scoped []*IssueTypeScheme
+	for _, issueType := range issueTypes {
+
+		if !issueType.IsProjectScoped() {
+			continue
+		}
+
+		if projectID == "" || issueType.ScopeProjectID() == projectID {
+			scoped = append(scoped, issueType)
+		}
+	}
+
+	return scoped
+}
+
 // IssueTypeScopeScheme represents the scope of an issue type in Jira.
 type IssueTypeScopeScheme struct {
-	Type    string         `json:"type,omitempty"`    // The type of the scope.
+	Type    string         `json:"type,omitempty"`    // The type of the scope, PROJECT for the issue types scoped to a team-managed project.
 	Project *ProjectScheme `json:"project,omitempty"` // The project of the scope.
 }
 
@@ -37,6 +37,26 @@ type IssueTypeSchemeItemPageScheme struct {
 	Values     []*IssueTypeSchemeMappingScheme `json:"values,omitempty"`     // The issue type scheme items in the page.
 }
 
+// Order returns the IDs of the issue types of each issue type scheme on the page, keyed by the scheme ID.
+//
+// The issue types are listed in the order of the scheme, as returned by Jira.
+func (i *IssueTypeSchemeItemPageScheme) Order() map[string][]string {
+
+	order := make(map[string][]string)
+
+	if i == nil {
+		return order
+	}
+
+	for _, item := range i.Values {
+		if item != nil {
+			order[item.IssueTypeSchemeID] = append(order[item.IssueTypeSchemeID], item.IssueTypeID)
+		}
+	}
+
+	return order
+}
+
 // IssueTypeSchemeMappingScheme represents a mapping of an issue type scheme in Jira.
 type IssueTypeSchemeMappingScheme struct {
 	IssueTypeSchemeID string `json:"issueTypeSchemeId,omitempty"` // The ID of the issue type scheme.
@@ -57,3 +77,26 @@ type IssueTypeSchemeProjectsScheme struct {
 	IssueTypeScheme *IssueTypeSchemeScheme `json:"issueTypeScheme,omitempty"` // The issue type scheme.
 	ProjectIDs      []string               `json:"projectIds,omitempty"`      // The IDs of the projects.
 }
+
+// IssueTypeSchemeProjectMappingScheme represents the issue type schemes used by a set of projects in Jira.
+type IssueTypeSchemeProjectMappingScheme struct {
+	Schemes  []*IssueTypeSchemeAssignmentScheme          // The issue type schemes used by the projects.
+	Projects map[string]*IssueTypeSchemeAssignmentScheme // The issue type scheme of each project, keyed by the project ID.
+}
+
+// Scheme returns the issue type scheme used by the project, or nil if the project wasn't found.
+func (i *IssueTypeSchemeProjectMappingScheme) Scheme(projectID string) *IssueTypeSchemeAssignmentScheme {
+
+	if i == nil {
+		return nil
+	}
+
+	return i.Projects[projectID]
+}
+
+// IssueTypeSchemeAssignmentScheme represents an issue type scheme with the projects using it in Jira.
+type IssueTypeSchemeAssignmentScheme struct {
+	Scheme       *IssueTypeSchemeScheme // The issue type scheme, including the ID of its default issue type.
+	ProjectIDs   []string               // The IDs of the requested projects using the scheme.
+	IssueTypeIDs []string               // The IDs of the issue types of the scheme, in the order of the scheme.
+}
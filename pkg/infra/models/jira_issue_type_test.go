@@ -0,0 +1,27 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssueTypeScope(t *testing.T) {
+
+	bug := &IssueTypeScheme{ID: "10001", Name: "Bug"}
+	task := &IssueTypeScheme{ID: "10002", Name: "Task", Scope: &IssueTypeScopeScheme{
+		Type: IssueTypeScopeProject, Project: &ProjectScheme{ID: "10000"}}}
+	story := &IssueTypeScheme{ID: "10003", Name: "Story", Scope: &IssueTypeScopeScheme{
+		Type: IssueTypeScopeProject, Project: &ProjectScheme{ID: "10010"}}}
+
+	issueTypes := []*IssueTypeScheme{bug, task, nil, story}
+
+	assert.False(t, bug.IsProjectScoped())
+	assert.Equal(t, "", bug.ScopeProjectID())
+	assert.True(t, task.IsProjectScoped())
+	assert.Equal(t, "10000", task.ScopeProjectID())
+
+	assert.Equal(t, []*IssueTypeScheme{bug}, GlobalIssueTypes(issueTypes))
+	assert.Equal(t, []*IssueTypeScheme{task}, ProjectScopedIssueTypes(issueTypes, "10000"))
+	assert.Equal(t, []*IssueTypeScheme{task, story}, ProjectScopedIssueTypes(issueTypes, ""))
+}
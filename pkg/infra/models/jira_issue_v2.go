@@ -133,6 +133,8 @@ type IssueFieldsSchemeV2 struct {
 	Security                 *SecurityScheme                 `json:"security,omitempty"`
 	Worklog                  *IssueWorklogRichTextPageScheme `json:"worklog,omitempty"`
 	DueDate                  *DateScheme                     `json:"duedate,omitempty"`
+	Sprint                   *SprintDetailScheme             `json:"sprint,omitempty"`
+	ClosedSprints            []*SprintDetailScheme           `json:"closedSprints,omitempty"`
 }
 
 // ParentScheme represents the parent of an issue in Jira.
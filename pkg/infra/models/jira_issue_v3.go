@@ -134,6 +134,8 @@ type IssueFieldsScheme struct {
 	Attachment               []*AttachmentScheme        `json:"attachment,omitempty"`               // The attachments of the issue.
 	Worklog                  *IssueWorklogADFPageScheme `json:"worklog,omitempty"`                  // The worklog of the issue.
 	DueDate                  *DateScheme                `json:"duedate,omitempty"`                  // The due date of the issue.
+	Sprint                   *SprintDetailScheme        `json:"sprint,omitempty"`                   // The active sprint of the issue, returned by the Agile API.
+	ClosedSprints            []*SprintDetailScheme      `json:"closedSprints,omitempty"`            // The closed sprints of the issue, returned by the Agile API.
 }
 
 // IssueTransitionScheme represents a transition of an issue in Jira.
@@ -17,6 +17,24 @@ type LinkTypeScheme struct {
 	Outward string `json:"outward,omitempty"` // The outward description of the link type.
 }
 
+// LinkTypeRenameOptionsScheme represents the options to rename an issue link type in Jira.
+type LinkTypeRenameOptionsScheme struct {
+	// MaxUsage is the maximum number of issues linked with the link type for the rename to proceed.
+	// Only the unused link types are renamed when zero, the usage is not limited when negative.
+	MaxUsage int
+
+	// DryRun reports the usage of the link type without renaming it.
+	DryRun bool
+}
+
+// LinkTypeRenameScheme represents the result of the rename of an issue link type in Jira.
+type LinkTypeRenameScheme struct {
+	Before  *LinkTypeScheme // The link type before the rename.
+	After   *LinkTypeScheme // The link type after the rename, nil when it wasn't renamed.
+	Usage   int             // The approximate number of issues linked with the link type.
+	Renamed bool            // Indicates if the link type was renamed.
+}
+
 // LinkedIssueScheme represents a linked issue in Jira.
 type LinkedIssueScheme struct {
 	ID     string                 `json:"id,omitempty"`     // The ID of the linked issue.
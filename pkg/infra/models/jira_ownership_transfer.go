@@ -0,0 +1,84 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// OwnershipTransferOptionsScheme represents the options to transfer the filters and dashboards of a user in Jira.
+type OwnershipTransferOptionsScheme struct {
+	SkipFilters    bool // Leaves the filters of the user untouched.
+	SkipDashboards bool // Leaves the dashboards of the user untouched.
+	DryRun         bool // Reports the filters and dashboards owned by the user without transferring them.
+}
+
+// OwnershipTransferScheme represents the transfer of the filters and dashboards of a user to another user in Jira.
+type OwnershipTransferScheme struct {
+	From       string                         // The account ID of the previous owner.
+	To         string                         // The account ID of the new owner.
+	DryRun     bool                           // Indicates if the filters and dashboards were only reported.
+	Filters    []*OwnershipTransferItemScheme // The filters owned by the previous owner.
+	Dashboards []*OwnershipTransferItemScheme // The dashboards owned by the previous owner.
+}
+
+// OwnershipTransferItemScheme represents the transfer of a filter or a dashboard in Jira.
+type OwnershipTransferItemScheme struct {
+	ID   string // The ID of the filter or dashboard.
+	Name string // The name of the filter or dashboard.
+	Err  error  // The error that prevented the transfer, if any.
+}
+
+// Err returns the errors of the filters and dashboards that couldn't be transferred, or nil if every one was transferred.
+func (t *OwnershipTransferScheme) Err() error {
+
+	if t == nil {
+		return nil
+	}
+
+	var errs []error
+	for _, item := range append(append([]*OwnershipTransferItemScheme{}, t.Filters...), t.Dashboards...) {
+		if item.Err != nil {
+			errs = append(errs, item.Err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Report returns a human-readable report of the transfer, one line per filter and dashboard.
+func (t *OwnershipTransferScheme) Report() string {
+
+	if t == nil {
+		return ""
+	}
+
+	var report strings.Builder
+	fmt.Fprintf(&report, "ownership transfer from %v to %v", t.From, t.To)
+	if t.DryRun {
+		report.WriteString(" (dry run)")
+	}
+	report.WriteString("\n")
+
+	write := func(kind string, items []*OwnershipTransferItemScheme) {
+		for _, item := range items {
+
+			status := "transferred"
+			switch {
+			case item.Err != nil:
+				status = "failed: " + item.Err.Error()
+			case t.DryRun:
+				status = "to transfer"
+			}
+
+			fmt.Fprintf(&report, "%v %v %q: %v\n", kind, item.ID, item.Name, status)
+		}
+	}
+
+	write("filter", t.Filters)
+	write("dashboard", t.Dashboards)
+
+	fmt.Fprintf(&report, "%v filters, %v dashboards\n", len(t.Filters), len(t.Dashboards))
+
+	return report.String()
+}
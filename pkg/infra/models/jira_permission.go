@@ -51,9 +51,16 @@ type PermissionGrantScheme struct {
 
 // PermissionGrantHolderScheme represents a holder of a permission grant in Jira.
 type PermissionGrantHolderScheme struct {
-	Type      string `json:"type,omitempty"`      // The type of the holder.
-	Parameter string `json:"parameter,omitempty"` // The parameter of the holder.
+	Type      string `json:"type,omitempty"`      // The type of the holder, see the PermissionHolderType constants.
+	Parameter string `json:"parameter,omitempty"` // The parameter of the holder, e.g. the account ID, project role ID or custom field ID.
+	Value     string `json:"value,omitempty"`     // The identifier associated with the holder type, e.g. the group ID.
 	Expand    string `json:"expand,omitempty"`    // The expand option for the holder.
+
+	User            *UserScheme            `json:"user,omitempty"`            // The user holder, returned when the "user" expand is used.
+	Group           *GroupDetailScheme     `json:"group,omitempty"`           // The group holder, returned when the "group" expand is used.
+	ProjectRole     *ProjectRoleScheme     `json:"projectRole,omitempty"`     // The project role holder, returned when the "projectRole" expand is used.
+	Field           *IssueFieldScheme      `json:"field,omitempty"`           // The custom field holder, returned when the "field" expand is used.
+	ApplicationRole *ApplicationRoleScheme `json:"applicationRole,omitempty"` // The application role holder, returned when the "all" expand is used.
 }
 
 // PermissionGrantPayloadScheme represents the payload for a permission grant in Jira.
@@ -66,3 +73,39 @@ type PermissionGrantPayloadScheme struct {
 type PermittedProjectsScheme struct {
 	Projects []*ProjectIdentifierScheme `json:"projects,omitempty"` // The permitted projects.
 }
+
+// MyPermissionsOptionsScheme represents the options to get the permissions of the current user in Jira.
+type MyPermissionsOptionsScheme struct {
+	Permissions []string // The keys of the permissions to check, e.g. BROWSE_PROJECTS.
+	ProjectKey  string   // The key of the project the project permissions are checked for.
+	ProjectID   string   // The ID of the project the project permissions are checked for.
+	IssueKey    string   // The key of the issue the project permissions are checked for.
+	IssueID     string   // The ID of the issue the project permissions are checked for.
+}
+
+// MyPermissionsScheme represents the permissions of the current user in Jira.
+type MyPermissionsScheme struct {
+	Permissions map[string]*MyPermissionScheme `json:"permissions,omitempty"` // The permissions, keyed by the permission key.
+}
+
+// Has reports whether the current user has the permission.
+func (m *MyPermissionsScheme) Has(permissionKey string) bool {
+
+	if m == nil {
+		return false
+	}
+
+	permission, ok := m.Permissions[permissionKey]
+	return ok && permission != nil && permission.HavePermission
+}
+
+// MyPermissionScheme represents a permission of the current user in Jira.
+type MyPermissionScheme struct {
+	ID             string `json:"id,omitempty"`             // The ID of the permission.
+	Key            string `json:"key,omitempty"`            // The key of the permission.
+	Name           string `json:"name,omitempty"`           // The name of the permission.
+	Type           string `json:"type,omitempty"`           // The type of the permission, GLOBAL or PROJECT.
+	Description    string `json:"description,omitempty"`    // The description of the permission.
+	HavePermission bool   `json:"havePermission,omitempty"` // Indicates if the user has the permission.
+	DeprecatedKey  bool   `json:"deprecatedKey,omitempty"`  // Indicates if the permission key is deprecated.
+}
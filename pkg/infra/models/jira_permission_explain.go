@@ -0,0 +1,45 @@
+package models
+
+// PermissionExplanationScheme represents why a user does or doesn't hold a permission in a project in Jira,
+// derived from the grants of the permission scheme of the project.
+type PermissionExplanationScheme struct {
+	ProjectKeyOrID     string                              // The key or ID of the project.
+	AccountID          string                              // The account ID of the user.
+	Permission         string                              // The key of the permission, e.g. BROWSE_PROJECTS.
+	PermissionSchemeID int                                 // The ID of the permission scheme of the project.
+	Granted            bool                                // Indicates if a grant holds the permission for the user on every issue of the project.
+	Conditional        bool                                // Indicates if the permission is only held on some issues, e.g. when the user is the assignee.
+	Grants             []*PermissionGrantExplanationScheme // The grants of the permission, in the order of the scheme.
+}
+
+// PermissionGrantExplanationScheme represents whether a grant of a permission scheme holds the permission for a user in Jira.
+type PermissionGrantExplanationScheme struct {
+	Grant       *PermissionGrantScheme // The grant of the permission scheme.
+	Matched     bool                   // Indicates if the grant holds the permission for the user.
+	Conditional bool                   // Indicates if the grant depends on the issue, e.g. the assignee or a custom field.
+	Reason      string                 // The explanation of the result, e.g. "the user is a member of the group jira-software-users".
+}
+
+// Reasons returns the reasons of the grants holding the permission for the user, or of every grant when none holds it.
+func (e *PermissionExplanationScheme) Reasons() []string {
+
+	if e == nil {
+		return nil
+	}
+
+	var matched, all []string
+	for _, grant := range e.Grants {
+
+		if grant.Matched {
+			matched = append(matched, grant.Reason)
+		}
+
+		all = append(all, grant.Reason)
+	}
+
+	if len(matched) != 0 {
+		return matched
+	}
+
+	return all
+}
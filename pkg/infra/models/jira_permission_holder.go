@@ -0,0 +1,142 @@
+package models
+
+// Permission holder types supported by the permission scheme grants.
+// See https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-permission-schemes/#about-permission-schemes-and-grants
+const (
+	PermissionHolderTypeAnyone              = "anyone"                  // Grant for anonymous users.
+	PermissionHolderTypeApplicationRole     = "applicationRole"         // Grant for users with access to the specified application.
+	PermissionHolderTypeAssignee            = "assignee"                // Grant for the user currently assigned to an issue.
+	PermissionHolderTypeGroup               = "group"                   // Grant for the specified group.
+	PermissionHolderTypeGroupCustomField    = "groupCustomField"        // Grant for a group selected in the specified custom field.
+	PermissionHolderTypeProjectLead         = "projectLead"             // Grant for the project lead.
+	PermissionHolderTypeProjectRole         = "projectRole"             // Grant for the specified project role.
+	PermissionHolderTypeReporter            = "reporter"                // Grant for the user who reported the issue.
+	PermissionHolderTypeServiceDeskCustomer = "sd.customer.portal.only" // Grant for the Jira Service Management customers.
+	PermissionHolderTypeUser                = "user"                    // Grant for the specified user.
+	PermissionHolderTypeUserCustomField     = "userCustomField"         // Grant for a user selected in the specified custom field.
+)
+
+// Permission grant expand values, used to include additional information about the holders on the permission grants.
+const (
+	PermissionGrantExpandPermissions = "permissions" // Returns all permission grants for each permission scheme.
+	PermissionGrantExpandUser        = "user"        // Returns information about the user who is granted the permission.
+	PermissionGrantExpandGroup       = "group"       // Returns information about the group that is granted the permission.
+	PermissionGrantExpandProjectRole = "projectRole" // Returns information about the project role granted the permission.
+	PermissionGrantExpandField       = "field"       // Returns information about the custom field granted the permission.
+	PermissionGrantExpandAll         = "all"         // Returns all expandable information.
+)
+
+// Built-in Jira project permission keys.
+const (
+	PermissionAdministerProjects       = "ADMINISTER_PROJECTS"
+	PermissionBrowseProjects           = "BROWSE_PROJECTS"
+	PermissionManageSprints            = "MANAGE_SPRINTS_PERMISSION"
+	PermissionServiceDeskAgent         = "SERVICEDESK_AGENT"
+	PermissionViewDevTools             = "VIEW_DEV_TOOLS"
+	PermissionViewReadOnlyWorkflow     = "VIEW_READONLY_WORKFLOW"
+	PermissionAssignableUser           = "ASSIGNABLE_USER"
+	PermissionAssignIssues             = "ASSIGN_ISSUES"
+	PermissionCloseIssues              = "CLOSE_ISSUES"
+	PermissionCreateIssues             = "CREATE_ISSUES"
+	PermissionDeleteIssues             = "DELETE_ISSUES"
+	PermissionEditIssues               = "EDIT_ISSUES"
+	PermissionLinkIssues               = "LINK_ISSUES"
+	PermissionModifyReporter           = "MODIFY_REPORTER"
+	PermissionMoveIssues               = "MOVE_ISSUES"
+	PermissionResolveIssues            = "RESOLVE_ISSUES"
+	PermissionScheduleIssues           = "SCHEDULE_ISSUES"
+	PermissionSetIssueSecurity         = "SET_ISSUE_SECURITY"
+	PermissionTransitionIssues         = "TRANSITION_ISSUES"
+	PermissionManageWatchers           = "MANAGE_WATCHERS"
+	PermissionViewVotersAndWatchers    = "VIEW_VOTERS_AND_WATCHERS"
+	PermissionAddComments              = "ADD_COMMENTS"
+	PermissionDeleteAllComments        = "DELETE_ALL_COMMENTS"
+	PermissionDeleteOwnComments        = "DELETE_OWN_COMMENTS"
+	PermissionEditAllComments          = "EDIT_ALL_COMMENTS"
+	PermissionEditOwnComments          = "EDIT_OWN_COMMENTS"
+	PermissionCreateAttachments        = "CREATE_ATTACHMENTS"
+	PermissionDeleteAllAttachments     = "DELETE_ALL_ATTACHMENTS"
+	PermissionDeleteOwnAttachments     = "DELETE_OWN_ATTACHMENTS"
+	PermissionDeleteAllWorklogs        = "DELETE_ALL_WORKLOGS"
+	PermissionDeleteOwnWorklogs        = "DELETE_OWN_WORKLOGS"
+	PermissionEditAllWorklogs          = "EDIT_ALL_WORKLOGS"
+	PermissionEditOwnWorklogs          = "EDIT_OWN_WORKLOGS"
+	PermissionWorkOnIssues             = "WORK_ON_ISSUES"
+	PermissionEditIssueLayout          = "EDIT_ISSUE_LAYOUT"
+	PermissionEditWorkflow             = "EDIT_WORKFLOW"
+	PermissionUnarchiveIssues          = "UNARCHIVE_ISSUES"
+	PermissionArchiveIssues            = "ARCHIVE_ISSUES"
+	PermissionViewAggregatedData       = "VIEW_AGGREGATED_DATA"
+	PermissionManageRepositorySettings = "MANAGE_REPOSITORY_SETTINGS"
+)
+
+// Built-in Jira global permission keys.
+const (
+	PermissionAdminister                     = "ADMINISTER"
+	PermissionSystemAdmin                    = "SYSTEM_ADMIN"
+	PermissionUserPicker                     = "USER_PICKER"
+	PermissionCreateSharedObjects            = "CREATE_SHARED_OBJECTS"
+	PermissionManageGroupFilterSubscriptions = "MANAGE_GROUP_FILTER_SUBSCRIPTIONS"
+	PermissionBulkChange                     = "BULK_CHANGE"
+)
+
+// NewPermissionGrantPayload returns the payload used to grant the permission to the holder.
+func NewPermissionGrantPayload(permission string, holder *PermissionGrantHolderScheme) *PermissionGrantPayloadScheme {
+	return &PermissionGrantPayloadScheme{Holder: holder, Permission: permission}
+}
+
+// NewAnyonePermissionHolder returns a holder that grants the permission to anonymous users.
+func NewAnyonePermissionHolder() *PermissionGrantHolderScheme {
+	return &PermissionGrantHolderScheme{Type: PermissionHolderTypeAnyone}
+}
+
+// NewApplicationRolePermissionHolder returns a holder that grants the permission to the users with access to the application.
+// If the application key is empty, the permission is granted to any logged-in user.
+func NewApplicationRolePermissionHolder(applicationKey string) *PermissionGrantHolderScheme {
+	return &PermissionGrantHolderScheme{Type: PermissionHolderTypeApplicationRole, Parameter: applicationKey}
+}
+
+// NewAssigneePermissionHolder returns a holder that grants the permission to the issue assignee.
+func NewAssigneePermissionHolder() *PermissionGrantHolderScheme {
+	return &PermissionGrantHolderScheme{Type: PermissionHolderTypeAssignee}
+}
+
+// NewGroupPermissionHolder returns a holder that grants the permission to the group identified by its ID.
+func NewGroupPermissionHolder(groupID string) *PermissionGrantHolderScheme {
+	return &PermissionGrantHolderScheme{Type: PermissionHolderTypeGroup, Value: groupID}
+}
+
+// NewGroupCustomFieldPermissionHolder returns a holder that grants the permission to the group selected in the custom field.
+func NewGroupCustomFieldPermissionHolder(customFieldID string) *PermissionGrantHolderScheme {
+	return &PermissionGrantHolderScheme{Type: PermissionHolderTypeGroupCustomField, Parameter: customFieldID}
+}
+
+// NewProjectLeadPermissionHolder returns a holder that grants the permission to the project lead.
+func NewProjectLeadPermissionHolder() *PermissionGrantHolderScheme {
+	return &PermissionGrantHolderScheme{Type: PermissionHolderTypeProjectLead}
+}
+
+// NewProjectRolePermissionHolder returns a holder that grants the permission to the project role identified by its ID.
+func NewProjectRolePermissionHolder(projectRoleID string) *PermissionGrantHolderScheme {
+	return &PermissionGrantHolderScheme{Type: PermissionHolderTypeProjectRole, Parameter: projectRoleID}
+}
+
+// NewReporterPermissionHolder returns a holder that grants the permission to the issue reporter.
+func NewReporterPermissionHolder() *PermissionGrantHolderScheme {
+	return &PermissionGrantHolderScheme{Type: PermissionHolderTypeReporter}
+}
+
+// NewServiceDeskCustomerPermissionHolder returns a holder that grants the permission to the Jira Service Management customers.
+func NewServiceDeskCustomerPermissionHolder() *PermissionGrantHolderScheme {
+	return &PermissionGrantHolderScheme{Type: PermissionHolderTypeServiceDeskCustomer}
+}
+
+// NewUserPermissionHolder returns a holder that grants the permission to the user identified by its account ID.
+func NewUserPermissionHolder(accountID string) *PermissionGrantHolderScheme {
+	return &PermissionGrantHolderScheme{Type: PermissionHolderTypeUser, Parameter: accountID}
+}
+
+// NewUserCustomFieldPermissionHolder returns a holder that grants the permission to the user selected in the custom field.
+func NewUserCustomFieldPermissionHolder(customFieldID string) *PermissionGrantHolderScheme {
+	return &PermissionGrantHolderScheme{Type: PermissionHolderTypeUserCustomField, Parameter: customFieldID}
+}
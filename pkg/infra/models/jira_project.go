@@ -18,6 +18,15 @@ const (
 	SoftwareCompanyManagedScrumProjectTemplate  = "com.pyxis.greenhopper.jira:gh-simplified-scrum-classic"
 )
 
+// ProjectAssigneeType represents the default assignee of the issues created in a project in Jira.
+type ProjectAssigneeType string
+
+// The default assignees of a project.
+const (
+	ProjectAssigneeProjectLead ProjectAssigneeType = "PROJECT_LEAD" // The issues are assigned to the project lead.
+	ProjectAssigneeUnassigned  ProjectAssigneeType = "UNASSIGNED"   // The issues are left unassigned, it requires unassigned issues to be allowed.
+)
+
 // ProjectPayloadScheme represents the payload for a project in Jira.
 type ProjectPayloadScheme struct {
 	NotificationScheme       int    `json:"notificationScheme,omitempty"`       // The ID of the notification scheme for the project.
@@ -1,10 +1,53 @@
 package models
 
+// ProjectFeatureKey represents the key of a project feature in Jira.
+//
+// The constants below cover the features usually returned for software projects, other keys can be used as well.
+type ProjectFeatureKey string
+
+// The keys of the project features.
+const (
+	ProjectFeatureBacklog     ProjectFeatureKey = "jsw.agility.backlog"     // The backlog of team-managed projects.
+	ProjectFeatureSprints     ProjectFeatureKey = "jsw.agility.sprints"     // The sprints of team-managed projects.
+	ProjectFeatureEstimation  ProjectFeatureKey = "jsw.agility.estimation"  // The story point estimation of team-managed projects.
+	ProjectFeatureReleases    ProjectFeatureKey = "jsw.agility.releases"    // The releases of team-managed projects.
+	ProjectFeatureCode        ProjectFeatureKey = "jsw.agility.code"        // The development tools integration of team-managed projects.
+	ProjectFeatureDeployments ProjectFeatureKey = "jsw.agility.deployments" // The deployments of team-managed projects.
+	ProjectFeaturePages       ProjectFeatureKey = "jsw.agility.pages"       // The pages of team-managed projects.
+	ProjectFeatureRoadmap     ProjectFeatureKey = "jsw.classic.roadmap"     // The timeline, formerly known as roadmap.
+)
+
+// ProjectFeatureState represents the state of a project feature in Jira.
+type ProjectFeatureState string
+
+// The states of the project features.
+const (
+	ProjectFeatureEnabled    ProjectFeatureState = "ENABLED"     // The feature is enabled.
+	ProjectFeatureDisabled   ProjectFeatureState = "DISABLED"    // The feature is disabled.
+	ProjectFeatureComingSoon ProjectFeatureState = "COMING_SOON" // The feature is not available yet, it cannot be set.
+)
+
 // ProjectFeaturesScheme represents the features of a project in Jira.
 type ProjectFeaturesScheme struct {
 	Features []*ProjectFeatureScheme `json:"features,omitempty"` // The features of the project.
 }
 
+// Feature returns the feature with the given key, or nil if the project doesn't have it.
+func (p *ProjectFeaturesScheme) Feature(key ProjectFeatureKey) *ProjectFeatureScheme {
+
+	if p == nil {
+		return nil
+	}
+
+	for _, feature := range p.Features {
+		if feature != nil && feature.Key() == key {
+			return feature
+		}
+	}
+
+	return nil
+}
+
 // ProjectFeatureScheme represents a feature of a project in Jira.
 type ProjectFeatureScheme struct {
 	ProjectID            int      `json:"projectId,omitempty"`            // The ID of the project.
@@ -16,3 +59,29 @@ type ProjectFeatureScheme struct {
 	LocalisedDescription string   `json:"localisedDescription,omitempty"` // The localized description of the feature.
 	ImageURI             string   `json:"imageUri,omitempty"`             // The URI of the feature image.
 }
+
+// Key returns the key of the feature.
+func (p *ProjectFeatureScheme) Key() ProjectFeatureKey {
+	return ProjectFeatureKey(p.Feature)
+}
+
+// FeatureState returns the state of the feature.
+func (p *ProjectFeatureScheme) FeatureState() ProjectFeatureState {
+	return ProjectFeatureState(p.State)
+}
+
+// PrerequisiteKeys returns the keys of the prerequisites of the feature.
+func (p *ProjectFeatureScheme) PrerequisiteKeys() []ProjectFeatureKey {
+
+	keys := make([]ProjectFeatureKey, 0, len(p.Prerequisites))
+	for _, prerequisite := range p.Prerequisites {
+		keys = append(keys, ProjectFeatureKey(prerequisite))
+	}
+
+	return keys
+}
+
+// Enabled reports whether the feature is enabled.
+func (p *ProjectFeatureScheme) Enabled() bool {
+	return p != nil && p.FeatureState() == ProjectFeatureEnabled
+}
@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+// ProjectConfigurationSnapshotScheme represents the configuration of a project at a point in time, e.g. for audits or drift detection.
+//
+// The schemes are nil when the project uses the default one, e.g. the default field configuration scheme.
+type ProjectConfigurationSnapshotScheme struct {
+	CapturedAt                 time.Time                                `json:"capturedAt"`                           // The time the snapshot was captured.
+	Project                    *ProjectScheme                           `json:"project,omitempty"`                    // The project.
+	IssueTypeScheme            *IssueTypeSchemeScheme                   `json:"issueTypeScheme,omitempty"`            // The issue type scheme of the project.
+	IssueTypeIDs               []string                                 `json:"issueTypeIds,omitempty"`               // The IDs of the issue types of the issue type scheme, in order.
+	IssueTypeScreenScheme      *IssueTypeScreenSchemeScheme             `json:"issueTypeScreenScheme,omitempty"`      // The issue type screen scheme of the project.
+	ScreenSchemeMappings       []*IssueTypeScreenSchemeItemScheme       `json:"screenSchemeMappings,omitempty"`       // The screen scheme of each issue type.
+	ScreenSchemes              []*ScreenSchemeScheme                    `json:"screenSchemes,omitempty"`              // The screen schemes of the issue types, with their screens.
+	FieldConfigurationScheme   *FieldConfigurationSchemeScheme          `json:"fieldConfigurationScheme,omitempty"`   // The field configuration scheme of the project.
+	FieldConfigurationMappings []*FieldConfigurationIssueTypeItemScheme `json:"fieldConfigurationMappings,omitempty"` // The field configuration of each issue type.
+	WorkflowScheme             *WorkflowSchemeScheme                    `json:"workflowScheme,omitempty"`             // The workflow scheme of the project.
+	PermissionScheme           *PermissionSchemeScheme                  `json:"permissionScheme,omitempty"`           // The permission scheme of the project, with its grants.
+	NotificationScheme         *NotificationSchemeScheme                `json:"notificationScheme,omitempty"`         // The notification scheme of the project, with its events.
+	Roles                      []*ProjectRoleScheme                     `json:"roles,omitempty"`                      // The project roles, with their actors, sorted by ID.
+}
@@ -7,3 +7,21 @@ type ProjectValidationMessageScheme struct {
 		ProjectKey string `json:"projectKey"` // The key of the project that has errors.
 	} `json:"errors"` // The errors of the project validation.
 }
+
+// ProjectCandidateScheme represents a project key and name checked before the project is created in Jira.
+type ProjectCandidateScheme struct {
+	Key  string // The desired key of the project.
+	Name string // The desired name of the project, not checked when empty.
+}
+
+// ProjectAvailabilityScheme represents the availability of a project key and name in Jira.
+type ProjectAvailabilityScheme struct {
+	Key           string   // The desired key of the project.
+	KeyAvailable  bool     // Indicates if the key is valid and not in use.
+	KeyErrors     []string // The reasons the key can't be used, when it isn't available.
+	SuggestedKey  string   // The next available key generated from the desired key, when it isn't available.
+	Name          string   // The desired name of the project.
+	NameAvailable bool     // Indicates if the name is not in use.
+	SuggestedName string   // The next available name generated from the desired name, when it isn't available and one can be generated.
+	Err           error    // The error that prevented the candidate from being checked, if any.
+}
@@ -38,3 +38,12 @@ type RoleActorScheme struct {
 type RoleActorUserScheme struct {
 	AccountID string `json:"accountId,omitempty"` // The account ID of the role actor user.
 }
+
+// ProjectRoleActorsChangeScheme represents the changes applied to the actors of a project role to match the requested actors.
+type ProjectRoleActorsChangeScheme struct {
+	AddedAccountIDs   []string // The account IDs of the users added to the project role.
+	AddedGroups       []string // The names of the groups added to the project role.
+	RemovedAccountIDs []string // The account IDs of the users removed from the project role.
+	RemovedGroups     []string // The names of the groups removed from the project role.
+	RolledBack        bool     // Indicates if the applied changes were reverted after a partial failure.
+}
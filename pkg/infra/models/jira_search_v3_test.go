@@ -0,0 +1,42 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssueSearchJQLScheme_Expands(t *testing.T) {
+
+	data := `{
+		"issues": [
+			{
+				"key": "KP-1",
+				"fields": {"summary": "Login fails", "customfield_10016": 3},
+				"changelog": {"startAt": 0, "maxResults": 1, "total": 1, "histories": [{"id": "10100", "items": [{"field": "status", "fromString": "To Do", "toString": "Done"}]}]}
+			}
+		],
+		"names": {"summary": "Summary", "customfield_10016": "Story point estimate"},
+		"schema": {
+			"summary": {"type": "string", "system": "summary"},
+			"customfield_10016": {"type": "number", "custom": "com.pyxis.greenhopper.jira:jsw-story-points", "customId": 10016}
+		},
+		"nextPageToken": "CAEaAggD"
+	}`
+
+	result := new(IssueSearchJQLScheme)
+	assert.NoError(t, json.Unmarshal([]byte(data), result))
+
+	assert.Equal(t, "Story point estimate", result.Names.Name("customfield_10016"))
+	assert.Equal(t, "customfield_10099", result.Names.Name("customfield_10099"))
+	assert.Equal(t, &IssueFieldSchemaScheme{Type: "number", Custom: "com.pyxis.greenhopper.jira:jsw-story-points", CustomID: 10016}, result.Schema["customfield_10016"])
+	assert.Len(t, result.Issues[0].Changelog.Histories, 1)
+
+	id, ok := result.Names.ID("Story point estimate")
+	assert.True(t, ok)
+	assert.Equal(t, "customfield_10016", id)
+
+	_, ok = result.Names.ID("Story Points")
+	assert.False(t, ok)
+}
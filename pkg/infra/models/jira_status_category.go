@@ -0,0 +1,83 @@
+package models
+
+import "strings"
+
+// StatusCategoryKey represents the key of a Jira status category.
+type StatusCategoryKey string
+
+// The status categories available on Jira, the keys are the values returned on the statusCategory.key attribute.
+const (
+	StatusCategoryUndefined  StatusCategoryKey = "undefined"     // No Category
+	StatusCategoryToDo       StatusCategoryKey = "new"           // To Do
+	StatusCategoryInProgress StatusCategoryKey = "indeterminate" // In Progress
+	StatusCategoryDone       StatusCategoryKey = "done"          // Done
+)
+
+// statusCategoryIDs maps the immutable status category IDs to their keys.
+var statusCategoryIDs = map[int]StatusCategoryKey{
+	1: StatusCategoryUndefined,
+	2: StatusCategoryToDo,
+	3: StatusCategoryDone,
+	4: StatusCategoryInProgress,
+}
+
+// ParseStatusCategoryKey converts the status category representations used across the Jira APIs, such as
+// "new", "To Do" or "TODO", into a StatusCategoryKey. It returns StatusCategoryUndefined if the value is unknown.
+func ParseStatusCategoryKey(value string) StatusCategoryKey {
+
+	switch strings.ToLower(strings.NewReplacer(" ", "", "_", "").Replace(value)) {
+	case "new", "todo":
+		return StatusCategoryToDo
+	case "indeterminate", "inprogress":
+		return StatusCategoryInProgress
+	case "done":
+		return StatusCategoryDone
+	default:
+		return StatusCategoryUndefined
+	}
+}
+
+// WorkflowName returns the status category name used by the workflow statuses endpoints, e.g. "TODO".
+func (k StatusCategoryKey) WorkflowName() string {
+
+	switch k {
+	case StatusCategoryToDo:
+		return "TODO"
+	case StatusCategoryInProgress:
+		return "IN_PROGRESS"
+	case StatusCategoryDone:
+		return "DONE"
+	default:
+		return ""
+	}
+}
+
+// Is reports whether the status category matches the key, it's safe to call on a nil status category.
+func (s *StatusCategoryScheme) Is(key StatusCategoryKey) bool {
+
+	if s == nil {
+		return false
+	}
+
+	if s.Key != "" {
+		return ParseStatusCategoryKey(s.Key) == key
+	}
+
+	category, ok := statusCategoryIDs[s.ID]
+	return ok && category == key
+}
+
+// InCategory reports whether the status belongs to the status category, it's safe to call on a nil status.
+func (s *StatusScheme) InCategory(key StatusCategoryKey) bool {
+	return s != nil && s.StatusCategory.Is(key)
+}
+
+// InCategory reports whether the status belongs to the status category, it's safe to call on a nil status.
+func (s *StatusDetailScheme) InCategory(key StatusCategoryKey) bool {
+	return s != nil && s.StatusCategory.Is(key)
+}
+
+// InCategory reports whether the workflow status belongs to the status category, it's safe to call on a nil status.
+func (w *WorkflowStatusDetailScheme) InCategory(key StatusCategoryKey) bool {
+	return w != nil && w.StatusCategory != "" && ParseStatusCategoryKey(w.StatusCategory) == key
+}
@@ -0,0 +1,92 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseStatusCategoryKey(t *testing.T) {
+
+	testCases := []struct {
+		value string
+		want  StatusCategoryKey
+	}{
+		{value: "new", want: StatusCategoryToDo},
+		{value: "To Do", want: StatusCategoryToDo},
+		{value: "TODO", want: StatusCategoryToDo},
+		{value: "indeterminate", want: StatusCategoryInProgress},
+		{value: "IN_PROGRESS", want: StatusCategoryInProgress},
+		{value: "done", want: StatusCategoryDone},
+		{value: "DONE", want: StatusCategoryDone},
+		{value: "", want: StatusCategoryUndefined},
+		{value: "unknown", want: StatusCategoryUndefined},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.value, func(t *testing.T) {
+			assert.Equal(t, testCase.want, ParseStatusCategoryKey(testCase.value))
+		})
+	}
+}
+
+func TestStatusCategoryKey_WorkflowName(t *testing.T) {
+	assert.Equal(t, "TODO", StatusCategoryToDo.WorkflowName())
+	assert.Equal(t, "IN_PROGRESS", StatusCategoryInProgress.WorkflowName())
+	assert.Equal(t, "DONE", StatusCategoryDone.WorkflowName())
+	assert.Equal(t, "", StatusCategoryUndefined.WorkflowName())
+}
+
+func TestStatusScheme_InCategory(t *testing.T) {
+
+	testCases := []struct {
+		name   string
+		status *StatusScheme
+		key    StatusCategoryKey
+		want   bool
+	}{
+		{
+			name:   "when the status category key matches",
+			status: &StatusScheme{StatusCategory: &StatusCategoryScheme{Key: "done"}},
+			key:    StatusCategoryDone,
+			want:   true,
+		},
+		{
+			name:   "when the status category key does not match",
+			status: &StatusScheme{StatusCategory: &StatusCategoryScheme{Key: "new"}},
+			key:    StatusCategoryDone,
+			want:   false,
+		},
+		{
+			name:   "when only the status category id is provided",
+			status: &StatusScheme{StatusCategory: &StatusCategoryScheme{ID: 4}},
+			key:    StatusCategoryInProgress,
+			want:   true,
+		},
+		{
+			name:   "when the status category is not provided",
+			status: &StatusScheme{},
+			key:    StatusCategoryDone,
+			want:   false,
+		},
+		{
+			name:   "when the status is nil",
+			status: nil,
+			key:    StatusCategoryDone,
+			want:   false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.want, testCase.status.InCategory(testCase.key))
+		})
+	}
+}
+
+func TestWorkflowStatusDetailScheme_InCategory(t *testing.T) {
+	assert.True(t, (&WorkflowStatusDetailScheme{StatusCategory: "IN_PROGRESS"}).InCategory(StatusCategoryInProgress))
+	assert.False(t, (&WorkflowStatusDetailScheme{StatusCategory: "TODO"}).InCategory(StatusCategoryDone))
+	assert.False(t, (&WorkflowStatusDetailScheme{}).InCategory(StatusCategoryUndefined))
+	assert.False(t, (*WorkflowStatusDetailScheme)(nil).InCategory(StatusCategoryDone))
+}
@@ -15,3 +15,37 @@ type TaskScheme struct {
 	Finished       int64  `json:"finished"`       // The timestamp when the task finished.
 	LastUpdate     int64  `json:"lastUpdate"`     // The timestamp of the last update to the task.
 }
+
+// The statuses of a task in Jira.
+const (
+	TaskStatusEnqueued        = "ENQUEUED"         // The task is waiting to be run.
+	TaskStatusRunning         = "RUNNING"          // The task is running.
+	TaskStatusComplete        = "COMPLETE"         // The task finished successfully.
+	TaskStatusFailed          = "FAILED"           // The task failed.
+	TaskStatusCancelRequested = "CANCEL_REQUESTED" // The task was asked to stop.
+	TaskStatusCancelled       = "CANCELLED"        // The task was cancelled.
+	TaskStatusDead            = "DEAD"             // The task was lost, e.g. because the node running it stopped.
+)
+
+// Done reports whether the task finished, successfully or not.
+func (t *TaskScheme) Done() bool {
+
+	if t == nil {
+		return false
+	}
+
+	switch t.Status {
+	case TaskStatusComplete, TaskStatusFailed, TaskStatusCancelled, TaskStatusDead:
+		return true
+	}
+
+	return false
+}
+
+// TaskProgressScheme represents the progress of a task in Jira, as reported while waiting for it.
+type TaskProgressScheme struct {
+	TaskID   string      // The ID of the task.
+	Status   string      // The status of the task, see the TaskStatus constants.
+	Progress int         // The progress of the task, in percent.
+	Task     *TaskScheme // The task as returned by Jira.
+}
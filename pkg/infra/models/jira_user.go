@@ -73,3 +73,9 @@ type UserPermissionCheckParamsScheme struct {
 	IssueKey   string // The issue key for the check.
 	ProjectKey string // The project key for the check.
 }
+
+// UserEmailResolutionScheme represents the account IDs resolved from email addresses.
+type UserEmailResolutionScheme struct {
+	AccountIDs map[string]string // The account IDs keyed by the email address, as requested.
+	Unresolved []string          // The email addresses that don't match a user.
+}
@@ -0,0 +1,54 @@
+package models
+
+import "time"
+
+// UserEmailScheme represents the email address of a user, returned by the email endpoints regardless of the
+// profile visibility settings of the user.
+type UserEmailScheme struct {
+	AccountID string `json:"accountId,omitempty"` // The account ID of the user.
+	Email     string `json:"email,omitempty"`     // The email address of the user.
+}
+
+// IsEmailRedacted reports whether the email address of the user is hidden by its profile visibility settings.
+//
+// The app accounts, which have no email address, are never reported as redacted.
+func (u *UserScheme) IsEmailRedacted() bool {
+	return u != nil && u.EmailAddress == "" && u.AccountType != UserAccountTypeApp
+}
+
+// IsTimeZoneRedacted reports whether the time zone of the user is hidden by its profile visibility settings.
+func (u *UserScheme) IsTimeZoneRedacted() bool {
+	return u != nil && u.TimeZone == ""
+}
+
+// IsRedacted reports whether a profile field of the user, the email address or the time zone, is hidden by
+// its profile visibility settings.
+func (u *UserScheme) IsRedacted() bool {
+	return u.IsEmailRedacted() || u.IsTimeZoneRedacted()
+}
+
+// Email returns the email address of the user, and false when it's hidden.
+func (u *UserScheme) Email() (string, bool) {
+
+	if u == nil || u.EmailAddress == "" {
+		return "", false
+	}
+
+	return u.EmailAddress, true
+}
+
+// Location returns the time zone of the user, and false when it's hidden or unknown.
+// The returned location is UTC in that case, so it can be used as is.
+func (u *UserScheme) Location() (*time.Location, bool) {
+
+	if u == nil || u.TimeZone == "" {
+		return time.UTC, false
+	}
+
+	location, err := time.LoadLocation(u.TimeZone)
+	if err != nil {
+		return time.UTC, false
+	}
+
+	return location, true
+}
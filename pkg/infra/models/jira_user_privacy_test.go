@@ -0,0 +1,61 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserScheme_IsRedacted(t *testing.T) {
+
+	testCases := []struct {
+		name             string
+		user             *UserScheme
+		emailRedacted    bool
+		timeZoneRedacted bool
+	}{
+		{
+			name: "when the profile is visible",
+			user: &UserScheme{AccountType: UserAccountTypeAtlassian, EmailAddress: "user@example.com", TimeZone: "Europe/Paris"},
+		},
+		{
+			name:             "when the email and the time zone are hidden",
+			user:             &UserScheme{AccountType: UserAccountTypeAtlassian},
+			emailRedacted:    true,
+			timeZoneRedacted: true,
+		},
+		{
+			name:             "when the user is an app",
+			user:             &UserScheme{AccountType: UserAccountTypeApp},
+			timeZoneRedacted: true,
+		},
+		{
+			name: "when the user is nil",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			assert.Equal(t, testCase.emailRedacted, testCase.user.IsEmailRedacted())
+			assert.Equal(t, testCase.timeZoneRedacted, testCase.user.IsTimeZoneRedacted())
+			assert.Equal(t, testCase.emailRedacted || testCase.timeZoneRedacted, testCase.user.IsRedacted())
+
+			location, ok := testCase.user.Location()
+			assert.Equal(t, !testCase.timeZoneRedacted && testCase.user != nil, ok)
+
+			if ok {
+				assert.Equal(t, testCase.user.TimeZone, location.String())
+			} else {
+				assert.Equal(t, time.UTC, location)
+			}
+
+			email, ok := testCase.user.Email()
+			assert.Equal(t, testCase.user != nil && testCase.user.EmailAddress != "", ok)
+			if ok {
+				assert.Equal(t, testCase.user.EmailAddress, email)
+			}
+		})
+	}
+}
@@ -1,5 +1,7 @@
 package models
 
+import "errors"
+
 // IssueWatcherScheme represents the watcher information for an issue in Jira.
 type IssueWatcherScheme struct {
 	Self       string              `json:"self,omitempty"`       // The URL of the watcher information.
@@ -20,3 +22,136 @@ type UserDetailScheme struct {
 	TimeZone     string `json:"timeZone,omitempty"`     // The time zone of the user.
 	AccountType  string `json:"accountType,omitempty"`  // The account type of the user.
 }
+
+// IssueWatchersOptionsScheme represents the options to get the watchers of many issues in Jira.
+type IssueWatchersOptionsScheme struct {
+	Concurrency int  // The number of issues read at the same time, IssueWatchersConcurrency when zero.
+	ExpandUsers bool // Reads the details of every distinct watcher once, e.g. to resolve the email addresses.
+}
+
+// IssueWatchersConcurrency is the number of issues whose watchers are read at the same time by default.
+const IssueWatchersConcurrency = 5
+
+// IssueWatchersScheme represents the watchers of many issues in Jira.
+type IssueWatchersScheme struct {
+	Issues []*IssueWatchersIssueScheme // The watchers of each issue, in the order of the issues.
+	Users  map[string]*UserScheme      // The details of the distinct watchers by account ID, when the users are expanded.
+
+	// UserErrors are the errors that prevented the details of watchers from being read, by account ID.
+	UserErrors map[string]error
+}
+
+// IssueWatchersIssueScheme represents the watchers of an issue read with the watchers of many issues.
+type IssueWatchersIssueScheme struct {
+	IssueKeyOrID string              // The key or ID of the issue.
+	Watchers     *IssueWatcherScheme // The watchers of the issue.
+	Err          error               // The error that prevented the watchers of the issue from being read, if any.
+}
+
+// Err returns the errors of the issues and users that couldn't be read, or nil if every issue was read.
+func (w *IssueWatchersScheme) Err() error {
+
+	if w == nil {
+		return nil
+	}
+
+	var errs []error
+	for _, issue := range w.Issues {
+		if issue.Err != nil {
+			errs = append(errs, issue.Err)
+		}
+	}
+
+	for _, accountID := range w.AccountIDs() {
+		if err, ok := w.UserErrors[accountID]; ok {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// AccountIDs returns the account IDs of the distinct watchers of the issues, in the order they were found.
+func (w *IssueWatchersScheme) AccountIDs() []string {
+
+	if w == nil {
+		return nil
+	}
+
+	seen := make(map[string]struct{})
+
+	var accountIDs []string
+	for _, issue := range w.Issues {
+
+		if issue.Watchers == nil {
+			continue
+		}
+
+		for _, watcher := range issue.Watchers.Watchers {
+
+			if _, ok := seen[watcher.AccountID]; ok || watcher.AccountID == "" {
+				continue
+			}
+
+			seen[watcher.AccountID] = struct{}{}
+			accountIDs = append(accountIDs, watcher.AccountID)
+		}
+	}
+
+	return accountIDs
+}
+
+// IssueWatchersUpdateConcurrency is the number of issues whose watchers are updated at the same time by default.
+const IssueWatchersUpdateConcurrency = 5
+
+// IssueWatchersUpdateAttempts is the number of attempts made to update the watchers of an issue by default,
+// the rate limited and the maintenance responses being retried.
+const IssueWatchersUpdateAttempts = 3
+
+// IssueWatchersUpdateOptionsScheme represents the options to add or remove a watcher of many issues in Jira.
+type IssueWatchersUpdateOptionsScheme struct {
+	Concurrency int  // The number of issues updated at the same time, IssueWatchersUpdateConcurrency when zero.
+	Rate        int  // The maximum number of issues updated per second, unlimited when zero.
+	Attempts    int  // The number of attempts made per issue, IssueWatchersUpdateAttempts when zero.
+	DryRun      bool // Reports the issues matching the query without updating them.
+}
+
+// IssueWatchersUpdateScheme represents the result of adding or removing a watcher of many issues in Jira.
+type IssueWatchersUpdateScheme struct {
+	JQL       string                            // The JQL query of the updated issues, "" when the issues were listed.
+	AccountID string                            // The account ID of the watcher, "" when the calling user was added.
+	Removed   bool                              // Indicates if the watcher was removed from the issues instead of added.
+	DryRun    bool                              // Indicates if the issues were only reported.
+	Updated   int                               // The number of issues updated.
+	Failed    int                               // The number of issues that couldn't be updated.
+	Issues    []*IssueWatchersIssueUpdateScheme // The issues, in the order returned by the search or listed.
+}
+
+// IssueWatchersIssueUpdateScheme represents the update of the watchers of an issue in Jira.
+type IssueWatchersIssueUpdateScheme struct {
+	IssueKey string // The key, or the ID when listed by ID, of the issue.
+	Attempts int    // The number of attempts made to update the issue.
+	Err      error  // The error that prevented the issue from being updated, if any.
+}
+
+// Err returns the errors of the issues that couldn't be updated, or nil if every issue was updated.
+func (u *IssueWatchersUpdateScheme) Err() error {
+
+	if u == nil {
+		return nil
+	}
+
+	var errs []error
+	for _, issue := range u.Issues {
+		if issue.Err != nil {
+			errs = append(errs, issue.Err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// IssueIsWatchingScheme represents whether the calling user watches each of many issues in Jira.
+type IssueIsWatchingScheme struct {
+	IssuesIsWatching map[string]bool `json:"issuesIsWatching,omitempty"` // Whether the user watches the issue, by issue ID.
+}
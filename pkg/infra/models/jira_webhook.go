@@ -0,0 +1,93 @@
+package models
+
+import "time"
+
+// WebhookPageScheme represents a page of the dynamic webhooks registered by the calling app.
+type WebhookPageScheme struct {
+	MaxResults int              `json:"maxResults,omitempty"` // The maximum number of webhooks per page.
+	StartAt    int              `json:"startAt,omitempty"`    // The index of the first webhook of the page.
+	Total      int              `json:"total,omitempty"`      // The total number of webhooks.
+	IsLast     bool             `json:"isLast,omitempty"`     // Indicates if this is the last page.
+	Values     []*WebhookScheme `json:"values,omitempty"`     // The webhooks of the page.
+}
+
+// WebhookScheme represents a dynamic webhook registered by an OAuth 2.0 or Connect app.
+type WebhookScheme struct {
+	ID                      int      `json:"id,omitempty"`                      // The ID of the webhook.
+	JQLFilter               string   `json:"jqlFilter,omitempty"`               // The JQL filter of the issues that trigger the webhook.
+	FieldIDsFilter          []string `json:"fieldIdsFilter,omitempty"`          // The fields whose changes trigger the jira:issue_updated event.
+	IssuePropertyKeysFilter []string `json:"issuePropertyKeysFilter,omitempty"` // The issue properties whose changes trigger the issue_property events.
+	Events                  []string `json:"events,omitempty"`                  // The events that trigger the webhook, e.g. jira:issue_created.
+	ExpirationDate          int64    `json:"expirationDate,omitempty"`          // The date the webhook expires, in milliseconds since the epoch.
+}
+
+// Expiration returns the date the webhook expires.
+func (w *WebhookScheme) Expiration() time.Time {
+	return time.UnixMilli(w.ExpirationDate)
+}
+
+// WebhookRegisterPayloadScheme represents the payload used to register dynamic webhooks.
+type WebhookRegisterPayloadScheme struct {
+	URL      string                 `json:"url,omitempty"`      // The URL the webhooks are sent to, it must be the app base URL or a path of it.
+	Webhooks []*WebhookDetailScheme `json:"webhooks,omitempty"` // The webhooks to register.
+}
+
+// WebhookDetailScheme represents a webhook of the register payload.
+type WebhookDetailScheme struct {
+	JQLFilter               string   `json:"jqlFilter,omitempty"`               // The JQL filter of the issues that trigger the webhook.
+	FieldIDsFilter          []string `json:"fieldIdsFilter,omitempty"`          // The fields whose changes trigger the jira:issue_updated event.
+	IssuePropertyKeysFilter []string `json:"issuePropertyKeysFilter,omitempty"` // The issue properties whose changes trigger the issue_property events.
+	Events                  []string `json:"events,omitempty"`                  // The events that trigger the webhook, e.g. jira:issue_created.
+}
+
+// WebhookRegistrationResultScheme represents the result of a dynamic webhooks registration.
+type WebhookRegistrationResultScheme struct {
+	WebhookRegistrationResult []*WebhookRegistrationScheme `json:"webhookRegistrationResult,omitempty"` // The result of each webhook, in the order of the payload.
+}
+
+// WebhookRegistrationScheme represents the result of a registered webhook.
+type WebhookRegistrationScheme struct {
+	CreatedWebhookID int      `json:"createdWebhookId,omitempty"` // The ID of the webhook, when it was registered.
+	Errors           []string `json:"errors,omitempty"`           // The errors of the webhook, when it couldn't be registered.
+}
+
+// WebhookRefreshScheme represents the new expiration date of the refreshed webhooks.
+type WebhookRefreshScheme struct {
+	ExpirationDate int64 `json:"expirationDate,omitempty"` // The date the webhooks expire, in milliseconds since the epoch.
+}
+
+// Expiration returns the date the refreshed webhooks expire.
+func (w *WebhookRefreshScheme) Expiration() time.Time {
+	return time.UnixMilli(w.ExpirationDate)
+}
+
+// FailedWebhookPageScheme represents a page of the webhooks that Jira failed to deliver to the calling app.
+type FailedWebhookPageScheme struct {
+	MaxResults int                    `json:"maxResults,omitempty"` // The maximum number of failed webhooks per page.
+	Next       string                 `json:"next,omitempty"`       // The URL of the next page, empty on the last page.
+	Values     []*FailedWebhookScheme `json:"values,omitempty"`     // The failed webhooks of the page, in the order of the failures.
+}
+
+// FailedWebhookScheme represents a webhook that Jira failed to deliver, after the retries.
+type FailedWebhookScheme struct {
+	ID          string `json:"id,omitempty"`          // The ID of the failed webhook.
+	Body        string `json:"body,omitempty"`        // The body of the webhook, when it's still available.
+	URL         string `json:"url,omitempty"`         // The URL the webhook was sent to.
+	FailureTime int64  `json:"failureTime,omitempty"` // The date of the last failure, in milliseconds since the epoch.
+}
+
+// Failure returns the date of the last failure of the webhook.
+func (w *FailedWebhookScheme) Failure() time.Time {
+	return time.UnixMilli(w.FailureTime)
+}
+
+// NextAfter returns the date to use as after to read the next page, the failure date of the last webhook of the page.
+// It returns the zero time on the last page.
+func (p *FailedWebhookPageScheme) NextAfter() time.Time {
+
+	if p.Next == "" || len(p.Values) == 0 {
+		return time.Time{}
+	}
+
+	return p.Values[len(p.Values)-1].Failure()
+}
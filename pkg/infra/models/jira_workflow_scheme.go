@@ -22,15 +22,18 @@ type WorkflowSchemePageScheme struct {
 
 // WorkflowSchemeScheme represents a workflow scheme in Jira.
 type WorkflowSchemeScheme struct {
-	ID                  int         `json:"id,omitempty"`                  // The ID of the scheme.
-	Name                string      `json:"name,omitempty"`                // The name of the scheme.
-	Description         string      `json:"description,omitempty"`         // The description of the scheme.
-	DefaultWorkflow     string      `json:"defaultWorkflow,omitempty"`     // The default workflow of the scheme.
-	Draft               bool        `json:"draft,omitempty"`               // Indicates if the scheme is a draft.
-	LastModifiedUser    *UserScheme `json:"lastModifiedUser,omitempty"`    // The user who last modified the scheme.
-	LastModified        string      `json:"lastModified,omitempty"`        // The date and time when the scheme was last modified.
-	Self                string      `json:"self,omitempty"`                // The URL of the scheme.
-	UpdateDraftIfNeeded bool        `json:"updateDraftIfNeeded,omitempty"` // Indicates if the draft should be updated if needed.
+	ID                        int               `json:"id,omitempty"`                        // The ID of the scheme.
+	Name                      string            `json:"name,omitempty"`                      // The name of the scheme.
+	Description               string            `json:"description,omitempty"`               // The description of the scheme.
+	DefaultWorkflow           string            `json:"defaultWorkflow,omitempty"`           // The default workflow of the scheme.
+	IssueTypeMappings         map[string]string `json:"issueTypeMappings,omitempty"`         // The issue type to workflow mappings of the scheme.
+	OriginalDefaultWorkflow   string            `json:"originalDefaultWorkflow,omitempty"`   // The default workflow of the active scheme, only returned for drafts.
+	OriginalIssueTypeMappings map[string]string `json:"originalIssueTypeMappings,omitempty"` // The issue type to workflow mappings of the active scheme, only returned for drafts.
+	Draft                     bool              `json:"draft,omitempty"`                     // Indicates if the scheme is a draft.
+	LastModifiedUser          *UserScheme       `json:"lastModifiedUser,omitempty"`          // The user who last modified the scheme.
+	LastModified              string            `json:"lastModified,omitempty"`              // The date and time when the scheme was last modified.
+	Self                      string            `json:"self,omitempty"`                      // The URL of the scheme.
+	UpdateDraftIfNeeded       bool              `json:"updateDraftIfNeeded,omitempty"`       // Indicates if the draft should be updated if needed.
 }
 
 // WorkflowSchemeAssociationPageScheme represents a page of workflow scheme associations in Jira.
@@ -43,3 +46,21 @@ type WorkflowSchemeAssociationsScheme struct {
 	ProjectIDs     []string              `json:"projectIds,omitempty"`     // The IDs of the projects associated with the scheme.
 	WorkflowScheme *WorkflowSchemeScheme `json:"workflowScheme,omitempty"` // The workflow scheme associated with the projects.
 }
+
+// WorkflowSchemeDefaultScheme represents the default workflow of a workflow scheme in Jira.
+type WorkflowSchemeDefaultScheme struct {
+	Workflow            string `json:"workflow,omitempty"`            // The name of the default workflow.
+	UpdateDraftIfNeeded bool   `json:"updateDraftIfNeeded,omitempty"` // Indicates if the draft should be updated if needed.
+}
+
+// WorkflowSchemeDraftPublishPayloadScheme represents the payload for publishing a draft workflow scheme in Jira.
+type WorkflowSchemeDraftPublishPayloadScheme struct {
+	StatusMappings []*WorkflowSchemeStatusMappingScheme `json:"statusMappings,omitempty"` // The status migrations of the issues whose workflow changes.
+}
+
+// WorkflowSchemeStatusMappingScheme represents the migration of a status when a draft workflow scheme is published.
+type WorkflowSchemeStatusMappingScheme struct {
+	IssueTypeID string `json:"issueTypeId,omitempty"` // The ID of the issue type.
+	StatusID    string `json:"statusId,omitempty"`    // The ID of the status in the current workflow.
+	NewStatusID string `json:"newStatusId,omitempty"` // The ID of the status in the new workflow.
+}
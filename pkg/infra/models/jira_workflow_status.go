@@ -15,7 +15,7 @@ type WorkflowStatusDetailPageScheme struct {
 type WorkflowStatusDetailScheme struct {
 	ID             string                     `json:"id,omitempty"`             // The ID of the workflow status.
 	Name           string                     `json:"name,omitempty"`           // The name of the workflow status.
-	StatusCategory string                     `json:"statusCategory,omitempty"` // The status category of the workflow status.
+	StatusCategory string                     `json:"statusCategory,omitempty"` // The status category of the workflow status, see StatusCategoryKey.WorkflowName.
 	Scope          *WorkflowStatusScopeScheme `json:"scope,omitempty"`          // The scope of the workflow status.
 	Description    string                     `json:"description,omitempty"`    // The description of the workflow status.
 	Usages         []*ProjectIssueTypesScheme `json:"usages,omitempty"`         // The usages of the workflow status.
@@ -48,7 +48,7 @@ type WorkflowStatusPayloadScheme struct {
 type WorkflowStatusNodeScheme struct {
 	ID             string `json:"id,omitempty"`             // The ID of the workflow status node.
 	Name           string `json:"name,omitempty"`           // The name of the workflow status node.
-	StatusCategory string `json:"statusCategory,omitempty"` // The status category of the workflow status node.
+	StatusCategory string `json:"statusCategory,omitempty"` // The status category of the workflow status node, see StatusCategoryKey.WorkflowName.
 	Description    string `json:"description,omitempty"`    // The description of the workflow status node.
 }
 
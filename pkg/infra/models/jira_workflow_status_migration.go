@@ -0,0 +1,60 @@
+package models
+
+import "errors"
+
+// WorkflowStatusMigrationConcurrency is the number of issues migrated at the same time by default.
+const WorkflowStatusMigrationConcurrency = 5
+
+// WorkflowStatusUsageScheme represents the approximate number of issues in a status in Jira.
+type WorkflowStatusUsageScheme struct {
+	Status   string                              // The name of the status.
+	Count    int                                 // The approximate number of issues in the status.
+	Projects []*WorkflowStatusProjectUsageScheme // The approximate number of issues in the status per project, when projects are provided.
+}
+
+// WorkflowStatusProjectUsageScheme represents the approximate number of issues of a project in a status in Jira.
+type WorkflowStatusProjectUsageScheme struct {
+	ProjectKey string // The key of the project.
+	Count      int    // The approximate number of issues of the project in the status.
+}
+
+// WorkflowStatusMigrationOptionsScheme represents the options to migrate the issues from one status to another in Jira.
+type WorkflowStatusMigrationOptionsScheme struct {
+	ProjectKeys []string                        // The keys of the projects whose issues are migrated, all the projects when empty.
+	Concurrency int                             // The number of issues migrated at the same time, WorkflowStatusMigrationConcurrency when zero.
+	Rate        int                             // The maximum number of issues migrated per second, unlimited when zero.
+	Transition  *IssueTransitionToOptionsScheme // The options used to transition each issue to the target status.
+	DryRun      bool                            // Reports the issues in the status without transitioning them.
+}
+
+// WorkflowStatusMigrationScheme represents the result of the migration of the issues from one status to another in Jira.
+type WorkflowStatusMigrationScheme struct {
+	From   string                                // The name of the status the issues are migrated from.
+	To     string                                // The name of the status the issues are migrated to.
+	DryRun bool                                  // Indicates if the issues were only reported.
+	Issues []*WorkflowStatusMigrationIssueScheme // The issues found in the status, in the order returned by the search.
+}
+
+// WorkflowStatusMigrationIssueScheme represents the migration of an issue from one status to another in Jira.
+type WorkflowStatusMigrationIssueScheme struct {
+	IssueKey   string                   // The key of the issue.
+	Transition *IssueTransitionToScheme // The transitions performed on the issue.
+	Err        error                    // The error that prevented the issue from being migrated, if any.
+}
+
+// Err returns the errors of the issues that couldn't be migrated, or nil if every issue was migrated.
+func (m *WorkflowStatusMigrationScheme) Err() error {
+
+	if m == nil {
+		return nil
+	}
+
+	var errs []error
+	for _, issue := range m.Issues {
+		if issue.Err != nil {
+			errs = append(errs, issue.Err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,201 @@
+package models
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+var (
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// UnknownFieldsError represents a response body with fields that the target structure doesn't capture.
+//
+// It matches ErrUnknownFields with errors.Is.
+type UnknownFieldsError struct {
+	Fields []string // The paths of the unknown fields, e.g. values[].newField.
+}
+
+// Error returns the paths of the unknown fields.
+func (e *UnknownFieldsError) Error() string {
+	return fmt.Sprintf("%v: %v", ErrUnknownFields, strings.Join(e.Fields, ", "))
+}
+
+// Is reports whether target is ErrUnknownFields.
+func (e *UnknownFieldsError) Is(target error) bool {
+	return target == ErrUnknownFields
+}
+
+// UnknownFieldsReporter is called with a successful response and the fields of its body that the target
+// structure doesn't capture, the structure being decoded as usual.
+type UnknownFieldsReporter func(response *ResponseScheme, err *UnknownFieldsError)
+
+// ReportUnknownFields calls report with the unknown fields of the response body, if any.
+// It does nothing when report is nil, and the errors of the check are ignored, the structure being already decoded.
+func ReportUnknownFields(report UnknownFieldsReporter, response *ResponseScheme, data []byte, structure interface{}) {
+
+	if report == nil {
+		return
+	}
+
+	var unknownFieldsErr *UnknownFieldsError
+	if errors.As(CheckUnknownFields(data, structure), &unknownFieldsErr) {
+		report(response, unknownFieldsErr)
+	}
+}
+
+// CheckUnknownFields compares the JSON data with the fields of the structure it was decoded into,
+// and returns an UnknownFieldsError listing every key the structure doesn't capture.
+//
+// Types with a custom UnmarshalJSON, maps of interfaces and raw messages are not inspected.
+func CheckUnknownFields(data []byte, structure interface{}) error {
+
+	if structure == nil || len(data) == 0 {
+		return nil
+	}
+
+	var raw interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	found := make(map[string]struct{})
+	collectUnknownFields("", raw, reflect.TypeOf(structure), found)
+
+	if len(found) == 0 {
+		return nil
+	}
+
+	fields := make([]string, 0, len(found))
+	for field := range found {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	return &UnknownFieldsError{Fields: fields}
+}
+
+// collectUnknownFields walks the decoded JSON value along with the type it was decoded into.
+func collectUnknownFields(path string, raw interface{}, t reflect.Type, found map[string]struct{}) {
+
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t.Implements(jsonUnmarshalerType) || reflect.PointerTo(t).Implements(jsonUnmarshalerType) ||
+		t.Implements(textUnmarshalerType) || reflect.PointerTo(t).Implements(textUnmarshalerType) {
+		return
+	}
+
+	switch value := raw.(type) {
+
+	case map[string]interface{}:
+
+		switch t.Kind() {
+
+		case reflect.Struct:
+
+			fields := structJSONFields(t)
+			for key, item := range value {
+
+				fieldType, ok := lookupJSONField(fields, key)
+				if !ok {
+					found[joinFieldPath(path, key)] = struct{}{}
+					continue
+				}
+
+				collectUnknownFields(joinFieldPath(path, key), item, fieldType, found)
+			}
+
+		case reflect.Map:
+
+			for key, item := range value {
+				collectUnknownFields(joinFieldPath(path, key), item, t.Elem(), found)
+			}
+		}
+
+	case []interface{}:
+
+		if t.Kind() != reflect.Slice && t.Kind() != reflect.Array {
+			return
+		}
+
+		for _, item := range value {
+			collectUnknownFields(path+"[]", item, t.Elem(), found)
+		}
+	}
+}
+
+// structJSONFields returns the types of the struct fields keyed by their JSON name, including the embedded structs.
+func structJSONFields(t reflect.Type) map[string]reflect.Type {
+
+	fields := make(map[string]reflect.Type)
+
+	for index := 0; index < t.NumField(); index++ {
+
+		field := t.Field(index)
+		tag := field.Tag.Get("json")
+
+		if tag == "-" || (field.PkgPath != "" && !field.Anonymous) {
+			continue
+		}
+
+		name, _, _ := strings.Cut(tag, ",")
+
+		if field.Anonymous && name == "" {
+
+			embedded := field.Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+
+			if embedded.Kind() == reflect.Struct {
+				for key, value := range structJSONFields(embedded) {
+					if _, ok := fields[key]; !ok {
+						fields[key] = value
+					}
+				}
+				continue
+			}
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		fields[name] = field.Type
+	}
+
+	return fields
+}
+
+// lookupJSONField finds the field for the key, using a case-insensitive match like encoding/json does.
+func lookupJSONField(fields map[string]reflect.Type, key string) (reflect.Type, bool) {
+
+	if fieldType, ok := fields[key]; ok {
+		return fieldType, true
+	}
+
+	for name, fieldType := range fields {
+		if strings.EqualFold(name, key) {
+			return fieldType, true
+		}
+	}
+
+	return nil, false
+}
+
+func joinFieldPath(path, key string) string {
+
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
@@ -0,0 +1,63 @@
+package models
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckUnknownFields(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		data      string
+		structure interface{}
+		want      []string
+	}{
+		{
+			name:      "when the body matches the structure",
+			data:      `{"id":"10001","key":"KP-1","fields":{"summary":"Summary"}}`,
+			structure: &IssueScheme{},
+		},
+		{
+			name:      "when the body has unknown top-level and nested fields",
+			data:      `{"id":"10001","brandNew":1,"fields":{"summary":"Summary","status":{"name":"Done","colorName":"green"}}}`,
+			structure: &IssueScheme{},
+			want:      []string{"brandNew", "fields.status.colorName"},
+		},
+		{
+			name:      "when the body has unknown fields inside a list",
+			data:      `{"values":[{"id":1,"extra":true},{"id":2,"extra":false}]}`,
+			structure: &BoardPageScheme{},
+			want:      []string{"values[].extra"},
+		},
+		{
+			name:      "when the keys only differ in case",
+			data:      `{"ID":"10001","KEY":"KP-1"}`,
+			structure: &IssueScheme{},
+		},
+		{
+			name:      "when the structure is a map of interfaces",
+			data:      `{"anything":{"goes":true}}`,
+			structure: &map[string]interface{}{},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			err := CheckUnknownFields([]byte(testCase.data), testCase.structure)
+
+			if testCase.want == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			var unknownFieldsErr *UnknownFieldsError
+			assert.True(t, errors.Is(err, ErrUnknownFields))
+			assert.True(t, errors.As(err, &unknownFieldsErr))
+			assert.Equal(t, testCase.want, unknownFieldsErr.Fields)
+		})
+	}
+}
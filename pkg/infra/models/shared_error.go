@@ -0,0 +1,325 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// The products used to identify the client that returned an Error.
+const (
+	ProductJira              = "jira"
+	ProductAgile             = "agile"
+	ProductConfluence        = "confluence"
+	ProductServiceManagement = "sm"
+	ProductAdmin             = "admin"
+	ProductAssets            = "assets"
+	ProductBitbucket         = "bitbucket"
+)
+
+// Error represents an unsuccessful response returned by any of the Atlassian products.
+//
+// It wraps the sentinel error that matches the HTTP status (e.g. ErrNotFound or ErrBadRequest), and errors.As can be
+// used to read the details. The statuses wrapping the newer sentinel errors, e.g. ErrConflict, still match
+// ErrInvalidStatusCode like before they had a dedicated one, so the existing errors.Is checks keep working.
+type Error struct {
+	Product     string            // The product that returned the error, e.g. jira or confluence.
+	StatusCode  int               // The HTTP status code of the response.
+	Code        string            // The Atlassian error code, when the response body provides one.
+	Retryable   bool              // Indicates if the request can be retried, e.g. rate limits, gateway errors or maintenances.
+	RetryAfter  time.Duration     // The delay announced by the Retry-After header of the response, zero when not provided.
+	Maintenance bool              // Indicates if the site is under maintenance, a 503 response announcing when to retry.
+	Messages    []string          // The error messages parsed from the response body.
+	Fields      map[string]string // The errors related to specific fields, keyed by field name.
+	Endpoint    string            // The endpoint that the request was made to.
+	Method      string            // The HTTP method used for the request.
+	RequestID   string            // The ID of the request, see ResponseScheme.RequestID.
+	TraceID     string            // The ID of the trace of the request, see ResponseScheme.TraceID.
+	Err         error             // The sentinel error that matches the HTTP status.
+}
+
+// APIError is an alias of Error, the structured error returned for the unsuccessful responses.
+//
+// Example usage:
+//
+//	_, _, err := client.Issue.Get(ctx, "KP-1", nil, nil)
+//
+//	var apiErr *models.APIError
+//	if errors.As(err, &apiErr) {
+//		fmt.Println(apiErr.StatusCode, apiErr.Messages, apiErr.Fields)
+//	}
+type APIError = Error
+
+// Error returns the product, the sentinel message, the status code, the parsed messages and the request and trace IDs,
+// so they can be quoted in a support ticket.
+func (e *Error) Error() string {
+
+	var message strings.Builder
+	fmt.Fprintf(&message, "%v: %v (status %d)", e.Product, e.Err, e.StatusCode)
+
+	if len(e.Messages) != 0 {
+		fmt.Fprintf(&message, ": %v", strings.Join(e.Messages, "; "))
+	}
+
+	if e.RequestID != "" {
+		fmt.Fprintf(&message, " [request id: %v]", e.RequestID)
+	}
+
+	if e.TraceID != "" {
+		fmt.Fprintf(&message, " [trace id: %v]", e.TraceID)
+	}
+
+	return message.String()
+}
+
+// Unwrap returns the sentinel error that matches the HTTP status.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is ErrMaintenance for a maintenance, or ErrInvalidStatusCode for a status without
+// one of the original sentinel errors, ErrNotFound, ErrUnauthorized, ErrInternal and ErrBadRequest.
+func (e *Error) Is(target error) bool {
+
+	if target == ErrMaintenance {
+		return e.Maintenance
+	}
+
+	if target != ErrInvalidStatusCode {
+		return false
+	}
+
+	switch e.StatusCode {
+	case http.StatusNotFound, http.StatusUnauthorized, http.StatusInternalServerError, http.StatusBadRequest:
+		return false
+	default:
+		return true
+	}
+}
+
+// IsRetryable reports whether err is an Error returned for a response that can be retried.
+func IsRetryable(err error) bool {
+
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr.Retryable
+	}
+
+	return false
+}
+
+// NewError builds the Error for an unsuccessful response of the product.
+//
+// The response body is parsed with the error formats used by the Atlassian products, e.g. the Jira
+// errorMessages and errors map, the Service Management errorMessage, or the errors list of the newer APIs.
+func NewError(product string, response *ResponseScheme) *Error {
+
+	apiErr := &Error{
+		Product:    product,
+		StatusCode: response.Code,
+		Endpoint:   response.Endpoint,
+		Method:     response.Method,
+		RequestID:  response.RequestID(),
+		TraceID:    response.TraceID(),
+		Retryable:  isRetryableStatus(response.Code),
+		Err:        statusError(response.Code),
+	}
+
+	apiErr.RetryAfter, _ = response.RetryAfter()
+
+	// The maintenance windows are announced with a Retry-After, unlike the generic unavailability errors
+	_, apiErr.Maintenance = response.Maintenance()
+
+	apiErr.parse(response.Bytes.Bytes())
+
+	return apiErr
+}
+
+// statusError returns the sentinel error that matches the HTTP status code.
+func statusError(code int) error {
+
+	switch code {
+
+	case http.StatusNotFound:
+		return ErrNotFound
+
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+
+	case http.StatusInternalServerError:
+		return ErrInternal
+
+	case http.StatusBadRequest:
+		return ErrBadRequest
+
+	case http.StatusConflict:
+		return ErrConflict
+
+	case http.StatusPreconditionFailed:
+		return ErrPreconditionFailed
+
+	default:
+		return ErrInvalidStatusCode
+	}
+}
+
+// isRetryableStatus reports whether a request that returned the status code can be retried.
+func isRetryableStatus(code int) bool {
+
+	switch code {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// decodeSnippetLength is the maximum number of bytes of the response body kept in a DecodeError.
+const decodeSnippetLength = 256
+
+// DecodeError represents a successful response whose body could not be decoded into the target structure.
+//
+// It matches ErrDecode with errors.Is, and unwraps to the underlying encoding/json error.
+type DecodeError struct {
+	Endpoint   string // The endpoint that the request was made to.
+	Method     string // The HTTP method used for the request.
+	TargetType string // The type name of the structure the body was decoded into.
+	Snippet    string // The beginning of the response body.
+	Err        error  // The underlying decoding error.
+}
+
+// Error returns the target type, the request, the decoding error and the body snippet.
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("%v into %v (%v %v): %v, body: %q", ErrDecode, e.TargetType, e.Method, e.Endpoint, e.Err, e.Snippet)
+}
+
+// Unwrap returns the underlying decoding error.
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is ErrDecode.
+func (e *DecodeError) Is(target error) bool {
+	return target == ErrDecode
+}
+
+// NewDecodeError builds the DecodeError for a response whose body could not be decoded into structure.
+func NewDecodeError(response *ResponseScheme, structure interface{}, err error) *DecodeError {
+
+	snippet := response.Bytes.Bytes()
+	if len(snippet) > decodeSnippetLength {
+		snippet = snippet[:decodeSnippetLength]
+	}
+
+	return &DecodeError{
+		Endpoint:   response.Endpoint,
+		Method:     response.Method,
+		TargetType: fmt.Sprintf("%T", structure),
+		Snippet:    string(snippet),
+		Err:        err,
+	}
+}
+
+// errorPayloadScheme represents the union of the error bodies returned by the Atlassian products.
+type errorPayloadScheme struct {
+	ErrorMessages    []string         `json:"errorMessages"`    // Jira and Agile messages.
+	Errors           json.RawMessage  `json:"errors"`           // Jira field errors map, or the errors list of the newer APIs.
+	ErrorMessage     string           `json:"errorMessage"`     // Service Management message.
+	I18nErrorMessage *errorI18nScheme `json:"i18nErrorMessage"` // Service Management error key.
+	Message          string           `json:"message"`          // Confluence and Admin message.
+	Code             json.RawMessage  `json:"code"`             // Admin error code.
+	Error            json.RawMessage  `json:"error"`            // Bitbucket error object, or a plain message.
+}
+
+// errorI18nScheme represents the translatable error returned by Service Management.
+type errorI18nScheme struct {
+	I18nKey string `json:"i18nKey"`
+}
+
+// errorItemScheme represents an item of the errors list returned by the newer APIs, e.g. Confluence v2 or Admin.
+type errorItemScheme struct {
+	Code   string `json:"code"`
+	Title  string `json:"title"`
+	Detail string `json:"detail"`
+}
+
+// parse fills the code, messages and field errors from the response body, unknown formats are ignored.
+func (e *Error) parse(body []byte) {
+
+	payload := new(errorPayloadScheme)
+	if len(body) == 0 || json.Unmarshal(body, payload) != nil {
+		return
+	}
+
+	e.Messages = append(e.Messages, payload.ErrorMessages...)
+
+	if payload.ErrorMessage != "" {
+		e.Messages = append(e.Messages, payload.ErrorMessage)
+	}
+
+	if payload.Message != "" {
+		e.Messages = append(e.Messages, payload.Message)
+	}
+
+	if payload.I18nErrorMessage != nil {
+		e.Code = payload.I18nErrorMessage.I18nKey
+	}
+
+	var code string
+	if json.Unmarshal(payload.Code, &code) == nil && code != "" {
+		e.Code = code
+	}
+
+	var fields map[string]string
+	var items []*errorItemScheme
+
+	switch {
+	case json.Unmarshal(payload.Errors, &fields) == nil && len(fields) != 0:
+
+		e.Fields = fields
+
+		keys := make([]string, 0, len(fields))
+		for key := range fields {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			e.Messages = append(e.Messages, fmt.Sprintf("%v: %v", key, fields[key]))
+		}
+
+	case json.Unmarshal(payload.Errors, &items) == nil:
+
+		for _, item := range items {
+
+			if item == nil {
+				continue
+			}
+
+			if e.Code == "" {
+				e.Code = item.Code
+			}
+
+			if message := strings.TrimSpace(strings.Join([]string{item.Title, item.Detail}, " ")); message != "" {
+				e.Messages = append(e.Messages, message)
+			}
+		}
+	}
+
+	var bitbucket struct {
+		Message string `json:"message"`
+		Detail  string `json:"detail"`
+	}
+
+	var plain string
+	switch {
+	case json.Unmarshal(payload.Error, &bitbucket) == nil && bitbucket.Message != "":
+		e.Messages = append(e.Messages, strings.TrimSpace(strings.Join([]string{bitbucket.Message, bitbucket.Detail}, " ")))
+	case json.Unmarshal(payload.Error, &plain) == nil && plain != "":
+		e.Messages = append(e.Messages, plain)
+	}
+}
@@ -0,0 +1,242 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewError(t *testing.T) {
+
+	newResponse := func(code int, body string) *ResponseScheme {
+		response := &ResponseScheme{
+			Code:     code,
+			Endpoint: "https://ctreminiom.atlassian.net/rest/api/3/issue",
+			Method:   http.MethodPost,
+		}
+		response.Bytes.WriteString(body)
+		return response
+	}
+
+	tests := []struct {
+		name     string
+		product  string
+		response *ResponseScheme
+		want     *Error
+	}{
+		{
+			name:     "jira error messages and field errors",
+			product:  ProductJira,
+			response: newResponse(http.StatusBadRequest, `{"errorMessages":["Issue does not exist"],"errors":{"summary":"You must specify a summary of the issue."}}`),
+			want: &Error{
+				Product:    ProductJira,
+				StatusCode: http.StatusBadRequest,
+				Messages:   []string{"Issue does not exist", "summary: You must specify a summary of the issue."},
+				Fields:     map[string]string{"summary": "You must specify a summary of the issue."},
+				Endpoint:   "https://ctreminiom.atlassian.net/rest/api/3/issue",
+				Method:     http.MethodPost,
+				Err:        ErrBadRequest,
+			},
+		},
+		{
+			name:     "jira version conflict",
+			product:  ProductJira,
+			response: newResponse(http.StatusConflict, `{"errorMessages":["The issue was updated by another user."],"errors":{}}`),
+			want: &Error{
+				Product:    ProductJira,
+				StatusCode: http.StatusConflict,
+				Messages:   []string{"The issue was updated by another user."},
+				Endpoint:   "https://ctreminiom.atlassian.net/rest/api/3/issue",
+				Method:     http.MethodPost,
+				Err:        ErrConflict,
+			},
+		},
+		{
+			name:     "confluence precondition failed",
+			product:  ProductConfluence,
+			response: newResponse(http.StatusPreconditionFailed, `{"message":"Version must be incremented on update."}`),
+			want: &Error{
+				Product:    ProductConfluence,
+				StatusCode: http.StatusPreconditionFailed,
+				Messages:   []string{"Version must be incremented on update."},
+				Endpoint:   "https://ctreminiom.atlassian.net/rest/api/3/issue",
+				Method:     http.MethodPost,
+				Err:        ErrPreconditionFailed,
+			},
+		},
+		{
+			name:     "service management error message and key",
+			product:  ProductServiceManagement,
+			response: newResponse(http.StatusNotFound, `{"errorMessage":"The request type does not exist","i18nErrorMessage":{"i18nKey":"sd.request.type.not.found","parameters":[]}}`),
+			want: &Error{
+				Product:    ProductServiceManagement,
+				StatusCode: http.StatusNotFound,
+				Code:       "sd.request.type.not.found",
+				Messages:   []string{"The request type does not exist"},
+				Endpoint:   "https://ctreminiom.atlassian.net/rest/api/3/issue",
+				Method:     http.MethodPost,
+				Err:        ErrNotFound,
+			},
+		},
+		{
+			name:     "errors list of the newer apis",
+			product:  ProductConfluence,
+			response: newResponse(http.StatusTooManyRequests, `{"errors":[{"status":429,"code":"TOO_MANY_REQUESTS","title":"Rate limited","detail":"Try again later"}]}`),
+			want: &Error{
+				Product:    ProductConfluence,
+				StatusCode: http.StatusTooManyRequests,
+				Code:       "TOO_MANY_REQUESTS",
+				Retryable:  true,
+				Messages:   []string{"Rate limited Try again later"},
+				Endpoint:   "https://ctreminiom.atlassian.net/rest/api/3/issue",
+				Method:     http.MethodPost,
+				Err:        ErrInvalidStatusCode,
+			},
+		},
+		{
+			name:     "admin message and code",
+			product:  ProductAdmin,
+			response: newResponse(http.StatusUnauthorized, `{"code":"unauthorized","message":"The API key is invalid"}`),
+			want: &Error{
+				Product:    ProductAdmin,
+				StatusCode: http.StatusUnauthorized,
+				Code:       "unauthorized",
+				Messages:   []string{"The API key is invalid"},
+				Endpoint:   "https://ctreminiom.atlassian.net/rest/api/3/issue",
+				Method:     http.MethodPost,
+				Err:        ErrUnauthorized,
+			},
+		},
+		{
+			name:     "bitbucket error object",
+			product:  ProductBitbucket,
+			response: newResponse(http.StatusInternalServerError, `{"type":"error","error":{"message":"Something went wrong","detail":"Please retry"}}`),
+			want: &Error{
+				Product:    ProductBitbucket,
+				StatusCode: http.StatusInternalServerError,
+				Messages:   []string{"Something went wrong Please retry"},
+				Endpoint:   "https://ctreminiom.atlassian.net/rest/api/3/issue",
+				Method:     http.MethodPost,
+				Err:        ErrInternal,
+			},
+		},
+		{
+			name:     "body that is not json",
+			product:  ProductAgile,
+			response: newResponse(http.StatusBadGateway, `<html>Bad Gateway</html>`),
+			want: &Error{
+				Product:    ProductAgile,
+				StatusCode: http.StatusBadGateway,
+				Retryable:  true,
+				Endpoint:   "https://ctreminiom.atlassian.net/rest/api/3/issue",
+				Method:     http.MethodPost,
+				Err:        ErrInvalidStatusCode,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.want, NewError(testCase.product, testCase.response))
+		})
+	}
+}
+
+func TestError_Error(t *testing.T) {
+
+	apiErr := &Error{
+		Product:    ProductJira,
+		StatusCode: http.StatusBadRequest,
+		Messages:   []string{"Issue does not exist", "summary: required"},
+		Err:        ErrBadRequest,
+	}
+
+	assert.Equal(t, "jira: atlassian invalid payload (status 400): Issue does not exist; summary: required", apiErr.Error())
+	assert.True(t, errors.Is(fmt.Errorf("wrapped: %w", apiErr), ErrBadRequest))
+}
+
+func TestAPIError(t *testing.T) {
+
+	response := &ResponseScheme{Code: http.StatusNotFound}
+	response.Bytes.WriteString(`{"errorMessages":["Issue does not exist or you do not have permission to see it."]}`)
+
+	err := fmt.Errorf("wrapped: %w", NewError(ProductJira, response))
+
+	var apiErr *APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+	assert.Equal(t, []string{"Issue does not exist or you do not have permission to see it."}, apiErr.Messages)
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestError_Is(t *testing.T) {
+
+	newError := func(code int) error {
+		return fmt.Errorf("wrapped: %w", NewError(ProductJira, &ResponseScheme{Code: code}))
+	}
+
+	assert.True(t, errors.Is(newError(http.StatusConflict), ErrConflict))
+	assert.True(t, errors.Is(newError(http.StatusConflict), ErrInvalidStatusCode))
+	assert.True(t, errors.Is(newError(http.StatusPreconditionFailed), ErrPreconditionFailed))
+	assert.True(t, errors.Is(newError(http.StatusPreconditionFailed), ErrInvalidStatusCode))
+	assert.True(t, errors.Is(newError(http.StatusTooManyRequests), ErrInvalidStatusCode))
+	assert.True(t, errors.Is(newError(http.StatusNotFound), ErrNotFound))
+	assert.False(t, errors.Is(newError(http.StatusNotFound), ErrInvalidStatusCode))
+	assert.False(t, errors.Is(newError(http.StatusConflict), ErrNotFound))
+}
+
+func TestIsRetryable(t *testing.T) {
+
+	assert.True(t, IsRetryable(fmt.Errorf("wrapped: %w", &Error{Retryable: true, Err: ErrInvalidStatusCode})))
+	assert.False(t, IsRetryable(&Error{Err: ErrNotFound}))
+	assert.False(t, IsRetryable(ErrNotFound))
+}
+
+func TestNewDecodeError(t *testing.T) {
+
+	response := &ResponseScheme{
+		Endpoint: "https://ctreminiom.atlassian.net/rest/api/3/issue/KP-1",
+		Method:   http.MethodGet,
+	}
+	response.Bytes.WriteString(strings.Repeat("x", decodeSnippetLength+10))
+
+	cause := errors.New("unexpected end of JSON input")
+	decodeErr := NewDecodeError(response, &IssueScheme{}, cause)
+
+	assert.Equal(t, "*models.IssueScheme", decodeErr.TargetType)
+	assert.Len(t, decodeErr.Snippet, decodeSnippetLength)
+	assert.True(t, errors.Is(decodeErr, ErrDecode))
+	assert.True(t, errors.Is(decodeErr, cause))
+	assert.Contains(t, decodeErr.Error(), "GET https://ctreminiom.atlassian.net/rest/api/3/issue/KP-1")
+}
+
+func TestError_RequestID(t *testing.T) {
+
+	response := &ResponseScheme{
+		Response: &http.Response{Header: http.Header{
+			"X-Arequestid": {"8a1b2c3d-4e5f"},
+			"Atl-Traceid":  {"7f1c9e2b8d3a4f60"},
+		}},
+		Code: http.StatusNotFound,
+	}
+	response.Bytes.WriteString(`{"errorMessages":["Issue does not exist"]}`)
+
+	assert.Equal(t, "8a1b2c3d-4e5f", response.RequestID())
+	assert.Equal(t, "7f1c9e2b8d3a4f60", response.TraceID())
+
+	apiErr := NewError(ProductJira, response)
+
+	assert.Equal(t, "8a1b2c3d-4e5f", apiErr.RequestID)
+	assert.Equal(t, "7f1c9e2b8d3a4f60", apiErr.TraceID)
+	assert.Equal(t, "jira: no atlassian resource found (status 404): Issue does not exist [request id: 8a1b2c3d-4e5f] [trace id: 7f1c9e2b8d3a4f60]", apiErr.Error())
+
+	response = &ResponseScheme{Response: &http.Response{Header: http.Header{"X-Trace-Id": {"c0ffee"}}}}
+	assert.Equal(t, "", response.RequestID())
+	assert.Equal(t, "c0ffee", response.TraceID())
+
+	assert.Equal(t, "", (*ResponseScheme)(nil).TraceID())
+}
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"context"
+	"net/http"
+)
+
+// The headers commonly set on a single call with WithHeader.
+const (
+	HeaderForceAcceptLanguage = "X-Force-Accept-Language" // Forces the Accept-Language header over the user language.
+	HeaderExperimentalAPI     = "X-ExperimentalApi"       // Opts in the experimental endpoints, e.g. some Service Management endpoints.
+)
+
+// headerKey is the context key of the additional headers of a call.
+type headerKey struct{}
+
+// WithHeader returns a copy of ctx that sets the header on the request of the call made with it.
+//
+// The header replaces the value set by the client, e.g. the Accept or User-Agent headers.
+//
+// e.g. reading the issue fields in Spanish:
+//
+//	ctx = models.WithHeader(ctx, models.HeaderForceAcceptLanguage, "true")
+//	ctx = models.WithHeader(ctx, "Accept-Language", "es")
+//	issue, response, err := client.Issue.Get(ctx, "KP-1", nil, nil)
+func WithHeader(ctx context.Context, key, value string) context.Context {
+
+	header := HeadersFromContext(ctx).Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+
+	header.Set(key, value)
+
+	return context.WithValue(ctx, headerKey{}, header)
+}
+
+// HeadersFromContext returns the headers set on ctx with WithHeader, or nil when there are none.
+func HeadersFromContext(ctx context.Context) http.Header {
+
+	if ctx == nil {
+		return nil
+	}
+
+	header, _ := ctx.Value(headerKey{}).(http.Header)
+
+	return header
+}
@@ -0,0 +1,21 @@
+package models
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithHeader(t *testing.T) {
+
+	ctx := WithHeader(context.Background(), HeaderExperimentalAPI, "opt-in")
+	nested := WithHeader(ctx, "accept-language", "es")
+
+	assert.Equal(t, http.Header{"X-Experimentalapi": {"opt-in"}}, HeadersFromContext(ctx))
+	assert.Equal(t, http.Header{"X-Experimentalapi": {"opt-in"}, "Accept-Language": {"es"}}, HeadersFromContext(nested))
+
+	assert.Nil(t, HeadersFromContext(context.Background()))
+	assert.Nil(t, HeadersFromContext(nil))
+}
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+// htmlHighlightPattern matches the parts of a value highlighted with the <b> or <strong> HTML tags.
+var htmlHighlightPattern = regexp.MustCompile(`(?is)<(b|strong)(?:\s[^>]*)?>(.*?)</(?:b|strong)>`)
+
+// htmlTagPattern matches the HTML tags Atlassian uses to highlight matched query strings, such as <b> and <strong>.
+var htmlTagPattern = regexp.MustCompile(`<[^>]*>`)
+
+// stripHTMLTags removes the HTML tags from the value and unescapes the HTML entities.
+func stripHTMLTags(value string) string {
+	if !strings.ContainsAny(value, "<&") {
+		return value
+	}
+
+	return strings.TrimSpace(html.UnescapeString(htmlTagPattern.ReplaceAllString(value, "")))
+}
+
+// htmlHighlights returns the parts of the value highlighted with the <b> or <strong> HTML tags, free of HTML.
+func htmlHighlights(value string) []string {
+
+	var highlights []string
+	for _, match := range htmlHighlightPattern.FindAllStringSubmatch(value, -1) {
+		if highlight := stripHTMLTags(match[2]); highlight != "" {
+			highlights = append(highlights, highlight)
+		}
+	}
+
+	return highlights
+}
@@ -0,0 +1,134 @@
+package models
+
+import (
+	"context"
+	"iter"
+)
+
+// PageCursorScheme represents the position of the page requested by a PageIterator.
+type PageCursorScheme struct {
+	StartAt       int    // The index of the first item of the page, for the endpoints paginated by offset.
+	MaxResults    int    // The maximum number of items per page.
+	NextPageToken string // The token of the page, for the endpoints paginated by token. Empty for the first page.
+}
+
+// PageResultScheme represents a page read by a PageIterator.
+type PageResultScheme[T any] struct {
+	Values        []T    // The items of the page.
+	IsLast        bool   // Indicates if this is the last page, e.g. the isLast marker of the endpoint.
+	Total         int    // The total number of items, when returned by the endpoint.
+	NextPageToken string // The token of the next page, for the endpoints paginated by token.
+}
+
+// PageFetcher fetches the page at the cursor.
+type PageFetcher[T any] func(ctx context.Context, cursor PageCursorScheme) (*PageResultScheme[T], error)
+
+// PageIterator iterates over the items of a paginated endpoint, fetching the pages on demand.
+//
+// The iteration stops on the last page, detected with the isLast marker, the total or an empty page,
+// on the first error, or when the context is canceled.
+//
+// Example usage:
+//
+//	it := client.Project.Iterate(ctx, options, 50)
+//	for it.Next() {
+//		fmt.Println(it.Value().Key)
+//	}
+//
+//	if err := it.Err(); err != nil {
+//		return err
+//	}
+type PageIterator[T any] struct {
+	ctx    context.Context
+	fetch  PageFetcher[T]
+	cursor PageCursorScheme
+	values []T
+	index  int
+	value  T
+	done   bool
+	err    error
+}
+
+// NewPageIterator returns an iterator fetching the pages of maxResults items with fetch.
+func NewPageIterator[T any](ctx context.Context, maxResults int, fetch PageFetcher[T]) *PageIterator[T] {
+	return &PageIterator[T]{
+		ctx:    ctx,
+		fetch:  fetch,
+		cursor: PageCursorScheme{MaxResults: maxResults},
+	}
+}
+
+// Next advances the iterator to the next item, fetching the next page when needed.
+// It returns false when the items are exhausted or an error occurred, see Err.
+func (p *PageIterator[T]) Next() bool {
+
+	for {
+
+		if p.err != nil {
+			return false
+		}
+
+		if p.index < len(p.values) {
+			p.value = p.values[p.index]
+			p.index++
+			return true
+		}
+
+		if p.done {
+			return false
+		}
+
+		if err := p.ctx.Err(); err != nil {
+			p.err = err
+			return false
+		}
+
+		page, err := p.fetch(p.ctx, p.cursor)
+		if err != nil {
+			p.err = err
+			return false
+		}
+
+		p.values, p.index = page.Values, 0
+		p.cursor.StartAt += len(page.Values)
+		p.cursor.NextPageToken = page.NextPageToken
+
+		p.done = page.IsLast || len(page.Values) == 0 || (page.Total > 0 && p.cursor.StartAt >= page.Total)
+	}
+}
+
+// Value returns the current item, set by the last call to Next.
+func (p *PageIterator[T]) Value() T {
+	return p.value
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (p *PageIterator[T]) Err() error {
+	return p.err
+}
+
+// Values returns the remaining items as a sequence to range over. Check Err once the loop is done.
+func (p *PageIterator[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for p.Next() {
+			if !yield(p.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// Collect returns the remaining items, reading every page.
+func (p *PageIterator[T]) Collect() ([]T, error) {
+
+	var values []T
+	for p.Next() {
+		values = append(values, p.Value())
+	}
+
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	return values, nil
+}
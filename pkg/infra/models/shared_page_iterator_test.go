@@ -0,0 +1,124 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPageIterator(t *testing.T) {
+
+	pages := func(values ...[]int) PageFetcher[int] {
+		return func(ctx context.Context, cursor PageCursorScheme) (*PageResultScheme[int], error) {
+
+			var total int
+			for _, page := range values {
+				total += len(page)
+			}
+
+			for index, startAt := 0, 0; index < len(values); index++ {
+
+				if startAt == cursor.StartAt {
+					return &PageResultScheme[int]{Values: values[index], Total: total}, nil
+				}
+
+				startAt += len(values[index])
+			}
+
+			return &PageResultScheme[int]{Total: total}, nil
+		}
+	}
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	testCases := []struct {
+		name    string
+		ctx     context.Context
+		fetch   PageFetcher[int]
+		want    []int
+		wantErr error
+	}{
+		{
+			name:  "when the pages stop on the total",
+			ctx:   context.Background(),
+			fetch: pages([]int{1, 2}, []int{3, 4}, []int{5}),
+			want:  []int{1, 2, 3, 4, 5},
+		},
+
+		{
+			name: "when the pages stop on the is last marker",
+			ctx:  context.Background(),
+			fetch: func(ctx context.Context, cursor PageCursorScheme) (*PageResultScheme[int], error) {
+
+				if cursor.NextPageToken == "" {
+					return &PageResultScheme[int]{Values: []int{1, 2}, NextPageToken: "token"}, nil
+				}
+
+				return &PageResultScheme[int]{Values: []int{3}, IsLast: true}, nil
+			},
+			want: []int{1, 2, 3},
+		},
+
+		{
+			name:  "when there are no values",
+			ctx:   context.Background(),
+			fetch: pages(),
+		},
+
+		{
+			name: "when a page fails",
+			ctx:  context.Background(),
+			fetch: func(ctx context.Context, cursor PageCursorScheme) (*PageResultScheme[int], error) {
+				return nil, ErrBadRequest
+			},
+			wantErr: ErrBadRequest,
+		},
+
+		{
+			name:    "when the context is canceled",
+			ctx:     canceled,
+			fetch:   pages([]int{1}),
+			wantErr: context.Canceled,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			got, err := NewPageIterator(testCase.ctx, 2, testCase.fetch).Collect()
+
+			if testCase.wantErr != nil {
+				assert.True(t, errors.Is(err, testCase.wantErr), "expected error: %v, got: %v", testCase.wantErr, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.want, got)
+		})
+	}
+}
+
+func TestPageIterator_Values(t *testing.T) {
+
+	var fetched int
+	iterator := NewPageIterator(context.Background(), 2, func(ctx context.Context, cursor PageCursorScheme) (*PageResultScheme[int], error) {
+		fetched++
+		return &PageResultScheme[int]{Values: []int{cursor.StartAt, cursor.StartAt + 1}}, nil
+	})
+
+	var got []int
+	for value := range iterator.Values() {
+
+		got = append(got, value)
+		if len(got) == 3 {
+			break
+		}
+	}
+
+	assert.NoError(t, iterator.Err())
+	assert.Equal(t, []int{0, 1, 2}, got)
+	assert.Equal(t, 2, fetched)
+}
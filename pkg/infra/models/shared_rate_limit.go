@@ -0,0 +1,125 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// RateLimiterScheme is a token bucket throttling the requests sent by a client, shared by all its services.
+//
+// The bucket holds up to burst tokens and is refilled with rps tokens per second; every request takes a token,
+// waiting for it when the bucket is empty. When a request is rate limited with a Retry-After, the bucket is paused
+// for the announced delay, so the other requests sharing the client wait instead of being rate limited too.
+type RateLimiterScheme struct {
+	rps   float64
+	burst int
+
+	mu          sync.Mutex
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+	metrics     RateLimiterMetricsScheme
+}
+
+// RateLimiterMetricsScheme represents the activity of a rate limiter.
+type RateLimiterMetricsScheme struct {
+	Requests int64         // The number of requests that went through the rate limiter.
+	Delayed  int64         // The number of requests that waited for a token.
+	Waited   time.Duration // The total time waited by the delayed requests.
+	Paused   int64         // The number of times the rate limiter was paused by a rate-limited response.
+}
+
+// NewRateLimiter returns a rate limiter allowing rps requests per second on average, with bursts of up to burst requests.
+func NewRateLimiter(rps float64, burst int) (*RateLimiterScheme, error) {
+
+	if rps <= 0 {
+		return nil, fmt.Errorf("requests per second must be greater than zero")
+	}
+
+	if burst <= 0 {
+		return nil, fmt.Errorf("burst must be greater than zero")
+	}
+
+	return &RateLimiterScheme{rps: rps, burst: burst, tokens: float64(burst), last: time.Now()}, nil
+}
+
+// Wait takes a token, waiting until one is available or the context is canceled.
+func (l *RateLimiterScheme) Wait(ctx context.Context) error {
+
+	l.mu.Lock()
+
+	now := time.Now()
+	l.tokens = min(float64(l.burst), l.tokens+now.Sub(l.last).Seconds()*l.rps)
+	l.last = now
+	l.tokens--
+
+	var delay time.Duration
+	if l.tokens < 0 {
+		delay = time.Duration(-l.tokens / l.rps * float64(time.Second))
+	}
+
+	delay = max(delay, l.pausedUntil.Sub(now))
+
+	l.metrics.Requests++
+	if delay > 0 {
+		l.metrics.Delayed++
+		l.metrics.Waited += delay
+	}
+
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+
+		// The token is given back, the request won't be sent
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+
+		return ctx.Err()
+
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Pause holds the requests sharing the rate limiter for the delay, e.g. the Retry-After of a rate-limited response.
+func (l *RateLimiterScheme) Pause(delay time.Duration) {
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if until := time.Now().Add(delay); until.After(l.pausedUntil) {
+		l.pausedUntil = until
+		l.metrics.Paused++
+	}
+}
+
+// Observe pauses the rate limiter when err is a 429 Too Many Requests announcing a Retry-After.
+func (l *RateLimiterScheme) Observe(err error) {
+
+	var apiErr *Error
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusTooManyRequests && apiErr.RetryAfter > 0 {
+		l.Pause(apiErr.RetryAfter)
+	}
+}
+
+// Metrics returns the activity of the rate limiter.
+func (l *RateLimiterScheme) Metrics() RateLimiterMetricsScheme {
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.metrics
+}
@@ -0,0 +1,58 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRateLimiter(t *testing.T) {
+
+	_, err := NewRateLimiter(0, 1)
+	assert.Error(t, err)
+
+	_, err = NewRateLimiter(10, 0)
+	assert.Error(t, err)
+}
+
+func TestRateLimiterScheme_Wait(t *testing.T) {
+
+	limiter, err := NewRateLimiter(100, 2)
+	assert.NoError(t, err)
+
+	// The burst is served without waiting, the next request waits for a token
+	for range 3 {
+		assert.NoError(t, limiter.Wait(context.Background()))
+	}
+
+	metrics := limiter.Metrics()
+	assert.Equal(t, int64(3), metrics.Requests)
+	assert.Equal(t, int64(1), metrics.Delayed)
+	assert.Greater(t, metrics.Waited, time.Duration(0))
+
+	// A canceled request doesn't wait
+	limiter.Pause(time.Minute)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assert.True(t, errors.Is(limiter.Wait(ctx), context.Canceled))
+	assert.Equal(t, int64(1), limiter.Metrics().Paused)
+}
+
+func TestRateLimiterScheme_Observe(t *testing.T) {
+
+	limiter, err := NewRateLimiter(100, 1)
+	assert.NoError(t, err)
+
+	limiter.Observe(&Error{StatusCode: http.StatusBadRequest})
+	limiter.Observe(&Error{StatusCode: http.StatusTooManyRequests})
+	assert.Zero(t, limiter.Metrics().Paused)
+
+	limiter.Observe(&Error{StatusCode: http.StatusTooManyRequests, Retryable: true, RetryAfter: time.Second})
+	assert.Equal(t, int64(1), limiter.Metrics().Paused)
+}
@@ -0,0 +1,217 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The backoffs waited between the attempts of a retryable request, doubled on every attempt up to RetryMaxBackoff.
+//
+// The maintenance windows last longer than the rate limits and the gateway errors, so the requests
+// failing with ErrMaintenance start with the longer MaintenanceBackoff.
+const (
+	RetryBackoff       = time.Second
+	MaintenanceBackoff = 30 * time.Second
+	RetryMaxBackoff    = 5 * time.Minute
+)
+
+// retryDelayPolicy is the policy whose backoff is returned by RetryDelay.
+var retryDelayPolicy = &RetryPolicyScheme{BaseDelay: RetryBackoff, MaxDelay: RetryMaxBackoff}
+
+// RetryAfter returns the delay announced by the Retry-After header of the response, either in seconds or as an HTTP date.
+// It returns false when the response has no Retry-After header or the header cannot be parsed.
+func (r *ResponseScheme) RetryAfter() (time.Duration, bool) {
+
+	if r == nil || r.Response == nil {
+		return 0, false
+	}
+
+	value := strings.TrimSpace(r.Header.Get("Retry-After"))
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+
+	return max(time.Until(date), 0), true
+}
+
+// Maintenance reports whether the response announces a maintenance of the site, a 503 Service Unavailable
+// with a Retry-After header, and returns the announced delay.
+// The 503 responses without Retry-After are generic server errors.
+func (r *ResponseScheme) Maintenance() (time.Duration, bool) {
+
+	if r == nil || r.Code != http.StatusServiceUnavailable {
+		return 0, false
+	}
+
+	return r.RetryAfter()
+}
+
+// RetryDelay returns how long to wait before the next attempt of a request that failed with err, attempt being
+// the number of attempts already made, and whether the request can be retried.
+//
+// The delay grows exponentially from RetryBackoff, or from MaintenanceBackoff for ErrMaintenance, up to
+// RetryMaxBackoff, and it's never shorter than the Retry-After announced by the response.
+//
+// Example usage:
+//
+//	for attempt := 1; ; attempt++ {
+//		issue, _, err := client.Issue.Get(ctx, "KP-1", nil, nil)
+//
+//		delay, retry := models.RetryDelay(err, attempt)
+//		if !retry {
+//			return issue, err
+//		}
+//
+//		time.Sleep(delay)
+//	}
+func RetryDelay(err error, attempt int) (time.Duration, bool) {
+
+	var apiErr *Error
+	if !errors.As(err, &apiErr) || !apiErr.Retryable {
+		return 0, false
+	}
+
+	return retryDelayPolicy.backoff(apiErr, attempt), true
+}
+
+// RetryPolicyScheme represents how a client retries the requests failing with a rate limit, a maintenance or a server error.
+//
+// The delay grows exponentially from BaseDelay up to MaxDelay, or from MaintenanceBackoff for ErrMaintenance, and
+// it's never shorter than the Retry-After announced by the response. The server may have applied a request before
+// failing with a server error, so the requests whose method isn't idempotent, e.g. POST, are only retried when
+// rate limited, the other ones being retried on the gateway errors, the maintenances and 500 Internal Server Error.
+type RetryPolicyScheme struct {
+	MaxAttempts int           // The maximum number of attempts of a request, including the first one.
+	BaseDelay   time.Duration // The delay waited after the first failed attempt.
+	MaxDelay    time.Duration // The maximum delay waited between two attempts, unless the Retry-After is longer.
+}
+
+// NewRetryPolicy returns a retry policy making at most maxAttempts attempts, waiting from baseDelay up to maxDelay between them.
+func NewRetryPolicy(maxAttempts int, baseDelay, maxDelay time.Duration) (*RetryPolicyScheme, error) {
+
+	if maxAttempts <= 0 {
+		return nil, fmt.Errorf("max attempts must be greater than zero")
+	}
+
+	if baseDelay <= 0 {
+		return nil, fmt.Errorf("base delay must be greater than zero")
+	}
+
+	if maxDelay < baseDelay {
+		return nil, fmt.Errorf("max delay must be greater than or equal to the base delay")
+	}
+
+	return &RetryPolicyScheme{MaxAttempts: maxAttempts, BaseDelay: baseDelay, MaxDelay: maxDelay}, nil
+}
+
+// Delay returns how long to wait before the next attempt of the request that failed with err, attempt being
+// the number of attempts already made, and whether the request can be retried.
+func (p *RetryPolicyScheme) Delay(request *http.Request, err error, attempt int) (time.Duration, bool) {
+
+	if p == nil || attempt >= p.MaxAttempts {
+		return 0, false
+	}
+
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		return 0, false
+	}
+
+	if request == nil || !isIdempotentMethod(request.Method) {
+		if apiErr.StatusCode != http.StatusTooManyRequests {
+			return 0, false
+		}
+	} else if !apiErr.Retryable && apiErr.StatusCode != http.StatusInternalServerError {
+		return 0, false
+	}
+
+	return p.backoff(apiErr, attempt), true
+}
+
+// backoff returns the delay to wait after the failed attempt, growing exponentially from BaseDelay, or from
+// MaintenanceBackoff for a maintenance, up to MaxDelay, and never shorter than the Retry-After of the error.
+func (p *RetryPolicyScheme) backoff(apiErr *Error, attempt int) time.Duration {
+
+	backoff, maxDelay := p.BaseDelay, p.MaxDelay
+	if apiErr.Maintenance {
+		backoff, maxDelay = max(backoff, MaintenanceBackoff), max(maxDelay, MaintenanceBackoff)
+	}
+
+	for i := 1; i < attempt && backoff < maxDelay; i++ {
+		backoff *= 2
+	}
+
+	backoff = min(backoff, maxDelay)
+
+	return max(backoff, apiErr.RetryAfter)
+}
+
+// Do sends the request with call until it succeeds, fails with an error that cannot be retried or the attempts are
+// exhausted, waiting the policy delay between the attempts. The result of the last attempt is returned.
+//
+// The body of the request is rewound between the attempts, so the requests without GetBody, e.g. the uploads
+// streamed from a reader, are only sent once. The wait stops when the context of the request is canceled.
+// A nil policy sends the request once.
+func (p *RetryPolicyScheme) Do(request *http.Request, call func(*http.Request) (*ResponseScheme, error)) (*ResponseScheme, error) {
+
+	for attempt := 1; ; attempt++ {
+
+		response, err := call(request)
+
+		delay, retry := p.Delay(request, err, attempt)
+		if !retry {
+			return response, err
+		}
+
+		if request.Body != nil && request.Body != http.NoBody {
+
+			if request.GetBody == nil {
+				return response, err
+			}
+
+			body, bodyErr := request.GetBody()
+			if bodyErr != nil {
+				return response, err
+			}
+
+			request.Body = body
+		}
+
+		timer := time.NewTimer(delay)
+
+		select {
+		case <-request.Context().Done():
+			timer.Stop()
+			return response, err
+		case <-timer.C:
+		}
+	}
+}
+
+// isIdempotentMethod reports whether sending twice a request with the HTTP method has the same effect as sending it once.
+func isIdempotentMethod(method string) bool {
+
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,307 @@
+package models
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResponseScheme_Maintenance(t *testing.T) {
+
+	newResponse := func(code int, retryAfter string) *ResponseScheme {
+		header := http.Header{}
+		if retryAfter != "" {
+			header.Set("Retry-After", retryAfter)
+		}
+		return &ResponseScheme{Response: &http.Response{StatusCode: code, Header: header}, Code: code}
+	}
+
+	tests := []struct {
+		name     string
+		response *ResponseScheme
+		want     time.Duration
+		wantOk   bool
+	}{
+		{
+			name:     "when the maintenance announces the delay in seconds",
+			response: newResponse(http.StatusServiceUnavailable, "120"),
+			want:     2 * time.Minute,
+			wantOk:   true,
+		},
+		{
+			name:     "when the maintenance announces a past date",
+			response: newResponse(http.StatusServiceUnavailable, "Wed, 21 Oct 2015 07:28:00 GMT"),
+			want:     0,
+			wantOk:   true,
+		},
+		{
+			name:     "when the service is unavailable without Retry-After",
+			response: newResponse(http.StatusServiceUnavailable, ""),
+		},
+		{
+			name:     "when the request is rate limited",
+			response: newResponse(http.StatusTooManyRequests, "10"),
+		},
+		{
+			name:     "when the response has no HTTP response",
+			response: &ResponseScheme{Code: http.StatusServiceUnavailable},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.response.Maintenance()
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+
+	newError := func(code int, retryAfter string) error {
+		header := http.Header{}
+		if retryAfter != "" {
+			header.Set("Retry-After", retryAfter)
+		}
+		return NewError(ProductJira, &ResponseScheme{Response: &http.Response{StatusCode: code, Header: header}, Code: code})
+	}
+
+	maintenance := newError(http.StatusServiceUnavailable, "5")
+	assert.True(t, errors.Is(maintenance, ErrMaintenance))
+	assert.True(t, errors.Is(maintenance, ErrInvalidStatusCode))
+	assert.True(t, IsRetryable(maintenance))
+
+	unavailable := newError(http.StatusServiceUnavailable, "")
+	assert.False(t, errors.Is(unavailable, ErrMaintenance))
+
+	tests := []struct {
+		name    string
+		err     error
+		attempt int
+		want    time.Duration
+		wantOk  bool
+	}{
+		{
+			name:    "when the site is under maintenance",
+			err:     maintenance,
+			attempt: 1,
+			want:    MaintenanceBackoff,
+			wantOk:  true,
+		},
+		{
+			name:    "when the maintenance is retried again",
+			err:     maintenance,
+			attempt: 2,
+			want:    2 * MaintenanceBackoff,
+			wantOk:  true,
+		},
+		{
+			name:    "when the service is unavailable",
+			err:     unavailable,
+			attempt: 3,
+			want:    4 * RetryBackoff,
+			wantOk:  true,
+		},
+		{
+			name:    "when the rate limit announces a longer delay",
+			err:     newError(http.StatusTooManyRequests, "20"),
+			attempt: 1,
+			want:    20 * time.Second,
+			wantOk:  true,
+		},
+		{
+			name:    "when the backoff reaches the maximum",
+			err:     maintenance,
+			attempt: 10,
+			want:    RetryMaxBackoff,
+			wantOk:  true,
+		},
+		{
+			name:    "when the error cannot be retried",
+			err:     newError(http.StatusBadRequest, ""),
+			attempt: 1,
+		},
+		{
+			name:    "when the error is not an API error",
+			err:     ErrNoSite,
+			attempt: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := RetryDelay(tt.err, tt.attempt)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRetryPolicyScheme_Delay(t *testing.T) {
+
+	policy, err := NewRetryPolicy(4, time.Second, 3*time.Second)
+	assert.NoError(t, err)
+
+	get := &http.Request{Method: http.MethodGet}
+	post := &http.Request{Method: http.MethodPost}
+
+	testCases := []struct {
+		name      string
+		request   *http.Request
+		err       error
+		attempt   int
+		wantDelay time.Duration
+		wantRetry bool
+	}{
+		{
+			name:      "when the request is rate limited",
+			request:   post,
+			err:       &Error{StatusCode: http.StatusTooManyRequests, Retryable: true},
+			attempt:   2,
+			wantDelay: 2 * time.Second,
+			wantRetry: true,
+		},
+
+		{
+			name:      "when the delay reaches the max delay",
+			request:   get,
+			err:       &Error{StatusCode: http.StatusBadGateway, Retryable: true},
+			attempt:   3,
+			wantDelay: 3 * time.Second,
+			wantRetry: true,
+		},
+
+		{
+			name:      "when the retry after is longer than the max delay",
+			request:   get,
+			err:       &Error{StatusCode: http.StatusTooManyRequests, Retryable: true, RetryAfter: time.Minute},
+			attempt:   1,
+			wantDelay: time.Minute,
+			wantRetry: true,
+		},
+
+		{
+			name:      "when an idempotent request fails with a server error",
+			request:   get,
+			err:       &Error{StatusCode: http.StatusInternalServerError},
+			attempt:   1,
+			wantDelay: time.Second,
+			wantRetry: true,
+		},
+
+		{
+			name:    "when a request that is not idempotent fails with a server error",
+			request: post,
+			err:     &Error{StatusCode: http.StatusInternalServerError},
+			attempt: 1,
+		},
+
+		{
+			name:    "when a request that is not idempotent fails with a gateway error",
+			request: post,
+			err:     &Error{StatusCode: http.StatusBadGateway, Retryable: true},
+			attempt: 1,
+		},
+
+		{
+			name:      "when the site is under maintenance",
+			request:   get,
+			err:       &Error{StatusCode: http.StatusServiceUnavailable, Retryable: true, Maintenance: true, RetryAfter: 5 * time.Second},
+			attempt:   2,
+			wantDelay: MaintenanceBackoff,
+			wantRetry: true,
+		},
+
+		{
+			name:    "when a request that is not idempotent fails with a maintenance",
+			request: post,
+			err:     &Error{StatusCode: http.StatusServiceUnavailable, Retryable: true, Maintenance: true, RetryAfter: 5 * time.Second},
+			attempt: 1,
+		},
+
+		{
+			name:    "when the attempts are exhausted",
+			request: get,
+			err:     &Error{StatusCode: http.StatusTooManyRequests, Retryable: true},
+			attempt: 4,
+		},
+
+		{
+			name:    "when the error is not retryable",
+			request: get,
+			err:     &Error{StatusCode: http.StatusBadRequest},
+			attempt: 1,
+		},
+
+		{
+			name:    "when the request succeeded",
+			request: get,
+			attempt: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			gotDelay, gotRetry := policy.Delay(testCase.request, testCase.err, testCase.attempt)
+
+			assert.Equal(t, testCase.wantDelay, gotDelay)
+			assert.Equal(t, testCase.wantRetry, gotRetry)
+		})
+	}
+}
+
+func TestRetryPolicyScheme_Do(t *testing.T) {
+
+	policy, err := NewRetryPolicy(3, time.Millisecond, time.Millisecond)
+	assert.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, "https://ctreminiom.atlassian.net/rest/api/3/issue", strings.NewReader(`{"fields":{}}`))
+	assert.NoError(t, err)
+
+	var bodies []string
+	response, err := policy.Do(request, func(request *http.Request) (*ResponseScheme, error) {
+
+		body, err := io.ReadAll(request.Body)
+		assert.NoError(t, err)
+		bodies = append(bodies, string(body))
+
+		if len(bodies) < 3 {
+			return &ResponseScheme{Code: http.StatusTooManyRequests}, &Error{StatusCode: http.StatusTooManyRequests, Retryable: true}
+		}
+
+		return &ResponseScheme{Code: http.StatusCreated}, nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, response.Code)
+	assert.Equal(t, []string{`{"fields":{}}`, `{"fields":{}}`, `{"fields":{}}`}, bodies)
+
+	// A nil policy sends the request once
+	var attempts int
+	_, err = (*RetryPolicyScheme)(nil).Do(request, func(request *http.Request) (*ResponseScheme, error) {
+		attempts++
+		return nil, &Error{StatusCode: http.StatusTooManyRequests, Retryable: true}
+	})
+
+	assert.True(t, IsRetryable(err))
+	assert.Equal(t, 1, attempts)
+}
+
+func TestNewRetryPolicy(t *testing.T) {
+
+	_, err := NewRetryPolicy(0, time.Second, time.Second)
+	assert.Error(t, err)
+
+	_, err = NewRetryPolicy(3, 0, time.Second)
+	assert.Error(t, err)
+
+	_, err = NewRetryPolicy(3, time.Minute, time.Second)
+	assert.Error(t, err)
+}
@@ -2,7 +2,13 @@ package models
 
 import (
 	"bytes"
+	"fmt"
+	"io"
 	"net/http"
+	"slices"
+	"sort"
+	"strings"
+	"time"
 )
 
 // ResponseScheme represents the response from an HTTP request.
@@ -13,4 +19,176 @@ type ResponseScheme struct {
 	Endpoint string       // The endpoint that the request was made to.
 	Method   string       // The HTTP method used for the request.
 	Bytes    bytes.Buffer // The response body.
+
+	// Stream is the open body of a streamed response, see StreamedResponseScheme, nil otherwise.
+	// The body is not copied into Bytes, it's read from Reader and must be closed with Close.
+	Stream io.ReadCloser
+
+	// Redaction is the redaction applied by Dump, set by the client from its WithDumpRedaction option.
+	Redaction *DumpRedactionScheme
+}
+
+// The headers identifying a request on the Atlassian side, to be quoted in the support tickets.
+const (
+	RequestIDHeader = "X-Arequestid" // The ID of the request, returned by Jira and Confluence.
+	TraceIDHeader   = "Atl-Traceid"  // The ID of the trace of the request, returned by the Atlassian edge.
+)
+
+// traceIDHeaders are the headers carrying the trace ID, the first one set being used.
+var traceIDHeaders = []string{TraceIDHeader, "X-Trace-Id", "X-B3-Traceid"}
+
+// RequestID returns the ID of the request announced by the X-AREQUESTID header, empty when not returned.
+func (r *ResponseScheme) RequestID() string {
+
+	if r == nil || r.Response == nil {
+		return ""
+	}
+
+	return r.Header.Get(RequestIDHeader)
+}
+
+// TraceID returns the ID of the trace of the request announced by the ATL-TraceId or X-Trace-Id headers,
+// empty when not returned.
+func (r *ResponseScheme) TraceID() string {
+
+	if r == nil || r.Response == nil {
+		return ""
+	}
+
+	for _, header := range traceIDHeaders {
+		if value := r.Header.Get(header); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+// Date returns the time of the server announced by the Date header of the response, e.g. to take a sync checkpoint
+// on the clock of the server. It returns false when the response has no Date header or the header cannot be parsed.
+func (r *ResponseScheme) Date() (time.Time, bool) {
+
+	if r == nil || r.Response == nil {
+		return time.Time{}, false
+	}
+
+	date, err := http.ParseTime(r.Header.Get("Date"))
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return date, true
+}
+
+// DumpRedactedValue replaces the values of the sensitive headers written by ResponseScheme.Dump.
+const DumpRedactedValue = "[REDACTED]"
+
+// dumpSensitiveHeaders are the headers whose values are always redacted by ResponseScheme.Dump.
+var dumpSensitiveHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie", "X-Api-Key"}
+
+// DumpRedactionScheme represents the redaction applied by ResponseScheme.Dump to the traces of the responses of a client.
+type DumpRedactionScheme struct {
+	// SensitiveHeaders are the headers whose values are redacted in addition to the authentication and cookie headers.
+	SensitiveHeaders []string
+
+	// Redactor, when set, is called with every header value and the body before they're written.
+	// The name is the canonical header name, or empty for the body, and the returned value is written instead.
+	// It can be used to scrub tokens or personal data from the trace.
+	Redactor func(name, value string) string
+}
+
+// redact returns the value of the header, or of the body when the name is empty, to be written in the trace.
+func (d *DumpRedactionScheme) redact(name, value string) string {
+
+	sensitive := dumpSensitiveHeaders
+	if d != nil {
+		sensitive = append(slices.Clone(sensitive), d.SensitiveHeaders...)
+	}
+
+	if name != "" {
+		for _, header := range sensitive {
+			if strings.EqualFold(name, header) {
+				value = DumpRedactedValue
+				break
+			}
+		}
+	}
+
+	if d != nil && d.Redactor != nil {
+		value = d.Redactor(name, value)
+	}
+
+	return value
+}
+
+// Dump writes an HTTP trace of the request and the response to w, to be attached to bug reports.
+//
+// The trace contains the request line and headers, the status line, the response headers and, when includeBody
+// is set, the response body. The values of the authentication and cookie headers are redacted, as well as the
+// headers and the body matched by the Redaction of the response.
+func (r *ResponseScheme) Dump(w io.Writer, includeBody bool) error {
+
+	if r == nil {
+		return nil
+	}
+
+	var trace strings.Builder
+
+	var request *http.Request
+	if r.Response != nil {
+		request = r.Response.Request
+	}
+
+	fmt.Fprintf(&trace, "> %v %v\n", r.Method, r.Endpoint)
+	if request != nil {
+		dumpHeaders(&trace, ">", request.Header, r.Redaction)
+	}
+	trace.WriteString(">\n")
+
+	proto, status := "HTTP/1.1", fmt.Sprintf("%d %v", r.Code, http.StatusText(r.Code))
+	if r.Response != nil {
+
+		if r.Response.Proto != "" {
+			proto = r.Response.Proto
+		}
+
+		if r.Response.Status != "" {
+			status = r.Response.Status
+		}
+	}
+
+	fmt.Fprintf(&trace, "< %v %v\n", proto, status)
+	if r.Response != nil {
+		dumpHeaders(&trace, "<", r.Response.Header, r.Redaction)
+	}
+	trace.WriteString("<\n")
+
+	if includeBody && r.Bytes.Len() != 0 {
+
+		body := r.Redaction.redact("", r.Bytes.String())
+
+		trace.WriteString(body)
+		if !strings.HasSuffix(body, "\n") {
+			trace.WriteString("\n")
+		}
+	}
+
+	_, err := io.WriteString(w, trace.String())
+	return err
+}
+
+// dumpHeaders writes the headers sorted by name, one line per value, redacting the sensitive ones.
+func dumpHeaders(trace *strings.Builder, prefix string, header http.Header, redaction *DumpRedactionScheme) {
+
+	names := make([]string, 0, len(header))
+	for name := range header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		for _, value := range header[name] {
+			fmt.Fprintf(trace, "%v %v: %v\n", prefix, name, redaction.redact(http.CanonicalHeaderKey(name), value))
+		}
+	}
 }
@@ -0,0 +1,133 @@
+package models
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResponseScheme_Dump(t *testing.T) {
+
+	request, err := http.NewRequest(http.MethodGet, "https://ctreminiom.atlassian.net/rest/api/3/issue/KP-1", nil)
+	assert.NoError(t, err)
+
+	request.Header.Set("Authorization", "Basic c2VjcmV0")
+	request.Header.Set("Accept", "application/json")
+
+	response := &ResponseScheme{
+		Response: &http.Response{
+			Status:  "404 Not Found",
+			Proto:   "HTTP/2.0",
+			Header:  http.Header{"Content-Type": {"application/json"}, "Set-Cookie": {"tenant.session.token=abc"}, "X-Arequestid": {"5a1c"}},
+			Request: request,
+		},
+		Code:     http.StatusNotFound,
+		Endpoint: "https://ctreminiom.atlassian.net/rest/api/3/issue/KP-1",
+		Method:   http.MethodGet,
+	}
+	response.Bytes.WriteString(`{"errorMessages":["Issue does not exist or you do not have permission to see it."],"errors":{}}`)
+
+	testCases := []struct {
+		name        string
+		includeBody bool
+		redaction   *DumpRedactionScheme
+		want        string
+	}{
+		{
+			name:        "when the body is included",
+			includeBody: true,
+			want: `> GET https://ctreminiom.atlassian.net/rest/api/3/issue/KP-1
+> Accept: application/json
+> Authorization: [REDACTED]
+>
+< HTTP/2.0 404 Not Found
+< Content-Type: application/json
+< Set-Cookie: [REDACTED]
+< X-Arequestid: 5a1c
+<
+{"errorMessages":["Issue does not exist or you do not have permission to see it."],"errors":{}}
+`,
+		},
+		{
+			name:        "when the redactor is set",
+			includeBody: true,
+			redaction: &DumpRedactionScheme{
+				SensitiveHeaders: []string{"accept"},
+				Redactor: func(name, value string) string {
+					if name == "" {
+						return strings.ReplaceAll(value, "Issue", "Entity")
+					}
+
+					if name == "X-Arequestid" {
+						return "-"
+					}
+
+					return value
+				},
+			},
+			want: `> GET https://ctreminiom.atlassian.net/rest/api/3/issue/KP-1
+> Accept: [REDACTED]
+> Authorization: [REDACTED]
+>
+< HTTP/2.0 404 Not Found
+< Content-Type: application/json
+< Set-Cookie: [REDACTED]
+< X-Arequestid: -
+<
+{"errorMessages":["Entity does not exist or you do not have permission to see it."],"errors":{}}
+`,
+		},
+		{
+			name:        "when the body is not included",
+			includeBody: false,
+			want: `> GET https://ctreminiom.atlassian.net/rest/api/3/issue/KP-1
+> Accept: application/json
+> Authorization: [REDACTED]
+>
+< HTTP/2.0 404 Not Found
+< Content-Type: application/json
+< Set-Cookie: [REDACTED]
+< X-Arequestid: 5a1c
+<
+`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			redacted := *response
+			redacted.Redaction = testCase.redaction
+
+			var trace bytes.Buffer
+			assert.NoError(t, redacted.Dump(&trace, testCase.includeBody))
+			assert.Equal(t, testCase.want, trace.String())
+		})
+	}
+
+	t.Run("when the http response is not set", func(t *testing.T) {
+
+		var trace bytes.Buffer
+		assert.NoError(t, (&ResponseScheme{Code: http.StatusOK, Method: http.MethodDelete, Endpoint: "rest/api/3/issue/KP-1"}).Dump(&trace, true))
+		assert.Equal(t, "> DELETE rest/api/3/issue/KP-1\n>\n< HTTP/1.1 200 OK\n<\n", trace.String())
+	})
+}
+
+func TestResponseScheme_Date(t *testing.T) {
+
+	response := &ResponseScheme{Response: &http.Response{Header: http.Header{"Date": {"Wed, 10 Jan 2024 09:30:45 GMT"}}}}
+
+	date, ok := response.Date()
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2024, time.January, 10, 9, 30, 45, 0, time.UTC), date)
+
+	_, ok = (&ResponseScheme{Response: &http.Response{Header: http.Header{"Date": {"yesterday"}}}}).Date()
+	assert.False(t, ok)
+
+	_, ok = (&ResponseScheme{}).Date()
+	assert.False(t, ok)
+}
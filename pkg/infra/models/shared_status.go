@@ -0,0 +1,42 @@
+package models
+
+import (
+	"context"
+	"slices"
+)
+
+// expectedStatusKey is the context key of the HTTP statuses expected by a call.
+type expectedStatusKey struct{}
+
+// WithExpectedStatus returns a copy of ctx that declares the HTTP statuses expected by the call made with it.
+//
+// An unsuccessful response with one of the statuses doesn't return an error, the status is available on
+// ResponseScheme.Code and the body on ResponseScheme.Bytes, the body is not decoded into the structure.
+//
+// e.g. creating a resource that may already exist:
+//
+//	ctx := models.WithExpectedStatus(ctx, http.StatusConflict)
+//	response, err := client.Issue.Link.Create(ctx, payload)
+//	if err == nil && response.Code == http.StatusConflict {
+//		// the link already exists.
+//	}
+//
+// Without it, the 409 and 412 statuses are returned as ErrConflict and ErrPreconditionFailed.
+func WithExpectedStatus(ctx context.Context, codes ...int) context.Context {
+
+	expected, _ := ctx.Value(expectedStatusKey{}).([]int)
+
+	return context.WithValue(ctx, expectedStatusKey{}, append(slices.Clone(expected), codes...))
+}
+
+// IsExpectedStatus reports whether the status code was declared as expected on ctx with WithExpectedStatus.
+func IsExpectedStatus(ctx context.Context, code int) bool {
+
+	if ctx == nil {
+		return false
+	}
+
+	expected, _ := ctx.Value(expectedStatusKey{}).([]int)
+
+	return slices.Contains(expected, code)
+}
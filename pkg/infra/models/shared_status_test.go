@@ -0,0 +1,24 @@
+package models
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithExpectedStatus(t *testing.T) {
+
+	ctx := WithExpectedStatus(context.Background(), http.StatusConflict)
+	nested := WithExpectedStatus(ctx, http.StatusPreconditionFailed)
+
+	assert.True(t, IsExpectedStatus(ctx, http.StatusConflict))
+	assert.False(t, IsExpectedStatus(ctx, http.StatusPreconditionFailed))
+
+	assert.True(t, IsExpectedStatus(nested, http.StatusConflict))
+	assert.True(t, IsExpectedStatus(nested, http.StatusPreconditionFailed))
+
+	assert.False(t, IsExpectedStatus(context.Background(), http.StatusConflict))
+	assert.False(t, IsExpectedStatus(nil, http.StatusConflict))
+}
@@ -0,0 +1,59 @@
+package models
+
+import (
+	"bytes"
+	"io"
+)
+
+// StreamedResponseScheme is passed to Call instead of a structure by the download methods, e.g.
+// Issue.Attachment.DownloadStream, to stream the body of the successful response instead of reading it into
+// ResponseScheme.Bytes, cutting the memory used by the attachment downloads.
+//
+// The streaming is scoped to the request it's passed with: the body is read from ResponseScheme.Reader and must be
+// closed with ResponseScheme.Close. The unsuccessful responses are read as usual to build the error.
+//
+// e.g. downloading an attachment to a file:
+//
+//	response, err := client.Issue.Attachment.DownloadStream(ctx, "10001", false)
+//	if err != nil {
+//		return err
+//	}
+//	defer response.Close()
+//
+//	_, err = io.Copy(file, response.Reader())
+type StreamedResponseScheme struct{}
+
+// IsStreamedResponse reports whether the structure passed to Call requests a streamed response, see StreamedResponseScheme.
+func IsStreamedResponse(structure interface{}) bool {
+
+	_, ok := structure.(*StreamedResponseScheme)
+
+	return ok
+}
+
+// Reader returns the response body, read from the Stream of a streamed response, or from Bytes otherwise.
+// The Bytes are not consumed, so Reader can be called many times on a response that isn't streamed.
+//
+// The method isn't named Body, it would shadow the closed body of the embedded http.Response.
+func (r *ResponseScheme) Reader() io.Reader {
+
+	if r == nil {
+		return bytes.NewReader(nil)
+	}
+
+	if r.Stream != nil {
+		return r.Stream
+	}
+
+	return bytes.NewReader(r.Bytes.Bytes())
+}
+
+// Close closes the Stream of a streamed response, it's a no-op for the other responses.
+func (r *ResponseScheme) Close() error {
+
+	if r == nil || r.Stream == nil {
+		return nil
+	}
+
+	return r.Stream.Close()
+}
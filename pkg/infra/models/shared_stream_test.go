@@ -0,0 +1,38 @@
+package models
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsStreamedResponse(t *testing.T) {
+
+	assert.True(t, IsStreamedResponse(new(StreamedResponseScheme)))
+	assert.False(t, IsStreamedResponse(new(IssueScheme)))
+	assert.False(t, IsStreamedResponse(nil))
+}
+
+func TestResponseScheme_Reader(t *testing.T) {
+
+	buffered := new(ResponseScheme)
+	buffered.Bytes.WriteString("attachment content")
+
+	for range 2 {
+		content, err := io.ReadAll(buffered.Reader())
+		assert.NoError(t, err)
+		assert.Equal(t, "attachment content", string(content))
+	}
+
+	assert.NoError(t, buffered.Close())
+
+	streamed := &ResponseScheme{Stream: io.NopCloser(strings.NewReader("streamed content"))}
+
+	content, err := io.ReadAll(streamed.Reader())
+	assert.NoError(t, err)
+	assert.Equal(t, "streamed content", string(content))
+	assert.Zero(t, streamed.Bytes.Len())
+	assert.NoError(t, streamed.Close())
+}
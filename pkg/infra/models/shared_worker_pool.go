@@ -0,0 +1,77 @@
+package models
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RunWorkers calls task for every index in [0, count) from a fixed pool of workers, and returns the task errors
+// indexed like the tasks.
+//
+// At most workers tasks run at the same time, one when workers isn't positive. When rate is positive, at most rate
+// tasks are started per second; rates too high to be throttled are unlimited. Once ctx is canceled, the tasks that
+// haven't started yet aren't called and get the context error.
+func RunWorkers(ctx context.Context, count, workers, rate int, task func(index int) error) []error {
+
+	errs := make([]error, count)
+	if count <= 0 {
+		return errs
+	}
+
+	workers = min(max(workers, 1), count)
+
+	var throttle <-chan time.Time
+	if rate > 0 {
+		if interval := time.Second / time.Duration(rate); interval > 0 {
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			throttle = ticker.C
+		}
+	}
+
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for index := range indexes {
+				if err := ctx.Err(); err != nil {
+					errs[index] = err
+					continue
+				}
+
+				errs[index] = task(index)
+			}
+		}()
+	}
+
+	for index := range count {
+
+		if throttle != nil && index > 0 {
+			select {
+			case <-ctx.Done():
+			case <-throttle:
+			}
+		}
+
+		if err := ctx.Err(); err != nil {
+			errs[index] = err
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			errs[index] = ctx.Err()
+		case indexes <- index:
+		}
+	}
+
+	close(indexes)
+	wg.Wait()
+
+	return errs
+}
@@ -0,0 +1,95 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunWorkers(t *testing.T) {
+
+	t.Run("when the tasks are bounded by the workers", func(t *testing.T) {
+
+		var running, peak atomic.Int64
+		errs := RunWorkers(context.Background(), 20, 3, 0, func(index int) error {
+
+			current := running.Add(1)
+			defer running.Add(-1)
+
+			for {
+				previous := peak.Load()
+				if current <= previous || peak.CompareAndSwap(previous, current) {
+					break
+				}
+			}
+
+			if index == 7 {
+				return errors.New("task failed")
+			}
+
+			return nil
+		})
+
+		assert.Len(t, errs, 20)
+		assert.LessOrEqual(t, peak.Load(), int64(3))
+
+		for index, err := range errs {
+			if index == 7 {
+				assert.EqualError(t, err, "task failed")
+				continue
+			}
+
+			assert.NoError(t, err)
+		}
+	})
+
+	t.Run("when the rate is too high to be throttled", func(t *testing.T) {
+
+		var calls atomic.Int64
+		errs := RunWorkers(context.Background(), 5, 0, math.MaxInt, func(int) error {
+			calls.Add(1)
+			return nil
+		})
+
+		assert.Len(t, errs, 5)
+		assert.Equal(t, int64(5), calls.Load())
+	})
+
+	t.Run("when the rate throttles the tasks", func(t *testing.T) {
+
+		var calls atomic.Int64
+		errs := RunWorkers(context.Background(), 3, 3, 1000, func(int) error {
+			calls.Add(1)
+			return nil
+		})
+
+		assert.Equal(t, []error{nil, nil, nil}, errs)
+		assert.Equal(t, int64(3), calls.Load())
+	})
+
+	t.Run("when the context is canceled", func(t *testing.T) {
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		errs := RunWorkers(ctx, 4, 1, 0, func(index int) error {
+			if index == 1 {
+				cancel()
+			}
+
+			return nil
+		})
+
+		assert.NoError(t, errs[0])
+		assert.NoError(t, errs[1])
+		assert.ErrorIs(t, errs[2], context.Canceled)
+		assert.ErrorIs(t, errs[3], context.Canceled)
+	})
+
+	t.Run("when there are no tasks", func(t *testing.T) {
+		assert.Empty(t, RunWorkers(context.Background(), 0, 5, 0, func(int) error { return nil }))
+	})
+}
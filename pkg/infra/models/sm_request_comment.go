@@ -1,12 +1,32 @@
 package models
 
+// The expansions supported by the request comment endpoints.
+const (
+	RequestCommentExpandAttachment   = "attachment"   // Returns the attachments metadata of the comment.
+	RequestCommentExpandRenderedBody = "renderedBody" // Returns the body of the comment rendered in HTML.
+)
+
+// RequestCommentVisibility filters the request comments by their visibility.
+type RequestCommentVisibility string
+
+// The visibilities supported by the request comment filters.
+const (
+	RequestCommentVisibilityAll      RequestCommentVisibility = ""         // Returns the public and internal comments.
+	RequestCommentVisibilityPublic   RequestCommentVisibility = "public"   // Returns the comments visible to the customers only.
+	RequestCommentVisibilityInternal RequestCommentVisibility = "internal" // Returns the comments visible to the agents only.
+)
+
 // RequestCommentOptionsScheme represents the options for filtering and paginating request comments.
+//
+// Visibility takes precedence over the Public and Internal flags, and RenderedBody adds the renderedBody expansion.
 type RequestCommentOptionsScheme struct {
-	Public   *bool    `url:"public,omitempty"`
-	Internal *bool    `url:"internal,omitempty"`
-	Expand   []string `url:"expand,omitempty"`
-	Start    int      `url:"start,omitempty"`
-	Limit    int      `url:"limit,omitempty"`
+	Public       *bool                    `url:"public,omitempty"`
+	Internal     *bool                    `url:"internal,omitempty"`
+	Visibility   RequestCommentVisibility `url:"-"`
+	RenderedBody bool                     `url:"-"`
+	Expand       []string                 `url:"expand,omitempty"`
+	Start        int                      `url:"start,omitempty"`
+	Limit        int                      `url:"limit,omitempty"`
 }
 
 // RequestCommentPageScheme represents a page of request comments in a system.
@@ -233,5 +233,5 @@ func (c *CreateCustomerRequestPayloadScheme) Components(components []string) err
 		values = append(values, map[string]interface{}{"name": component})
 	}
 
-	return c.AddCustomField("components", values)
+	return c.AddCustomField(IssueFieldComponents, values)
 }
@@ -0,0 +1,212 @@
+package models
+
+import (
+	"time"
+)
+
+// RequestFieldValues represents a collection of typed request field values, the customer request counterpart of CustomFields.
+//
+// The collection is added to the request payload with CreateCustomerRequestPayloadScheme.FieldValues.
+type RequestFieldValues struct{ Values map[string]interface{} }
+
+// Summary adds the summary field to the collection.
+func (r *RequestFieldValues) Summary(summary string) error {
+	return r.Text(IssueFieldSummary, summary)
+}
+
+// Description adds the description field to the collection.
+func (r *RequestFieldValues) Description(description string) error {
+	return r.Text(IssueFieldDescription, description)
+}
+
+// Text adds a text field to the collection.
+func (r *RequestFieldValues) Text(fieldID, textValue string) error {
+
+	if textValue == "" {
+		return ErrNoTextType
+	}
+
+	return r.Raw(fieldID, textValue)
+}
+
+// URL adds a URL field to the collection.
+func (r *RequestFieldValues) URL(fieldID, URL string) error {
+
+	if URL == "" {
+		return ErrNoURLType
+	}
+
+	return r.Raw(fieldID, URL)
+}
+
+// Number adds a number field to the collection.
+func (r *RequestFieldValues) Number(fieldID string, numberValue float64) error {
+	return r.Raw(fieldID, numberValue)
+}
+
+// Labels adds the labels field to the collection.
+func (r *RequestFieldValues) Labels(labels []string) error {
+
+	if len(labels) == 0 {
+		return ErrNoLabelsType
+	}
+
+	return r.Raw(IssueFieldLabels, labels)
+}
+
+// Date adds a date field to the collection.
+func (r *RequestFieldValues) Date(fieldID string, dateValue time.Time) error {
+
+	if dateValue.IsZero() {
+		return ErrNoDatePickerType
+	}
+
+	return r.Raw(fieldID, dateValue.Format("2006-01-02"))
+}
+
+// DateTime adds a datetime field to the collection.
+func (r *RequestFieldValues) DateTime(fieldID string, dateTimeValue time.Time) error {
+
+	if dateTimeValue.IsZero() {
+		return ErrNoDateTimeType
+	}
+
+	return r.Raw(fieldID, dateTimeValue.Format(time.RFC3339))
+}
+
+// Select adds a select or radio button field to the collection.
+func (r *RequestFieldValues) Select(fieldID, option string) error {
+
+	if option == "" {
+		return ErrNoSelectType
+	}
+
+	return r.Raw(fieldID, map[string]interface{}{"value": option})
+}
+
+// MultiSelect adds a multi-select or checkbox field to the collection.
+func (r *RequestFieldValues) MultiSelect(fieldID string, options []string) error {
+
+	if len(options) == 0 {
+		return ErrNoMultiSelectType
+	}
+
+	var optionsNode []map[string]interface{}
+	for _, option := range options {
+		optionsNode = append(optionsNode, map[string]interface{}{"value": option})
+	}
+
+	return r.Raw(fieldID, optionsNode)
+}
+
+// User adds a user field to the collection.
+func (r *RequestFieldValues) User(fieldID, accountID string) error {
+
+	if accountID == "" {
+		return ErrNoUserType
+	}
+
+	return r.Raw(fieldID, map[string]interface{}{"accountId": accountID})
+}
+
+// Users adds a multi-user field to the collection.
+func (r *RequestFieldValues) Users(fieldID string, accountIDs []string) error {
+
+	if len(accountIDs) == 0 {
+		return ErrNoMultiUserType
+	}
+
+	var accountsNode []map[string]interface{}
+	for _, accountID := range accountIDs {
+
+		if accountID == "" {
+			return ErrNoUserType
+		}
+
+		accountsNode = append(accountsNode, map[string]interface{}{"accountId": accountID})
+	}
+
+	return r.Raw(fieldID, accountsNode)
+}
+
+// Cascading adds a cascading field to the collection.
+func (r *RequestFieldValues) Cascading(fieldID, parent, child string) error {
+
+	if parent == "" {
+		return ErrNoCascadingParent
+	}
+
+	if child == "" {
+		return ErrNoCascadingChild
+	}
+
+	return r.Raw(fieldID, map[string]interface{}{"value": parent, "child": map[string]interface{}{"value": child}})
+}
+
+// Raw adds an untyped field to the collection.
+func (r *RequestFieldValues) Raw(fieldID string, value interface{}) error {
+
+	if fieldID == "" {
+		return ErrNoCustomFieldID
+	}
+
+	if r.Values == nil {
+		r.Values = make(map[string]interface{})
+	}
+
+	r.Values[fieldID] = value
+	return nil
+}
+
+// FieldValues adds the typed request field values to the request payload.
+func (c *CreateCustomerRequestPayloadScheme) FieldValues(values *RequestFieldValues) error {
+
+	if values == nil || len(values.Values) == 0 {
+		return ErrNoValueType
+	}
+
+	for fieldID, value := range values.Values {
+		if err := c.AddCustomField(fieldID, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// OnBehalfOf raises the request on behalf of the customer with the account ID provided.
+//
+// To raise the request on behalf of a customer identified by email, use the Request.CreateOnBehalfOf method.
+func (c *CreateCustomerRequestPayloadScheme) OnBehalfOf(accountID string) error {
+
+	if accountID == "" {
+		return ErrNoAccountID
+	}
+
+	c.RaiseOnBehalfOf = accountID
+	return nil
+}
+
+// Participants adds the account IDs provided as participants of the request at creation time.
+func (c *CreateCustomerRequestPayloadScheme) Participants(accountIDs ...string) error {
+
+	if len(accountIDs) == 0 {
+		return ErrNoAccountSlice
+	}
+
+	for _, accountID := range accountIDs {
+		if accountID == "" {
+			return ErrNoAccountID
+		}
+	}
+
+	c.RequestParticipants = append(c.RequestParticipants, accountIDs...)
+	return nil
+}
+
+// RequestOnBehalfOfScheme represents the customer a request is raised on behalf of, identified by email.
+type RequestOnBehalfOfScheme struct {
+	Email          string // The email address of the customer.
+	DisplayName    string // The display name used when the customer is created.
+	CreateCustomer bool   // Creates the customer and adds it to the service desk when no customer matches the email, it requires the permission to create customers.
+}
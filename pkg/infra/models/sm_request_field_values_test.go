@@ -0,0 +1,54 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestFieldValues(t *testing.T) {
+
+	values := &RequestFieldValues{}
+
+	assert.NoError(t, values.Summary("Request JSD help via REST"))
+	assert.NoError(t, values.Number("customfield_10010", 3))
+	assert.NoError(t, values.Labels([]string{"label-00"}))
+	assert.NoError(t, values.Date("customfield_10011", time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)))
+	assert.NoError(t, values.Select("priority", "Major"))
+	assert.NoError(t, values.Users("customfield_10012", []string{"account-id-sample"}))
+	assert.NoError(t, values.Cascading("customfield_10013", "America", "Costa Rica"))
+
+	assert.ErrorIs(t, values.Text("customfield_10014", ""), ErrNoTextType)
+	assert.ErrorIs(t, values.Users("customfield_10012", []string{""}), ErrNoUserType)
+	assert.ErrorIs(t, values.Raw("", "value"), ErrNoCustomFieldID)
+
+	payload := &CreateCustomerRequestPayloadScheme{}
+	assert.NoError(t, payload.FieldValues(values))
+	assert.ErrorIs(t, payload.FieldValues(&RequestFieldValues{}), ErrNoValueType)
+
+	assert.Equal(t, map[string]interface{}{
+		"summary":           "Request JSD help via REST",
+		"customfield_10010": float64(3),
+		"labels":            []string{"label-00"},
+		"customfield_10011": "2024-05-01",
+		"priority":          map[string]interface{}{"value": "Major"},
+		"customfield_10012": []map[string]interface{}{{"accountId": "account-id-sample"}},
+		"customfield_10013": map[string]interface{}{"value": "America", "child": map[string]interface{}{"value": "Costa Rica"}},
+	}, payload.RequestFieldValues)
+}
+
+func TestCreateCustomerRequestPayloadScheme_OnBehalfOf(t *testing.T) {
+
+	payload := &CreateCustomerRequestPayloadScheme{}
+
+	assert.ErrorIs(t, payload.OnBehalfOf(""), ErrNoAccountID)
+	assert.NoError(t, payload.OnBehalfOf("account-id-sample"))
+	assert.Equal(t, "account-id-sample", payload.RaiseOnBehalfOf)
+
+	assert.ErrorIs(t, payload.Participants(), ErrNoAccountSlice)
+	assert.ErrorIs(t, payload.Participants("account-id-sample", ""), ErrNoAccountID)
+
+	assert.NoError(t, payload.Participants("account-id-sample-1", "account-id-sample-2"))
+	assert.Equal(t, []string{"account-id-sample-1", "account-id-sample-2"}, payload.RequestParticipants)
+}
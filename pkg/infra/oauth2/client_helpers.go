@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/ctreminiom/go-atlassian/v2/service/common"
 )
@@ -70,4 +71,116 @@ func CreateOAuthTransport(source TokenSource, base http.RoundTripper, auth commo
 		Base:   base,
 		Auth:   auth,
 	}
-}
\ No newline at end of file
+}
+
+// LimitConnsPerHost returns a copy of the HTTP client whose transport opens at most maxConns connections to a host
+// and keeps as many of them idle for reuse, so the high-throughput back-fills reuse a bounded connection pool instead
+// of opening a connection per request. HTTP/2 is attempted, and the client is tuned as ConfigureTransport does.
+func LimitConnsPerHost(httpClient common.HTTPClient, maxConns int) (common.HTTPClient, error) {
+
+	if maxConns <= 0 {
+		return nil, fmt.Errorf("max connections per host must be greater than zero")
+	}
+
+	tuned, err := ConfigureTransport(httpClient, func(transport *http.Transport) {
+		transport.ForceAttemptHTTP2 = true
+		transport.MaxConnsPerHost = maxConns
+		transport.MaxIdleConnsPerHost = maxConns
+		if transport.MaxIdleConns != 0 && transport.MaxIdleConns < maxConns {
+			transport.MaxIdleConns = maxConns
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure the connection pool: %w", err)
+	}
+
+	return tuned, nil
+}
+
+// SetIdleTimeout returns a copy of the HTTP client whose transport closes the idle connections after the timeout,
+// tuned as ConfigureTransport does.
+func SetIdleTimeout(httpClient common.HTTPClient, timeout time.Duration) (common.HTTPClient, error) {
+
+	if timeout <= 0 {
+		return nil, fmt.Errorf("idle timeout must be greater than zero")
+	}
+
+	tuned, err := ConfigureTransport(httpClient, func(transport *http.Transport) {
+		transport.IdleConnTimeout = timeout
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure the connection pool: %w", err)
+	}
+
+	return tuned, nil
+}
+
+// ConfigureTransport returns a copy of the HTTP client whose base *http.Transport is tuned by configure.
+// The token storage wrapper and the OAuth transport are preserved around the tuned transport, and the
+// transport is cloned first, so the shared http.DefaultClient and http.DefaultTransport are never modified.
+func ConfigureTransport(httpClient common.HTTPClient, configure func(*http.Transport)) (common.HTTPClient, error) {
+
+	switch client := httpClient.(type) {
+	case *HTTPWrapper:
+
+		original, err := ConfigureTransport(client.OriginalClient, configure)
+		if err != nil {
+			return nil, err
+		}
+
+		wrapper := *client
+		wrapper.OriginalClient = original
+		return &wrapper, nil
+
+	case *Transport:
+
+		transport, err := configureRoundTripper(client, configure)
+		if err != nil {
+			return nil, err
+		}
+
+		return transport.(*Transport), nil
+
+	case *http.Client:
+
+		base, err := configureRoundTripper(client.Transport, configure)
+		if err != nil {
+			return nil, err
+		}
+
+		tuned := *client
+		tuned.Transport = base
+		return &tuned, nil
+	}
+
+	return nil, fmt.Errorf("the transport of the HTTP client %T cannot be configured", httpClient)
+}
+
+// configureRoundTripper returns a tuned clone of the round tripper, http.DefaultTransport when nil.
+func configureRoundTripper(roundTripper http.RoundTripper, configure func(*http.Transport)) (http.RoundTripper, error) {
+
+	if roundTripper == nil {
+		roundTripper = http.DefaultTransport
+	}
+
+	switch transport := roundTripper.(type) {
+	case *http.Transport:
+
+		tuned := transport.Clone()
+		configure(tuned)
+		return tuned, nil
+
+	case *Transport:
+
+		base, err := configureRoundTripper(transport.Base, configure)
+		if err != nil {
+			return nil, err
+		}
+
+		tuned := *transport
+		tuned.Base = base
+		return &tuned, nil
+	}
+
+	return nil, fmt.Errorf("the transport %T cannot be configured", roundTripper)
+}
@@ -0,0 +1,95 @@
+package oauth2
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ctreminiom/go-atlassian/v2/service/common"
+)
+
+func TestConfigureTransport(t *testing.T) {
+
+	configure := func(transport *http.Transport) {
+		transport.IdleConnTimeout = time.Minute
+	}
+
+	t.Run("when the client is the default client", func(t *testing.T) {
+
+		got, err := ConfigureTransport(http.DefaultClient, configure)
+		assert.NoError(t, err)
+
+		client, ok := got.(*http.Client)
+		if !assert.True(t, ok) {
+			return
+		}
+
+		assert.Equal(t, time.Minute, client.Transport.(*http.Transport).IdleConnTimeout)
+		assert.Nil(t, http.DefaultClient.Transport)
+	})
+
+	t.Run("when the client is wrapped by the OAuth transport and the token storage", func(t *testing.T) {
+
+		store := &MockTokenStore{}
+		source := NewReuseTokenSource(&common.OAuth2Token{AccessToken: "token"}, nil)
+		wrapped := WrapHTTPClient(&Transport{Source: source, Base: &http.Transport{MaxConnsPerHost: 4}}).WithStore(store)
+
+		got, err := ConfigureTransport(wrapped, configure)
+		assert.NoError(t, err)
+
+		wrapper, ok := got.(*HTTPWrapper)
+		if !assert.True(t, ok) {
+			return
+		}
+
+		assert.Equal(t, store, wrapper.Store)
+
+		transport, ok := wrapper.OriginalClient.(*Transport)
+		if !assert.True(t, ok) {
+			return
+		}
+
+		assert.Equal(t, source, transport.Source)
+
+		base := transport.Base.(*http.Transport)
+		assert.Equal(t, time.Minute, base.IdleConnTimeout)
+		assert.Equal(t, 4, base.MaxConnsPerHost)
+	})
+
+	t.Run("when the client transport cannot be configured", func(t *testing.T) {
+
+		_, err := ConfigureTransport(&http.Client{Transport: roundTripperFunc(nil)}, configure)
+		assert.Error(t, err)
+	})
+}
+
+func TestLimitConnsPerHost(t *testing.T) {
+
+	got, err := LimitConnsPerHost(&http.Client{Transport: &http.Transport{MaxIdleConns: 2}}, 8)
+	assert.NoError(t, err)
+
+	transport := got.(*http.Client).Transport.(*http.Transport)
+	assert.Equal(t, 8, transport.MaxConnsPerHost)
+	assert.Equal(t, 8, transport.MaxIdleConnsPerHost)
+	assert.Equal(t, 8, transport.MaxIdleConns)
+	assert.True(t, transport.ForceAttemptHTTP2)
+
+	_, err = LimitConnsPerHost(http.DefaultClient, 0)
+	assert.Error(t, err)
+}
+
+func TestSetIdleTimeout(t *testing.T) {
+
+	got, err := SetIdleTimeout(http.DefaultClient, time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Minute, got.(*http.Client).Transport.(*http.Transport).IdleConnTimeout)
+
+	_, err = SetIdleTimeout(http.DefaultClient, 0)
+	assert.Error(t, err)
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
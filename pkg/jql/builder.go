@@ -0,0 +1,267 @@
+// Package jql builds Jira Query Language (JQL) queries, e.g. the queries of Issue.Search or Archival.PreserveByJQL.
+//
+// The operands are quoted and escaped, so a value provided by a user, e.g. a summary, can't alter the query.
+//
+// Example usage:
+//
+//	query, err := jql.New().
+//		Project("ABC").
+//		Status(jql.In, "Open", "In Progress").
+//		Where("summary", jql.Contains, summary).
+//		OrderBy("created", jql.Desc).
+//		Build()
+package jql
+
+import (
+	"fmt"
+	"strings"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// Operator is the operator of a JQL clause.
+type Operator string
+
+// Operator constants represent the operators of the JQL clauses.
+const (
+	Equals            Operator = "="
+	NotEquals         Operator = "!="
+	GreaterThan       Operator = ">"
+	GreaterThanEquals Operator = ">="
+	LessThan          Operator = "<"
+	LessThanEquals    Operator = "<="
+	Contains          Operator = "~"  // Text search, using the Jira text search syntax.
+	NotContains       Operator = "!~" // Text search, using the Jira text search syntax.
+	In                Operator = "in"
+	NotIn             Operator = "not in"
+	Is                Operator = "is"     // Only accepts Empty.
+	IsNot             Operator = "is not" // Only accepts Empty.
+	Was               Operator = "was"
+	WasNot            Operator = "was not"
+	WasIn             Operator = "was in"
+	WasNotIn          Operator = "was not in"
+)
+
+// list reports whether the operator takes a list of operands.
+func (o Operator) list() bool {
+	return o == In || o == NotIn || o == WasIn || o == WasNotIn
+}
+
+// Direction is the sort direction of an ORDER BY field.
+type Direction string
+
+// Direction constants represent the sort directions of the ORDER BY fields.
+const (
+	Asc  Direction = "ASC"
+	Desc Direction = "DESC"
+)
+
+// Builder builds a JQL query, its clauses being joined with AND.
+//
+// The methods return the builder to chain the calls; the first invalid clause is reported by Build.
+type Builder struct {
+	clauses []string
+	orderBy []string
+	err     error
+}
+
+// New returns an empty query builder.
+func New() *Builder {
+	return &Builder{}
+}
+
+// Where adds a clause comparing a field to string operands, e.g. Where("labels", jql.In, "backend", "api").
+//
+// The operands of the Contains and NotContains operators are searched as is, see SearchText.
+func (b *Builder) Where(field string, operator Operator, values ...string) *Builder {
+
+	operands := make([]Value, len(values))
+	for index, value := range values {
+
+		if operator == Contains || operator == NotContains {
+			operands[index] = SearchText(value)
+			continue
+		}
+
+		operands[index] = String(value)
+	}
+
+	return b.WhereValues(field, operator, operands...)
+}
+
+// WhereValues adds a clause comparing a field to operands, e.g. WhereValues("assignee", jql.Equals, jql.Func("currentUser")).
+//
+// The list operators, e.g. In, take one or more operands, the other operators exactly one; Is and IsNot default to Empty.
+func (b *Builder) WhereValues(field string, operator Operator, values ...Value) *Builder {
+
+	if (operator == Is || operator == IsNot) && len(values) == 0 {
+		values = []Value{Empty}
+	}
+
+	if len(values) == 0 {
+		return b.fail(fmt.Errorf("%w: %v %v", model.ErrNoJQLValues, field, operator))
+	}
+
+	if !operator.list() && len(values) > 1 {
+		return b.fail(fmt.Errorf("%w: %v %v", model.ErrInvalidJQLValues, field, operator))
+	}
+
+	operands := make([]string, len(values))
+	for index, value := range values {
+		operands[index] = value.raw
+	}
+
+	operand := operands[0]
+	if operator.list() {
+		operand = "(" + strings.Join(operands, ", ") + ")"
+	}
+
+	b.clauses = append(b.clauses, fmt.Sprintf("%v %v %v", Field(field), operator, operand))
+	return b
+}
+
+// Project adds a clause matching the issues of the projects, by key or ID.
+func (b *Builder) Project(keys ...string) *Builder {
+	return b.Where("project", membership(len(keys)), keys...)
+}
+
+// IssueType adds a clause matching the issues of the issue types, by name or ID.
+func (b *Builder) IssueType(names ...string) *Builder {
+	return b.Where("issuetype", membership(len(names)), names...)
+}
+
+// Status adds a clause comparing the status of the issues, e.g. Status(jql.In, "Open", "In Progress").
+func (b *Builder) Status(operator Operator, values ...string) *Builder {
+	return b.Where("status", operator, values...)
+}
+
+// Assignee adds a clause comparing the assignee of the issues, by account ID.
+func (b *Builder) Assignee(operator Operator, accountIDs ...string) *Builder {
+	return b.Where("assignee", operator, accountIDs...)
+}
+
+// Text adds a clause matching the issues whose summary, description, environment or comments contain the text,
+// searched as is, see SearchText.
+func (b *Builder) Text(value string) *Builder {
+	return b.Where("text", Contains, value)
+}
+
+// IsEmpty adds a clause matching the issues without a value for the field.
+func (b *Builder) IsEmpty(field string) *Builder {
+	return b.WhereValues(field, Is, Empty)
+}
+
+// IsNotEmpty adds a clause matching the issues with a value for the field.
+func (b *Builder) IsNotEmpty(field string) *Builder {
+	return b.WhereValues(field, IsNot, Empty)
+}
+
+// Raw adds a clause as is. It must not contain values provided by the users, use Where instead.
+func (b *Builder) Raw(clause string) *Builder {
+
+	if clause != "" {
+		b.clauses = append(b.clauses, "("+clause+")")
+	}
+
+	return b
+}
+
+// Or adds a clause matching the issues matched by any of the queries. Their ORDER BY fields are ignored.
+func (b *Builder) Or(queries ...*Builder) *Builder {
+
+	var clauses []string
+	for _, query := range queries {
+
+		if query.err != nil {
+			return b.fail(query.err)
+		}
+
+		if clause := query.where(); clause != "" {
+			clauses = append(clauses, clause)
+		}
+	}
+
+	switch len(clauses) {
+	case 0:
+		return b
+	case 1:
+		b.clauses = append(b.clauses, "("+clauses[0]+")")
+	default:
+		b.clauses = append(b.clauses, "(("+strings.Join(clauses, ") OR (")+"))")
+	}
+
+	return b
+}
+
+// Not adds a clause matching the issues not matched by the query. Its ORDER BY fields are ignored.
+func (b *Builder) Not(query *Builder) *Builder {
+
+	if query.err != nil {
+		return b.fail(query.err)
+	}
+
+	if clause := query.where(); clause != "" {
+		b.clauses = append(b.clauses, "NOT ("+clause+")")
+	}
+
+	return b
+}
+
+// OrderBy adds a field to sort the issues by, the fields being applied in the order they're added.
+func (b *Builder) OrderBy(field string, direction Direction) *Builder {
+
+	if direction == "" {
+		b.orderBy = append(b.orderBy, Field(field))
+		return b
+	}
+
+	b.orderBy = append(b.orderBy, Field(field)+" "+string(direction))
+	return b
+}
+
+// Build returns the query, or the error of the first invalid clause.
+func (b *Builder) Build() (string, error) {
+
+	if b.err != nil {
+		return "", fmt.Errorf("jql: %w", b.err)
+	}
+
+	return b.String(), nil
+}
+
+// String returns the query, without the invalid clauses; use Build to check them.
+func (b *Builder) String() string {
+
+	query := b.where()
+
+	if len(b.orderBy) != 0 {
+		query = strings.TrimSpace(query + " ORDER BY " + strings.Join(b.orderBy, ", "))
+	}
+
+	return query
+}
+
+// where returns the clauses of the query, joined with AND.
+func (b *Builder) where() string {
+	return strings.Join(b.clauses, " AND ")
+}
+
+// membership returns the operator matching any of the count operands.
+func membership(count int) Operator {
+
+	if count > 1 {
+		return In
+	}
+
+	return Equals
+}
+
+// fail records the first error of the builder.
+func (b *Builder) fail(err error) *Builder {
+
+	if b.err == nil {
+		b.err = err
+	}
+
+	return b
+}
@@ -0,0 +1,116 @@
+package jql
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilder_Build(t *testing.T) {
+
+	testCases := []struct {
+		name    string
+		query   *Builder
+		want    string
+		wantErr error
+	}{
+		{
+			name: "when the query is chained",
+			query: New().
+				Project("ABC").
+				Status(In, "Open", "In Progress").
+				OrderBy("created", Desc),
+			want: `project = "ABC" AND status in ("Open", "In Progress") ORDER BY created DESC`,
+		},
+		{
+			name:  "when a value tries to escape its quotes",
+			query: New().Where("summary", Contains, `x" OR project = "SECRET`),
+			want:  `summary ~ "x\" OR project = \"SECRET"`,
+		},
+		{
+			name:  "when a value contains backslashes and line breaks",
+			query: New().Text("C:\\temp\nlog"),
+			want:  `text ~ "C\\:\\\\temp\nlog"`,
+		},
+		{
+			name:  "when a text search contains reserved characters",
+			query: New().Where("summary", Contains, "C++ (beta)*").Where("description", NotContains, "a&&b || !c"),
+			want:  `summary ~ "C\\+\\+ \\(beta\\)\\*" AND description !~ "a\\&\\&b \\|\\| \\!c"`,
+		},
+		{
+			name:  "when the field has spaces or is a keyword",
+			query: New().Where("Story Points", GreaterThan, "3").Where("order", Equals, "1").Where("cf[10010]", Equals, "x"),
+			want:  `"Story Points" > "3" AND "order" = "1" AND cf[10010] = "x"`,
+		},
+		{
+			name: "when the values are functions, numbers and dates",
+			query: New().
+				WhereValues("assignee", Equals, Func("currentUser")).
+				WhereValues("created", GreaterThanEquals, Func("startOfDay", "-1d")).
+				WhereValues("votes", GreaterThan, Number(2)).
+				WhereValues("duedate", LessThan, Date(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC))),
+			want: `assignee = currentUser() AND created >= startOfDay("-1d") AND votes > 2 AND duedate < "2024-03-01"`,
+		},
+		{
+			name:  "when the clauses are empty checks",
+			query: New().IsEmpty("resolution").IsNotEmpty("fixVersion").Where("sprint", Is),
+			want:  `resolution is EMPTY AND fixVersion is not EMPTY AND sprint is EMPTY`,
+		},
+		{
+			name: "when the clauses are combined",
+			query: New().
+				Project("ABC", "DEF").
+				Or(New().IssueType("Bug"), New().Where("priority", Equals, "High")).
+				Not(New().Status(Equals, "Done")).
+				OrderBy("priority", Desc).
+				OrderBy("key", Asc),
+			want: `project in ("ABC", "DEF") AND ((issuetype = "Bug") OR (priority = "High")) AND NOT (status = "Done") ORDER BY priority DESC, key ASC`,
+		},
+		{
+			name:  "when the query only sorts the issues",
+			query: New().OrderBy("rank", ""),
+			want:  `ORDER BY rank`,
+		},
+		{
+			name:    "when a single value operator has several values",
+			query:   New().Status(Equals, "Open", "Done"),
+			wantErr: model.ErrInvalidJQLValues,
+		},
+		{
+			name:    "when a clause has no value",
+			query:   New().Status(In),
+			wantErr: model.ErrNoJQLValues,
+		},
+		{
+			name:    "when a nested query is invalid",
+			query:   New().Or(New().Assignee(In)),
+			wantErr: model.ErrNoJQLValues,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			got, err := testCase.query.Build()
+
+			if testCase.wantErr != nil {
+				assert.True(t, errors.Is(err, testCase.wantErr), "expected error: %v, got: %v", testCase.wantErr, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.want, got)
+		})
+	}
+}
+
+func TestQuoteText(t *testing.T) {
+
+	assert.Equal(t, `"\\{code\\}\\[x\\]\\^2\\~3\\?4\\-5"`, QuoteText("{code}[x]^2~3?4-5"))
+	assert.Equal(t, `"plain text"`, QuoteText("plain text"))
+	assert.Equal(t, `"a*b"`, Quote("a*b"))
+	assert.Equal(t, `"a\\*b"`, SearchText("a*b").String())
+}
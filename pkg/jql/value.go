@@ -0,0 +1,107 @@
+package jql
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Value is an operand of a JQL clause, see String, Number, Date, DateTime, Func and Empty.
+type Value struct {
+	raw string
+}
+
+// Empty is the EMPTY keyword, matching the fields without a value.
+var Empty = Value{raw: "EMPTY"}
+
+// String returns a string operand, quoted and escaped so it can't alter the query.
+func String(value string) Value {
+	return Value{raw: Quote(value)}
+}
+
+// SearchText returns a text search operand of the Contains and NotContains operators, quoted and escaped so the text
+// is searched as is: the reserved characters of the Jira text search syntax, e.g. the wildcards, are escaped too.
+func SearchText(value string) Value {
+	return Value{raw: QuoteText(value)}
+}
+
+// Number returns a numeric operand.
+func Number(value int) Value {
+	return Value{raw: strconv.Itoa(value)}
+}
+
+// Date returns a date operand, e.g. for the created or duedate fields.
+func Date(value time.Time) Value {
+	return Value{raw: Quote(value.Format("2006-01-02"))}
+}
+
+// DateTime returns a date operand with the hours and the minutes, in the time zone of the time.
+func DateTime(value time.Time) Value {
+	return Value{raw: Quote(value.Format("2006-01-02 15:04"))}
+}
+
+// Func returns a function call operand, e.g. Func("currentUser") or Func("startOfDay", "-1d"). The arguments are quoted.
+func Func(name string, args ...string) Value {
+
+	quoted := make([]string, len(args))
+	for index, arg := range args {
+		quoted[index] = Quote(arg)
+	}
+
+	return Value{raw: name + "(" + strings.Join(quoted, ", ") + ")"}
+}
+
+// String returns the operand as written in the query.
+func (v Value) String() string {
+	return v.raw
+}
+
+// jqlEscaper escapes the characters with a special meaning in a quoted JQL string.
+var jqlEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`"`, `\"`,
+	"\n", `\n`,
+	"\r", `\r`,
+	"\t", `\t`,
+)
+
+// Quote returns the value as a double-quoted JQL string, escaping the backslashes, the quotes and the line breaks.
+func Quote(value string) string {
+	return `"` + jqlEscaper.Replace(value) + `"`
+}
+
+// textSearchEscaper escapes the reserved characters of the Jira text search syntax, before the value is quoted.
+var textSearchEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"+", `\+`, "-", `\-`, "&", `\&`, "|", `\|`, "!", `\!`,
+	"(", `\(`, ")", `\)`, "{", `\{`, "}", `\}`, "[", `\[`, "]", `\]`,
+	"^", `\^`, "~", `\~`, "*", `\*`, "?", `\?`, ":", `\:`,
+)
+
+// QuoteText returns the value as a double-quoted JQL string for the ~ and !~ operators, escaping the reserved characters
+// of the Jira text search syntax with a double backslash, e.g. "C++" is written "C\\+\\+", then the value as Quote does.
+func QuoteText(value string) string {
+	return Quote(textSearchEscaper.Replace(value))
+}
+
+// jqlField matches the field names that can be written without quotes, e.g. status, issuetype or cf[10010].
+var jqlField = regexp.MustCompile(`^(?:[A-Za-z][A-Za-z0-9_.]*|cf\[\d+\])$`)
+
+// jqlReserved are the JQL keywords that must be quoted when used as field names.
+var jqlReserved = map[string]bool{
+	"and": true, "or": true, "not": true, "empty": true, "null": true,
+	"order": true, "by": true, "asc": true, "desc": true, "in": true,
+	"is": true, "was": true, "changed": true,
+}
+
+// Field returns the name of a field as written in the query, quoted when it contains spaces or special characters,
+// e.g. "Story Points", or when it's a JQL keyword.
+func Field(name string) string {
+
+	if jqlField.MatchString(name) && !jqlReserved[strings.ToLower(name)] {
+		return name
+	}
+
+	return Quote(name)
+}
@@ -0,0 +1,63 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// ConfluenceEvent represents the payload of a Confluence webhook event.
+//
+// The Confluence payloads don't carry the name of the event, the content of the event tells them apart.
+// The fields that don't apply to the event are nil, e.g. the Page of a comment event.
+type ConfluenceEvent struct {
+	Timestamp             int64                   `json:"timestamp,omitempty"`             // The time of the event, in milliseconds since the epoch.
+	UserAccountID         string                  `json:"userAccountId,omitempty"`         // The account ID of the user who triggered the event.
+	AccountType           string                  `json:"accountType,omitempty"`           // The account type of the user who triggered the event.
+	UpdateTrigger         string                  `json:"updateTrigger,omitempty"`         // The action that triggered an update, e.g. edit_page.
+	SuppressNotifications bool                    `json:"suppressNotifications,omitempty"` // Indicates if the watchers weren't notified of the change.
+	Page                  *ConfluenceContentEvent `json:"page,omitempty"`                  // The page of the page events.
+	Blog                  *ConfluenceContentEvent `json:"blog,omitempty"`                  // The blog post of the blog post events.
+	Comment               *ConfluenceContentEvent `json:"comment,omitempty"`               // The comment of the comment events.
+	Space                 *ConfluenceSpaceEvent   `json:"space,omitempty"`                 // The space of the space events.
+}
+
+// ConfluenceContentEvent represents the page, blog post or comment of a Confluence webhook event.
+type ConfluenceContentEvent struct {
+	ID                    int64                   `json:"id,omitempty"`                    // The ID of the content.
+	Title                 string                  `json:"title,omitempty"`                 // The title of the content.
+	ContentType           string                  `json:"contentType,omitempty"`           // The type of the content, e.g. page, blogpost or comment.
+	SpaceKey              string                  `json:"spaceKey,omitempty"`              // The key of the space of the content.
+	Self                  string                  `json:"self,omitempty"`                  // The URL of the content.
+	Version               int                     `json:"version,omitempty"`               // The version of the content.
+	CreatorAccountID      string                  `json:"creatorAccountId,omitempty"`      // The account ID of the creator of the content.
+	LastModifierAccountID string                  `json:"lastModifierAccountId,omitempty"` // The account ID of the last user who modified the content.
+	CreationDate          int64                   `json:"creationDate,omitempty"`          // The creation date, in milliseconds since the epoch.
+	ModificationDate      int64                   `json:"modificationDate,omitempty"`      // The last modification date, in milliseconds since the epoch.
+	Parent                *ConfluenceContentEvent `json:"parent,omitempty"`                // The content of a comment.
+}
+
+// ConfluenceSpaceEvent represents the space of a Confluence webhook event.
+type ConfluenceSpaceEvent struct {
+	Key              string `json:"key,omitempty"`              // The key of the space.
+	Name             string `json:"name,omitempty"`             // The name of the space.
+	Self             string `json:"self,omitempty"`             // The URL of the space.
+	CreatorAccountID string `json:"creatorAccountId,omitempty"` // The account ID of the creator of the space.
+	CreationDate     int64  `json:"creationDate,omitempty"`     // The creation date, in milliseconds since the epoch.
+	ModificationDate int64  `json:"modificationDate,omitempty"` // The last modification date, in milliseconds since the epoch.
+}
+
+// ParseConfluenceEvent parses the payload of a Confluence webhook event.
+func ParseConfluenceEvent(payload []byte) (*ConfluenceEvent, error) {
+
+	event := new(ConfluenceEvent)
+	if err := json.Unmarshal(payload, event); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+// Time returns the time of the event.
+func (e *ConfluenceEvent) Time() time.Time {
+	return time.UnixMilli(e.Timestamp)
+}
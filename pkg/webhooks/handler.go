@@ -0,0 +1,167 @@
+package webhooks
+
+import (
+	"context"
+	"io"
+	"net/http"
+)
+
+// maxPayloadSize is the maximum size of the webhook payloads read by the handlers.
+const maxPayloadSize = 10 << 20
+
+// JiraCallback handles a Jira webhook event. An error answers the webhook with a 500 Internal Server Error,
+// so Jira retries it.
+type JiraCallback func(ctx context.Context, event *JiraEvent) error
+
+// ConfluenceCallback handles a Confluence webhook event. An error answers the webhook with a 500 Internal Server Error.
+type ConfluenceCallback func(ctx context.Context, event *ConfluenceEvent) error
+
+// JiraHandler is an http.Handler dispatching the Jira webhook events to the callbacks.
+//
+// The requests are verified with the Secret when it's set, and rejected with a 401 Unauthorized when the signature
+// doesn't match. The events without a callback are acknowledged and ignored.
+type JiraHandler struct {
+	Secret string // The secret the webhooks were registered with, optional.
+
+	OnIssueCreated   JiraCallback // Called on the jira:issue_created events.
+	OnIssueUpdated   JiraCallback // Called on the jira:issue_updated events.
+	OnIssueDeleted   JiraCallback // Called on the jira:issue_deleted events.
+	OnCommentCreated JiraCallback // Called on the comment_created events.
+	OnCommentUpdated JiraCallback // Called on the comment_updated events.
+	OnCommentDeleted JiraCallback // Called on the comment_deleted events.
+	OnSprint         JiraCallback // Called on the sprint events, see JiraEvent.WebhookEvent for the change.
+	OnEvent          JiraCallback // Called on the events without a dedicated callback.
+}
+
+// ServeHTTP implements http.Handler.
+func (h *JiraHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+
+	payload, ok := readPayload(w, r, h.Secret)
+	if !ok {
+		return
+	}
+
+	event, err := ParseJiraEvent(payload)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if callback := h.callback(event); callback != nil {
+		err = callback(r.Context(), event)
+	}
+
+	acknowledge(w, err)
+}
+
+// callback returns the callback of the event, nil when it's not handled.
+func (h *JiraHandler) callback(event *JiraEvent) JiraCallback {
+
+	callbacks := map[string]JiraCallback{
+		JiraEventIssueCreated:   h.OnIssueCreated,
+		JiraEventIssueUpdated:   h.OnIssueUpdated,
+		JiraEventIssueDeleted:   h.OnIssueDeleted,
+		JiraEventCommentCreated: h.OnCommentCreated,
+		JiraEventCommentUpdated: h.OnCommentUpdated,
+		JiraEventCommentDeleted: h.OnCommentDeleted,
+	}
+
+	if callback := callbacks[event.WebhookEvent]; callback != nil {
+		return callback
+	}
+
+	if event.IsSprintEvent() && h.OnSprint != nil {
+		return h.OnSprint
+	}
+
+	return h.OnEvent
+}
+
+// ConfluenceHandler is an http.Handler dispatching the Confluence webhook events to the callbacks, by content.
+//
+// The requests are verified with the Secret when it's set, and rejected with a 401 Unauthorized when the signature
+// doesn't match. The events without a callback are acknowledged and ignored.
+type ConfluenceHandler struct {
+	Secret string // The secret the webhooks were registered with, optional.
+
+	OnPage    ConfluenceCallback // Called on the page events.
+	OnBlog    ConfluenceCallback // Called on the blog post events.
+	OnComment ConfluenceCallback // Called on the comment events.
+	OnSpace   ConfluenceCallback // Called on the space events.
+	OnEvent   ConfluenceCallback // Called on the events without a dedicated callback.
+}
+
+// ServeHTTP implements http.Handler.
+func (h *ConfluenceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+
+	payload, ok := readPayload(w, r, h.Secret)
+	if !ok {
+		return
+	}
+
+	event, err := ParseConfluenceEvent(payload)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if callback := h.callback(event); callback != nil {
+		err = callback(r.Context(), event)
+	}
+
+	acknowledge(w, err)
+}
+
+// callback returns the callback of the event, nil when it's not handled.
+func (h *ConfluenceHandler) callback(event *ConfluenceEvent) ConfluenceCallback {
+
+	switch {
+	case event.Comment != nil && h.OnComment != nil:
+		return h.OnComment
+	case event.Page != nil && h.OnPage != nil:
+		return h.OnPage
+	case event.Blog != nil && h.OnBlog != nil:
+		return h.OnBlog
+	case event.Space != nil && h.OnSpace != nil:
+		return h.OnSpace
+	}
+
+	return h.OnEvent
+}
+
+// readPayload reads the body of a webhook request and verifies its signature when the secret is set.
+// It answers the request and returns false when the request is rejected.
+func readPayload(w http.ResponseWriter, r *http.Request, secret string) ([]byte, bool) {
+
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return nil, false
+	}
+
+	payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxPayloadSize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return nil, false
+	}
+
+	if secret != "" {
+		if err := VerifySignature(secret, payload, r.Header.Get(SignatureHeader)); err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return nil, false
+		}
+	}
+
+	return payload, true
+}
+
+// acknowledge answers a webhook request with the error of its callback, if any.
+func acknowledge(w http.ResponseWriter, err error) {
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,182 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const jiraIssueUpdatedPayload = `{
+	"timestamp": 1717416000000,
+	"webhookEvent": "jira:issue_updated",
+	"issue_event_type_name": "issue_generic",
+	"user": {"accountId": "uuid-sample", "displayName": "Sample User"},
+	"issue": {
+		"id": "10002",
+		"key": "KP-2",
+		"fields": {"summary": "Sample issue", "created": "2024-06-03T10:00:00.000+0000", "status": {"name": "Done"}}
+	},
+	"changelog": {
+		"id": "10101",
+		"items": [{"field": "status", "fieldtype": "jira", "fieldId": "status", "from": "3", "fromString": "In Progress", "to": "10001", "toString": "Done"}]
+	}
+}`
+
+const jiraSprintStartedPayload = `{
+	"timestamp": 1717416000000,
+	"webhookEvent": "sprint_started",
+	"sprint": {"id": 37, "state": "active", "name": "Sprint 4", "startDate": "2024-06-03T10:00:00.000Z", "endDate": "2024-06-17T10:00:00.000Z", "originBoardId": 5}
+}`
+
+const confluenceCommentPayload = `{
+	"timestamp": 1717416000000,
+	"userAccountId": "uuid-sample",
+	"comment": {
+		"id": 65538,
+		"contentType": "comment",
+		"spaceKey": "DS",
+		"version": 1,
+		"parent": {"id": 65537, "title": "Sample page", "contentType": "page", "spaceKey": "DS"}
+	}
+}`
+
+func TestJiraHandler_ServeHTTP(t *testing.T) {
+
+	var got *JiraEvent
+
+	handler := &JiraHandler{
+		Secret: "secret",
+		OnIssueUpdated: func(ctx context.Context, event *JiraEvent) error {
+			got = event
+			return nil
+		},
+		OnSprint: func(ctx context.Context, event *JiraEvent) error {
+			got = event
+			return errors.New("sprint not handled yet")
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		method     string
+		payload    string
+		signature  string
+		wantStatus int
+		wantEvent  string
+	}{
+		{
+			name:       "when the issue updated event is dispatched",
+			method:     http.MethodPost,
+			payload:    jiraIssueUpdatedPayload,
+			signature:  Sign("secret", []byte(jiraIssueUpdatedPayload)),
+			wantStatus: http.StatusNoContent,
+			wantEvent:  JiraEventIssueUpdated,
+		},
+		{
+			name:       "when the sprint callback fails",
+			method:     http.MethodPost,
+			payload:    jiraSprintStartedPayload,
+			signature:  Sign("secret", []byte(jiraSprintStartedPayload)),
+			wantStatus: http.StatusInternalServerError,
+			wantEvent:  JiraEventSprintStarted,
+		},
+		{
+			name:       "when the event has no callback",
+			method:     http.MethodPost,
+			payload:    `{"webhookEvent": "jira:issue_deleted"}`,
+			signature:  Sign("secret", []byte(`{"webhookEvent": "jira:issue_deleted"}`)),
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "when the signature doesn't match",
+			method:     http.MethodPost,
+			payload:    jiraIssueUpdatedPayload,
+			signature:  Sign("another secret", []byte(jiraIssueUpdatedPayload)),
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "when the payload is not valid",
+			method:     http.MethodPost,
+			payload:    `{`,
+			signature:  Sign("secret", []byte(`{`)),
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "when the method is not post",
+			method:     http.MethodGet,
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			got = nil
+
+			request := httptest.NewRequest(testCase.method, "/webhooks/jira", strings.NewReader(testCase.payload))
+			request.Header.Set(SignatureHeader, testCase.signature)
+
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+
+			assert.Equal(t, testCase.wantStatus, recorder.Code)
+
+			if testCase.wantEvent == "" {
+				assert.Nil(t, got)
+				return
+			}
+
+			assert.Equal(t, testCase.wantEvent, got.WebhookEvent)
+		})
+	}
+
+	event, err := ParseJiraEvent([]byte(jiraIssueUpdatedPayload))
+	assert.NoError(t, err)
+	assert.Equal(t, "KP-2", event.Issue.Key)
+	assert.Equal(t, int64(1717416000000), event.Time().UnixMilli())
+
+	change, ok := event.Changed("status")
+	assert.True(t, ok)
+	assert.Equal(t, "Done", change.ToString)
+
+	_, ok = event.Changed("assignee")
+	assert.False(t, ok)
+
+	event, err = ParseJiraEvent([]byte(jiraSprintStartedPayload))
+	assert.NoError(t, err)
+	assert.Equal(t, 37, event.Sprint.ID)
+	assert.Equal(t, 6, int(event.Sprint.StartDate.Month()))
+}
+
+func TestConfluenceHandler_ServeHTTP(t *testing.T) {
+
+	var got *ConfluenceEvent
+
+	handler := &ConfluenceHandler{
+		OnComment: func(ctx context.Context, event *ConfluenceEvent) error {
+			got = event
+			return nil
+		},
+	}
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/webhooks/confluence", strings.NewReader(confluenceCommentPayload)))
+
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+	assert.NotNil(t, got)
+	assert.Equal(t, int64(65537), got.Comment.Parent.ID)
+	assert.Equal(t, "Sample page", got.Comment.Parent.Title)
+
+	got = nil
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/webhooks/confluence", strings.NewReader(`{"page": {"id": 65537}}`)))
+
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+	assert.Nil(t, got)
+}
@@ -0,0 +1,101 @@
+// Package webhooks parses the webhook events sent by Jira and Confluence, verifies their signature and dispatches
+// them to callbacks with an http.Handler.
+//
+// Example usage:
+//
+//	handler := &webhooks.JiraHandler{
+//		Secret: os.Getenv("JIRA_WEBHOOK_SECRET"),
+//		OnIssueUpdated: func(ctx context.Context, event *webhooks.JiraEvent) error {
+//			for _, item := range event.Changelog.Items {
+//				log.Printf("%v: %v changed from %q to %q", event.Issue.Key, item.Field, item.FromString, item.ToString)
+//			}
+//			return nil
+//		},
+//	}
+//
+//	http.Handle("/webhooks/jira", handler)
+package webhooks
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// Jira webhook event constants, the webhookEvent of the payloads.
+const (
+	JiraEventIssueCreated   = "jira:issue_created"
+	JiraEventIssueUpdated   = "jira:issue_updated"
+	JiraEventIssueDeleted   = "jira:issue_deleted"
+	JiraEventCommentCreated = "comment_created"
+	JiraEventCommentUpdated = "comment_updated"
+	JiraEventCommentDeleted = "comment_deleted"
+	JiraEventSprintCreated  = "sprint_created"
+	JiraEventSprintUpdated  = "sprint_updated"
+	JiraEventSprintDeleted  = "sprint_deleted"
+	JiraEventSprintStarted  = "sprint_started"
+	JiraEventSprintClosed   = "sprint_closed"
+)
+
+// JiraEvent represents the payload of a Jira webhook event.
+//
+// The issues and the comments are sent in the format of the REST API v2, their rich text fields being wiki markup.
+// The fields that don't apply to the event are nil, e.g. the Sprint of an issue event.
+type JiraEvent struct {
+	Timestamp          int64                       `json:"timestamp,omitempty"`             // The time of the event, in milliseconds since the epoch.
+	WebhookEvent       string                      `json:"webhookEvent,omitempty"`          // The event, see the JiraEvent constants.
+	IssueEventTypeName string                      `json:"issue_event_type_name,omitempty"` // The type of issue event, e.g. issue_generic or issue_assigned.
+	MatchedWebhookIDs  []int                       `json:"matchedWebhookIds,omitempty"`     // The IDs of the dynamic webhooks matching the event.
+	User               *model.UserScheme           `json:"user,omitempty"`                  // The user who triggered the event.
+	Issue              *model.IssueSchemeV2        `json:"issue,omitempty"`                 // The issue of the issue and comment events.
+	Changelog          *JiraChangelogScheme        `json:"changelog,omitempty"`             // The changes of the issue updated events.
+	Comment            *model.IssueCommentSchemeV2 `json:"comment,omitempty"`               // The comment of the comment events.
+	Sprint             *model.SprintScheme         `json:"sprint,omitempty"`                // The sprint of the sprint events.
+	OldValue           *model.SprintScheme         `json:"oldValue,omitempty"`              // The sprint before the change, for the sprint updated events.
+}
+
+// JiraChangelogScheme represents the changes of an issue updated event.
+type JiraChangelogScheme struct {
+	ID    string                                   `json:"id,omitempty"`    // The ID of the changelog.
+	Items []*model.IssueChangelogHistoryItemScheme `json:"items,omitempty"` // The changed fields.
+}
+
+// ParseJiraEvent parses the payload of a Jira webhook event.
+func ParseJiraEvent(payload []byte) (*JiraEvent, error) {
+
+	event := new(JiraEvent)
+	if err := json.Unmarshal(payload, event); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+// Time returns the time of the event.
+func (e *JiraEvent) Time() time.Time {
+	return time.UnixMilli(e.Timestamp)
+}
+
+// IsSprintEvent reports whether the event is a sprint event.
+func (e *JiraEvent) IsSprintEvent() bool {
+	return strings.HasPrefix(e.WebhookEvent, "sprint_")
+}
+
+// Changed returns the change of the field in the changelog of the event, matched by field ID or name, and false
+// when the field isn't changed.
+func (e *JiraEvent) Changed(field string) (*model.IssueChangelogHistoryItemScheme, bool) {
+
+	if e.Changelog == nil {
+		return nil, false
+	}
+
+	for _, item := range e.Changelog.Items {
+		if item != nil && (item.FieldID == field || item.Field == field) {
+			return item, true
+		}
+	}
+
+	return nil, false
+}
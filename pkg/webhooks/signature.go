@@ -0,0 +1,54 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// SignatureHeader is the header of the signature of the webhook requests sent with a secret.
+const SignatureHeader = "X-Hub-Signature"
+
+// signaturePrefix is the prefix of the signatures, naming the HMAC hash function.
+const signaturePrefix = "sha256="
+
+// Sign returns the signature of a webhook payload with the secret, as sent in the SignatureHeader.
+func Sign(secret string, payload []byte) string {
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature checks the signature of a webhook payload, the value of the SignatureHeader, against the secret
+// the webhook was registered with.
+func VerifySignature(secret string, payload []byte, signature string) error {
+
+	if signature == "" {
+		return fmt.Errorf("webhooks: %w", model.ErrNoWebhookSignature)
+	}
+
+	digest, ok := strings.CutPrefix(signature, signaturePrefix)
+	if !ok {
+		return fmt.Errorf("webhooks: %w", model.ErrInvalidWebhookSignature)
+	}
+
+	decoded, err := hex.DecodeString(digest)
+	if err != nil {
+		return fmt.Errorf("webhooks: %w", model.ErrInvalidWebhookSignature)
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	if !hmac.Equal(decoded, mac.Sum(nil)) {
+		return fmt.Errorf("webhooks: %w", model.ErrInvalidWebhookSignature)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package webhooks
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+func TestVerifySignature(t *testing.T) {
+
+	payload := []byte(`{"webhookEvent":"jira:issue_created"}`)
+
+	testCases := []struct {
+		name      string
+		signature string
+		Err       error
+	}{
+		{
+			name:      "when the signature matches",
+			signature: Sign("It's a Secret to Everybody", payload),
+		},
+		{
+			name: "when the signature is not provided",
+			Err:  model.ErrNoWebhookSignature,
+		},
+		{
+			name:      "when the signature is signed with another secret",
+			signature: Sign("another secret", payload),
+			Err:       model.ErrInvalidWebhookSignature,
+		},
+		{
+			name:      "when the signature has no hash function",
+			signature: Sign("It's a Secret to Everybody", payload)[len(signaturePrefix):],
+			Err:       model.ErrInvalidWebhookSignature,
+		},
+		{
+			name:      "when the signature is not hexadecimal",
+			signature: "sha256=not-hexadecimal",
+			Err:       model.ErrInvalidWebhookSignature,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			err := VerifySignature("It's a Secret to Everybody", payload, testCase.signature)
+
+			if testCase.Err != nil {
+				assert.True(t, errors.Is(err, testCase.Err), "expected error: %v, got: %v", testCase.Err, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSign(t *testing.T) {
+	assert.Equal(t, "sha256=757107ea0eb2509fc211221cce984b8a37570b6d7586c22c46f4379c8b043e17",
+		Sign("It's a Secret to Everybody", []byte("Hello, World!")))
+}
@@ -44,4 +44,48 @@ type OrganizationPolicyConnector interface {
 	//
 	// https://docs.go-atlassian.io/atlassian-admin-cloud/organization/policy#delete-a-policy
 	Delete(ctx context.Context, organizationID, policyID string) (*model.ResponseScheme, error)
+
+	// AddResource adds a resource to a policy for an org.
+	//
+	// POST /admin/v1/orgs/{organizationID}/policies/{policyID}/resources
+	//
+	// https://docs.go-atlassian.io/atlassian-admin-cloud/organization/policy
+	AddResource(ctx context.Context, organizationID, policyID string, payload *model.OrganizationPolicyResource) (*model.OrganizationPolicyScheme, *model.ResponseScheme, error)
+
+	// UpdateResource updates a resource of a policy for an org.
+	//
+	// PUT /admin/v1/orgs/{organizationID}/policies/{policyID}/resources/{resourceID}
+	//
+	// https://docs.go-atlassian.io/atlassian-admin-cloud/organization/policy
+	UpdateResource(ctx context.Context, organizationID, policyID, resourceID string, payload *model.OrganizationPolicyResource) (*model.OrganizationPolicyScheme, *model.ResponseScheme, error)
+
+	// DeleteResource deletes a resource from a policy for an org.
+	//
+	// DELETE /admin/v1/orgs/{organizationID}/policies/{policyID}/resources/{resourceID}
+	//
+	// https://docs.go-atlassian.io/atlassian-admin-cloud/organization/policy
+	DeleteResource(ctx context.Context, organizationID, policyID, resourceID string) (*model.ResponseScheme, error)
+
+	// Validate validates a policy for an org.
+	//
+	// GET /admin/v1/orgs/{organizationID}/policies/{policyID}/validate
+	//
+	// https://docs.go-atlassian.io/atlassian-admin-cloud/organization/policy
+	Validate(ctx context.Context, organizationID, policyID string) (*model.OrganizationPolicyValidationScheme, *model.ResponseScheme, error)
+
+	// IPAllowlists returns the IP allowlist policies of an org, following the pages of the policies.
+	//
+	// GET /admin/v1/orgs/{organizationID}/policies?type=ip-allowlist
+	//
+	// https://docs.go-atlassian.io/atlassian-admin-cloud/organization/policy#get-list-of-policies
+	IPAllowlists(ctx context.Context, organizationID string) ([]*model.OrganizationPolicyData, error)
+
+	// DataResidency returns the data residency status of the resources of an org, following the pages of the data residency policies.
+	//
+	// The application status of each resource reports whether the resource is pinned to the realm of its policy or a move is in progress.
+	//
+	// GET /admin/v1/orgs/{organizationID}/policies?type=data-residency
+	//
+	// https://docs.go-atlassian.io/atlassian-admin-cloud/organization/policy#get-list-of-policies
+	DataResidency(ctx context.Context, organizationID string) ([]*model.DataResidencyResourceScheme, error)
 }
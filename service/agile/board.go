@@ -25,11 +25,32 @@ type BoardConnector interface {
 
 	// Create creates a new board. Board name, type and filter ID is required.
 	//
+	// The board can be located in a project or in the user's profile, use the model.NewProjectBoardLocation and
+	// model.NewUserBoardLocation helpers to build the location.
+	//
 	// POST /rest/agile/1.0/board
 	//
 	// Docs: https://docs.go-atlassian.io/jira-agile/boards#create-board
 	Create(ctx context.Context, payload *model.BoardPayloadScheme) (*model.BoardScheme, *model.ResponseScheme, error)
 
+	// UpdateFilter changes the saved filter used by the board.
+	//
+	// This method uses the private Jira Software API, the endpoint may change without notice.
+	//
+	// PUT /rest/greenhopper/1.0/rapidviewconfig/filter
+	//
+	// https://docs.go-atlassian.io/jira-agile/boards#update-board-filter
+	UpdateFilter(ctx context.Context, boardID, filterID int) (*model.ResponseScheme, error)
+
+	// Admins returns the users and groups who can administer the board.
+	//
+	// This method uses the private Jira Software API, the endpoint may change without notice.
+	//
+	// GET /rest/greenhopper/1.0/rapidviewconfig/editmodel.json?rapidViewId={boardID}
+	//
+	// https://docs.go-atlassian.io/jira-agile/boards#get-board-admins
+	Admins(ctx context.Context, boardID int) (*model.BoardAdminsScheme, *model.ResponseScheme, error)
+
 	// Filter returns any boards which use the provided filter id.
 	//
 	// This method can be executed by users without a valid software license in order
@@ -148,6 +169,15 @@ type BoardConnector interface {
 	// https://docs.go-atlassian.io/jira-agile/boards#get-projects
 	Projects(ctx context.Context, boardID, startAt, maxResults int) (*model.BoardProjectPageScheme, *model.ResponseScheme, error)
 
+	// ProjectsFull returns all projects that are statically associated with the board, based on its filter.
+	//
+	// The results are not paginated.
+	//
+	// GET /rest/agile/1.0/board/{boardID}/project/full
+	//
+	// https://docs.go-atlassian.io/jira-agile/boards#get-projects-full
+	ProjectsFull(ctx context.Context, boardID int) ([]*model.BoardProjectScheme, *model.ResponseScheme, error)
+
 	// Sprints returns all sprints from a board, for a given board ID.
 	//
 	// This only includes sprints that the user has permission to view.
@@ -178,12 +208,21 @@ type BoardConnector interface {
 	//
 	// Returned versions are ordered by the name of the project from which they belong and then by sequence defined by user.
 	//
-	// GET /rest/agile/1.0/board/{boardID}/sprint/{sprintID}/issue
+	// GET /rest/agile/1.0/board/{boardID}/version
 	//
 	// https://docs.go-atlassian.io/jira-agile/boards#get-all-versions
 	Versions(ctx context.Context, boardID, startAt, maxResults int, released bool) (*model.BoardVersionPageScheme,
 		*model.ResponseScheme, error)
 
+	// AllVersions returns the released and unreleased versions from a board, for a given board ID.
+	//
+	// Unlike Versions, the released filter is not sent, so both kinds of versions are returned in the same page.
+	//
+	// GET /rest/agile/1.0/board/{boardID}/version
+	//
+	// https://docs.go-atlassian.io/jira-agile/boards#get-all-versions
+	AllVersions(ctx context.Context, boardID, startAt, maxResults int) (*model.BoardVersionPageScheme, *model.ResponseScheme, error)
+
 	// Delete deletes the board. Admin without the view permission can still remove the board.
 	//
 	// DELETE /rest/agile/1.0/board/{boardID}
@@ -198,4 +237,34 @@ type BoardConnector interface {
 	// https://docs.go-atlassian.io/jira-agile/boards#get-boards
 	Gets(ctx context.Context, opts *model.GetBoardsOptions, startAt, maxResults int) (*model.BoardPageScheme,
 		*model.ResponseScheme, error)
+
+	// QuickFilters returns all quick filters from a board, for a given board ID.
+	//
+	// The quick filters are ordered by their position on the board.
+	//
+	// GET /rest/agile/1.0/board/{boardID}/quickfilter
+	//
+	// https://docs.go-atlassian.io/jira-agile/boards#get-all-quick-filters
+	QuickFilters(ctx context.Context, boardID, startAt, maxResults int) (*model.BoardQuickFilterPageScheme, *model.ResponseScheme, error)
+
+	// QuickFilter returns the quick filter for a given quick filter ID.
+	//
+	// GET /rest/agile/1.0/board/{boardID}/quickfilter/{quickFilterID}
+	//
+	// https://docs.go-atlassian.io/jira-agile/boards#get-quick-filter
+	QuickFilter(ctx context.Context, boardID, quickFilterID int) (*model.BoardQuickFilterScheme, *model.ResponseScheme, error)
+
+	// Features returns the features of a board, e.g. the estimation, the sprints or the backlog, and their state.
+	//
+	// GET /rest/agile/1.0/board/{boardID}/features
+	//
+	// https://docs.go-atlassian.io/jira-agile/boards#get-features-for-board
+	Features(ctx context.Context, boardID int) (*model.BoardFeaturesScheme, *model.ResponseScheme, error)
+
+	// ToggleFeature enables or disables a feature of a board, and returns the features of the board.
+	//
+	// PUT /rest/agile/1.0/board/{boardID}/features
+	//
+	// https://docs.go-atlassian.io/jira-agile/boards#toggle-features
+	ToggleFeature(ctx context.Context, boardID int, payload *model.BoardFeatureTogglePayloadScheme) (*model.BoardFeaturesScheme, *model.ResponseScheme, error)
 }
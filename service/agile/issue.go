@@ -0,0 +1,39 @@
+package agile
+
+import (
+	"context"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// IssueConnector represents the Jira Software issues.
+// Use it to estimate and rank issues.
+type IssueConnector interface {
+
+	// Estimation returns the estimation of the issue and a fieldId of the field that is used for it.
+	//
+	// The boardID is required to determine which field is used for estimation.
+	//
+	// GET /rest/agile/1.0/issue/{issueIDOrKey}/estimation
+	//
+	// https://docs.go-atlassian.io/jira-agile/issues#get-issue-estimation-for-board
+	Estimation(ctx context.Context, issueIDOrKey string, boardID int) (*model.IssueEstimationScheme, *model.ResponseScheme, error)
+
+	// Estimate updates the estimation of the issue.
+	//
+	// The boardID is required to determine which field is used for estimation.
+	//
+	// PUT /rest/agile/1.0/issue/{issueIDOrKey}/estimation
+	//
+	// https://docs.go-atlassian.io/jira-agile/issues#estimate-issue-for-board
+	Estimate(ctx context.Context, issueIDOrKey string, boardID int, value string) (*model.IssueEstimationScheme, *model.ResponseScheme, error)
+
+	// Rank moves (ranks) issues before or after a given issue.
+	//
+	// At most 50 issues may be ranked at once, if the ranking partially fails the result contains the status of each issue.
+	//
+	// PUT /rest/agile/1.0/issue/rank
+	//
+	// https://docs.go-atlassian.io/jira-agile/issues#rank-issues
+	Rank(ctx context.Context, payload *model.IssueRankPayloadScheme) (*model.IssueRankResultScheme, *model.ResponseScheme, error)
+}
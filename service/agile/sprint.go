@@ -94,4 +94,14 @@ type SprintConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-agile/sprints#move-issues-to-sprint
 	Move(ctx context.Context, sprintID int, payload *models.SprintMovePayloadScheme) (*models.ResponseScheme, error)
+
+	// Burndown returns the raw data of the burndown chart of a started sprint, derived from the changelogs of its issues.
+	//
+	// The issues removed from the sprint and the issues carried over to another sprint are read from the sprint report
+	// of the board of the sprint, and the truncated changelogs are paged.
+	//
+	// GET /rest/agile/1.0/sprint/{sprintID}/issue
+	//
+	// GET /rest/greenhopper/1.0/rapid/charts/sprintreport
+	Burndown(ctx context.Context, sprintID int, options *models.SprintBurndownOptionsScheme) (*models.SprintBurndownScheme, error)
 }
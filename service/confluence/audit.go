@@ -0,0 +1,33 @@
+package confluence
+
+import (
+	"context"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// AuditConnector audits the activities undertaken in Confluence.
+// Use it to get, create and export the audit records.
+type AuditConnector interface {
+
+	// Get returns all records in the audit log, optionally filtered by date range and search string.
+	//
+	// GET /wiki/rest/api/audit
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/audit#get-audit-records
+	Get(ctx context.Context, options *model.ConfluenceAuditRecordGetOptions, start, limit int) (*model.ConfluenceAuditRecordPageScheme, *model.ResponseScheme, error)
+
+	// Create creates a record in the audit log.
+	//
+	// POST /wiki/rest/api/audit
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/audit#create-audit-record
+	Create(ctx context.Context, payload *model.ConfluenceAuditRecordPayloadScheme) (*model.ConfluenceAuditRecordScheme, *model.ResponseScheme, error)
+
+	// Export exports audit records as a CSV file or ZIP file, the file is available in the ResponseScheme bytes.
+	//
+	// GET /wiki/rest/api/audit/export
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/audit#export-audit-records
+	Export(ctx context.Context, options *model.ConfluenceAuditRecordGetOptions, format string) (*model.ResponseScheme, error)
+}
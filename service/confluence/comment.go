@@ -14,3 +14,64 @@ type CommentConnector interface {
 	// https://docs.go-atlassian.io/confluence-cloud/content/comments#get-content-comments
 	Gets(ctx context.Context, contentID string, expand, location []string, startAt, maxResults int) (*model.ContentPageScheme, *model.ResponseScheme, error)
 }
+
+// ContentCommentConnector represents the Confluence Cloud footer and inline comments.
+// Use it to get, create, reply to, delete, and change the comments of the pages and blog posts.
+type ContentCommentConnector interface {
+
+	// Gets returns all the comments, footer or inline depending on the service.
+	//
+	// GET /wiki/api/v2/{footer-comments,inline-comments}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/v2/comment
+	Gets(ctx context.Context, options *model.ContentCommentOptionsScheme, cursor string, limit int) (*model.ContentCommentChunkScheme, *model.ResponseScheme, error)
+
+	// GetsByPage returns the root comments of a page.
+	//
+	// GET /wiki/api/v2/pages/{id}/{footer-comments,inline-comments}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/v2/comment
+	GetsByPage(ctx context.Context, pageID int, options *model.ContentCommentOptionsScheme, cursor string, limit int) (*model.ContentCommentChunkScheme, *model.ResponseScheme, error)
+
+	// GetsByBlogPost returns the root comments of a blog post.
+	//
+	// GET /wiki/api/v2/blogposts/{id}/{footer-comments,inline-comments}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/v2/comment
+	GetsByBlogPost(ctx context.Context, blogPostID int, options *model.ContentCommentOptionsScheme, cursor string, limit int) (*model.ContentCommentChunkScheme, *model.ResponseScheme, error)
+
+	// Children returns the replies of a comment.
+	//
+	// GET /wiki/api/v2/{footer-comments,inline-comments}/{id}/children
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/v2/comment
+	Children(ctx context.Context, commentID int, options *model.ContentCommentOptionsScheme, cursor string, limit int) (*model.ContentCommentChunkScheme, *model.ResponseScheme, error)
+
+	// Get returns a specific comment.
+	//
+	// GET /wiki/api/v2/{footer-comments,inline-comments}/{id}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/v2/comment
+	Get(ctx context.Context, commentID int, format string, version int) (*model.ContentCommentScheme, *model.ResponseScheme, error)
+
+	// Create creates a comment on a page or a blog post, or a reply to a comment.
+	//
+	// POST /wiki/api/v2/{footer-comments,inline-comments}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/v2/comment
+	Create(ctx context.Context, payload *model.ContentCommentCreatePayloadScheme) (*model.ContentCommentScheme, *model.ResponseScheme, error)
+
+	// Update updates a comment, the inline comments can be resolved or reopened too.
+	//
+	// PUT /wiki/api/v2/{footer-comments,inline-comments}/{id}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/v2/comment
+	Update(ctx context.Context, commentID int, payload *model.ContentCommentUpdatePayloadScheme) (*model.ContentCommentScheme, *model.ResponseScheme, error)
+
+	// Delete deletes a comment.
+	//
+	// DELETE /wiki/api/v2/{footer-comments,inline-comments}/{id}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/v2/comment
+	Delete(ctx context.Context, commentID int) (*model.ResponseScheme, error)
+}
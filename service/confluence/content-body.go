@@ -0,0 +1,38 @@
+package confluence
+
+import (
+	"context"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// ContentBodyConnector provides methods to convert content bodies between representations in Confluence.
+type ContentBodyConnector interface {
+
+	// Convert converts a content body from one representation to another, e.g. storage to atlas_doc_format.
+	//
+	// Supported target representations are storage, editor, view, export_view, styled_view and atlas_doc_format.
+	//
+	// POST /wiki/rest/api/contentbody/convert/{to}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/content/body#convert-content-body
+	Convert(ctx context.Context, to string, payload *model.ContentBodyConvertPayloadScheme, options *model.ContentBodyConvertOptionsScheme) (*model.ContentBodyConvertedScheme, *model.ResponseScheme, error)
+
+	// ConvertAsync starts the conversion of a content body in the background and returns the ID of the conversion task.
+	//
+	// Use AsyncResult to poll the task until it's completed.
+	//
+	// POST /wiki/rest/api/contentbody/convert/async/{to}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/content/body#asynchronously-convert-content-body
+	ConvertAsync(ctx context.Context, to string, payload *model.ContentBodyConvertPayloadScheme, options *model.ContentBodyConvertOptionsScheme) (*model.ContentBodyAsyncIDScheme, *model.ResponseScheme, error)
+
+	// AsyncResult returns the converted content body of an async conversion task, or its current status
+	//
+	// if the task is not completed yet.
+	//
+	// GET /wiki/rest/api/contentbody/convert/async/{id}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/content/body#get-asynchronously-converted-content-body
+	AsyncResult(ctx context.Context, asyncID string) (*model.ContentBodyAsyncScheme, *model.ResponseScheme, error)
+}
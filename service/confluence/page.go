@@ -3,6 +3,8 @@ package confluence
 import (
 	"context"
 	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"io"
+	"io/fs"
 )
 
 // PageConnector represents the Confluence Cloud Pages.
@@ -96,4 +98,71 @@ type PageConnector interface {
 	//
 	// https://docs.go-atlassian.io/confluence-cloud/v2/page#delete-page
 	Delete(ctx context.Context, pageID int) (*models.ResponseScheme, error)
+
+	// Versions returns the versions of a page.
+	//
+	// GET /wiki/api/v2/pages/{id}/versions
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/v2/page#get-page-versions
+	Versions(ctx context.Context, pageID int, options *models.PageVersionOptionsScheme, cursor string, limit int) (*models.PageVersionChunkScheme, *models.ResponseScheme, error)
+
+	// Version returns the details of a specific version of a page.
+	//
+	// GET /wiki/api/v2/pages/{page-id}/versions/{version-number}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/v2/page#get-version-details-for-page-version
+	Version(ctx context.Context, pageID, versionNumber int) (*models.PageVersionDetailScheme, *models.ResponseScheme, error)
+
+	// DeleteVersion deletes a historical version of a page.
+	//
+	// The changes of the deleted version are rolled up into the next version, the current version cannot be deleted.
+	//
+	// DELETE /wiki/rest/api/content/{id}/version/{versionNumber}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/content/versions#delete-content-version
+	DeleteVersion(ctx context.Context, pageID, versionNumber int) (*models.ResponseScheme, error)
+
+	// Restore rolls back a page to a historical version.
+	//
+	// A new version is created with the content and the title of the historical version.
+	//
+	// POST /wiki/rest/api/content/{id}/version
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/content/versions#restore-content-version
+	Restore(ctx context.Context, pageID, versionNumber int, message string) (*models.ContentVersionScheme, *models.ResponseScheme, error)
+
+	// Publish converts the Markdown to the storage format and publishes it as a page, identified by its title within the space.
+	//
+	// The page is created when missing, otherwise a new version is published when the body changed.
+	//
+	// A version conflict caused by a concurrent update is retried once with the latest version number.
+	//
+	// GET /wiki/api/v2/pages
+	//
+	// POST /wiki/api/v2/pages
+	//
+	// PUT /wiki/api/v2/pages/{id}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/v2/page#update-page
+	Publish(ctx context.Context, payload *models.PagePublishPayloadScheme) (*models.PagePublishScheme, *models.ResponseScheme, error)
+
+	// Export writes the page tree rooted at the page to a zip bundle, with the storage format bodies, labels, attachments and the ordering of the pages.
+	//
+	// The bundle can be recreated in another space or site with the Import method.
+	//
+	// GET /wiki/api/v2/pages/{id}
+	//
+	// GET /wiki/api/v2/pages/{id}/children
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/v2/page
+	Export(ctx context.Context, pageID int, w io.Writer) (*models.PageBundleScheme, error)
+
+	// Import recreates the page tree of a bundle written by Export, the bundle can be a zip reader or an extracted directory.
+	//
+	// The links to the source space and between the pages of the tree are rewritten to the target space and the created pages.
+	//
+	// POST /wiki/api/v2/pages
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/v2/page
+	Import(ctx context.Context, bundle fs.FS, options *models.PageBundleImportOptionsScheme) (*models.PageBundleImportScheme, error)
 }
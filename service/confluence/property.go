@@ -35,3 +35,33 @@ type ContentPropertyConnector interface {
 	// https://docs.go-atlassian.io/confluence-cloud/content/properties#delete-content-property
 	Delete(ctx context.Context, contentID, key string) (*model.ResponseScheme, error)
 }
+
+// SpacePropertyConnector represents the Confluence space properties.
+// Use it to search, get, create, update and delete the properties of a space.
+type SpacePropertyConnector interface {
+
+	// Gets returns the properties of a space, filtered by key when the key is provided.
+	//
+	// GET /wiki/api/v2/spaces/{space-id}/properties
+	Gets(ctx context.Context, spaceID int, key, cursor string, limit int) (*model.SpacePropertyPageScheme, *model.ResponseScheme, error)
+
+	// Create creates a property for a space.
+	//
+	// POST /wiki/api/v2/spaces/{space-id}/properties
+	Create(ctx context.Context, spaceID int, payload *model.SpacePropertyPayloadScheme) (*model.SpacePropertyScheme, *model.ResponseScheme, error)
+
+	// Get returns a space property by its ID.
+	//
+	// GET /wiki/api/v2/spaces/{space-id}/properties/{property-id}
+	Get(ctx context.Context, spaceID int, propertyID string) (*model.SpacePropertyScheme, *model.ResponseScheme, error)
+
+	// Update updates a space property, the payload version number being the current version number plus one.
+	//
+	// PUT /wiki/api/v2/spaces/{space-id}/properties/{property-id}
+	Update(ctx context.Context, spaceID int, propertyID string, payload *model.SpacePropertyPayloadScheme) (*model.SpacePropertyScheme, *model.ResponseScheme, error)
+
+	// Delete deletes a space property.
+	//
+	// DELETE /wiki/api/v2/spaces/{space-id}/properties/{property-id}
+	Delete(ctx context.Context, spaceID int, propertyID string) (*model.ResponseScheme, error)
+}
@@ -0,0 +1,55 @@
+package confluence
+
+import (
+	"context"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// SettingsConnector provides methods to manage the look and feel and read the system settings of Confluence.
+//
+// The spaceKey parameter is optional, when it's not provided the operation applies to the site.
+type SettingsConnector interface {
+
+	// LookAndFeel returns the look and feel settings for the site or a single space.
+	//
+	// GET /wiki/rest/api/settings/lookandfeel
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/settings#get-look-and-feel-settings
+	LookAndFeel(ctx context.Context, spaceKey string) (*model.LookAndFeelSettingsScheme, *model.ResponseScheme, error)
+
+	// SelectLookAndFeel sets the look and feel type used by a space: global, custom or theme.
+	//
+	// PUT /wiki/rest/api/settings/lookandfeel
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/settings#select-look-and-feel-settings
+	SelectLookAndFeel(ctx context.Context, payload *model.LookAndFeelSelectionScheme) (*model.LookAndFeelSelectionScheme, *model.ResponseScheme, error)
+
+	// UpdateLookAndFeel updates the custom look and feel of the site or a single space.
+	//
+	// POST /wiki/rest/api/settings/lookandfeel/custom
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/settings#update-look-and-feel-settings
+	UpdateLookAndFeel(ctx context.Context, spaceKey string, payload *model.LookAndFeelScheme) (*model.LookAndFeelScheme, *model.ResponseScheme, error)
+
+	// ResetLookAndFeel resets the custom look and feel of the site or a single space to the default values.
+	//
+	// DELETE /wiki/rest/api/settings/lookandfeel/custom
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/settings#reset-look-and-feel-settings
+	ResetLookAndFeel(ctx context.Context, spaceKey string) (*model.ResponseScheme, error)
+
+	// Theme returns the theme selected for the site.
+	//
+	// GET /wiki/rest/api/settings/theme/selected
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/settings#get-global-theme
+	Theme(ctx context.Context) (*model.ThemeScheme, *model.ResponseScheme, error)
+
+	// SystemInfo returns the system information of the Confluence site, e.g. the cloud ID, edition and default locale.
+	//
+	// GET /wiki/rest/api/settings/systemInfo
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/settings#get-system-info
+	SystemInfo(ctx context.Context) (*model.SystemInfoScheme, *model.ResponseScheme, error)
+}
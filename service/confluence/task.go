@@ -2,6 +2,8 @@ package confluence
 
 import (
 	"context"
+	"time"
+
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 )
 
@@ -24,4 +26,11 @@ type TaskConnector interface {
 	//
 	// https://docs.go-atlassian.io/confluence-cloud/long-task#get-long-running-task
 	Get(ctx context.Context, taskID string) (*model.LongTaskScheme, *model.ResponseScheme, error)
+
+	// WaitFor polls a long-running task (e.g. space delete, copy page hierarchy or PDF export) until it's finished.
+	//
+	// The task is fetched every pollInterval, a zero interval falls back to one second.
+	//
+	// It returns model.ErrLongTaskFailed when the task finishes without success, and the context error when ctx is done first.
+	WaitFor(ctx context.Context, taskID string, pollInterval time.Duration) (*model.LongTaskScheme, *model.ResponseScheme, error)
 }
@@ -0,0 +1,69 @@
+package confluence
+
+import (
+	"context"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// WatcherConnector provides methods to manage the content and space watchers in Confluence.
+//
+// The accountID parameter is optional, when it's not provided the operation applies to the calling user.
+type WatcherConnector interface {
+
+	// Gets returns the watchers of a piece of content.
+	//
+	// GET /wiki/rest/api/content/{id}/notification/created
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/content/watchers#get-watches-for-page
+	Gets(ctx context.Context, contentID string, start, limit int) (*model.ContentWatcherPageScheme, *model.ResponseScheme, error)
+
+	// Status returns whether a user is watching a piece of content.
+	//
+	// GET /wiki/rest/api/user/watch/content/{contentId}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/content/watchers#get-content-watch-status
+	Status(ctx context.Context, contentID, accountID string) (*model.WatchStatusScheme, *model.ResponseScheme, error)
+
+	// Add adds a user as a watcher to a piece of content.
+	//
+	// POST /wiki/rest/api/user/watch/content/{contentId}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/content/watchers#add-content-watcher
+	Add(ctx context.Context, contentID, accountID string) (*model.ResponseScheme, error)
+
+	// Remove removes a user as a watcher from a piece of content.
+	//
+	// DELETE /wiki/rest/api/user/watch/content/{contentId}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/content/watchers#remove-content-watcher
+	Remove(ctx context.Context, contentID, accountID string) (*model.ResponseScheme, error)
+
+	// SpaceWatchers returns the watchers of a space.
+	//
+	// GET /wiki/rest/api/space/{spaceKey}/watch
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/content/watchers#get-space-watchers
+	SpaceWatchers(ctx context.Context, spaceKey string, start, limit int) (*model.SpaceWatcherPageScheme, *model.ResponseScheme, error)
+
+	// SpaceStatus returns whether a user is watching a space.
+	//
+	// GET /wiki/rest/api/user/watch/space/{spaceKey}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/content/watchers#get-space-watch-status
+	SpaceStatus(ctx context.Context, spaceKey, accountID string) (*model.WatchStatusScheme, *model.ResponseScheme, error)
+
+	// AddSpace adds a user as a watcher to a space.
+	//
+	// POST /wiki/rest/api/user/watch/space/{spaceKey}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/content/watchers#add-space-watcher
+	AddSpace(ctx context.Context, spaceKey, accountID string) (*model.ResponseScheme, error)
+
+	// RemoveSpace removes a user as a watcher from a space.
+	//
+	// DELETE /wiki/rest/api/user/watch/space/{spaceKey}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/content/watchers#remove-space-watcher
+	RemoveSpace(ctx context.Context, spaceKey, accountID string) (*model.ResponseScheme, error)
+}
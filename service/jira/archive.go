@@ -37,6 +37,22 @@ type ArchiveService interface {
 	// https://docs.go-atlassian.io/jira-software-cloud/issues/archiving#archive-issues-by-jql
 	PreserveByJQL(ctx context.Context, jql string) (taskID string, response *models.ResponseScheme, err error)
 
+	// PreserveByJQLAndWait archives the issues that match the provided JQL query and waits for the archival task.
+	//
+	// The task is polled until it's done, and onProgress, when set, is called with each state of the task.
+	//
+	// Parameters:
+	//   - ctx: The context for request lifecycle management, the wait stops when it's done.
+	//   - jql: The JQL query to select issues for archival.
+	//   - onProgress: The callback receiving the progress of the archival task.
+	//
+	// Returns:
+	//   - task: The archival task once it's done.
+	//   - err: An error if the operation fails, models.ErrTaskFailed when the task doesn't complete.
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/issues/archiving#archive-issues-by-jql
+	PreserveByJQLAndWait(ctx context.Context, jql string, onProgress func(*models.TaskProgressScheme)) (task *models.TaskScheme, err error)
+
 	// Restore brings back the given archived issues using their issue IDs or keys.
 	//
 	// Parameters:
@@ -64,4 +80,26 @@ type ArchiveService interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues/archiving#export-archived-issues
 	Export(ctx context.Context, payload *models.IssueArchivalExportPayloadScheme) (task *models.IssueArchiveExportResultScheme, response *models.ResponseScheme, err error)
+
+	// Settings returns the license of the Jira instance, deciding whether the issues can be archived.
+	//
+	// Parameters:
+	//   - ctx: The context for controlling request execution.
+	//
+	// Returns:
+	//   - settings: The applications of the instance and their plans.
+	//   - response: The HTTP response scheme for the request.
+	//   - err: An error if the operation fails.
+	Settings(ctx context.Context) (settings *models.IssueArchivalSettingsScheme, response *models.ResponseScheme, err error)
+
+	// CheckEligibility classifies the issues before archiving them with Preserve, reducing its partial failures.
+	//
+	// Parameters:
+	//   - ctx: The context for controlling request execution.
+	//   - issueIdsOrKeys: A list of issue IDs or keys to be archived.
+	//
+	// Returns:
+	//   - eligibility: The eligible issues and the ineligible issues by reason.
+	//   - err: An error if the checks can't be made.
+	CheckEligibility(ctx context.Context, issueIDsOrKeys []string) (eligibility *models.IssueArchivalEligibilityScheme, err error)
 }
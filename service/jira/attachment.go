@@ -41,6 +41,19 @@ type AttachmentConnector interface {
 	// https://docs.go-atlassian.io/jira-software-cloud/issues/attachments#get-all-metadata-for-an-expanded-attachment
 	Human(ctx context.Context, attachmentID string) (*model.IssueAttachmentHumanMetadataScheme, *model.ResponseScheme, error)
 
+	// Raw returns the metadata for the contents of an attachment, if it is an archive, e.g. the entries of a ZIP file,
+	// with their sizes in bytes. The attachment itself is not downloaded.
+	//
+	// GET /rest/api/{2-3}/attachment/{attachmentID}/expand/raw
+	//
+	// Experimental Endpoint
+	Raw(ctx context.Context, attachmentID string) (*model.IssueAttachmentRawMetadataScheme, *model.ResponseScheme, error)
+
+	// Thumbnail returns the thumbnail of an attachment, read as Download.
+	//
+	// GET /rest/api/{2-3}/attachment/thumbnail/{id}
+	Thumbnail(ctx context.Context, attachmentID string, options *model.IssueAttachmentThumbnailOptionsScheme) (*model.ResponseScheme, error)
+
 	// Add adds one attachment to an issue. Attachments are posted as multipart/form-data (RFC 1867).
 	//
 	// POST /rest/api/{2-3}/issue/{issueKeyOrID}/attachments
@@ -48,12 +61,58 @@ type AttachmentConnector interface {
 	// https://docs.go-atlassian.io/jira-software-cloud/issues/attachments#add-attachment
 	Add(ctx context.Context, issueKeyOrID, fileName string, file io.Reader) ([]*model.IssueAttachmentScheme, *model.ResponseScheme, error)
 
+	// AddFromReader adds one attachment to an issue, streaming the file into the multipart/form-data body instead of
+	// buffering it in memory, so the large files can be uploaded with a constant memory usage.
+	//
+	// The size of the file sets the Content-Length of the request, a negative size sends it chunked. The file being
+	// read once, the request isn't retried.
+	//
+	// POST /rest/api/{2-3}/issue/{issueKeyOrID}/attachments
+	AddFromReader(ctx context.Context, issueKeyOrID, fileName string, size int64, file io.Reader) ([]*model.IssueAttachmentScheme, *model.ResponseScheme, error)
+
+	// Upload adds one attachment to an issue and checks that the attachment matches the file.
+	//
+	// Jira doesn't support chunked uploads, the rate-limited and mismatching uploads are retried from the beginning of the file.
+	//
+	// POST /rest/api/{2-3}/issue/{issueKeyOrID}/attachments
+	//
+	// GET /rest/api/{2-3}/attachment/content/{id}
+	//
+	// DELETE /rest/api/{2-3}/attachment/{id}
+	Upload(ctx context.Context, issueKeyOrID, fileName string, file io.ReadSeeker, options *model.IssueAttachmentUploadOptionsScheme) (*model.IssueAttachmentUploadScheme, error)
+
 	// Download returns the contents of an attachment. A Range header can be set to define a range of bytes within the attachment to download.
 	//
 	// See the HTTP Range header standard for details.
 	//
+	// The content is read into ResponseScheme.Bytes, see DownloadStream to stream it instead.
+	//
 	// GET /rest/api/{2-3}/attachment/content/{id}
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues/attachments#download-attachment
 	Download(ctx context.Context, attachmentID string, redirect bool) (*model.ResponseScheme, error)
+
+	// DownloadStream returns the contents of an attachment like Download, streamed instead of read into ResponseScheme.Bytes.
+	//
+	// The contents are read from ResponseScheme.Reader, and the response must be closed with ResponseScheme.Close.
+	//
+	// GET /rest/api/{2-3}/attachment/content/{id}
+	DownloadStream(ctx context.Context, attachmentID string, redirect bool) (*model.ResponseScheme, error)
+
+	// DownloadTo streams the contents of an attachment to the writer, without reading them in memory,
+	// and returns the number of bytes written.
+	//
+	// GET /rest/api/{2-3}/attachment/content/{id}
+	DownloadTo(ctx context.Context, attachmentID string, w io.Writer) (int64, *model.ResponseScheme, error)
+
+	// Archive downloads all the attachments of an issue concurrently into a ZIP file without reading them in memory.
+	//
+	// The attachments are downloaded to temporary files, a few at a time, then written to the ZIP file in the order
+	// returned by Jira. The attachments that can't be downloaded are reported on the returned archive and left out of
+	// the ZIP file; an error while writing an attachment stops the archive, as the ZIP file can't be completed.
+	//
+	// GET /rest/api/{2-3}/issue/{issueKeyOrID}?fields=attachment
+	//
+	// GET /rest/api/{2-3}/attachment/content/{id}
+	Archive(ctx context.Context, issueKeyOrID string, w io.Writer) (*model.IssueAttachmentArchiveScheme, error)
 }
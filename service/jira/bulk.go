@@ -0,0 +1,55 @@
+package jira
+
+import (
+	"context"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+type IssueBulkConnector interface {
+
+	// Fields returns the fields that can be edited in bulk on the issues.
+	//
+	// GET /rest/api/3/bulk/issues/fields
+	Fields(ctx context.Context, issueIDsOrKeys []string, searchText, startingAfter, endingBefore string) (*model.BulkEditableFieldPageScheme, *model.ResponseScheme, error)
+
+	// Edit edits the fields of the issues in bulk, and returns the ID of the task of the operation.
+	//
+	// POST /rest/api/3/bulk/issues/fields
+	Edit(ctx context.Context, payload *model.BulkEditPayloadScheme) (string, *model.ResponseScheme, error)
+
+	// Transitions returns the transitions available on the issues, grouped by workflow.
+	//
+	// GET /rest/api/3/bulk/issues/transition
+	Transitions(ctx context.Context, issueIDsOrKeys []string, startingAfter, endingBefore string) (*model.BulkTransitionPageScheme, *model.ResponseScheme, error)
+
+	// Transition transitions the issues in bulk, and returns the ID of the task of the operation.
+	//
+	// POST /rest/api/3/bulk/issues/transition
+	Transition(ctx context.Context, payload *model.BulkTransitionPayloadScheme) (string, *model.ResponseScheme, error)
+
+	// Watch adds the calling user as watcher of the issues in bulk, and returns the ID of the task of the operation.
+	//
+	// POST /rest/api/3/bulk/issues/watch
+	Watch(ctx context.Context, issueIDsOrKeys []string) (string, *model.ResponseScheme, error)
+
+	// Unwatch removes the calling user from the watchers of the issues in bulk, and returns the ID of the task of the operation.
+	//
+	// POST /rest/api/3/bulk/issues/unwatch
+	Unwatch(ctx context.Context, issueIDsOrKeys []string) (string, *model.ResponseScheme, error)
+
+	// Move moves the issues in bulk to other projects or issue types, and returns the ID of the task of the operation.
+	//
+	// POST /rest/api/3/bulk/issues/move
+	Move(ctx context.Context, payload *model.BulkMovePayloadScheme) (string, *model.ResponseScheme, error)
+
+	// Progress returns the progress of a bulk operation.
+	//
+	// GET /rest/api/3/bulk/queue/{taskId}
+	Progress(ctx context.Context, taskID string) (*model.BulkOperationProgressScheme, *model.ResponseScheme, error)
+
+	// Wait polls a bulk operation until it's finished, calling onProgress with each progress of the operation.
+	//
+	// GET /rest/api/3/bulk/queue/{taskId}
+	Wait(ctx context.Context, taskID string, onProgress func(*model.BulkOperationProgressScheme)) (*model.BulkOperationProgressScheme, error)
+}
@@ -36,6 +36,12 @@ type CommentRichTextConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues/comments#update-comment
 	Update(ctx context.Context, issueKeyOrID, commentID string, payload *model.CommentPayloadSchemeV2, expand []string) (*model.IssueCommentSchemeV2, *model.ResponseScheme, error)
+
+	// Sync returns the comments of an issue created or updated since the checkpoint of the previous sync,
+	// the comments being filtered by their updated date.
+	//
+	// GET /rest/api/{2-3}/issue/{issueKeyOrID}/comment
+	Sync(ctx context.Context, issueKeyOrID string, options *model.IssueCommentSyncOptionsScheme) (*model.IssueCommentSyncSchemeV2, error)
 }
 
 type CommentADFConnector interface {
@@ -68,6 +74,20 @@ type CommentADFConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues/comments#update-comment
 	Update(ctx context.Context, issueKeyOrID, commentID string, payload *model.CommentPayloadScheme, expand []string) (*model.IssueCommentScheme, *model.ResponseScheme, error)
+
+	// Mention adds a comment to an issue, mentioning the users after the text.
+	//
+	// The users are identified by account ID or by email address, the email addresses are resolved with the user search
+	// endpoint by User.ResolveEmails, sharing its cache.
+	//
+	// POST /rest/api/{2-3}/issue/{issueKeyOrID}/comment
+	Mention(ctx context.Context, issueKeyOrID, text string, users, expand []string) (*model.IssueCommentScheme, *model.ResponseScheme, error)
+
+	// Sync returns the comments of an issue created or updated since the checkpoint of the previous sync,
+	// the comments being filtered by their updated date.
+	//
+	// GET /rest/api/{2-3}/issue/{issueKeyOrID}/comment
+	Sync(ctx context.Context, issueKeyOrID string, options *model.IssueCommentSyncOptionsScheme) (*model.IssueCommentSyncScheme, error)
 }
 
 type CommentSharedConnector interface {
@@ -33,6 +33,13 @@ type DashboardConnector interface {
 	// https://docs.go-atlassian.io/jira-software-cloud/dashboards#search-for-dashboards
 	Search(ctx context.Context, options *model.DashboardSearchOptionsScheme, startAt, maxResults int) (*model.DashboardSearchPageScheme, *model.ResponseScheme, error)
 
+	// SearchAll returns the dashboards matching the options, following the pages of the search.
+	//
+	// GET /rest/api/{2-3}/dashboard/search
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/dashboards#search-for-dashboards
+	SearchAll(ctx context.Context, options *model.DashboardSearchOptionsScheme) ([]*model.DashboardScheme, error)
+
 	// Get returns a dashboard.
 	//
 	// GET /rest/api/{2-3}/dashboard/{dashboardID}
@@ -62,4 +69,9 @@ type DashboardConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/dashboards#update-dashboard
 	Update(ctx context.Context, dashboardID string, payload *model.DashboardPayloadScheme) (*model.DashboardScheme, *model.ResponseScheme, error)
+
+	// ChangeOwner changes the owner of the dashboards, up to 100 at a time.
+	//
+	// PUT /rest/api/{2-3}/dashboard/bulk/edit
+	ChangeOwner(ctx context.Context, dashboardIDs []string, accountID string) (*model.DashboardBulkEditScheme, *model.ResponseScheme, error)
 }
@@ -0,0 +1,20 @@
+package jira
+
+import (
+	"context"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+type ExpressionConnector interface {
+
+	// Evaluate evaluates a Jira expression in the context, and returns its value with the complexity of the evaluation.
+	//
+	// POST /rest/api/{2-3}/expression/eval
+	Evaluate(ctx context.Context, expression string, expressionContext *model.ExpressionContextScheme) (*model.ExpressionEvaluationScheme, *model.ResponseScheme, error)
+
+	// Analyse checks the Jira expressions for syntax, type or complexity errors, without evaluating them.
+	//
+	// POST /rest/api/{2-3}/expression/analyse
+	Analyse(ctx context.Context, expressions []string, check string, contextVariables map[string]string) (*model.ExpressionAnalysisScheme, *model.ResponseScheme, error)
+}
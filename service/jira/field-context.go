@@ -57,6 +57,8 @@ type FieldContextConnector interface {
 
 	// SetDefaultValue sets default for contexts of a custom field.
 	//
+	// The default values are built per field type with the Default constructors, e.g. models.DefaultSingleOption.
+	//
 	// PUT /rest/api/{2-3}/field/{fieldID}/context/defaultValue
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues/fields/context#set-custom-field-contexts-default-values
@@ -55,6 +55,13 @@ type FieldConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues/fields#delete-field
 	Delete(ctx context.Context, fieldID string) (*model.TaskScheme, *model.ResponseScheme, error)
+
+	// Usage returns the usage report of the custom fields, walking their contexts, screens and issues.
+	//
+	// The issue counts are approximate, and the errors of a custom field are reported on its usage.
+	//
+	// It helps to find the custom fields that are candidates for deletion, see CustomFieldUsageScheme.Unused.
+	Usage(ctx context.Context, options *model.FieldSearchOptionsScheme) ([]*model.CustomFieldUsageScheme, error)
 }
 
 type FieldTrashConnector interface {
@@ -41,6 +41,13 @@ type FilterConnector interface {
 	Search(ctx context.Context, options *model.FilterSearchOptionScheme, startAt, maxResults int) (*model.FilterSearchPageScheme,
 		*model.ResponseScheme, error)
 
+	// SearchAll returns the filters matching the options, following the pages of the search.
+	//
+	// GET /rest/api/{2-3}/filter/search
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/filters#search-filters
+	SearchAll(ctx context.Context, options *model.FilterSearchOptionScheme) ([]*model.FilterDetailScheme, error)
+
 	// Get returns a filter.
 	//
 	// GET /rest/api/{2-3}/filter/{filterID}
@@ -68,4 +75,13 @@ type FilterConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/filters#change-filter-owner
 	Change(ctx context.Context, filterID int, accountID string) (*model.ResponseScheme, error)
+
+	// Subscriptions returns a page of the subscriptions of a filter.
+	//
+	// The subscriptions are returned by the get filter endpoint with the subscriptions expansion, whose end index is inclusive.
+	//
+	// GET /rest/api/{2-3}/filter/{filterID}?expand=subscriptions[startAt:endAt]
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/filters#get-filter-subscriptions
+	Subscriptions(ctx context.Context, filterID, startAt, maxResults int) (*model.FilterSubscriptionPageScheme, *model.ResponseScheme, error)
 }
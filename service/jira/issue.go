@@ -56,6 +56,17 @@ type IssueSharedConnector interface {
 	Transitions(ctx context.Context, issueKeyOrID string) (*model.IssueTransitionsScheme, *model.ResponseScheme, error)
 	// TODO The Transitions methods requires more parameters such as expand, transitionID, and more
 	// The parameters are documented on this [page](https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issues/#api-rest-api-3-issue-issueidorkey-transitions-get)
+
+	// TransitionTo transitions an issue until it reaches the target status, comparing the status names without case.
+	//
+	// The next status is the target status, the next status of the path or the first status of the shortest route of the workflow graph.
+	//
+	// The transitions stop with an error when no transition leads to the next status or the maximum number of transitions is reached.
+	//
+	// GET /rest/api/{2-3}/issue/{issueKeyOrID}/transitions
+	//
+	// POST /rest/api/{2-3}/issue/{issueKeyOrID}/transitions
+	TransitionTo(ctx context.Context, issueKeyOrID, status string, options *model.IssueTransitionToOptionsScheme) (*model.IssueTransitionToScheme, error)
 }
 
 type IssueRichTextConnector interface {
@@ -79,6 +90,19 @@ type IssueRichTextConnector interface {
 	// https://docs.go-atlassian.io/jira-software-cloud/issues#bulk-create-issue
 	Creates(ctx context.Context, payload []*model.IssueBulkSchemeV2) (*model.IssueBulkResponseScheme, *model.ResponseScheme, error)
 
+	// CreateSubtask creates a subtask of the parent issue, in the project of the parent.
+	//
+	// When the payload has no issue type, the first subtask issue type of the project is used.
+	//
+	// The inheritFields, e.g. priority, components or a custom field ID, are copied from the parent when the payload doesn't set them.
+	//
+	// GET /rest/api/{2-3}/issue/{issueKeyOrID}
+	//
+	// GET /rest/api/{2-3}/project/{projectKeyOrID}
+	//
+	// POST /rest/api/{2-3}/issue
+	CreateSubtask(ctx context.Context, parentKey string, payload *model.IssueSchemeV2, inheritFields []string) (*model.IssueResponseScheme, *model.ResponseScheme, error)
+
 	// Get returns the details for an issue.
 	//
 	// The issue is identified by its ID or key, however, if the identifier doesn't match an issue, a case-insensitive search
@@ -92,6 +116,11 @@ type IssueRichTextConnector interface {
 	// https://docs.go-atlassian.io/jira-software-cloud/issues#get-issue
 	Get(ctx context.Context, issueKeyOrID string, fields, expand []string) (*model.IssueSchemeV2, *model.ResponseScheme, error)
 
+	// GetIfExists returns the issue, and false instead of an error when the issue doesn't exist.
+	//
+	// GET /rest/api/{2-3}/issue/{issueKeyOrID}
+	GetIfExists(ctx context.Context, issueKeyOrID string, fields, expand []string) (*model.IssueSchemeV2, bool, error)
+
 	// Update edits an issue.
 	//
 	// Edits an issue. A transition may be applied and issue properties updated as part of the edit.
@@ -112,6 +141,34 @@ type IssueRichTextConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues#transition-issue
 	Move(ctx context.Context, issueKeyOrID, transitionID string, options *model.IssueMoveOptionsV2) (*model.ResponseScheme, error)
+
+	// Redact rewrites the description and the comments of an issue, replacing the matches of the patterns.
+	//
+	// Only the description and the comments with matches are updated, and the watchers are notified of the updates only
+	// with the NotifyUsers option.
+	//
+	// With the dry-run option, the number of matches is reported and the issue is left untouched.
+	//
+	// PUT /rest/api/{2-3}/issue/{issueKeyOrID}
+	//
+	// PUT /rest/api/{2-3}/issue/{issueKeyOrID}/comment/{commentID}
+	Redact(ctx context.Context, issueKeyOrID string, options *model.IssueRedactionOptionsScheme) (*model.IssueRedactionScheme, error)
+
+	// Split creates a new issue from the payload and moves the selected items of the issue to the new issue.
+	//
+	// The comments are copied, the attachments are re-uploaded, the issue links are recreated on the new issue
+	// and the subtasks are moved under the new issue. The items that can't be moved are reported without stopping the split.
+	//
+	// POST /rest/api/{2-3}/issue
+	//
+	// POST /rest/api/{2-3}/issue/{issueKeyOrID}/comment
+	//
+	// POST /rest/api/{2-3}/issue/{issueKeyOrID}/attachments
+	//
+	// POST /rest/api/{2-3}/issueLink
+	//
+	// PUT /rest/api/{2-3}/issue/{issueKeyOrID}
+	Split(ctx context.Context, issueKeyOrID string, payload *model.IssueSchemeV2, options *model.IssueSplitOptionsScheme) (*model.IssueSplitScheme, error)
 }
 
 type IssueADFConnector interface {
@@ -135,6 +192,19 @@ type IssueADFConnector interface {
 	// https://docs.go-atlassian.io/jira-software-cloud/issues#bulk-create-issue
 	Creates(ctx context.Context, payload []*model.IssueBulkSchemeV3) (*model.IssueBulkResponseScheme, *model.ResponseScheme, error)
 
+	// CreateSubtask creates a subtask of the parent issue, in the project of the parent.
+	//
+	// When the payload has no issue type, the first subtask issue type of the project is used.
+	//
+	// The inheritFields, e.g. priority, components or a custom field ID, are copied from the parent when the payload doesn't set them.
+	//
+	// GET /rest/api/{2-3}/issue/{issueKeyOrID}
+	//
+	// GET /rest/api/{2-3}/project/{projectKeyOrID}
+	//
+	// POST /rest/api/{2-3}/issue
+	CreateSubtask(ctx context.Context, parentKey string, payload *model.IssueScheme, inheritFields []string) (*model.IssueResponseScheme, *model.ResponseScheme, error)
+
 	// Get returns the details for an issue.
 	//
 	// The issue is identified by its ID or key, however, if the identifier doesn't match an issue, a case-insensitive search
@@ -148,6 +218,11 @@ type IssueADFConnector interface {
 	// https://docs.go-atlassian.io/jira-software-cloud/issues#get-issue
 	Get(ctx context.Context, issueKeyOrID string, fields, expand []string) (*model.IssueScheme, *model.ResponseScheme, error)
 
+	// GetIfExists returns the issue, and false instead of an error when the issue doesn't exist.
+	//
+	// GET /rest/api/{2-3}/issue/{issueKeyOrID}
+	GetIfExists(ctx context.Context, issueKeyOrID string, fields, expand []string) (*model.IssueScheme, bool, error)
+
 	// Update edits an issue.
 	//
 	// Edits an issue. A transition may be applied and issue properties updated as part of the edit.
@@ -168,4 +243,32 @@ type IssueADFConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues#transition-issue
 	Move(ctx context.Context, issueKeyOrID, transitionID string, options *model.IssueMoveOptionsV3) (*model.ResponseScheme, error)
+
+	// Redact rewrites the description and the comments of an issue, replacing the matches of the patterns.
+	//
+	// Only the description and the comments with matches are updated, and the watchers are notified of the updates only
+	// with the NotifyUsers option.
+	//
+	// With the dry-run option, the number of matches is reported and the issue is left untouched.
+	//
+	// PUT /rest/api/{2-3}/issue/{issueKeyOrID}
+	//
+	// PUT /rest/api/{2-3}/issue/{issueKeyOrID}/comment/{commentID}
+	Redact(ctx context.Context, issueKeyOrID string, options *model.IssueRedactionOptionsScheme) (*model.IssueRedactionScheme, error)
+
+	// Split creates a new issue from the payload and moves the selected items of the issue to the new issue.
+	//
+	// The comments are copied, the attachments are re-uploaded, the issue links are recreated on the new issue
+	// and the subtasks are moved under the new issue. The items that can't be moved are reported without stopping the split.
+	//
+	// POST /rest/api/{2-3}/issue
+	//
+	// POST /rest/api/{2-3}/issue/{issueKeyOrID}/comment
+	//
+	// POST /rest/api/{2-3}/issue/{issueKeyOrID}/attachments
+	//
+	// POST /rest/api/{2-3}/issueLink
+	//
+	// PUT /rest/api/{2-3}/issue/{issueKeyOrID}
+	Split(ctx context.Context, issueKeyOrID string, payload *model.IssueScheme, options *model.IssueSplitOptionsScheme) (*model.IssueSplitScheme, error)
 }
@@ -97,4 +97,13 @@ type LinkTypeConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues/link/types#delete-issue-link-type
 	Delete(ctx context.Context, issueLinkTypeID string) (*model.ResponseScheme, error)
+
+	// Rename renames an issue link type and its inward and outward descriptions, the empty values are left unchanged.
+	//
+	// The rename is refused when another link type has the name or the link type links more issues than allowed.
+	//
+	// POST /rest/api/{2-3}/search/approximate-count
+	//
+	// PUT /rest/api/{2-3}/issueLinkType/{issueLinkTypeID}
+	Rename(ctx context.Context, issueLinkTypeID string, payload *model.LinkTypeScheme, options *model.LinkTypeRenameOptionsScheme) (*model.LinkTypeRenameScheme, error)
 }
@@ -74,4 +74,20 @@ type MetadataConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues/metadata#get-create-field-metadata-for-a-project-and-issue-type-id
 	FetchFieldMappings(ctx context.Context, projectKeyOrID, issueTypeID string, startAt, maxResults int) (gjson.Result, *model.ResponseScheme, error)
+
+	// CreateCompat returns the create metadata of the projects in the shape of the deprecated Create, built from the
+	// per-project and per-issue-type endpoints, to keep the code reading the legacy response working.
+	//
+	// The projects must be provided by key or ID, a project provided by both is returned once. The issue types are
+	// filtered by ID or name, and the fields of the issue types are included when the expand contains
+	// "projects.issuetypes.fields".
+	//
+	// GET /rest/api/{2-3}/project/{projectKeyOrID}
+	//
+	// GET /rest/api/{2-3}/issue/createmeta/{projectIdOrKey}/issuetypes
+	//
+	// GET /rest/api/{2-3}/issue/createmeta/{projectIdOrKey}/issuetypes/{issueTypeId}
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/issues/metadata#get-create-issue-metadata
+	CreateCompat(ctx context.Context, opts *model.IssueMetadataCreateOptions) (gjson.Result, error)
 }
@@ -28,6 +28,20 @@ type PermissionConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/permissions#get-permitted-projects
 	Projects(ctx context.Context, permissions []string) (*model.PermittedProjectsScheme, *model.ResponseScheme, error)
+
+	// Mine returns the permissions of the current user, globally or in the context of a project or an issue.
+	//
+	// GET /rest/api/{2-3}/mypermissions
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/permissions#get-my-permissions
+	Mine(ctx context.Context, options *model.MyPermissionsOptionsScheme) (*model.MyPermissionsScheme, *model.ResponseScheme, error)
+
+	// Can reports whether the current user has a permission, globally or in the project when projectKey is set.
+	//
+	// The answers are cached for a few minutes, so it can be used to gate actions without calling Jira every time.
+	//
+	// GET /rest/api/{2-3}/mypermissions
+	Can(ctx context.Context, permissionKey, projectKey string) (bool, error)
 }
 
 type PermissionSchemeConnector interface {
@@ -88,6 +102,8 @@ type PermissionSchemeGrantConnector interface {
 
 	// Gets returns all permission grants for a permission scheme.
 	//
+	// The expand values are defined by the model.PermissionGrantExpand constants.
+	//
 	// GET /rest/api/{2-3}/permissionscheme/{permissionSchemeID}/permission
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/permissions/scheme/grant#get-permission-scheme-grants
@@ -22,6 +22,11 @@ type ProjectConnector interface {
 	// https://docs.go-atlassian.io/jira-software-cloud/projects#get-projects-paginated
 	Search(ctx context.Context, options *model.ProjectSearchOptionsScheme, startAt, maxResults int) (*model.ProjectSearchScheme, *model.ResponseScheme, error)
 
+	// Iterate returns an iterator over the projects visible to the user, fetching the pages of maxResults projects on demand.
+	//
+	// GET /rest/api/{2-3}/project/search
+	Iterate(ctx context.Context, options *model.ProjectSearchOptionsScheme, maxResults int) *model.PageIterator[*model.ProjectScheme]
+
 	// Get returns the project details for a project.
 	//
 	// GET /rest/api/{2-3}project/{projectKeyOrID}
@@ -29,6 +34,11 @@ type ProjectConnector interface {
 	// https://docs.go-atlassian.io/jira-software-cloud/projects#get-project
 	Get(ctx context.Context, projectKeyOrID string, expand []string) (*model.ProjectScheme, *model.ResponseScheme, error)
 
+	// GetIfExists returns the project, and false instead of an error when the project doesn't exist.
+	//
+	// GET /rest/api/{2-3}/project/{projectKeyOrID}
+	GetIfExists(ctx context.Context, projectKeyOrID string, expand []string) (*model.ProjectScheme, bool, error)
+
 	// Update updates the project details of a project.
 	//
 	// PUT /rest/api/{2-3}/project/{projectKeyOrID}
@@ -91,6 +101,30 @@ type ProjectConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/projects#get-project-notification-scheme
 	NotificationScheme(ctx context.Context, projectKeyOrID string, expand []string) (*model.NotificationSchemeScheme, *model.ResponseScheme, error)
+
+	// Snapshot captures the configuration of the project in a single document, e.g. for audits or drift detection.
+	//
+	// It includes the issue type, issue type screen, field configuration, workflow, permission and notification schemes,
+	// the issue type mappings of the schemes, the screen schemes mapped to the issue types with their screens, and the
+	// project roles with their actors. The schemes are fetched in parallel.
+	Snapshot(ctx context.Context, projectKeyOrID string) (*model.ProjectConfigurationSnapshotScheme, error)
+
+	// SetLead changes the lead of the project, e.g. when the current lead leaves the organization.
+	//
+	// PUT /rest/api/{2-3}/project/{projectKeyOrID}
+	SetLead(ctx context.Context, projectKeyOrID, accountID string) (*model.ProjectScheme, *model.ResponseScheme, error)
+
+	// SetDefaultAssignee changes the default assignee of the issues created in the project.
+	//
+	// PUT /rest/api/{2-3}/project/{projectKeyOrID}
+	SetDefaultAssignee(ctx context.Context, projectKeyOrID string, assigneeType model.ProjectAssigneeType) (*model.ProjectScheme, *model.ResponseScheme, error)
+
+	// LedBy returns every project visible to the user whose lead is the account, e.g. to reassign them when offboarding the user.
+	//
+	// The projects are searched with the lead expanded, and filtered by the account ID of the lead.
+	//
+	// GET /rest/api/{2-3}/project/search
+	LedBy(ctx context.Context, accountID string) ([]*model.ProjectScheme, error)
 }
 
 type ProjectCategoryConnector interface {
@@ -176,6 +210,18 @@ type ProjectComponentConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/projects/components#get-component
 	Get(ctx context.Context, componentID string) (*model.ComponentScheme, *model.ResponseScheme, error)
+
+	// Search returns a page of the components of the projects, of every project when none is provided.
+	//
+	// GET /rest/api/{2-3}/component
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/projects/components#find-components-for-projects
+	Search(ctx context.Context, options *model.ComponentSearchOptionsScheme, startAt, maxResults int) (*model.ComponentPageScheme, *model.ResponseScheme, error)
+
+	// GetsByLead returns the components led by the user across the projects, of every project when none is provided.
+	//
+	// GET /rest/api/{2-3}/component
+	GetsByLead(ctx context.Context, accountID string, projectIDsOrKeys []string) ([]*model.ComponentScheme, error)
 }
 
 type ProjectFeatureConnector interface {
@@ -193,6 +239,18 @@ type ProjectFeatureConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/projects/features#set-project-feature-state
 	Set(ctx context.Context, projectKeyOrID, featureKey, state string) (*model.ProjectFeaturesScheme, *model.ResponseScheme, error)
+
+	// SetState sets the state of a project feature after checking it can be toggled.
+	//
+	// The features of the project are fetched first, the feature must exist, must not be locked, and its prerequisites
+	// must be enabled before it can be enabled.
+	//
+	// The features are returned without an update when the feature is already in the requested state.
+	//
+	// PUT /rest/api/{2-3}/project/{projectKeyOrID}/features/{featureKey}
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/projects/features#set-project-feature-state
+	SetState(ctx context.Context, projectKeyOrID string, featureKey model.ProjectFeatureKey, state model.ProjectFeatureState) (*model.ProjectFeaturesScheme, *model.ResponseScheme, error)
 }
 
 type ProjectPermissionSchemeConnector interface {
@@ -217,6 +275,33 @@ type ProjectPermissionSchemeConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/projects/permission-schemes#get-project-issue-security-levels
 	SecurityLevels(ctx context.Context, projectKeyOrID string) (*model.IssueSecurityLevelsScheme, *model.ResponseScheme, error)
+
+	// AssignSecurityScheme associates an issue security scheme with a project and remaps the security levels of its issues.
+	//
+	// The association runs asynchronously, the returned task can be followed with the Task service.
+	//
+	// PUT /rest/api/{2-3}/issuesecurityschemes/project
+	AssignSecurityScheme(ctx context.Context, payload *model.IssueSecuritySchemeAssignPayloadScheme) (*model.TaskScheme, *model.ResponseScheme, error)
+
+	// SetSecurityLevel sets a security level on the issues matching a JQL query, with a bounded number of issues updated at the same time.
+	//
+	// The issues are searched before the first update, the issues already on the level are skipped,
+	// and the issues that can't be updated are reported on the returned update.
+	//
+	// POST /rest/api/{2-3}/search/jql
+	//
+	// PUT /rest/api/{2-3}/issue/{issueKeyOrID}
+	SetSecurityLevel(ctx context.Context, jql, levelID string, options *model.IssueSecurityLevelUpdateOptionsScheme) (*model.IssueSecurityLevelUpdateScheme, error)
+
+	// Explain reports why the user does or doesn't hold the permission in the project, going through the grants of the
+	// permission scheme of the project, the groups and application roles of the user, the project roles and the project lead.
+	//
+	// GET /rest/api/{2-3}/project/{projectKeyOrID}/permissionscheme
+	//
+	// GET /rest/api/{2-3}/user
+	//
+	// GET /rest/api/{2-3}/project/{projectKeyOrID}/role/{id}
+	Explain(ctx context.Context, projectKeyOrID, accountID, permission string) (*model.PermissionExplanationScheme, error)
 }
 
 type ProjectPropertyConnector interface {
@@ -309,6 +394,19 @@ type ProjectRoleActorConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/projects/roles/actors#delete-actors-from-project-role
 	Delete(ctx context.Context, projectKeyOrID string, roleID int, accountID, group string) (*model.ResponseScheme, error)
+
+	// Set replaces the actors of a project role for the project with the given users and groups.
+	//
+	// The missing actors are added and the extra actors are removed, one call at a time.
+	//
+	// When a call fails, the changes already applied are reverted and reported on the returned change.
+	//
+	// GET /rest/api/{2-3}/project/{projectKeyOrID}/role/{id}
+	//
+	// POST /rest/api/{2-3}/project/{projectKeyOrID}/role/{id}
+	//
+	// DELETE /rest/api/{2-3}/project/{projectKeyOrID}/role/{id}
+	Set(ctx context.Context, projectKeyOrID string, roleID int, accountIDs, groups []string) (*model.ProjectRoleActorsChangeScheme, error)
 }
 
 type ProjectTypeConnector interface {
@@ -372,6 +470,19 @@ type ProjectValidatorConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/projects/validation#get-valid-project-name
 	Name(ctx context.Context, name string) (string, *model.ResponseScheme, error)
+
+	// Availability checks the validity and availability of the keys and names of the candidates,
+	//
+	// and suggests the next available key and name of the candidates that can't be used.
+	//
+	// The candidates that can't be checked are reported on the returned availabilities.
+	//
+	// GET /rest/api/{2-3}/projectvalidate/key
+	//
+	// GET /rest/api/{2-3}/projectvalidate/validProjectKey
+	//
+	// GET /rest/api/{2-3}/projectvalidate/validProjectName
+	Availability(ctx context.Context, candidates []*model.ProjectCandidateScheme) ([]*model.ProjectAvailabilityScheme, error)
 }
 
 type ProjectVersionConnector interface {
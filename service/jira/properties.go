@@ -80,3 +80,77 @@ type IssuePropertyConnector interface {
 	*/
 	Delete(ctx context.Context, issueKeyOrID, propertyKey string) (*model.ResponseScheme, error)
 }
+
+/*
+WorklogPropertyConnector represents worklog properties, which provides for storing custom data against a worklog.
+
+Use it to get, set, and delete worklog properties as well as obtain the keys of all properties on a worklog,
+e.g. to tag the worklogs with the IDs of an external time-tracking system.
+*/
+type WorklogPropertyConnector interface {
+
+	/*
+		Gets returns the keys of all properties for a worklog.
+
+		Permissions required:
+			- Browse projects project permission for the project that the issue is in.
+			- If issue-level security is configured, issue-level security permission to view the issue.
+			- If the worklog has visibility restrictions, belongs to the group or has the role visibility is restricted to.
+
+		Endpoint: GET /rest/api/{apiVersion}/issue/{issueKeyOrID}/worklog/{worklogID}/properties
+
+		You can refer to the documentation: [Get worklog property keys]
+
+		[Get worklog property keys]: https://docs.go-atlassian.io/jira-software-cloud/issues/worklogs/properties#get-worklog-property-keys
+	*/
+	Gets(ctx context.Context, issueKeyOrID, worklogID string) (*model.PropertyPageScheme, *model.ResponseScheme, error)
+
+	/*
+		Get returns the value of a worklog property.
+
+		Permissions required:
+			- Browse projects project permission for the project that the issue is in.
+			- If issue-level security is configured, issue-level security permission to view the issue.
+			- If the worklog has visibility restrictions, belongs to the group or has the role visibility is restricted to.
+
+		Endpoint: GET /rest/api/{apiVersion}/issue/{issueKeyOrID}/worklog/{worklogID}/properties/{propertyKey}
+
+		You can refer to the documentation: [Get worklog property]
+
+		[Get worklog property]: https://docs.go-atlassian.io/jira-software-cloud/issues/worklogs/properties#get-worklog-property
+	*/
+	Get(ctx context.Context, issueKeyOrID, worklogID, propertyKey string) (*model.EntityPropertyScheme, *model.ResponseScheme, error)
+
+	/*
+		Set sets the value of a worklog property. Use this operation to store custom data against the worklog.
+			- The value of the request body must be a valid, non-empty JSON blob. The maximum length is 32768 characters.
+
+		Permissions required:
+			- Browse projects and Edit all worklogs project permission, or Edit own worklogs to update worklogs created by the user.
+			- If issue-level security is configured, issue-level security permission to view the issue.
+			- If the worklog has visibility restrictions, belongs to the group or has the role visibility is restricted to.
+
+		Endpoint: PUT /rest/api/{apiVersion}/issue/{issueKeyOrID}/worklog/{worklogID}/properties/{propertyKey}
+
+		You can refer to the documentation: [Set worklog property]
+
+		[Set worklog property]: https://docs.go-atlassian.io/jira-software-cloud/issues/worklogs/properties#set-worklog-property
+	*/
+	Set(ctx context.Context, issueKeyOrID, worklogID, propertyKey string, payload interface{}) (*model.ResponseScheme, error)
+
+	/*
+		Delete deletes a worklog property.
+
+		Permissions required:
+			- Browse projects and Edit all worklogs project permission, or Edit own worklogs to update worklogs created by the user.
+			- If issue-level security is configured, issue-level security permission to view the issue.
+			- If the worklog has visibility restrictions, belongs to the group or has the role visibility is restricted to.
+
+		Endpoint: DELETE /rest/api/{apiVersion}/issue/{issueKeyOrID}/worklog/{worklogID}/properties/{propertyKey}
+
+		You can refer to the documentation: [Delete worklog property]
+
+		[Delete worklog property]: https://docs.go-atlassian.io/jira-software-cloud/issues/worklogs/properties#delete-worklog-property
+	*/
+	Delete(ctx context.Context, issueKeyOrID, worklogID, propertyKey string) (*model.ResponseScheme, error)
+}
@@ -14,6 +14,28 @@ type SearchSharedConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues/search#check-issues-against-jql
 	Checks(ctx context.Context, payload *model.IssueSearchCheckPayloadScheme) (*model.IssueMatchesPageScheme, *model.ResponseScheme, error)
+
+	// Picker returns lists of issues matching a query string.
+	//
+	// Use this resource to provide auto-completion suggestions when the user is looking for an issue using a word or string.
+	//
+	// GET /rest/api/{2-3}/issue/picker
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/issues/search#get-issue-picker-suggestions
+	Picker(ctx context.Context, options *model.IssuePickerOptionsScheme) (*model.IssuePickerScheme, *model.ResponseScheme, error)
+
+	// Sync returns the keys of the issues of the JQL scope created, updated or deleted since the checkpoint.
+	//
+	// The created and updated issues are found with the updated >= JQL clause, the deleted issues by reconciling the
+	// KnownKeys with the keys of the scope, e.g. the keys stored by a data warehouse connector.
+	//
+	// Use the returned Checkpoint as the Since option of the next sync, it's taken on the clock of the server. The JQL
+	// dates are written in the time zone of the current user, unless the Location option is provided.
+	//
+	// GET /rest/api/{2-3}/myself
+	//
+	// POST /rest/api/{2-3}/search/jql
+	Sync(ctx context.Context, options *model.IssueSearchSyncOptionsScheme) (*model.IssueSearchSyncScheme, error)
 }
 
 type SearchRichTextConnector interface {
@@ -43,6 +65,20 @@ type SearchRichTextConnector interface {
 	//
 	SearchJQL(ctx context.Context, jql string, fields, expands []string, maxResults int, nextPageToken string) (*model.IssueSearchJQLSchemeV2, *model.ResponseScheme, error)
 
+	// Iterate returns an iterator over the issues matching the JQL query, fetching the pages of maxResults issues on demand
+	// with the next page tokens of the new JQL search endpoint.
+	//
+	// POST /rest/api/2/search/jql
+	Iterate(ctx context.Context, jql string, fields, expands []string, maxResults int) *model.PageIterator[*model.IssueSchemeV2]
+
+	// Search searches issues using the new JQL search endpoint, with every option of the request body.
+	//
+	// The query is sent in the request body, so long JQL queries and field lists don't hit the URL length limits.
+	//
+	// POST /rest/api/2/search/jql
+	//
+	Search(ctx context.Context, payload *model.IssueSearchJQLPayloadScheme) (*model.IssueSearchJQLSchemeV2, *model.ResponseScheme, error)
+
 	// ApproximateCount gets an approximate count of issues matching a JQL query
 	//
 	// POST /rest/api/2/search/approximate-count
@@ -83,6 +119,20 @@ type SearchADFConnector interface {
 	//
 	SearchJQL(ctx context.Context, jql string, fields, expands []string, maxResults int, nextPageToken string) (*model.IssueSearchJQLScheme, *model.ResponseScheme, error)
 
+	// Iterate returns an iterator over the issues matching the JQL query, fetching the pages of maxResults issues on demand
+	// with the next page tokens of the new JQL search endpoint.
+	//
+	// POST /rest/api/3/search/jql
+	Iterate(ctx context.Context, jql string, fields, expands []string, maxResults int) *model.PageIterator[*model.IssueScheme]
+
+	// Search searches issues using the new JQL search endpoint, with every option of the request body.
+	//
+	// The query is sent in the request body, so long JQL queries and field lists don't hit the URL length limits.
+	//
+	// POST /rest/api/3/search/jql
+	//
+	Search(ctx context.Context, payload *model.IssueSearchJQLPayloadScheme) (*model.IssueSearchJQLScheme, *model.ResponseScheme, error)
+
 	// ApproximateCount gets an approximate count of issues matching a JQL query
 	//
 	// POST /rest/api/3/search/approximate-count
@@ -2,6 +2,7 @@ package jira
 
 import (
 	"context"
+	"time"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 )
@@ -28,4 +29,9 @@ type TaskConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/tasks#cancel-task
 	Cancel(ctx context.Context, taskID string) (*model.ResponseScheme, error)
+
+	// WaitFor polls a task until it's done, calling progressFn with each state of the task.
+	//
+	// GET /rest/api/{2-3}/task/{taskID}
+	WaitFor(ctx context.Context, taskID string, pollInterval time.Duration, progressFn func(*model.TaskProgressScheme)) (*model.TaskScheme, *model.ResponseScheme, error)
 }
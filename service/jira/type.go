@@ -54,6 +54,19 @@ type TypeConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues/type#get-alternative-issue-types
 	Alternatives(ctx context.Context, issueTypeID string) ([]*model.IssueTypeScheme, *model.ResponseScheme, error)
+
+	// Project returns the issue types of a project, including the issue types scoped to a team-managed project.
+	//
+	// The issue types are filtered by hierarchy level when the level is provided,
+	// e.g. -1 for the subtask issue types, 0 for the standard issue types and 1 for the epic issue types.
+	//
+	// The issue types scoped to a team-managed project are created from the project settings,
+	// the scope of the issue types created with Create is always global.
+	//
+	// GET /rest/api/{2-3}/issuetype/project
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/issues/type#get-issue-types-for-project
+	Project(ctx context.Context, projectID int, level *int) ([]*model.IssueTypeScheme, *model.ResponseScheme, error)
 }
 
 type TypeSchemeConnector interface {
@@ -86,6 +99,15 @@ type TypeSchemeConnector interface {
 	// https://docs.go-atlassian.io/jira-software-cloud/issues/types/scheme#get-issue-type-schemes-for-projects
 	Projects(ctx context.Context, projectIDs []int, startAt, maxResults int) (*model.ProjectIssueTypeSchemePageScheme, *model.ResponseScheme, error)
 
+	// ForProjects returns the issue type schemes used by the projects, with the ordered issue types of each scheme.
+	//
+	// The pages of the project and mapping endpoints are walked until the last one.
+	//
+	// GET /rest/api/{2-3}/issuetypescheme/project
+	//
+	// GET /rest/api/{2-3}/issuetypescheme/mapping
+	ForProjects(ctx context.Context, projectIDs []int) (*model.IssueTypeSchemeProjectMappingScheme, error)
+
 	// Assign assigns an issue type scheme to a project.
 	//
 	// PUT /rest/api/{2-3}/issuetypescheme/project
@@ -161,6 +183,11 @@ type TypeScreenSchemeConnector interface {
 	// https://docs.go-atlassian.io/jira-software-cloud/issues/types/screen-scheme#get-issue-type-screen-schemes
 	Gets(ctx context.Context, options *model.ScreenSchemeParamsScheme, startAt, maxResults int) (*model.IssueTypeScreenSchemePageScheme, *model.ResponseScheme, error)
 
+	// Iterate returns an iterator over the issue type screen schemes, fetching the pages of maxResults schemes on demand.
+	//
+	// GET /rest/api/{2-3}/issuetypescreenscheme
+	Iterate(ctx context.Context, options *model.ScreenSchemeParamsScheme, maxResults int) *model.PageIterator[*model.IssueTypeScreenSchemeScheme]
+
 	// Create creates an issue type screen scheme.
 	//
 	// POST /rest/api/{2-3}/issuetypescreenscheme
@@ -15,6 +15,11 @@ type UserConnector interface {
 	// https://docs.go-atlassian.io/jira-software-cloud/users#get-user
 	Get(ctx context.Context, accountID string, expand []string) (*model.UserScheme, *model.ResponseScheme, error)
 
+	// GetIfExists returns the user, and false instead of an error when the user doesn't exist.
+	//
+	// GET /rest/api/{2-3}/user
+	GetIfExists(ctx context.Context, accountID string, expand []string) (*model.UserScheme, bool, error)
+
 	// Create creates a user. This resource is retained for legacy compatibility.
 	//
 	// As soon as a more suitable alternative is available this resource will be deprecated.
@@ -62,6 +67,40 @@ type UserConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/users#get-all-users
 	Gets(ctx context.Context, startAt, maxResults int) ([]*model.UserScheme, *model.ResponseScheme, error)
+
+	// ResolveEmails returns the account IDs of the users with the email addresses.
+	//
+	// The emails are looked up with the user search endpoint, in batches of concurrent requests,
+	// and the resolved account IDs are cached for the lifetime of the client. The emails are matched exactly,
+	// ignoring the case, so the users hiding their email address with their profile visibility are unresolved.
+	//
+	// GET /rest/api/{2-3}/user/search
+	ResolveEmails(ctx context.Context, emails []string) (*model.UserEmailResolutionScheme, error)
+
+	// Email returns the email address of a user, even when it's hidden by the profile visibility settings of the user.
+	//
+	// The endpoint is only available to the apps approved by Atlassian.
+	//
+	// GET /rest/api/{2-3}/user/email
+	Email(ctx context.Context, accountID string) (*model.UserEmailScheme, *model.ResponseScheme, error)
+
+	// Emails returns the email addresses of the users, even when they're hidden by the profile visibility settings.
+	//
+	// The endpoint is only available to the apps approved by Atlassian.
+	//
+	// GET /rest/api/{2-3}/user/email/bulk
+	Emails(ctx context.Context, accountIDs []string) ([]*model.UserEmailScheme, *model.ResponseScheme, error)
+
+	// TransferOwnership transfers the filters and dashboards owned by a user, e.g. a departing user, to another user.
+	//
+	// GET /rest/api/{2-3}/filter/search
+	//
+	// GET /rest/api/{2-3}/dashboard/search
+	//
+	// PUT /rest/api/{2-3}/filter/{id}/owner
+	//
+	// PUT /rest/api/{2-3}/dashboard/bulk/edit
+	TransferOwnership(ctx context.Context, fromAccountID, toAccountID string, options *model.OwnershipTransferOptionsScheme) (*model.OwnershipTransferScheme, error)
 }
 
 type UserSearchConnector interface {
@@ -32,4 +32,48 @@ type WatcherConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues/watcher#delete-watcher
 	Delete(ctx context.Context, issueKeyOrID, accountID string) (*model.ResponseScheme, error)
+
+	// Aggregate returns the watchers of many issues, reading a bounded number of issues at the same time.
+	//
+	// When the users are expanded, the details of every distinct watcher are read once and shared by the issues.
+	//
+	// GET /rest/api/{2-3}/issue/{issueKeyOrID}/watchers
+	//
+	// GET /rest/api/{2-3}/user
+	Aggregate(ctx context.Context, issueKeysOrIDs []string, options *model.IssueWatchersOptionsScheme) (*model.IssueWatchersScheme, error)
+
+	// AddByJQL adds a user as a watcher of every issue matching the JQL query, updating a bounded number of issues at the same time.
+	//
+	// If no user is specified the calling user is added.
+	//
+	// POST /rest/api/{2-3}/search/jql
+	//
+	// POST /rest/api/{2-3}/issue/{issueKeyOrID}/watchers
+	AddByJQL(ctx context.Context, jql, accountID string, options *model.IssueWatchersUpdateOptionsScheme) (*model.IssueWatchersUpdateScheme, error)
+
+	// DeleteByJQL deletes a user as a watcher of every issue matching the JQL query, updating a bounded number of issues at the same time.
+	//
+	// POST /rest/api/{2-3}/search/jql
+	//
+	// DELETE /rest/api/{2-3}/issue/{issueKeyOrID}/watchers
+	DeleteByJQL(ctx context.Context, jql, accountID string, options *model.IssueWatchersUpdateOptionsScheme) (*model.IssueWatchersUpdateScheme, error)
+
+	// AddBulk adds a user as a watcher of the issues, updating a bounded number of issues at the same time.
+	//
+	// If no user is specified the calling user is added.
+	//
+	// POST /rest/api/{2-3}/issue/{issueKeyOrID}/watchers
+	AddBulk(ctx context.Context, issueKeysOrIDs []string, accountID string, options *model.IssueWatchersUpdateOptionsScheme) (*model.IssueWatchersUpdateScheme, error)
+
+	// DeleteBulk deletes a user as a watcher of the issues, updating a bounded number of issues at the same time.
+	//
+	// DELETE /rest/api/{2-3}/issue/{issueKeyOrID}/watchers
+	DeleteBulk(ctx context.Context, issueKeysOrIDs []string, accountID string, options *model.IssueWatchersUpdateOptionsScheme) (*model.IssueWatchersUpdateScheme, error)
+
+	// IsWatching returns, in a single request, whether the calling user watches each of the issues, by issue ID.
+	//
+	// POST /rest/api/{2-3}/issue/watching
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/issues/watcher#get-is-watching-issue-bulk
+	IsWatching(ctx context.Context, issueIDs []string) (*model.IssueIsWatchingScheme, *model.ResponseScheme, error)
 }
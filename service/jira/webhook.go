@@ -0,0 +1,59 @@
+package jira
+
+import (
+	"context"
+	"time"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// WebhookConnector the interface for the dynamic webhook methods of the Jira Service.
+//
+// The dynamic webhooks can only be used by OAuth 2.0 and Connect apps, and expire after 30 days unless refreshed.
+type WebhookConnector interface {
+
+	// Gets returns a paginated list of the webhooks registered by the calling app.
+	//
+	// GET /rest/api/{2-3}/webhook
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/webhooks#get-dynamic-webhooks-for-app
+	Gets(ctx context.Context, startAt, maxResults int) (*model.WebhookPageScheme, *model.ResponseScheme, error)
+
+	// Register registers webhooks, the result of each webhook is returned in the order of the payload.
+	//
+	// POST /rest/api/{2-3}/webhook
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/webhooks#register-dynamic-webhooks
+	Register(ctx context.Context, payload *model.WebhookRegisterPayloadScheme) (*model.WebhookRegistrationResultScheme, *model.ResponseScheme, error)
+
+	// Delete removes webhooks by ID, only the webhooks registered by the calling app are removed.
+	//
+	// DELETE /rest/api/{2-3}/webhook
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/webhooks#delete-webhooks-by-id
+	Delete(ctx context.Context, webhookIDs []int) (*model.ResponseScheme, error)
+
+	// Refresh extends the life of the webhooks, the webhooks expire 30 days after the refresh.
+	//
+	// PUT /rest/api/{2-3}/webhook/refresh
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/webhooks#extend-webhook-life
+	Refresh(ctx context.Context, webhookIDs []int) (*model.WebhookRefreshScheme, *model.ResponseScheme, error)
+
+	// Failed returns the webhooks that Jira failed to deliver to the calling app after the retries, the oldest first.
+	//
+	// GET /rest/api/{2-3}/webhook/failed
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/webhooks#get-failed-webhooks
+	Failed(ctx context.Context, after time.Time, maxResults int) (*model.FailedWebhookPageScheme, *model.ResponseScheme, error)
+}
+
+// WebhookStore persists the IDs of the dynamic webhooks kept alive by a WebhookKeeper, e.g. in a database.
+type WebhookStore interface {
+
+	// Load returns the IDs of the webhooks to keep alive.
+	Load(ctx context.Context) ([]int, error)
+
+	// Save replaces the IDs of the webhooks to keep alive.
+	Save(ctx context.Context, webhookIDs []int) error
+}
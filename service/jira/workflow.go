@@ -304,6 +304,108 @@ type WorkflowSchemeIssueTypeConnector interface {
 	Mapping(ctx context.Context, schemeID int, workflowName string, returnDraft bool) ([]*model.IssueTypesWorkflowMappingScheme, *model.ResponseScheme, error)
 }
 
+// WorkflowSchemeDraftConnector represents the workflows scheme draft endpoints.
+//
+// Use it to create, get, update, publish, and delete the draft of an active workflow scheme.
+type WorkflowSchemeDraftConnector interface {
+
+	// Create creates a draft workflow scheme from an active workflow scheme.
+	//
+	// An active workflow scheme can only have one draft workflow scheme.
+	//
+	// POST /rest/api/{2-3}/workflowscheme/{id}/createdraft
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/workflow/scheme/draft#create-draft-workflow-scheme
+	Create(ctx context.Context, schemeID int) (*model.WorkflowSchemeScheme, *model.ResponseScheme, error)
+
+	// Get returns the draft workflow scheme for an active workflow scheme.
+	//
+	// GET /rest/api/{2-3}/workflowscheme/{id}/draft
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/workflow/scheme/draft#get-draft-workflow-scheme
+	Get(ctx context.Context, schemeID int) (*model.WorkflowSchemeScheme, *model.ResponseScheme, error)
+
+	// Update updates a draft workflow scheme.
+	//
+	// If a draft workflow scheme does not exist for the active workflow scheme, then a draft is created.
+	//
+	// PUT /rest/api/{2-3}/workflowscheme/{id}/draft
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/workflow/scheme/draft#update-draft-workflow-scheme
+	Update(ctx context.Context, schemeID int, payload *model.WorkflowSchemePayloadScheme) (*model.WorkflowSchemeScheme, *model.ResponseScheme, error)
+
+	// Delete deletes a draft workflow scheme.
+	//
+	// DELETE /rest/api/{2-3}/workflowscheme/{id}/draft
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/workflow/scheme/draft#delete-draft-workflow-scheme
+	Delete(ctx context.Context, schemeID int) (*model.ResponseScheme, error)
+
+	// Publish publishes a draft workflow scheme.
+	//
+	// Where the draft workflow includes new workflow statuses for an issue type, mappings are provided to update issues with the original workflow status to the new workflow status.
+	//
+	// The publication runs asynchronously, the task is returned when Jira starts it, otherwise the task is nil.
+	//
+	// POST /rest/api/{2-3}/workflowscheme/{id}/draft/publish
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/workflow/scheme/draft#publish-draft-workflow-scheme
+	Publish(ctx context.Context, schemeID int, payload *model.WorkflowSchemeDraftPublishPayloadScheme, validateOnly bool) (*model.TaskScheme, *model.ResponseScheme, error)
+
+	// Default returns the default workflow for a workflow scheme's draft.
+	//
+	// The default workflow is the workflow that is assigned any issue types that have not been mapped to any other workflow.
+	//
+	// GET /rest/api/{2-3}/workflowscheme/{id}/draft/default
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/workflow/scheme/draft#get-draft-default-workflow
+	Default(ctx context.Context, schemeID int) (*model.WorkflowSchemeDefaultScheme, *model.ResponseScheme, error)
+
+	// SetDefault sets the default workflow for a workflow scheme's draft.
+	//
+	// PUT /rest/api/{2-3}/workflowscheme/{id}/draft/default
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/workflow/scheme/draft#update-draft-default-workflow
+	SetDefault(ctx context.Context, schemeID int, workflowName string) (*model.WorkflowSchemeScheme, *model.ResponseScheme, error)
+
+	// DeleteDefault resets the default workflow for a workflow scheme's draft.
+	//
+	// That is, the default workflow is set to Jira's system workflow (the jira workflow).
+	//
+	// DELETE /rest/api/{2-3}/workflowscheme/{id}/draft/default
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/workflow/scheme/draft#delete-draft-default-workflow
+	DeleteDefault(ctx context.Context, schemeID int) (*model.WorkflowSchemeScheme, *model.ResponseScheme, error)
+
+	// IssueType returns the issue type-workflow mapping for an issue type in a workflow scheme's draft.
+	//
+	// GET /rest/api/{2-3}/workflowscheme/{id}/draft/issuetype/{issueType}
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/workflow/scheme/draft#get-workflow-for-issue-type-in-draft-workflow-scheme
+	IssueType(ctx context.Context, schemeID int, issueTypeID string) (*model.IssueTypeWorkflowMappingScheme, *model.ResponseScheme, error)
+
+	// SetIssueType sets the workflow for an issue type in a workflow scheme's draft.
+	//
+	// PUT /rest/api/{2-3}/workflowscheme/{id}/draft/issuetype/{issueType}
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/workflow/scheme/draft#set-workflow-for-issue-type-in-draft-workflow-scheme
+	SetIssueType(ctx context.Context, schemeID int, issueTypeID string, payload *model.IssueTypeWorkflowPayloadScheme) (*model.WorkflowSchemeScheme, *model.ResponseScheme, error)
+
+	// DeleteIssueType deletes the issue type-workflow mapping for an issue type in a workflow scheme's draft.
+	//
+	// DELETE /rest/api/{2-3}/workflowscheme/{id}/draft/issuetype/{issueType}
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/workflow/scheme/draft#delete-workflow-for-issue-type-in-draft-workflow-scheme
+	DeleteIssueType(ctx context.Context, schemeID int, issueTypeID string) (*model.WorkflowSchemeScheme, *model.ResponseScheme, error)
+
+	// Mapping returns the workflow-issue type mappings for a workflow scheme's draft.
+	//
+	// GET /rest/api/{2-3}/workflowscheme/{id}/draft/workflow
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/workflow/scheme/draft#get-issue-types-for-workflows-in-draft-workflow-scheme
+	Mapping(ctx context.Context, schemeID int, workflowName string) ([]*model.IssueTypesWorkflowMappingScheme, *model.ResponseScheme, error)
+}
+
 // WorkflowStatusConnector represents the workflows statuses.
 //
 // Use it to search, get, create, delete, and change statuses.
@@ -366,4 +468,34 @@ type WorkflowStatusConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/workflow/status#get-workflow-status
 	Get(ctx context.Context, idOrName string) (*model.StatusDetailScheme, *model.ResponseScheme, error)
+
+	// Categories returns a list of all status categories.
+	//
+	// GET /rest/api/{2-3}/statuscategory
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/workflow/status#get-all-status-categories
+	Categories(ctx context.Context) ([]*model.StatusCategoryScheme, *model.ResponseScheme, error)
+
+	// Category returns a status category.
+	//
+	// Status categories provided a mechanism for categorizing statuses.
+	//
+	// GET /rest/api/{2-3}/statuscategory/{idOrKey}
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/workflow/status#get-status-category
+	Category(ctx context.Context, idOrKey string) (*model.StatusCategoryScheme, *model.ResponseScheme, error)
+
+	// Usage returns the approximate number of issues in a status, in total and per project when projects are provided.
+	//
+	// POST /rest/api/{2-3}/search/approximate-count
+	Usage(ctx context.Context, status string, projectKeys []string) (*model.WorkflowStatusUsageScheme, error)
+
+	// Migrate transitions the issues in a status to another status, with a bounded number of issues migrated at the same time.
+	//
+	// The issues are searched before the first transition, the issues that can't be migrated are reported on the returned migration.
+	//
+	// POST /rest/api/{2-3}/search/jql
+	//
+	// POST /rest/api/{2-3}/issue/{issueKeyOrID}/transitions
+	Migrate(ctx context.Context, from, to string, options *model.WorkflowStatusMigrationOptionsScheme) (*model.WorkflowStatusMigrationScheme, error)
 }
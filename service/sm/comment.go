@@ -27,6 +27,13 @@ type CommentConnector interface {
 	// https://docs.go-atlassian.io/jira-service-management-cloud/request/comments#get-request-comment-by-id
 	Get(ctx context.Context, issueKeyOrID string, commentID int, expand []string) (*model.RequestCommentScheme, *model.ResponseScheme, error)
 
+	// GetWithAttachments returns a customer request's comment with its rendered body and attachments metadata in a single call.
+	//
+	// GET /rest/servicedeskapi/request/{issueKeyOrID}/comment/{commentID}?expand=attachment,renderedBody
+	//
+	// https://docs.go-atlassian.io/jira-service-management-cloud/request/comments#get-request-comment-by-id
+	GetWithAttachments(ctx context.Context, issueKeyOrID string, commentID int) (*model.RequestCommentScheme, *model.ResponseScheme, error)
+
 	// Create creates a public or private (internal) comment on a customer request, with the comment visibility set by public.
 	//
 	// POST /rest/servicedeskapi/request/{issueKeyOrID}/comment
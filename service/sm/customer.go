@@ -21,6 +21,22 @@ type CustomerConnector interface {
 	// https://docs.go-atlassian.io/jira-service-management-cloud/customer#create-customer
 	Create(ctx context.Context, email, displayName string) (*model.CustomerScheme, *model.ResponseScheme, error)
 
+	// Ensure returns the customer with the email provided, creating it when missing, and adds it to the service desk.
+	//
+	// When the account already exists on the site, the 409 conflict is handled by searching the users of the site by
+	// exact email address, so an existing account is added to the service desk even when it isn't a customer of it yet.
+	// An account whose email is hidden can't be matched and is reported with ErrCustomerNotFound, it's added by account
+	// ID with Add instead.
+	//
+	// POST /rest/servicedeskapi/customer
+	//
+	// GET /rest/api/3/user/search
+	//
+	// POST /rest/servicedeskapi/servicedesk/{serviceDeskId}/customer
+	//
+	// https://docs.go-atlassian.io/jira-service-management-cloud/customer#create-customer
+	Ensure(ctx context.Context, serviceDeskID, email, displayName string) (*model.CustomerScheme, *model.ResponseScheme, error)
+
 	// Gets  returns a list of the customers on a service desk.
 	//
 	// GET /rest/servicedeskapi/servicedesk/{serviceDeskID}/customer
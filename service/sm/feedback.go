@@ -10,6 +10,8 @@ type FeedbackConnector interface {
 
 	// Get retrieves a feedback of a request using it's request key or request id
 	//
+	// This endpoint is experimental, it requires the WithExperimentalAPIs client option.
+	//
 	// GET /rest/servicedeskapi/request/{requestIDOrKey}/feedback
 	//
 	// https://docs.go-atlassian.io/jira-service-management-cloud/request/feedback#get-feedback
@@ -17,6 +19,8 @@ type FeedbackConnector interface {
 
 	// Post adds a feedback on a request using its request key or request id
 	//
+	// This endpoint is experimental, it requires the WithExperimentalAPIs client option.
+	//
 	// POST /rest/servicedeskapi/request/{requestIDOrKey}/feedback
 	//
 	// https://docs.go-atlassian.io/jira-service-management-cloud/request/feedback#post-feedback
@@ -24,6 +28,8 @@ type FeedbackConnector interface {
 
 	// Delete deletes the feedback of request using its request key or request id
 	//
+	// This endpoint is experimental, it requires the WithExperimentalAPIs client option.
+	//
 	// DELETE /rest/servicedeskapi/request/{requestIDOrKey}/feedback
 	//
 	// https://docs.go-atlassian.io/jira-service-management-cloud/request/feedback#delete-feedback
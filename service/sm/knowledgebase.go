@@ -10,6 +10,8 @@ type KnowledgeBaseConnector interface {
 
 	// Search returns articles which match the given query string across all service desks.
 	//
+	// This endpoint is experimental, it requires the WithExperimentalAPIs client option.
+	//
 	// GET /rest/servicedeskapi/knowledgebase/article
 	//
 	// https://docs.go-atlassian.io/jira-service-management-cloud/knowledgebase#search-articles
@@ -17,6 +19,8 @@ type KnowledgeBaseConnector interface {
 
 	// Gets returns articles which match the given query string across all service desks.
 	//
+	// This endpoint is experimental, it requires the WithExperimentalAPIs client option.
+	//
 	// GET /rest/servicedeskapi/servicedesk/{serviceDeskID}/knowledgebase/article
 	//
 	// https://docs.go-atlassian.io/jira-service-management-cloud/knowledgebase#get-articles
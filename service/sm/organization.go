@@ -64,6 +64,15 @@ type OrganizationConnector interface {
 	// https://docs.go-atlassian.io/jira-service-management-cloud/organization#add-users-to-organization
 	Add(ctx context.Context, organizationID int, accountIDs []string) (*model.ResponseScheme, error)
 
+	// BulkAdd adds a large list of users to an organization.
+	//
+	// The account IDs are split in batches of 50 users, added with bounded concurrency, and the errors of the failed batches are joined.
+	//
+	// POST /rest/servicedeskapi/organization/{organizationID}/user
+	//
+	// https://docs.go-atlassian.io/jira-service-management-cloud/organization#add-users-to-organization
+	BulkAdd(ctx context.Context, organizationID int, accountIDs []string) error
+
 	// Remove removes users from an organization.
 	//
 	// DELETE /rest/servicedeskapi/organization/{organizationID}/user
@@ -17,6 +17,15 @@ type RequestConnector interface {
 	// https://docs.go-atlassian.io/jira-service-management/request#create-customer-request
 	Create(ctx context.Context, payload *model.CreateCustomerRequestPayloadScheme) (*model.CustomerRequestScheme, *model.ResponseScheme, error)
 
+	// CreateOnBehalfOf creates a customer request on behalf of the customer identified by email.
+	//
+	// The customer is looked up in the service desk of the payload and, when permitted by CreateCustomer, created and added to the service desk.
+	//
+	// POST /rest/servicedeskapi/request
+	//
+	// https://docs.go-atlassian.io/jira-service-management/request#create-customer-request
+	CreateOnBehalfOf(ctx context.Context, payload *model.CreateCustomerRequestPayloadScheme, customer *model.RequestOnBehalfOfScheme) (*model.CustomerRequestScheme, *model.ResponseScheme, error)
+
 	// Gets returns all customer requests for the user executing the query.
 	//
 	// The returned customer requests are ordered chronologically by the latest activity on each request. For example, the latest status transition or comment.
@@ -25,6 +25,8 @@ type TypeConnector interface {
 
 	// Create enables a customer request type to be added to a service desk based on an issue type.
 	//
+	// This endpoint is experimental, it requires the WithExperimentalAPIs client option.
+	//
 	// POST /rest/servicedeskapi/servicedesk/{serviceDeskID}/requesttype
 	//
 	// https://docs.go-atlassian.io/jira-service-management-cloud/request/types#create-request-type
@@ -39,6 +41,8 @@ type TypeConnector interface {
 
 	// Delete deletes a customer request type from a service desk, and removes it from all customer requests.
 	//
+	// This endpoint is experimental, it requires the WithExperimentalAPIs client option.
+	//
 	// DELETE /rest/servicedeskapi/servicedesk/{serviceDeskID}/requesttype/{requestTypeID}
 	//
 	// https://docs.go-atlassian.io/jira-service-management-cloud/request/types#delete-request-type
@@ -13,6 +13,8 @@ type WorkSpaceConnector interface {
 	//
 	// These assets may include knowledge base articles, request types, request fields, customer portals, queues, etc.
 	//
+	// This endpoint is experimental, it requires the WithExperimentalAPIs client option.
+	//
 	// GET /rest/servicedeskapi/assets/workspace
 	//
 	// https://docs.go-atlassian.io/jira-service-management/workspaces#get-workspaces